@@ -17,6 +17,11 @@ import (
 var (
 	kubeconfigPath string
 	contextName    string
+	contextNames   []string
+	snapshotPath   string
+	recordPath     string
+	replayPath     string
+	replaySpeed    float64
 	namespace      string
 	configPath     string
 )
@@ -34,7 +39,12 @@ Kubernetes clusters. It provides real-time monitoring and navigation of your clu
 	rootCmd.PersistentFlags().StringVar(&configPath, "config", "", "Path to config file (default: ~/.k8s-tui/config.yaml)")
 	rootCmd.Flags().StringVar(&kubeconfigPath, "kubeconfig", "", "Path to kubeconfig file")
 	rootCmd.Flags().StringVar(&contextName, "context", "", "Kubernetes context to use")
-	rootCmd.Flags().StringVarP(&namespace, "namespace", "n", "default", "Kubernetes namespace to use")
+	rootCmd.Flags().StringSliceVar(&contextNames, "contexts", nil, "Comma-separated kube contexts to view side by side (aggregated multi-cluster view)")
+	rootCmd.Flags().StringVar(&snapshotPath, "snapshot", "", "Browse a directory of manifests or a kubectl List dump instead of a live cluster")
+	rootCmd.Flags().StringVar(&recordPath, "record", "", "Record every watch event to this file")
+	rootCmd.Flags().StringVar(&replayPath, "replay", "", "Replay a recording made with --record instead of watching a cluster")
+	rootCmd.Flags().Float64Var(&replaySpeed, "replay-speed", 1, "Replay speed multiplier (0 replays without delays)")
+	rootCmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Kubernetes namespace to use (default: the context's namespace)")
 
 	// Add init-config subcommand
 	initConfigCmd := &cobra.Command{
@@ -63,23 +73,65 @@ func run(_ *cobra.Command, _ []string) error {
 		return fmt.Errorf("invalid configuration: %w", err)
 	}
 
-	// Create Kubernetes client
-	client, err := k8s.NewClient(kubeconfigPath, contextName, namespace)
-	if err != nil {
-		return fmt.Errorf("failed to create kubernetes client: %w", err)
+	if contextName != "" && len(contextNames) > 0 {
+		return fmt.Errorf("--context and --contexts cannot be used together")
 	}
 
-	// Test connection with configured timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
+	if snapshotPath != "" && (contextName != "" || len(contextNames) > 0) {
+		return fmt.Errorf("--snapshot cannot be combined with --context or --contexts")
+	}
 
-	if err := client.TestConnection(ctx); err != nil {
-		return fmt.Errorf("failed to connect to cluster: %w", err)
+	if replayPath != "" && (recordPath != "" || snapshotPath != "" || contextName != "" || len(contextNames) > 0) {
+		return fmt.Errorf("--replay cannot be combined with --record, --snapshot, --context or --contexts")
+	}
+	if recordPath != "" && len(contextNames) > 0 {
+		return fmt.Errorf("--record cannot be combined with --contexts")
+	}
+
+	var model app.Model
+	if replayPath != "" {
+		replayer, err := k8s.NewEventReplayer(replayPath, replaySpeed)
+		if err != nil {
+			return fmt.Errorf("failed to load recording: %w", err)
+		}
+		client, err := k8s.NewReplayClient(replayPath, replayer, namespace)
+		if err != nil {
+			return fmt.Errorf("failed to load recording: %w", err)
+		}
+		model = app.NewModelWithConfig(client, cfg)
+		model.SetReplayer(replayer)
+	} else if snapshotPath != "" {
+		client, err := k8s.NewSnapshotClient(snapshotPath, namespace)
+		if err != nil {
+			return fmt.Errorf("failed to load snapshot: %w", err)
+		}
+		model = app.NewModelWithConfig(client, cfg)
+	} else if len(contextNames) > 0 {
+		clients, err := newClusterClients(contextNames)
+		if err != nil {
+			return err
+		}
+		model = app.NewMultiClusterModelWithConfig(clients, cfg)
+	} else {
+		client, err := newConnectedClient(contextName)
+		if err != nil {
+			return err
+		}
+		model = app.NewModelWithConfig(client, cfg)
+	}
+
+	if recordPath != "" {
+		recorder, err := k8s.NewEventRecorder(recordPath)
+		if err != nil {
+			return err
+		}
+		defer recorder.Close()
+		model.SetRecorder(recorder)
 	}
 
 	// Create the Bubble Tea program with configuration
 	p := tea.NewProgram(
-		app.NewModelWithConfig(client, cfg),
+		model,
 		tea.WithAltScreen(),       // Use alternate screen buffer
 		tea.WithMouseCellMotion(), // Enable mouse support
 	)
@@ -92,6 +144,49 @@ func run(_ *cobra.Command, _ []string) error {
 	return nil
 }
 
+// newConnectedClient creates a Kubernetes client for the given context and verifies it can reach the cluster
+func newConnectedClient(contextName string) (*k8s.Client, error) {
+	client, err := k8s.NewClient(kubeconfigPath, contextName, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kubernetes client: %w", err)
+	}
+
+	// Test connection with configured timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := client.TestConnection(ctx); err != nil {
+		return nil, fmt.Errorf("failed to connect to cluster: %w", err)
+	}
+
+	return client, nil
+}
+
+// newClusterClients creates a connected client for each of the given contexts
+func newClusterClients(contextNames []string) ([]*k8s.Client, error) {
+	clients := make([]*k8s.Client, 0, len(contextNames))
+	seen := make(map[string]bool)
+
+	for _, name := range contextNames {
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		client, err := newConnectedClient(name)
+		if err != nil {
+			return nil, fmt.Errorf("context %s: %w", name, err)
+		}
+		clients = append(clients, client)
+	}
+
+	if len(clients) == 0 {
+		return nil, fmt.Errorf("no contexts specified")
+	}
+
+	return clients, nil
+}
+
 func initConfig(_ *cobra.Command, _ []string) error {
 	// Determine config path
 	cfgPath := configPath
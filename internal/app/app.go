@@ -3,13 +3,24 @@ package app
 import (
 	"context"
 	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/williajm/k8s-tui/internal/config"
 	"github.com/williajm/k8s-tui/internal/k8s"
@@ -27,6 +38,12 @@ const (
 	ViewModeLogStream
 	ViewModeDescribe
 	ViewModeContainerSelect
+	ViewModeValue           // Full value of a single configmap or revealed secret key
+	ViewModeAccessQuery     // RBAC access query panel
+	ViewModeNetworkPolicies // Effective network policy of the selected pod
+	ViewModeQuotas          // Resource quotas and limit ranges of the current namespace
+	ViewModeRevisions       // Revision history and pod template diff of a deployment or statefulset
+	ViewModeEditReview      // Diff and dry run of an object edited in $EDITOR
 )
 
 // Model represents the application state
@@ -41,10 +58,30 @@ type Model struct {
 	resourceList      *components.ResourceList
 	detailView        *components.DetailView
 	namespaceSelector *components.Selector
+	contextSelector   *components.Selector
+	resourceSelector  *components.Selector
 	logViewer         *components.LogViewer
 	describeViewer    *components.DescribeViewer
 	containerSelector *components.ContainerSelector
+	valueViewer       *components.ValueViewer
+	revisionViewer    *components.RevisionViewer
+	editReview        *components.EditReview
+	editTarget        *editTarget // Object being edited in $EDITOR
+	confirmDialog     *components.ConfirmDialog
+	accessQuery       *components.AccessQueryPanel
+	confirmAction     func(m Model) (tea.Model, tea.Cmd) // Run when the confirm dialog is accepted
+	deleteDialog      *components.DeleteDialog
+	deleteTarget      *deleteTarget // Object the delete dialog is shown for
+	deleteSeq         int           // Sequence number of the latest delete request, to drop stale results
+	scaleDialog       *components.ScaleDialog
+	scaleTarget       *workloadTarget // Workload the scale dialog is shown for
+	rolloutDialog     *components.RolloutDialog
+	rolloutTarget     *workloadTarget // Workload the rollout dialog is shown for
 	watchManager      *k8s.WatchManager
+	multiCluster      *k8s.MultiClusterWatcher   // Non-nil in aggregated multi-cluster mode
+	apiResources      map[string]k8s.APIResource // Discovered resources by display name
+	genericResource   *k8s.APIResource           // Resource shown in the generic tab, if chosen
+	genericRefresh    bool                       // A batched refresh of the generic table cells is scheduled
 	width             int
 	height            int
 	err               error
@@ -59,17 +96,267 @@ type Model struct {
 	logStreamActive   bool
 	previousViewMode  ViewMode
 	useWatchAPI       bool
+	selectedKey       int                                  // Highlighted key in the configmap or secret detail view
+	nodeAllocation    *models.NodeAllocation               // Allocated resources of the node in the detail view
+	pendingNode       *clusterObjectRef                    // Node to open once the node list has loaded
+	pendingVolume     *clusterObjectRef                    // Persistentvolume to open once the volume list has loaded
+	pendingTarget     *namespacedObjectRef                 // Deployment or statefulset to open once its list has loaded
+	pendingPod        *namespacedObjectRef                 // Pod to open once the pod list has loaded
+	cronJobJobs       []models.JobInfo                     // Jobs of the cronjob in the detail view; nil while loading
+	daemonSetNodes    []models.DaemonSetNodeStatus         // Daemon pod on each node in the detail view; nil while loading
+	ingressProblems   map[string]string                    // Missing services/ports of the ingress in the detail view; nil while checking
+	claimPods         []models.PodInfo                     // Pods mounting the persistentvolumeclaim in the detail view; nil while loading
+	workloadHPAs      []models.HorizontalPodAutoscalerInfo // HPAs scaling the deployment or statefulset in the detail view; nil while loading
+	workloadPDBs      []models.PodDisruptionBudgetInfo     // PDBs covering the deployment or statefulset in the detail view; nil while loading
+	workloadRevisions []models.RevisionInfo                // Revision history of the deployment or statefulset in the detail view; nil while loading
+	subjectBindings   []models.AccessGrant                 // Bindings of the serviceaccount in the detail view; nil while loading
+	serviceEndpoints  []models.EndpointInfo                // Endpoints of the service in the detail view; nil while loading
+	networkAnalysis   *models.PodNetworkAnalysis           // Network policies of the pod in the network policy view; nil while loading
+	namespaceQuotas   []models.ResourceQuotaInfo           // Resource quotas of the namespace in the quota view; nil while loading
+	limitRanges       []models.LimitRangeInfo              // Limit ranges of the namespace in the quota view; nil while loading
+	watchErrors       map[components.ResourceType]error    // Fatal error of each stopped watcher, shown while its tab is active
 }
 
 // Message types
 type resourcesLoadedMsg struct {
-	resourceType components.ResourceType
-	pods         []models.PodInfo
-	services     []models.ServiceInfo
-	deployments  []models.DeploymentInfo
-	statefulSets []models.StatefulSetInfo
-	events       []models.EventInfo
-	err          error
+	resourceType             components.ResourceType
+	pods                     []models.PodInfo
+	services                 []models.ServiceInfo
+	deployments              []models.DeploymentInfo
+	statefulSets             []models.StatefulSetInfo
+	events                   []models.EventInfo
+	configMaps               []models.ConfigMapInfo
+	secrets                  []models.SecretInfo
+	nodes                    []models.NodeInfo
+	jobs                     []models.JobInfo
+	cronJobs                 []models.CronJobInfo
+	daemonSets               []models.DaemonSetInfo
+	replicaSets              []models.ReplicaSetInfo
+	ingresses                []models.IngressInfo
+	persistentVolumeClaims   []models.PersistentVolumeClaimInfo
+	persistentVolumes        []models.PersistentVolumeInfo
+	storageClasses           []models.StorageClassInfo
+	horizontalPodAutoscalers []models.HorizontalPodAutoscalerInfo
+	serviceAccounts          []models.ServiceAccountInfo
+	roles                    []models.RoleInfo
+	clusterRoles             []models.RoleInfo
+	roleBindings             []models.RoleBindingInfo
+	clusterRoleBindings      []models.RoleBindingInfo
+	networkPolicies          []models.NetworkPolicyInfo
+	podDisruptionBudgets     []models.PodDisruptionBudgetInfo
+	generic                  []models.GenericResourceInfo
+	tableColumns             []string // Server-side table columns for generic resources
+	err                      error
+}
+
+// genericRefreshDelay batches generic watch events into one server-side table list
+const genericRefreshDelay = 500 * time.Millisecond
+
+// genericRefreshMsg fires once genericRefreshDelay has passed since the first batched generic event
+type genericRefreshMsg struct{}
+
+// genericCellsLoadedMsg carries freshly server-rendered table rows of the generic resource
+type genericCellsLoadedMsg struct {
+	resource k8s.APIResource
+	rows     []models.GenericResourceInfo
+}
+
+// nodeAllocationLoadedMsg carries the allocated resources of a node, summed from its pods
+type nodeAllocationLoadedMsg struct {
+	cluster    string
+	name       string
+	allocation models.NodeAllocation
+	err        error
+}
+
+// cronJobJobsLoadedMsg carries the jobs created by a cronjob
+type cronJobJobsLoadedMsg struct {
+	cluster   string
+	namespace string
+	name      string
+	jobs      []models.JobInfo
+	err       error
+}
+
+// daemonSetNodesLoadedMsg carries the daemon pod status on every node for a daemonset
+type daemonSetNodesLoadedMsg struct {
+	cluster   string
+	namespace string
+	name      string
+	nodes     []models.DaemonSetNodeStatus
+	err       error
+}
+
+// ingressProblemsLoadedMsg carries the backends of an ingress that point at a missing service or port
+type ingressProblemsLoadedMsg struct {
+	cluster   string
+	namespace string
+	name      string
+	problems  map[string]string
+	err       error
+}
+
+// claimPodsLoadedMsg carries the pods that mount a persistentvolumeclaim
+type claimPodsLoadedMsg struct {
+	cluster   string
+	namespace string
+	name      string
+	pods      []models.PodInfo
+	err       error
+}
+
+// workloadHPAsLoadedMsg carries the autoscalers that scale a deployment or statefulset
+type workloadHPAsLoadedMsg struct {
+	cluster     string
+	namespace   string
+	kind        string
+	name        string
+	autoscalers []models.HorizontalPodAutoscalerInfo
+	err         error
+}
+
+// workloadPDBsLoadedMsg carries the disruption budgets that cover the pods of a
+// deployment or statefulset
+type workloadPDBsLoadedMsg struct {
+	cluster   string
+	namespace string
+	kind      string
+	name      string
+	budgets   []models.PodDisruptionBudgetInfo
+	err       error
+}
+
+// workloadRevisionsLoadedMsg carries the revision history of a deployment or statefulset
+type workloadRevisionsLoadedMsg struct {
+	cluster   string
+	namespace string
+	kind      string
+	name      string
+	revisions []models.RevisionInfo
+	err       error
+}
+
+// deleteTarget is the object the delete dialog is shown for
+type deleteTarget struct {
+	kind     string
+	ref      namespacedObjectRef
+	resource *k8s.APIResource // Set for objects of the generic tab
+}
+
+// deleteResultMsg carries the outcome of a delete or of its dry run
+type deleteResultMsg struct {
+	seq    int
+	dryRun bool
+	err    error
+}
+
+// workloadTarget is the deployment, statefulset or daemonset a workload action is shown for
+type workloadTarget struct {
+	kind string
+	ref  namespacedObjectRef
+}
+
+// scaleResultMsg carries the outcome of a scale request
+type scaleResultMsg struct {
+	target workloadTarget
+	err    error
+}
+
+// rolloutResultMsg carries the outcome of a rollout restart, pause or resume
+type rolloutResultMsg struct {
+	target workloadTarget
+	err    error
+}
+
+// editTarget is the object being edited in $EDITOR
+type editTarget struct {
+	ref      namespacedObjectRef
+	resource k8s.APIResource
+	live     string // Editable YAML of the live object the edit is diffed against
+	edited   string // Content of the editor when it last closed
+	err      error  // Why the last edit could not be applied, shown when editing again
+}
+
+// editLoadedMsg carries the object to open in the editor
+type editLoadedMsg struct {
+	target  editTarget
+	content string
+	err     error
+}
+
+// editorClosedMsg is sent when the editor exits
+type editorClosedMsg struct {
+	path string
+	err  error
+}
+
+// editValidatedMsg carries the outcome of the server-side dry run of an edit
+type editValidatedMsg struct {
+	err error
+}
+
+// editAppliedMsg carries the outcome of applying an edit
+type editAppliedMsg struct {
+	err error
+}
+
+// serviceEndpointsLoadedMsg carries the endpoints that back a service
+type serviceEndpointsLoadedMsg struct {
+	cluster   string
+	namespace string
+	name      string
+	endpoints []models.EndpointInfo
+	err       error
+}
+
+// networkAnalysisLoadedMsg carries the effective network policy of a pod
+type networkAnalysisLoadedMsg struct {
+	cluster   string
+	namespace string
+	name      string
+	analysis  models.PodNetworkAnalysis
+	err       error
+}
+
+// namespaceQuotasLoadedMsg carries the resource quotas and limit ranges of a namespace
+type namespaceQuotasLoadedMsg struct {
+	namespace   string
+	quotas      []models.ResourceQuotaInfo
+	limitRanges []models.LimitRangeInfo
+	err         error
+}
+
+// subjectBindingsLoadedMsg carries the bindings that apply to a serviceaccount
+type subjectBindingsLoadedMsg struct {
+	cluster   string
+	namespace string
+	name      string
+	bindings  []models.AccessGrant
+	err       error
+}
+
+// accessAnsweredMsg carries the answer to an RBAC access query
+type accessAnsweredMsg struct {
+	answer models.AccessAnswer
+	err    error
+}
+
+// clusterObjectRef identifies a cluster-scoped object, such as a node, by source cluster and name
+type clusterObjectRef struct {
+	cluster string
+	name    string
+}
+
+// namespacedObjectRef identifies a namespaced object, such as a deployment, by source cluster, namespace and name
+type namespacedObjectRef struct {
+	cluster   string
+	namespace string
+	name      string
+}
+
+type apiResourcesLoadedMsg struct {
+	resources []k8s.APIResource
+	err       error
 }
 
 type namespacesLoadedMsg struct {
@@ -77,6 +364,11 @@ type namespacesLoadedMsg struct {
 	err        error
 }
 
+type contextSwitchedMsg struct {
+	client *k8s.Client
+	err    error
+}
+
 type logEntryMsg struct {
 	entry   models.LogEntry
 	nextCmd tea.Cmd
@@ -142,6 +434,12 @@ func NewModelWithConfig(client *k8s.Client, cfg *config.Config) Model {
 		client.GetNamespace(),
 		false, // Will be set to true after first successful load
 	)
+	switch {
+	case client.IsReplay():
+		header.SetMode("Replay")
+	case client.IsSnapshot():
+		header.SetMode("Snapshot")
+	}
 
 	// Create watch manager
 	watchManager := k8s.NewWatchManager(client)
@@ -160,9 +458,19 @@ func NewModelWithConfig(client *k8s.Client, cfg *config.Config) Model {
 		resourceList:      components.NewResourceList(components.ResourceTypePod),
 		detailView:        components.NewDetailView(),
 		namespaceSelector: components.NewSelector("Select Namespace"),
+		contextSelector:   components.NewSelector("Select Context"),
+		resourceSelector:  components.NewSelector("Select Resource Kind"),
 		logViewer:         nil, // Created on demand
 		describeViewer:    components.NewDescribeViewer(),
 		containerSelector: nil, // Created on demand
+		valueViewer:       components.NewValueViewer(),
+		revisionViewer:    components.NewRevisionViewer(),
+		editReview:        components.NewEditReview(),
+		confirmDialog:     components.NewConfirmDialog(),
+		deleteDialog:      components.NewDeleteDialog(),
+		scaleDialog:       components.NewScaleDialog(),
+		rolloutDialog:     components.NewRolloutDialog(),
+		accessQuery:       components.NewAccessQueryPanel(),
 		watchManager:      watchManager,
 		connected:         false,
 		loading:           true,
@@ -175,6 +483,62 @@ func NewModelWithConfig(client *k8s.Client, cfg *config.Config) Model {
 	}
 }
 
+// NewMultiClusterModelWithConfig creates a model that aggregates resources from
+// several kube contexts into one view, with a CLUSTER column identifying the source
+func NewMultiClusterModelWithConfig(clients []*k8s.Client, cfg *config.Config) Model {
+	m := NewModelWithConfig(clients[0], cfg)
+	m.multiCluster = k8s.NewMultiClusterWatcher(clients)
+	m.header.SetContext(strings.Join(m.multiCluster.GetContexts(), ","))
+	m.resourceList.SetShowCluster(true)
+	return m
+}
+
+// SetRecorder records every watch event the model receives to a file
+func (m *Model) SetRecorder(recorder *k8s.EventRecorder) {
+	m.watchManager.SetRecorder(recorder)
+}
+
+// SetReplayer makes the model replay a recording instead of watching the cluster
+func (m *Model) SetReplayer(replayer *k8s.EventReplayer) {
+	m.watchManager.SetReplayer(replayer)
+}
+
+// clients returns every client whose resources are shown
+func (m Model) clients() []*k8s.Client {
+	if m.multiCluster != nil {
+		return m.multiCluster.GetClients()
+	}
+	return []*k8s.Client{m.client}
+}
+
+// clientFor returns the client for the cluster a resource was loaded from
+func (m Model) clientFor(cluster string) *k8s.Client {
+	if m.multiCluster != nil {
+		if client := m.multiCluster.GetClient(cluster); client != nil {
+			return client
+		}
+	}
+	return m.client
+}
+
+// selectedReadOnly reports whether the cluster of the selected object cannot be written to,
+// such as a snapshot or replay
+func (m Model) selectedReadOnly() bool {
+	cluster, _, _, ok := m.resourceList.GetSelectedRef()
+	if !ok {
+		return m.client.IsReadOnly()
+	}
+	return m.clientFor(cluster).IsReadOnly()
+}
+
+// clusterLabel returns the cluster name to tag resources from client with (multi-cluster mode only)
+func (m Model) clusterLabel(client *k8s.Client) string {
+	if m.multiCluster != nil {
+		return client.GetCurrentContext()
+	}
+	return ""
+}
+
 // Init initializes the application
 func (m Model) Init() tea.Cmd {
 	if m.useWatchAPI {
@@ -198,10 +562,38 @@ func (m Model) Init() tea.Cmd {
 //
 //nolint:gocyclo,funlen // Complex state machine, acceptable for main update function
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
-	// Handle namespace selector first if visible
+	// Handle selectors first if visible
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.contextSelector.IsVisible() {
+		return m.handleContextSelector(keyMsg)
+	}
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.resourceSelector.IsVisible() {
+		return m.handleResourceSelector(keyMsg)
+	}
 	if m.namespaceSelector.IsVisible() {
 		return m.handleNamespaceSelector(msg)
 	}
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.confirmDialog.IsVisible() {
+		return m.handleConfirmDialog(keyMsg)
+	}
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.deleteDialog.IsVisible() {
+		return m.handleDeleteDialog(keyMsg)
+	}
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.scaleDialog.IsVisible() {
+		return m.handleScaleDialog(keyMsg)
+	}
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.rolloutDialog.IsVisible() {
+		return m.handleRolloutDialog(keyMsg)
+	}
+
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.viewMode == ViewModeAccessQuery {
+		return m.handleAccessQuery(keyMsg)
+	}
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.viewMode == ViewModeRevisions {
+		return m.handleRevisionViewer(keyMsg)
+	}
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.viewMode == ViewModeEditReview {
+		return m.handleEditReview(keyMsg)
+	}
 
 	// Handle search mode
 	if m.searchMode {
@@ -229,11 +621,21 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		m.resourceList.SetSize(m.width, remainingHeight)
 		m.detailView.SetSize(m.width, remainingHeight)
+		m.accessQuery.SetSize(m.width, remainingHeight)
+		m.valueViewer.SetSize(m.width, m.height)
+		m.revisionViewer.SetSize(m.width, m.height)
+		m.editReview.SetSize(m.width, m.height)
 
 		// Selector size
 		selectorWidth := minInt(m.width-10, 50)
 		selectorHeight := minInt(m.height-6, 20)
 		m.namespaceSelector.SetSize(selectorWidth, selectorHeight)
+		m.contextSelector.SetSize(selectorWidth, selectorHeight)
+		m.resourceSelector.SetSize(selectorWidth, selectorHeight)
+		m.confirmDialog.SetWidth(minInt(m.width-10, 60))
+		m.deleteDialog.SetWidth(minInt(m.width-10, 80))
+		m.scaleDialog.SetWidth(minInt(m.width-10, 70))
+		m.rolloutDialog.SetWidth(minInt(m.width-10, 80))
 
 	case resourcesLoadedMsg:
 		m.loading = false
@@ -242,7 +644,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.connected = false
 		} else {
 			m.connected = true
-			m.err = nil
+			m.err = m.activeWatchError()
 
 			// Update the appropriate resource list
 			switch msg.resourceType {
@@ -256,9 +658,242 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.resourceList.SetStatefulSets(msg.statefulSets)
 			case components.ResourceTypeEvent:
 				m.resourceList.SetEvents(msg.events)
+			case components.ResourceTypeConfigMap:
+				m.resourceList.SetConfigMaps(msg.configMaps)
+			case components.ResourceTypeSecret:
+				m.resourceList.SetSecrets(msg.secrets)
+			case components.ResourceTypeNode:
+				m.resourceList.SetNodes(msg.nodes)
+			case components.ResourceTypeJob:
+				m.resourceList.SetJobs(msg.jobs)
+			case components.ResourceTypeCronJob:
+				m.resourceList.SetCronJobs(msg.cronJobs)
+			case components.ResourceTypeDaemonSet:
+				m.resourceList.SetDaemonSets(msg.daemonSets)
+			case components.ResourceTypeReplicaSet:
+				m.resourceList.SetReplicaSets(msg.replicaSets)
+			case components.ResourceTypeIngress:
+				m.resourceList.SetIngresses(msg.ingresses)
+			case components.ResourceTypePersistentVolumeClaim:
+				m.resourceList.SetPersistentVolumeClaims(msg.persistentVolumeClaims)
+			case components.ResourceTypePersistentVolume:
+				m.resourceList.SetPersistentVolumes(msg.persistentVolumes)
+			case components.ResourceTypeStorageClass:
+				m.resourceList.SetStorageClasses(msg.storageClasses)
+			case components.ResourceTypeHorizontalPodAutoscaler:
+				m.resourceList.SetHorizontalPodAutoscalers(msg.horizontalPodAutoscalers)
+			case components.ResourceTypeServiceAccount:
+				m.resourceList.SetServiceAccounts(msg.serviceAccounts)
+			case components.ResourceTypeRole:
+				m.resourceList.SetRoles(msg.roles)
+			case components.ResourceTypeClusterRole:
+				m.resourceList.SetClusterRoles(msg.clusterRoles)
+			case components.ResourceTypeRoleBinding:
+				m.resourceList.SetRoleBindings(msg.roleBindings)
+			case components.ResourceTypeClusterRoleBinding:
+				m.resourceList.SetClusterRoleBindings(msg.clusterRoleBindings)
+			case components.ResourceTypeNetworkPolicy:
+				m.resourceList.SetNetworkPolicies(msg.networkPolicies)
+			case components.ResourceTypePodDisruptionBudget:
+				m.resourceList.SetPodDisruptionBudgets(msg.podDisruptionBudgets)
+			case components.ResourceTypeGeneric:
+				m.resourceList.SetGenericColumns(msg.tableColumns)
+				m.resourceList.SetGenericResources(msg.generic)
 			}
 		}
 		m.header.SetConnected(m.connected)
+		m.refreshWorkloadProgress()
+
+		if msg.resourceType == components.ResourceTypePod && m.pendingPod != nil {
+			m.openPendingPod()
+		}
+		if msg.resourceType == components.ResourceTypeNode && m.pendingNode != nil {
+			return m, m.openPendingNode()
+		}
+		if msg.resourceType == components.ResourceTypePersistentVolume && m.pendingVolume != nil {
+			m.openPendingVolume()
+		}
+		if (msg.resourceType == components.ResourceTypeDeployment || msg.resourceType == components.ResourceTypeStatefulSet) && m.pendingTarget != nil {
+			return m, m.openPendingTarget(msg.resourceType)
+		}
+
+	case nodeAllocationLoadedMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		if node := m.resourceList.GetSelectedNode(); node != nil && node.Cluster == msg.cluster && node.Name == msg.name {
+			m.nodeAllocation = &msg.allocation
+		}
+
+	case cronJobJobsLoadedMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		if cronJob := m.resourceList.GetSelectedCronJob(); cronJob != nil &&
+			cronJob.Cluster == msg.cluster && cronJob.Namespace == msg.namespace && cronJob.Name == msg.name {
+			m.cronJobJobs = msg.jobs
+		}
+
+	case daemonSetNodesLoadedMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		if daemonSet := m.resourceList.GetSelectedDaemonSet(); daemonSet != nil &&
+			daemonSet.Cluster == msg.cluster && daemonSet.Namespace == msg.namespace && daemonSet.Name == msg.name {
+			m.daemonSetNodes = msg.nodes
+		}
+
+	case ingressProblemsLoadedMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		if ingress := m.resourceList.GetSelectedIngress(); ingress != nil &&
+			ingress.Cluster == msg.cluster && ingress.Namespace == msg.namespace && ingress.Name == msg.name {
+			m.ingressProblems = msg.problems
+		}
+
+	case claimPodsLoadedMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		if claim := m.resourceList.GetSelectedPersistentVolumeClaim(); claim != nil &&
+			claim.Cluster == msg.cluster && claim.Namespace == msg.namespace && claim.Name == msg.name {
+			m.claimPods = msg.pods
+		}
+
+	case workloadHPAsLoadedMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		if m.isSelectedWorkload(msg.cluster, msg.namespace, msg.kind, msg.name) {
+			m.workloadHPAs = msg.autoscalers
+			if m.scaleDialog.IsVisible() {
+				m.scaleDialog.SetAutoscalers(msg.autoscalers)
+			}
+		}
+
+	case workloadPDBsLoadedMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		if m.isSelectedWorkload(msg.cluster, msg.namespace, msg.kind, msg.name) {
+			m.workloadPDBs = msg.budgets
+		}
+
+	case workloadRevisionsLoadedMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		if m.isSelectedWorkload(msg.cluster, msg.namespace, msg.kind, msg.name) {
+			m.workloadRevisions = msg.revisions
+			if m.viewMode == ViewModeRevisions {
+				m.revisionViewer.SetRevisions(msg.revisions)
+			}
+		}
+
+	case deleteResultMsg:
+		if msg.seq != m.deleteSeq || !m.deleteDialog.IsVisible() {
+			return m, nil
+		}
+		if msg.dryRun {
+			m.deleteDialog.SetDryRunResult(msg.err)
+			return m, nil
+		}
+		if msg.err != nil {
+			m.deleteDialog.SetDeleteFailed(msg.err)
+			return m, nil
+		}
+		m.deleteDialog.Hide()
+		m.deleteTarget = nil
+		if m.viewMode == ViewModeDetail {
+			m.viewMode = ViewModeList
+		}
+		m.loading = true
+		return m, m.loadResources()
+
+	case scaleResultMsg:
+		if m.scaleDialog.IsVisible() && m.scaleTarget != nil && *m.scaleTarget == msg.target {
+			m.scaleDialog.SetScaleResult(msg.err)
+			m.refreshScaleProgress()
+		}
+
+	case rolloutResultMsg:
+		if m.rolloutDialog.IsVisible() && m.rolloutTarget != nil && *m.rolloutTarget == msg.target {
+			m.rolloutDialog.SetActionResult(msg.err)
+			m.refreshRolloutProgress()
+		}
+
+	case serviceEndpointsLoadedMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		if service := m.resourceList.GetSelectedService(); service != nil &&
+			service.Cluster == msg.cluster && service.Namespace == msg.namespace && service.Name == msg.name {
+			m.serviceEndpoints = msg.endpoints
+		}
+
+	case networkAnalysisLoadedMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		if pod := m.resourceList.GetSelectedPod(); pod != nil &&
+			pod.Cluster == msg.cluster && pod.Namespace == msg.namespace && pod.Name == msg.name {
+			m.networkAnalysis = &msg.analysis
+		}
+
+	case namespaceQuotasLoadedMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		if m.viewMode == ViewModeQuotas && msg.namespace == m.client.GetNamespace() {
+			m.namespaceQuotas = msg.quotas
+			m.limitRanges = msg.limitRanges
+		}
+
+	case subjectBindingsLoadedMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		if serviceAccount := m.resourceList.GetSelectedServiceAccount(); serviceAccount != nil &&
+			serviceAccount.Cluster == msg.cluster && serviceAccount.Namespace == msg.namespace && serviceAccount.Name == msg.name {
+			m.subjectBindings = msg.bindings
+		}
+
+	case accessAnsweredMsg:
+		if msg.err != nil {
+			m.accessQuery.SetError(msg.err)
+			return m, nil
+		}
+		m.accessQuery.SetAnswer(msg.answer)
+
+	case apiResourcesLoadedMsg:
+		if msg.err != nil {
+			m.resourceSelector.Hide()
+			m.err = msg.err
+			return m, nil
+		}
+		m.apiResources = make(map[string]k8s.APIResource, len(msg.resources))
+		options := make([]string, len(msg.resources))
+		for i, resource := range msg.resources {
+			m.apiResources[resource.DisplayName()] = resource
+			options[i] = resource.DisplayName()
+		}
+		m.resourceSelector.SetOptions(options)
+		if m.genericResource != nil {
+			m.resourceSelector.SelectOption(m.genericResource.DisplayName())
+		}
 
 	case logStreamStartedMsg:
 		m.logStreamActive = true
@@ -289,6 +924,44 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.describeViewer.SetYAML(msg.yaml)
 		m.describeViewer.SetJSON(msg.json)
 
+	case editLoadedMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			m.editTarget = nil
+			return m, nil
+		}
+		m.editTarget = &msg.target
+		return m, m.runEditor(msg.content)
+
+	case editorClosedMsg:
+		return m.reviewEdit(msg)
+
+	case editValidatedMsg:
+		if m.viewMode != ViewModeEditReview || m.editTarget == nil {
+			return m, nil
+		}
+		if k8s.IsConflict(msg.err) {
+			return m, m.reloadConflictedEdit(msg.err)
+		}
+		m.editTarget.err = msg.err
+		m.editReview.SetValidated(msg.err)
+
+	case editAppliedMsg:
+		if m.viewMode != ViewModeEditReview || m.editTarget == nil {
+			return m, nil
+		}
+		if k8s.IsConflict(msg.err) {
+			return m, m.reloadConflictedEdit(msg.err)
+		}
+		if msg.err != nil {
+			m.editTarget.err = msg.err
+			m.editReview.SetValidated(msg.err)
+			return m, nil
+		}
+		m.editTarget = nil
+		m.viewMode = ViewModeDescribe
+		return m, m.loadDescribe()
+
 	case containersLoadedMsg:
 		if msg.err != nil {
 			m.err = msg.err
@@ -314,17 +987,48 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.namespaceSelector.SetOptions(msg.namespaces)
 		}
 
+	case contextSwitchedMsg:
+		if msg.err != nil {
+			m.loading = false
+			m.err = fmt.Errorf("failed to switch context: %w", msg.err)
+			return m, nil
+		}
+		return m, m.applyClient(msg.client)
+
 	case watchEventMsg:
 		// Handle watch events (ADDED, MODIFIED, DELETED)
+		staleCells := m.genericCellsStale(msg.event)
 		m.handleWatchEvent(msg.event)
 		// Continue waiting for more events
-		return m, m.waitForWatchEvents()
+		cmds := []tea.Cmd{m.waitForWatchEvents(), m.reloadStaleRevisions()}
+		if staleCells {
+			cmds = append(cmds, m.scheduleGenericRefresh())
+		}
+		return m, tea.Batch(cmds...)
+
+	case genericRefreshMsg:
+		m.genericRefresh = false
+		return m, m.loadGenericCells()
+
+	case genericCellsLoadedMsg:
+		if m.genericResource != nil && m.genericResource.DisplayName() == msg.resource.DisplayName() {
+			for _, row := range msg.rows {
+				m.resourceList.UpdateGenericCells(row.Cluster, row.Namespace, row.Name, row.Cells)
+			}
+		}
 
 	case watchErrorMsg:
-		// Handle watch errors
+		// Handle watch errors. Fatal errors (e.g. RBAC denying secrets) stop that watcher, so they
+		// are kept and shown whenever its tab is active, without hiding the other resource types.
 		if msg.error.Fatal {
-			m.err = msg.error.Err
-			m.connected = false
+			if m.watchErrors == nil {
+				m.watchErrors = make(map[components.ResourceType]error)
+			}
+			m.watchErrors[components.ResourceType(msg.error.ResourceType)] = msg.error.Err
+			if int(msg.error.ResourceType) == m.tabs.GetActiveTab() {
+				m.err = msg.error.Err
+				m.connected = false
+			}
 		}
 		// Continue waiting for more events even on non-fatal errors
 		return m, m.waitForWatchEvents()
@@ -379,6 +1083,12 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			}
 			m.viewMode = m.previousViewMode
 			return m, nil
+		case ViewModeValue:
+			m.closeValueViewer()
+			return m, nil
+		case ViewModeNetworkPolicies, ViewModeQuotas:
+			m.viewMode = m.previousViewMode
+			return m, nil
 		}
 	}
 
@@ -393,6 +1103,7 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 	case key.Matches(msg, m.keyMap.Refresh):
 		m.loading = true
+		m.retryWatch()
 		return m, m.loadResources()
 
 	case key.Matches(msg, m.keyMap.Namespace):
@@ -400,6 +1111,29 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.namespaceSelector.Show()
 		return m, m.loadNamespaces()
 
+	case key.Matches(msg, m.keyMap.Context):
+		// Contexts are fixed at startup in multi-cluster, snapshot and replay mode
+		if m.multiCluster != nil || m.client.IsReadOnly() {
+			return m, nil
+		}
+
+		// Show context selector
+		m.contextSelector.SetOptions(m.client.GetContexts())
+		m.contextSelector.SelectOption(m.client.GetCurrentContext())
+		m.contextSelector.Show()
+		return m, nil
+
+	case key.Matches(msg, m.keyMap.Resources):
+		// Choose the resource kind shown in the generic tab
+		return m, m.showResourceSelector()
+
+	case key.Matches(msg, m.keyMap.Access):
+		// Ask what a subject, or the current user, may do
+		if m.viewMode == ViewModeList || m.viewMode == ViewModeDetail {
+			m.openAccessQuery()
+			return m, nil
+		}
+
 	case key.Matches(msg, m.keyMap.Search):
 		// Enter search mode
 		m.searchMode = true
@@ -409,27 +1143,81 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case key.Matches(msg, m.keyMap.Tab):
 		// Next tab
 		m.tabs.NextTab()
-		m.resourceList.SetResourceType(components.ResourceType(m.tabs.GetActiveTab()))
-		m.viewMode = ViewModeList // Reset to list view when switching tabs
-		m.loading = true
-		return m, m.loadResources()
+		cmd := m.showActiveTab()
+		return m, cmd
 
 	case key.Matches(msg, m.keyMap.ShiftTab):
 		// Previous tab
 		m.tabs.PrevTab()
-		m.resourceList.SetResourceType(components.ResourceType(m.tabs.GetActiveTab()))
-		m.viewMode = ViewModeList // Reset to list view when switching tabs
-		m.loading = true
-		return m, m.loadResources()
+		cmd := m.showActiveTab()
+		return m, cmd
+
+	case key.Matches(msg, m.keyMap.NextView):
+		// Next view of a grouped tab, e.g. PVCs -> PVs in the Storage tab
+		if m.tabs.NextView() {
+			cmd := m.showActiveTab()
+			return m, cmd
+		}
+
+	case key.Matches(msg, m.keyMap.PrevView):
+		// Previous view of a grouped tab
+		if m.tabs.PrevView() {
+			cmd := m.showActiveTab()
+			return m, cmd
+		}
 
 	case key.Matches(msg, m.keyMap.Enter):
 		// Enter detail view (but not if we're in special modes that handle Enter themselves)
 		if m.viewMode == ViewModeList {
 			m.viewMode = ViewModeDetail
-			return m, nil
-		}
-		// Don't handle Enter here for other view modes - let them handle it
-		// (ViewModeContainerSelect, ViewModeDescribe, etc.)
+			m.selectedKey = 0
+			if service := m.resourceList.GetSelectedService(); service != nil {
+				m.serviceEndpoints = nil
+				return m, m.loadServiceEndpoints(service)
+			}
+			if node := m.resourceList.GetSelectedNode(); node != nil {
+				m.nodeAllocation = nil
+				return m, m.loadNodeAllocation(node.Cluster, node.Name)
+			}
+			if cronJob := m.resourceList.GetSelectedCronJob(); cronJob != nil {
+				m.cronJobJobs = nil
+				return m, m.loadCronJobJobs(cronJob)
+			}
+			if daemonSet := m.resourceList.GetSelectedDaemonSet(); daemonSet != nil {
+				m.daemonSetNodes = nil
+				return m, m.loadDaemonSetNodes(daemonSet)
+			}
+			if ingress := m.resourceList.GetSelectedIngress(); ingress != nil {
+				m.ingressProblems = nil
+				return m, m.loadIngressProblems(ingress)
+			}
+			if claim := m.resourceList.GetSelectedPersistentVolumeClaim(); claim != nil {
+				m.claimPods = nil
+				return m, m.loadClaimPods(claim)
+			}
+			if deployment := m.resourceList.GetSelectedDeployment(); deployment != nil {
+				return m, m.loadWorkloadDetails(deployment.Cluster, deployment.Namespace, "Deployment", deployment.Name,
+					deployment.Deployment.Spec.Template.Labels)
+			}
+			if statefulSet := m.resourceList.GetSelectedStatefulSet(); statefulSet != nil {
+				return m, m.loadWorkloadDetails(statefulSet.Cluster, statefulSet.Namespace, "StatefulSet", statefulSet.Name,
+					statefulSet.StatefulSet.Spec.Template.Labels)
+			}
+			if serviceAccount := m.resourceList.GetSelectedServiceAccount(); serviceAccount != nil {
+				m.subjectBindings = nil
+				return m, m.loadSubjectBindings(serviceAccount)
+			}
+			return m, nil
+		}
+		if m.viewMode == ViewModeDetail && m.tabs.GetActiveTab() == int(components.ResourceTypeConfigMap) {
+			m.openConfigMapValue()
+			return m, nil
+		}
+		if m.viewMode == ViewModeDetail && m.tabs.GetActiveTab() == int(components.ResourceTypeService) {
+			return m, m.goToEndpointPod()
+		}
+		// Don't handle Enter here for other view modes - let them handle it
+		// (ViewModeContainerSelect, ViewModeDescribe, etc.)
 
 	case key.Matches(msg, m.keyMap.Back):
 		// Handle back based on view mode
@@ -447,6 +1235,12 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		case ViewModeDescribe:
 			m.viewMode = m.previousViewMode
 			return m, nil
+		case ViewModeValue:
+			m.closeValueViewer()
+			return m, nil
+		case ViewModeNetworkPolicies, ViewModeQuotas:
+			m.viewMode = m.previousViewMode
+			return m, nil
 		}
 
 	case key.Matches(msg, m.keyMap.Logs):
@@ -456,7 +1250,7 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				pod := m.resourceList.GetSelectedPod()
 				if pod != nil {
 					m.previousViewMode = m.viewMode
-					return m, m.loadContainers(pod.Namespace, pod.Name)
+					return m, m.loadContainers(pod.Cluster, pod.Namespace, pod.Name)
 				}
 			}
 		}
@@ -466,14 +1260,95 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if m.viewMode == ViewModeDetail {
 			m.previousViewMode = m.viewMode
 			m.viewMode = ViewModeDescribe
+			_, editable := m.editableResource()
+			m.describeViewer.SetEditable(editable && !m.selectedReadOnly())
 			return m, m.loadDescribe()
 		}
 
+	case key.Matches(msg, m.keyMap.Node):
+		// Open the node the selected pod is scheduled on
+		if (m.viewMode == ViewModeList || m.viewMode == ViewModeDetail) && m.tabs.GetActiveTab() == int(components.ResourceTypePod) {
+			if pod := m.resourceList.GetSelectedPod(); pod != nil && pod.Node != "" {
+				return m, m.goToNode(pod.Cluster, pod.Node)
+			}
+		}
+
+	case key.Matches(msg, m.keyMap.Volume):
+		// Open the persistentvolume the selected claim is bound to
+		if (m.viewMode == ViewModeList || m.viewMode == ViewModeDetail) && m.tabs.GetActiveTab() == int(components.ResourceTypePersistentVolumeClaim) {
+			if claim := m.resourceList.GetSelectedPersistentVolumeClaim(); claim != nil && claim.Volume != "" {
+				return m, m.goToVolume(claim.Cluster, claim.Volume)
+			}
+		}
+
+	case key.Matches(msg, m.keyMap.Target):
+		// Open the deployment or statefulset the selected HPA scales
+		if (m.viewMode == ViewModeList || m.viewMode == ViewModeDetail) && m.tabs.GetActiveTab() == int(components.ResourceTypeHorizontalPodAutoscaler) {
+			if hpa := m.resourceList.GetSelectedHorizontalPodAutoscaler(); hpa != nil {
+				return m, m.goToScaleTarget(hpa)
+			}
+		}
+
+	case key.Matches(msg, m.keyMap.Policies):
+		// Show the network policies selecting the selected pod and the traffic they allow
+		if (m.viewMode == ViewModeList || m.viewMode == ViewModeDetail) && m.tabs.GetActiveTab() == int(components.ResourceTypePod) {
+			if pod := m.resourceList.GetSelectedPod(); pod != nil {
+				m.previousViewMode = m.viewMode
+				m.viewMode = ViewModeNetworkPolicies
+				m.networkAnalysis = nil
+				return m, m.loadNetworkAnalysis(pod)
+			}
+		}
+
+	case key.Matches(msg, m.keyMap.Quotas):
+		// Show the resource quota usage and limit ranges of the current namespace
+		if m.viewMode == ViewModeList || m.viewMode == ViewModeDetail {
+			m.previousViewMode = m.viewMode
+			m.viewMode = ViewModeQuotas
+			m.namespaceQuotas = nil
+			m.limitRanges = nil
+			return m, m.loadNamespaceQuotas()
+		}
+
+	case key.Matches(msg, m.keyMap.Delete):
+		// Confirm the delete of the selected object; snapshots and replays are read-only
+		if (m.viewMode == ViewModeList || m.viewMode == ViewModeDetail) && !m.selectedReadOnly() {
+			return m, m.openDeleteDialog()
+		}
+
+	case key.Matches(msg, m.keyMap.Scale):
+		// Set the replica count of the selected deployment or statefulset
+		if (m.viewMode == ViewModeList || m.viewMode == ViewModeDetail) && !m.selectedReadOnly() {
+			return m, m.openScaleDialog()
+		}
+
+	case key.Matches(msg, m.keyMap.History):
+		// Browse the revisions of the deployment or statefulset in the detail view
+		if m.viewMode == ViewModeDetail {
+			m.openRevisionViewer()
+			return m, nil
+		}
+
+	case key.Matches(msg, m.keyMap.Rollout):
+		// Restart, pause or resume the rollout of the selected workload
+		if (m.viewMode == ViewModeList || m.viewMode == ViewModeDetail) && !m.selectedReadOnly() {
+			m.openRolloutDialog()
+			return m, nil
+		}
+
+	case key.Matches(msg, m.keyMap.Reveal):
+		// Ask before decoding the highlighted secret key
+		if m.viewMode == ViewModeDetail && m.tabs.GetActiveTab() == int(components.ResourceTypeSecret) {
+			m.confirmRevealSecretKey()
+			return m, nil
+		}
+
 	case key.Matches(msg, m.keyMap.Events):
 		// Jump to Events tab
 		m.tabs.SetActiveTab(4)
 		m.resourceList.SetResourceType(components.ResourceTypeEvent)
 		m.viewMode = ViewModeList
+		m.valueViewer.Clear()
 		m.loading = true
 		return m, m.loadResources()
 	}
@@ -486,6 +1361,10 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.handleDescribeViewerKeys(msg)
 	case ViewModeContainerSelect:
 		return m.handleContainerSelectorKeys(msg)
+	case ViewModeValue:
+		var cmd tea.Cmd
+		m.valueViewer, cmd = m.valueViewer.Update(msg)
+		return m, cmd
 	}
 
 	// Don't process other keys if help is shown
@@ -516,334 +1395,2618 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 	}
 
-	return m, nil
-}
-
-// handleNamespaceSelector handles input when namespace selector is visible
-func (m Model) handleNamespaceSelector(msg tea.Msg) (tea.Model, tea.Cmd) {
-	switch msg := msg.(type) {
-	case tea.KeyMsg:
+	// Key selection in the configmap and secret detail views
+	if m.viewMode == ViewModeDetail {
 		switch {
 		case key.Matches(msg, m.keyMap.Up):
-			m.namespaceSelector.MoveUp()
+			if m.selectedKey > 0 {
+				m.selectedKey--
+			}
 
 		case key.Matches(msg, m.keyMap.Down):
-			m.namespaceSelector.MoveDown()
-
-		case key.Matches(msg, m.keyMap.Enter):
-			// Select namespace and reload resources
-			selectedNS := m.namespaceSelector.GetSelected()
-			if selectedNS != "" {
-				m.client.SetNamespace(selectedNS)
-				m.header = components.NewHeader(
-					m.client.GetCurrentContext(),
-					m.client.GetNamespace(),
-					m.connected,
-				)
-				m.namespaceSelector.Hide()
-				m.loading = true
-
-				// If using watch mode, restart watchers with new namespace
-				if m.useWatchAPI {
-					// Restart watch manager with new namespace
-					if err := m.watchManager.UpdateNamespace(selectedNS); err != nil {
-						m.err = fmt.Errorf("failed to switch namespace: %w", err)
-					}
-					return m, nil
-				}
-
-				// Otherwise use polling mode to reload resources
-				return m, m.loadResources()
+			if m.selectedKey < m.detailKeyCount()-1 {
+				m.selectedKey++
 			}
-
-		case key.Matches(msg, m.keyMap.Back), key.Matches(msg, m.keyMap.Quit):
-			// Cancel namespace selection
-			m.namespaceSelector.Hide()
-		}
-
-	case namespacesLoadedMsg:
-		if msg.err == nil && len(msg.namespaces) > 0 {
-			m.namespaceSelector.SetOptions(msg.namespaces)
 		}
 	}
 
 	return m, nil
 }
 
-// handleSearchMode handles input when in search mode
-func (m Model) handleSearchMode(msg tea.Msg) (tea.Model, tea.Cmd) {
-	if keyMsg, ok := msg.(tea.KeyMsg); ok {
-		switch keyMsg.Type {
-		case tea.KeyEsc:
-			// Exit search mode
-			m.searchMode = false
-			m.searchQuery = ""
-			m.resourceList.SetSearchFilter("")
-
-		case tea.KeyEnter:
-			// Apply search and exit search mode
-			m.searchMode = false
-			m.resourceList.SetSearchFilter(m.searchQuery)
+// openConfigMapValue shows the full value of the highlighted configmap key
+func (m *Model) openConfigMapValue() {
+	cm := m.resourceList.GetSelectedConfigMap()
+	if cm == nil || len(cm.Keys) == 0 {
+		return
+	}
+	if m.selectedKey >= len(cm.Keys) {
+		// Keys can disappear while the detail view is open
+		m.selectedKey = len(cm.Keys) - 1
+	}
 
-		case tea.KeyBackspace:
-			// Remove last character
-			if len(m.searchQuery) > 0 {
-				m.searchQuery = m.searchQuery[:len(m.searchQuery)-1]
-			}
+	key := cm.Keys[m.selectedKey]
+	size := models.FormatBytes(key.Size)
+	if key.Binary {
+		size = "binary, " + size
+	}
+	title := fmt.Sprintf("ConfigMap %s/%s: %s (%s)", cm.Namespace, cm.Name, key.Name, size)
+	m.valueViewer.SetValue(title, cm.KeyValue(key))
+	m.viewMode = ViewModeValue
+}
 
-		case tea.KeyRunes:
-			// Add character to search query
-			m.searchQuery += string(keyMsg.Runes)
+// detailKeyCount returns the number of selectable keys in the current detail view
+func (m Model) detailKeyCount() int {
+	switch components.ResourceType(m.tabs.GetActiveTab()) {
+	case components.ResourceTypeConfigMap:
+		if cm := m.resourceList.GetSelectedConfigMap(); cm != nil {
+			return len(cm.Keys)
 		}
+	case components.ResourceTypeSecret:
+		if secret := m.resourceList.GetSelectedSecret(); secret != nil {
+			return len(secret.Keys)
+		}
+	case components.ResourceTypeService:
+		return len(m.serviceEndpoints)
 	}
-
-	return m, nil
+	return 0
 }
 
-// View renders the UI
-func (m Model) View() string {
-	if m.width == 0 {
-		return "Loading..."
+// confirmRevealSecretKey asks for confirmation before showing the highlighted secret key
+func (m *Model) confirmRevealSecretKey() {
+	selected := m.resourceList.GetSelectedSecret()
+	if selected == nil || len(selected.Keys) == 0 {
+		return
+	}
+	if m.selectedKey >= len(selected.Keys) {
+		// Keys can disappear while the detail view is open
+		m.selectedKey = len(selected.Keys) - 1
 	}
 
-	// Show namespace selector if visible
-	if m.namespaceSelector.IsVisible() {
-		return m.viewNamespaceSelector()
+	secret := *selected
+	key := secret.Keys[m.selectedKey]
+	m.confirmDialog.Show(
+		"Reveal Secret",
+		fmt.Sprintf("Reveal key %s of secret %s/%s?", key.Name, secret.Namespace, secret.Name),
+	)
+	m.confirmAction = func(m Model) (tea.Model, tea.Cmd) {
+		title := fmt.Sprintf("Secret %s/%s: %s (%s)", secret.Namespace, secret.Name, key.Name, models.FormatBytes(key.Size))
+		m.valueViewer.SetValue(title, secret.RevealKey(key.Name))
+		m.viewMode = ViewModeValue
+		return m, nil
 	}
+}
 
-	// Show container selector if visible
-	if m.viewMode == ViewModeContainerSelect && m.containerSelector != nil && m.containerSelector.IsVisible() {
-		return m.viewContainerSelector()
+// showActiveTab shows the list of the tab or view that was just selected
+func (m *Model) showActiveTab() tea.Cmd {
+	m.resourceList.SetResourceType(components.ResourceType(m.tabs.GetActiveTab()))
+	m.viewMode = ViewModeList // Reset to list view when switching tabs
+	m.valueViewer.Clear()
+	if m.needsResourceSelection() {
+		return m.showResourceSelector()
 	}
+	m.loading = true
+	return m.loadResources()
+}
 
-	// Show help if requested
-	if m.showHelp {
-		return m.viewHelp()
+// goToEndpointPod switches to the Pods tab and opens the pod behind the highlighted
+// endpoint of the service in the detail view, once the pod list has loaded
+func (m *Model) goToEndpointPod() tea.Cmd {
+	service := m.resourceList.GetSelectedService()
+	if service == nil || m.selectedKey >= len(m.serviceEndpoints) {
+		return nil
+	}
+	endpoint := m.serviceEndpoints[m.selectedKey]
+	if endpoint.Pod == "" {
+		return nil
 	}
 
-	// Show error if present
-	if m.err != nil {
-		return m.viewError()
+	m.tabs.SetActiveTab(int(components.ResourceTypePod))
+	m.resourceList.SetResourceType(components.ResourceTypePod)
+	m.viewMode = ViewModeList
+	m.pendingPod = &namespacedObjectRef{cluster: service.Cluster, namespace: endpoint.Namespace, name: endpoint.Pod}
+	m.loading = true
+	return m.loadResources()
+}
+
+// openPendingPod shows the detail view of the pod requested by goToEndpointPod
+func (m *Model) openPendingPod() {
+	ref := m.pendingPod
+	m.pendingPod = nil
+	if m.resourceList.SelectPod(ref.cluster, ref.namespace, ref.name) {
+		m.viewMode = ViewModeDetail
 	}
+}
 
-	// Handle special view modes
-	switch m.viewMode {
-	case ViewModeLogStream:
-		if m.logViewer != nil {
-			return m.logViewer.View()
-		}
-		return "Log viewer not initialized"
+// goToNode switches to the Nodes tab and opens the given node once the list has loaded
+func (m *Model) goToNode(cluster, name string) tea.Cmd {
+	m.tabs.SetActiveTab(int(components.ResourceTypeNode))
+	m.resourceList.SetResourceType(components.ResourceTypeNode)
+	m.viewMode = ViewModeList
+	m.pendingNode = &clusterObjectRef{cluster: cluster, name: name}
+	m.loading = true
+	return m.loadResources()
+}
 
-	case ViewModeDescribe:
-		return m.describeViewer.View()
+// openPendingNode shows the detail view of the node requested by goToNode
+func (m *Model) openPendingNode() tea.Cmd {
+	ref := m.pendingNode
+	m.pendingNode = nil
+	if !m.resourceList.SelectNode(ref.cluster, ref.name) {
+		return nil
 	}
 
-	// Build the main view for list and detail modes
-	return m.renderMainView()
+	m.viewMode = ViewModeDetail
+	m.nodeAllocation = nil
+	return m.loadNodeAllocation(ref.cluster, ref.name)
 }
 
-// renderMainView renders the main view with header, tabs, content, and footer
-func (m Model) renderMainView() string {
-	header := m.header.View()
-	tabs := m.tabs.View()
-	footer := m.footer.View()
+// goToVolume switches to the PVs view of the Storage tab and opens the given persistentvolume once the list has loaded
+func (m *Model) goToVolume(cluster, name string) tea.Cmd {
+	m.tabs.SetActiveTab(int(components.ResourceTypePersistentVolume))
+	m.resourceList.SetResourceType(components.ResourceTypePersistentVolume)
+	m.viewMode = ViewModeList
+	m.pendingVolume = &clusterObjectRef{cluster: cluster, name: name}
+	m.loading = true
+	return m.loadResources()
+}
 
-	var mainContent string
-	if m.viewMode == ViewModeDetail {
-		mainContent = m.viewDetail()
-	} else {
-		mainContent = m.resourceList.View()
+// openPendingVolume shows the detail view of the persistentvolume requested by goToVolume
+func (m *Model) openPendingVolume() {
+	ref := m.pendingVolume
+	m.pendingVolume = nil
+	if m.resourceList.SelectPersistentVolume(ref.cluster, ref.name) {
+		m.viewMode = ViewModeDetail
 	}
+}
 
-	// Add search indicator if in search mode
-	if m.searchMode {
-		searchPrompt := fmt.Sprintf("Search: %s_", m.searchQuery)
-		mainContent = mainContent + "\n" + searchPrompt
+// goToScaleTarget switches to the tab of the workload an HPA scales and opens it once the list has loaded
+func (m *Model) goToScaleTarget(hpa *models.HorizontalPodAutoscalerInfo) tea.Cmd {
+	var resourceType components.ResourceType
+	switch hpa.TargetKind {
+	case "Deployment":
+		resourceType = components.ResourceTypeDeployment
+	case "StatefulSet":
+		resourceType = components.ResourceTypeStatefulSet
+	default:
+		m.err = fmt.Errorf("cannot open scale target %s: unsupported kind", hpa.Reference())
+		return nil
 	}
 
-	// Stack components vertically
-	view := header + "\n" + tabs + "\n" + mainContent + "\n" + footer
+	m.tabs.SetActiveTab(int(resourceType))
+	m.resourceList.SetResourceType(resourceType)
+	m.viewMode = ViewModeList
+	m.pendingTarget = &namespacedObjectRef{cluster: hpa.Cluster, namespace: hpa.Namespace, name: hpa.TargetName}
+	m.loading = true
+	return m.loadResources()
+}
 
-	return view
+// openPendingTarget shows the detail view of the workload requested by goToScaleTarget
+func (m *Model) openPendingTarget(resourceType components.ResourceType) tea.Cmd {
+	ref := m.pendingTarget
+	m.pendingTarget = nil
+
+	if resourceType == components.ResourceTypeStatefulSet {
+		if !m.resourceList.SelectStatefulSet(ref.cluster, ref.namespace, ref.name) {
+			return nil
+		}
+		m.viewMode = ViewModeDetail
+		podLabels := m.resourceList.GetSelectedStatefulSet().StatefulSet.Spec.Template.Labels
+		return m.loadWorkloadDetails(ref.cluster, ref.namespace, "StatefulSet", ref.name, podLabels)
+	}
+
+	if !m.resourceList.SelectDeployment(ref.cluster, ref.namespace, ref.name) {
+		return nil
+	}
+	m.viewMode = ViewModeDetail
+	podLabels := m.resourceList.GetSelectedDeployment().Deployment.Spec.Template.Labels
+	return m.loadWorkloadDetails(ref.cluster, ref.namespace, "Deployment", ref.name, podLabels)
 }
 
-// viewDetail renders the detail view based on current resource type
-func (m Model) viewDetail() string {
-	switch components.ResourceType(m.tabs.GetActiveTab()) {
-	case components.ResourceTypePod:
-		pod := m.resourceList.GetSelectedPod()
-		return m.detailView.ViewPod(pod)
+// loadWorkloadDetails resets and loads the autoscalers, disruption budgets and revision
+// history shown in the detail view of a deployment or statefulset
+func (m *Model) loadWorkloadDetails(cluster, namespace, kind, name string, podLabels map[string]string) tea.Cmd {
+	m.workloadHPAs = nil
+	m.workloadPDBs = nil
+	m.workloadRevisions = nil
+	return tea.Batch(
+		m.loadWorkloadHPAs(cluster, namespace, kind, name),
+		m.loadWorkloadPDBs(cluster, namespace, kind, name, podLabels),
+		m.loadWorkloadRevisions(cluster, namespace, kind, name),
+	)
+}
 
-	case components.ResourceTypeService:
-		service := m.resourceList.GetSelectedService()
-		return m.detailView.ViewService(service)
+// reloadStaleRevisions reloads the revision history of the deployment or statefulset
+// in the detail or revision view once the workload has moved to another revision,
+// e.g. after a rollout or rollback
+func (m *Model) reloadStaleRevisions() tea.Cmd {
+	if m.workloadRevisions == nil || (m.viewMode != ViewModeDetail && m.viewMode != ViewModeRevisions) {
+		return nil
+	}
+	var current *models.RevisionInfo
+	for i := range m.workloadRevisions {
+		if m.workloadRevisions[i].Current {
+			current = &m.workloadRevisions[i]
+		}
+	}
+	if current == nil {
+		return nil
+	}
 
+	switch components.ResourceType(m.tabs.GetActiveTab()) {
 	case components.ResourceTypeDeployment:
 		deployment := m.resourceList.GetSelectedDeployment()
-		return m.detailView.ViewDeployment(deployment)
-
+		if deployment == nil || deployment.Deployment == nil ||
+			deployment.Deployment.Annotations[models.RevisionAnnotation] == strconv.FormatInt(current.Revision, 10) {
+			return nil
+		}
+		m.workloadRevisions = nil
+		return m.loadWorkloadRevisions(deployment.Cluster, deployment.Namespace, "Deployment", deployment.Name)
 	case components.ResourceTypeStatefulSet:
 		statefulSet := m.resourceList.GetSelectedStatefulSet()
-		return m.detailView.ViewStatefulSet(statefulSet)
-
-	case components.ResourceTypeEvent:
-		event := m.resourceList.GetSelectedEvent()
-		return m.detailView.ViewEvent(event)
+		if statefulSet == nil || statefulSet.StatefulSet == nil || statefulSet.StatefulSet.Status.UpdateRevision == current.Name {
+			return nil
+		}
+		m.workloadRevisions = nil
+		return m.loadWorkloadRevisions(statefulSet.Cluster, statefulSet.Namespace, "StatefulSet", statefulSet.Name)
+	default:
+		return nil
+	}
+}
 
+// isSelectedWorkload reports whether the given deployment, statefulset or daemonset is
+// selected in the list
+func (m Model) isSelectedWorkload(cluster, namespace, kind, name string) bool {
+	switch kind {
+	case "Deployment":
+		deployment := m.resourceList.GetSelectedDeployment()
+		return deployment != nil && deployment.Cluster == cluster && deployment.Namespace == namespace && deployment.Name == name
+	case "StatefulSet":
+		statefulSet := m.resourceList.GetSelectedStatefulSet()
+		return statefulSet != nil && statefulSet.Cluster == cluster && statefulSet.Namespace == namespace && statefulSet.Name == name
+	case "DaemonSet":
+		daemonSet := m.resourceList.GetSelectedDaemonSet()
+		return daemonSet != nil && daemonSet.Cluster == cluster && daemonSet.Namespace == namespace && daemonSet.Name == name
 	default:
-		return "Unknown resource type"
+		return false
 	}
 }
 
-// viewNamespaceSelector renders the namespace selector
-func (m Model) viewNamespaceSelector() string {
-	// Render the selector centered on screen
-	selector := m.namespaceSelector.View()
+// openAccessQuery opens the RBAC access query panel, asking about the selected
+// serviceaccount if there is one
+func (m *Model) openAccessQuery() {
+	subject := ""
+	if m.tabs.GetActiveTab() == int(components.ResourceTypeServiceAccount) {
+		if serviceAccount := m.resourceList.GetSelectedServiceAccount(); serviceAccount != nil {
+			subject = "sa:" + serviceAccount.Namespace + "/" + serviceAccount.Name
+		}
+	}
+	m.accessQuery.Open(subject, m.client.GetNamespace())
+	m.previousViewMode = m.viewMode
+	m.viewMode = ViewModeAccessQuery
+}
 
-	return lipgloss.Place(
-		m.width,
-		m.height,
-		lipgloss.Center,
-		lipgloss.Center,
-		selector,
-		lipgloss.WithWhitespaceChars(" "),
-		lipgloss.WithWhitespaceForeground(lipgloss.AdaptiveColor{Light: "0", Dark: "0"}),
-		lipgloss.WithWhitespaceBackground(lipgloss.AdaptiveColor{Light: "0", Dark: "0"}),
-	)
+// handleAccessQuery handles input when the access query panel is open
+func (m Model) handleAccessQuery(keyMsg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch keyMsg.Type {
+	case tea.KeyCtrlC:
+		return m, tea.Quit
+	case tea.KeyEsc:
+		m.viewMode = m.previousViewMode
+	case tea.KeyTab, tea.KeyDown:
+		m.accessQuery.NextField()
+	case tea.KeyShiftTab, tea.KeyUp:
+		m.accessQuery.PrevField()
+	case tea.KeyCtrlT:
+		m.accessQuery.ToggleMode()
+	case tea.KeyBackspace:
+		m.accessQuery.DeleteChar()
+	case tea.KeyRunes, tea.KeySpace:
+		m.accessQuery.InsertText(string(keyMsg.Runes))
+	case tea.KeyEnter:
+		m.accessQuery.SetRunning()
+		return m, m.runAccessQuery()
+	}
+	return m, nil
 }
 
-// viewContainerSelector renders the container selector
-func (m Model) viewContainerSelector() string {
-	// Render the selector centered on screen
-	selector := m.containerSelector.View()
+// closeValueViewer returns to the detail view, clearing the value so a revealed
+// secret is masked again
+func (m *Model) closeValueViewer() {
+	m.valueViewer.Clear()
+	m.viewMode = ViewModeDetail
+}
 
-	return lipgloss.Place(
-		m.width,
-		m.height,
-		lipgloss.Center,
-		lipgloss.Center,
-		selector,
-		lipgloss.WithWhitespaceChars(" "),
-		lipgloss.WithWhitespaceForeground(lipgloss.AdaptiveColor{Light: "0", Dark: "0"}),
-		lipgloss.WithWhitespaceBackground(lipgloss.AdaptiveColor{Light: "0", Dark: "0"}),
-	)
+// handleConfirmDialog handles input when the confirm dialog is visible
+func (m Model) handleConfirmDialog(keyMsg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch keyMsg.String() {
+	case "y", "Y":
+		action := m.confirmAction
+		m.confirmDialog.Hide()
+		m.confirmAction = nil
+		if action != nil {
+			return action(m)
+		}
+
+	case "n", "N", "esc", "q":
+		m.confirmDialog.Hide()
+		m.confirmAction = nil
+
+	case "ctrl+c":
+		return m, tea.Quit
+	}
+
+	return m, nil
 }
 
-// viewHelp renders the help screen
-func (m Model) viewHelp() string {
-	header := m.header.View()
-	help := m.footer.ViewDetailed()
-	helpPrompt := "\nPress ? or ESC to close help"
+// openDeleteDialog shows the delete dialog for the selected object and starts the
+// dry run of its delete
+func (m *Model) openDeleteDialog() tea.Cmd {
+	cluster, namespace, name, ok := m.resourceList.GetSelectedRef()
+	if !ok {
+		return nil
+	}
 
-	view := header + "\n" + help + helpPrompt
+	resourceType := components.ResourceType(m.tabs.GetActiveTab())
+	target := &deleteTarget{
+		kind: k8s.ResourceType(resourceType).String(),
+		ref:  namespacedObjectRef{cluster: cluster, namespace: namespace, name: name},
+	}
+	if resourceType == components.ResourceTypeGeneric {
+		if m.genericResource == nil {
+			return nil
+		}
+		resource := *m.genericResource
+		target.kind = resource.Kind
+		target.resource = &resource
+	}
 
-	return view
+	m.deleteTarget = target
+	m.deleteDialog.Show(target.kind, namespace, name)
+	return m.runDelete(true)
 }
 
-// viewError renders an error message
-func (m Model) viewError() string {
-	header := m.header.View()
-	footer := m.footer.View()
+// runDelete deletes the target of the delete dialog with the chosen options, or only
+// runs the server-side dry run of the delete
+func (m *Model) runDelete(dryRun bool) tea.Cmd {
+	target := m.deleteTarget
+	if target == nil {
+		return nil
+	}
 
-	errorMsg := fmt.Sprintf(
-		"Error: %v\n\nPress 'r' to retry or 'q' to quit",
-		m.err,
+	opts := k8s.DeleteOptions{
+		PropagationPolicy: m.deleteDialog.PropagationPolicy(),
+		DryRun:            dryRun,
+	}
+	if seconds, ok := m.deleteDialog.GracePeriod(); ok {
+		opts.GracePeriodSeconds = &seconds
+	}
+
+	m.deleteSeq++
+	seq := m.deleteSeq
+	if dryRun {
+		m.deleteDialog.SetDryRunning()
+	} else {
+		m.deleteDialog.SetDeleting()
+	}
+
+	client := m.clientFor(target.ref.cluster)
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		var err error
+		if target.resource != nil {
+			err = client.DeleteDynamic(ctx, *target.resource, target.ref.namespace, target.ref.name, opts)
+		} else {
+			err = client.DeleteResource(ctx, target.kind, target.ref.namespace, target.ref.name, opts)
+		}
+		return deleteResultMsg{seq: seq, dryRun: dryRun, err: err}
+	}
+}
+
+// handleDeleteDialog handles input when the delete dialog is visible
+func (m Model) handleDeleteDialog(keyMsg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch keyMsg.String() {
+	case "p":
+		// Changing an option invalidates the dry run, so run it again
+		if !m.deleteDialog.IsDeleting() {
+			m.deleteDialog.NextPropagationPolicy()
+			return m, m.runDelete(true)
+		}
+
+	case "g":
+		if !m.deleteDialog.IsDeleting() {
+			m.deleteDialog.NextGracePeriod()
+			return m, m.runDelete(true)
+		}
+
+	case "y", "Y":
+		if m.deleteDialog.CanConfirm() {
+			return m, m.runDelete(false)
+		}
+
+	case "n", "N", "esc", "q":
+		m.deleteDialog.Hide()
+		m.deleteTarget = nil
+
+	case "ctrl+c":
+		return m, tea.Quit
+	}
+
+	return m, nil
+}
+
+// openScaleDialog shows the scale dialog for the selected deployment or statefulset
+// and looks for autoscalers that would override a manual scale
+func (m *Model) openScaleDialog() tea.Cmd {
+	var target workloadTarget
+	var replicas int32
+	switch components.ResourceType(m.tabs.GetActiveTab()) {
+	case components.ResourceTypeDeployment:
+		deployment := m.resourceList.GetSelectedDeployment()
+		if deployment == nil {
+			return nil
+		}
+		target = workloadTarget{kind: "Deployment", ref: namespacedObjectRef{cluster: deployment.Cluster, namespace: deployment.Namespace, name: deployment.Name}}
+		replicas = deployment.Replicas
+	case components.ResourceTypeStatefulSet:
+		statefulSet := m.resourceList.GetSelectedStatefulSet()
+		if statefulSet == nil {
+			return nil
+		}
+		target = workloadTarget{kind: "StatefulSet", ref: namespacedObjectRef{cluster: statefulSet.Cluster, namespace: statefulSet.Namespace, name: statefulSet.Name}}
+		replicas = statefulSet.Replicas
+	default:
+		return nil
+	}
+
+	m.scaleTarget = &target
+	m.scaleDialog.Show(target.kind, target.ref.namespace, target.ref.name, replicas)
+	m.refreshScaleProgress()
+	return m.loadWorkloadHPAs(target.ref.cluster, target.ref.namespace, target.kind, target.ref.name)
+}
+
+// refreshWorkloadProgress updates the scale and rollout dialogs from the live state of
+// their workload
+func (m *Model) refreshWorkloadProgress() {
+	m.refreshScaleProgress()
+	m.refreshRolloutProgress()
+}
+
+// refreshScaleProgress shows the live ready and current replica counts of the scaled
+// workload, as kept up to date by the watch
+func (m *Model) refreshScaleProgress() {
+	if !m.scaleDialog.IsVisible() || m.scaleTarget == nil {
+		return
+	}
+	target := m.scaleTarget
+	if !m.isSelectedWorkload(target.ref.cluster, target.ref.namespace, target.kind, target.ref.name) {
+		return
+	}
+
+	switch target.kind {
+	case "Deployment":
+		if deployment := m.resourceList.GetSelectedDeployment(); deployment.Deployment != nil {
+			m.scaleDialog.SetProgress(deployment.Deployment.Status.ReadyReplicas, deployment.Deployment.Status.Replicas)
+		}
+	case "StatefulSet":
+		if statefulSet := m.resourceList.GetSelectedStatefulSet(); statefulSet.StatefulSet != nil {
+			m.scaleDialog.SetProgress(statefulSet.StatefulSet.Status.ReadyReplicas, statefulSet.StatefulSet.Status.Replicas)
+		}
+	}
+}
+
+// scaleWorkload sets the replica count of the target of the scale dialog
+func (m Model) scaleWorkload(target workloadTarget, replicas int32) tea.Cmd {
+	client := m.clientFor(target.ref.cluster)
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		err := client.ScaleWorkload(ctx, target.kind, target.ref.namespace, target.ref.name, replicas)
+		return scaleResultMsg{target: target, err: err}
+	}
+}
+
+// handleScaleDialog handles input when the scale dialog is visible
+func (m Model) handleScaleDialog(keyMsg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch keyMsg.Type {
+	case tea.KeyCtrlC:
+		return m, tea.Quit
+	case tea.KeyEsc:
+		m.scaleDialog.Hide()
+		m.scaleTarget = nil
+		return m, nil
+	}
+	if !m.scaleDialog.IsEditing() || m.scaleTarget == nil {
+		return m, nil
+	}
+
+	switch keyMsg.Type {
+	case tea.KeyUp:
+		m.scaleDialog.Increment()
+	case tea.KeyDown:
+		m.scaleDialog.Decrement()
+	case tea.KeyBackspace:
+		m.scaleDialog.DeleteChar()
+	case tea.KeyRunes:
+		switch text := string(keyMsg.Runes); text {
+		case "+":
+			m.scaleDialog.Increment()
+		case "-":
+			m.scaleDialog.Decrement()
+		default:
+			m.scaleDialog.InsertText(text)
+		}
+	case tea.KeyEnter:
+		replicas, err := m.scaleDialog.Replicas()
+		if err != nil {
+			m.scaleDialog.SetScaleResult(err)
+			return m, nil
+		}
+		m.scaleDialog.SetScaling(replicas)
+		return m, m.scaleWorkload(*m.scaleTarget, replicas)
+	}
+
+	return m, nil
+}
+
+// openRolloutDialog shows the rollout dialog for the selected deployment, statefulset
+// or daemonset
+func (m *Model) openRolloutDialog() {
+	var target workloadTarget
+	switch components.ResourceType(m.tabs.GetActiveTab()) {
+	case components.ResourceTypeDeployment:
+		deployment := m.resourceList.GetSelectedDeployment()
+		if deployment == nil {
+			return
+		}
+		target = workloadTarget{kind: "Deployment", ref: namespacedObjectRef{cluster: deployment.Cluster, namespace: deployment.Namespace, name: deployment.Name}}
+	case components.ResourceTypeStatefulSet:
+		statefulSet := m.resourceList.GetSelectedStatefulSet()
+		if statefulSet == nil {
+			return
+		}
+		target = workloadTarget{kind: "StatefulSet", ref: namespacedObjectRef{cluster: statefulSet.Cluster, namespace: statefulSet.Namespace, name: statefulSet.Name}}
+	case components.ResourceTypeDaemonSet:
+		daemonSet := m.resourceList.GetSelectedDaemonSet()
+		if daemonSet == nil {
+			return
+		}
+		target = workloadTarget{kind: "DaemonSet", ref: namespacedObjectRef{cluster: daemonSet.Cluster, namespace: daemonSet.Namespace, name: daemonSet.Name}}
+	default:
+		return
+	}
+
+	m.rolloutTarget = &target
+	m.rolloutDialog.Show(target.kind, target.ref.namespace, target.ref.name, models.RolloutStatus{})
+	m.refreshRolloutProgress()
+}
+
+// refreshRolloutProgress shows the live rollout status of the workload in the rollout
+// dialog, as kept up to date by the watch
+func (m *Model) refreshRolloutProgress() {
+	if !m.rolloutDialog.IsVisible() || m.rolloutTarget == nil {
+		return
+	}
+	target := m.rolloutTarget
+	if !m.isSelectedWorkload(target.ref.cluster, target.ref.namespace, target.kind, target.ref.name) {
+		return
+	}
+
+	switch target.kind {
+	case "Deployment":
+		if deployment := m.resourceList.GetSelectedDeployment(); deployment.Deployment != nil {
+			m.rolloutDialog.SetStatus(models.NewDeploymentRolloutStatus(deployment.Deployment))
+		}
+	case "StatefulSet":
+		if statefulSet := m.resourceList.GetSelectedStatefulSet(); statefulSet.StatefulSet != nil {
+			m.rolloutDialog.SetStatus(models.NewStatefulSetRolloutStatus(statefulSet.StatefulSet))
+		}
+	case "DaemonSet":
+		if daemonSet := m.resourceList.GetSelectedDaemonSet(); daemonSet.DaemonSet != nil {
+			m.rolloutDialog.SetStatus(models.NewDaemonSetRolloutStatus(daemonSet.DaemonSet))
+		}
+	}
+}
+
+// runRolloutAction restarts, pauses or resumes the rollout of a workload
+func (m Model) runRolloutAction(target workloadTarget, action components.RolloutAction) tea.Cmd {
+	client := m.clientFor(target.ref.cluster)
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		var err error
+		switch action {
+		case components.RolloutRestart:
+			err = client.RestartRollout(ctx, target.kind, target.ref.namespace, target.ref.name)
+		case components.RolloutPause:
+			err = client.SetRolloutPaused(ctx, target.kind, target.ref.namespace, target.ref.name, true)
+		case components.RolloutResume:
+			err = client.SetRolloutPaused(ctx, target.kind, target.ref.namespace, target.ref.name, false)
+		}
+		return rolloutResultMsg{target: target, err: err}
+	}
+}
+
+// handleRolloutDialog handles input when the rollout dialog is visible
+func (m Model) handleRolloutDialog(keyMsg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch keyMsg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc", "q":
+		m.rolloutDialog.Hide()
+		m.rolloutTarget = nil
+		return m, nil
+	}
+	if !m.rolloutDialog.IsChoosing() || m.rolloutTarget == nil {
+		return m, nil
+	}
+
+	var action components.RolloutAction
+	switch pressed := keyMsg.String(); {
+	case pressed == "r":
+		action = components.RolloutRestart
+	case pressed == "p" && m.rolloutDialog.CanPause():
+		action = components.RolloutPause
+	case pressed == "u" && m.rolloutDialog.CanResume():
+		action = components.RolloutResume
+	default:
+		return m, nil
+	}
+
+	m.rolloutDialog.SetRunning(action)
+	return m, m.runRolloutAction(*m.rolloutTarget, action)
+}
+
+// openRevisionViewer shows the revision history of the deployment or statefulset in
+// the detail view
+func (m *Model) openRevisionViewer() {
+	switch components.ResourceType(m.tabs.GetActiveTab()) {
+	case components.ResourceTypeDeployment:
+		deployment := m.resourceList.GetSelectedDeployment()
+		if deployment == nil {
+			return
+		}
+		m.revisionViewer.Open("Deployment", deployment.Namespace, deployment.Name)
+	case components.ResourceTypeStatefulSet:
+		statefulSet := m.resourceList.GetSelectedStatefulSet()
+		if statefulSet == nil {
+			return
+		}
+		m.revisionViewer.Open("StatefulSet", statefulSet.Namespace, statefulSet.Name)
+	default:
+		return
+	}
+
+	// Revisions still loading are shown once they arrive
+	if m.workloadRevisions != nil {
+		m.revisionViewer.SetRevisions(m.workloadRevisions)
+	}
+	m.viewMode = ViewModeRevisions
+}
+
+// handleRevisionViewer handles input when the revision viewer is open
+func (m Model) handleRevisionViewer(keyMsg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch keyMsg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc", "q", "left", "h", "backspace":
+		m.viewMode = ViewModeDetail
+	case "up", "k":
+		m.revisionViewer.MoveUp()
+	case "down", "j":
+		m.revisionViewer.MoveDown()
+	case "pgup", "ctrl+u":
+		m.revisionViewer.ScrollUp()
+	case "pgdown", "ctrl+d":
+		m.revisionViewer.ScrollDown()
+	case "b":
+		m.revisionViewer.SetBase()
+	case "u":
+		// Snapshots and replays are read-only
+		if !m.selectedReadOnly() {
+			m.confirmRollback()
+		}
+	}
+	return m, nil
+}
+
+// confirmRollback asks before restoring the pod template of the revision selected in
+// the revision viewer, then follows the rollout in the rollout dialog
+func (m *Model) confirmRollback() {
+	selected := m.revisionViewer.Selected()
+	if selected == nil || selected.Current || selected.Template == nil {
+		return
+	}
+
+	var target workloadTarget
+	switch components.ResourceType(m.tabs.GetActiveTab()) {
+	case components.ResourceTypeDeployment:
+		deployment := m.resourceList.GetSelectedDeployment()
+		if deployment == nil {
+			return
+		}
+		target = workloadTarget{kind: "Deployment", ref: namespacedObjectRef{cluster: deployment.Cluster, namespace: deployment.Namespace, name: deployment.Name}}
+	case components.ResourceTypeStatefulSet:
+		statefulSet := m.resourceList.GetSelectedStatefulSet()
+		if statefulSet == nil {
+			return
+		}
+		target = workloadTarget{kind: "StatefulSet", ref: namespacedObjectRef{cluster: statefulSet.Cluster, namespace: statefulSet.Namespace, name: statefulSet.Name}}
+	default:
+		return
+	}
+
+	revision := selected.Revision
+	template := selected.Template
+	m.confirmDialog.Show(
+		"Roll Back "+target.kind,
+		fmt.Sprintf("Roll back %s/%s to revision %d (%s)?", target.ref.namespace, target.ref.name, revision, selected.Images),
 	)
+	m.confirmAction = func(m Model) (tea.Model, tea.Cmd) {
+		m.viewMode = ViewModeDetail
+		m.rolloutTarget = &target
+		m.rolloutDialog.Show(target.kind, target.ref.namespace, target.ref.name, models.RolloutStatus{})
+		m.refreshRolloutProgress()
+		m.rolloutDialog.SetRunning(components.RolloutUndo)
+		return m, m.rollbackWorkload(target, template)
+	}
+}
 
-	view := header + "\n" + errorMsg + "\n" + footer
+// rollbackWorkload restores the pod template of an earlier revision of a workload
+func (m Model) rollbackWorkload(target workloadTarget, template *corev1.PodTemplateSpec) tea.Cmd {
+	client := m.clientFor(target.ref.cluster)
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		err := client.RollbackWorkload(ctx, target.kind, target.ref.namespace, target.ref.name, template)
+		return rolloutResultMsg{target: target, err: err}
+	}
+}
+
+// editHeaderLines explain the edit at the top of the file opened in the editor
+var editHeaderLines = []string{
+	"Please edit the object below. Lines beginning with a '#' are ignored,",
+	"and an empty file aborts the edit. On save, the changes are shown as a diff",
+	"against the live object and validated with a server-side dry run before",
+	"they are applied. Status and managed fields are left out.",
+}
+
+// editableResource returns the API resource used to edit the object in the describe
+// view; secrets and events cannot be edited
+func (m Model) editableResource() (k8s.APIResource, bool) {
+	resourceType := components.ResourceType(m.tabs.GetActiveTab())
+	if resourceType == components.ResourceTypeGeneric {
+		if m.genericResource == nil || !m.genericResource.IsEditable() {
+			return k8s.APIResource{}, false
+		}
+		return *m.genericResource, true
+	}
+	return k8s.EditableResource(k8s.ResourceType(resourceType).String())
+}
+
+// openEditor loads the object in the describe view and opens it in $EDITOR
+func (m *Model) openEditor() tea.Cmd {
+	resource, ok := m.editableResource()
+	if !ok {
+		return nil
+	}
+	cluster, namespace, name, ok := m.resourceList.GetSelectedRef()
+	if !ok {
+		return nil
+	}
+
+	target := editTarget{ref: namespacedObjectRef{cluster: cluster, namespace: namespace, name: name}, resource: resource}
+	client := m.clientFor(cluster)
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		live, err := client.GetEditableYAML(ctx, resource, namespace, name)
+		if err != nil {
+			return editLoadedMsg{err: err}
+		}
+		target.live = live
+		return editLoadedMsg{target: target, content: editHeader(nil) + live}
+	}
+}
+
+// reloadConflictedEdit reopens an edit that conflicted with a change made since the
+// object was read. The edit is rebased on the latest resourceVersion and diffed
+// against the latest object, so the other change shows up in the diff before the
+// edit can overwrite it.
+func (m Model) reloadConflictedEdit(conflict error) tea.Cmd {
+	target := *m.editTarget
+	client := m.clientFor(target.ref.cluster)
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		live, err := client.GetEditableYAML(ctx, target.resource, target.ref.namespace, target.ref.name)
+		if err != nil {
+			return editLoadedMsg{err: err}
+		}
+		latest, err := k8s.ParseEditedYAML(target.resource, target.ref.name, live)
+		if err != nil {
+			return editLoadedMsg{err: err}
+		}
+		edited, err := k8s.ParseEditedYAML(target.resource, target.ref.name, target.edited)
+		if err != nil {
+			return editLoadedMsg{err: err}
+		}
+		edited.SetResourceVersion(latest.GetResourceVersion())
+		rebased, err := k8s.EditableYAML(edited)
+		if err != nil {
+			return editLoadedMsg{err: err}
+		}
+
+		target.live = live
+		target.err = fmt.Errorf("%w\nThe object was changed while you edited it. Your edit now applies to the latest "+
+			"version: review the diff against it before applying", conflict)
+		return editLoadedMsg{target: target, content: editHeader(target.err) + rebased}
+	}
+}
+
+// editHeader returns the comment block at the top of the edited file, with the error
+// that stopped the previous attempt, if any
+func editHeader(err error) string {
+	var b strings.Builder
+	for _, line := range editHeaderLines {
+		b.WriteString("# " + line + "\n")
+	}
+	if err != nil {
+		b.WriteString("#\n")
+		for i, line := range strings.Split(err.Error(), "\n") {
+			if i == 0 {
+				line = "error: " + line
+			}
+			b.WriteString("# " + line + "\n")
+		}
+	}
+	b.WriteString("#\n")
+	return b.String()
+}
+
+// editorCommand returns the command that opens a file in the user's editor:
+// $KUBE_EDITOR or $EDITOR, like kubectl edit, falling back to vi
+func editorCommand(path string) *exec.Cmd {
+	editor := os.Getenv("KUBE_EDITOR")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	args := strings.Fields(editor)
+	if len(args) == 0 {
+		args = []string{"vi"}
+	}
+	return exec.Command(args[0], append(args[1:], path)...) //nolint:gosec // The editor is chosen by the user
+}
+
+// runEditor writes content to a temporary file and opens it in the editor, suspending
+// the UI until the editor exits
+func (m Model) runEditor(content string) tea.Cmd {
+	file, err := os.CreateTemp("", "k8s-tui-edit-*.yaml")
+	if err != nil {
+		return func() tea.Msg {
+			return editorClosedMsg{err: fmt.Errorf("failed to create edit file: %w", err)}
+		}
+	}
+	path := file.Name()
+	_, err = file.WriteString(content)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return func() tea.Msg {
+			return editorClosedMsg{path: path, err: fmt.Errorf("failed to write edit file: %w", err)}
+		}
+	}
+
+	return tea.ExecProcess(editorCommand(path), func(err error) tea.Msg {
+		if err != nil {
+			err = fmt.Errorf("failed to run editor: %w", err)
+		}
+		return editorClosedMsg{path: path, err: err}
+	})
+}
+
+// reviewEdit shows the diff of the edited object against the live object once the
+// editor exits, and validates the edit with a server-side dry run
+func (m Model) reviewEdit(msg editorClosedMsg) (tea.Model, tea.Cmd) {
+	var content []byte
+	err := msg.err
+	if msg.path != "" {
+		if err == nil {
+			content, err = os.ReadFile(msg.path)
+			if err != nil {
+				err = fmt.Errorf("failed to read edit file: %w", err)
+			}
+		}
+		_ = os.Remove(msg.path)
+	}
+	if m.editTarget == nil {
+		return m, nil
+	}
+
+	target := m.editTarget
+	kind := target.resource.Kind
+	m.viewMode = ViewModeEditReview
+	if err != nil {
+		target.err = err
+		m.editReview.Show(kind, target.ref.namespace, target.ref.name, nil)
+		m.editReview.SetInvalid(err)
+		return m, nil
+	}
+
+	target.edited = string(content)
+	if isBlankEdit(target.edited) {
+		m.editTarget = nil
+		m.viewMode = ViewModeDescribe
+		return m, nil
+	}
+
+	obj, err := k8s.ParseEditedYAML(target.resource, target.ref.name, target.edited)
+	if err != nil {
+		target.err = err
+		m.editReview.Show(kind, target.ref.namespace, target.ref.name, models.DiffLines(target.live, target.edited))
+		m.editReview.SetInvalid(err)
+		return m, nil
+	}
+	// Diff the re-rendered object, so comments and formatting are not shown as changes
+	edited, err := k8s.EditableYAML(obj)
+	if err != nil {
+		target.err = err
+		m.editReview.Show(kind, target.ref.namespace, target.ref.name, nil)
+		m.editReview.SetInvalid(err)
+		return m, nil
+	}
+
+	diff := models.DiffLines(target.live, edited)
+	m.editReview.Show(kind, target.ref.namespace, target.ref.name, diff)
+	if !models.HasChanges(diff) {
+		return m, nil
+	}
+	return m, m.updateEdited(true)
+}
+
+// isBlankEdit reports whether an edited file holds nothing but comments
+func isBlankEdit(content string) bool {
+	for _, line := range strings.Split(content, "\n") {
+		if trimmed := strings.TrimSpace(line); trimmed != "" && !strings.HasPrefix(trimmed, "#") {
+			return false
+		}
+	}
+	return true
+}
+
+// updateEdited validates the edit with a server-side dry run, or applies it
+func (m Model) updateEdited(dryRun bool) tea.Cmd {
+	target := *m.editTarget
+	client := m.clientFor(target.ref.cluster)
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		obj, err := k8s.ParseEditedYAML(target.resource, target.ref.name, target.edited)
+		if err == nil {
+			err = client.UpdateEdited(ctx, target.resource, target.ref.namespace, obj, dryRun)
+		}
+		if dryRun {
+			return editValidatedMsg{err: err}
+		}
+		return editAppliedMsg{err: err}
+	}
+}
+
+// handleEditReview handles input when the edit review is shown
+func (m Model) handleEditReview(keyMsg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch keyMsg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc", "q":
+		// Discard the edit; results still in flight are dropped
+		m.editTarget = nil
+		m.viewMode = ViewModeDescribe
+	case "pgup", "ctrl+u":
+		m.editReview.ScrollUp()
+	case "pgdown", "ctrl+d":
+		m.editReview.ScrollDown()
+	case "e":
+		if m.editTarget != nil && !m.editReview.IsBusy() {
+			content := m.editTarget.edited
+			if isBlankEdit(content) {
+				content = m.editTarget.live
+			}
+			return m, m.runEditor(editHeader(m.editTarget.err) + stripEditHeader(content))
+		}
+	case "y":
+		if m.editTarget != nil && m.editReview.CanApply() {
+			m.editReview.SetApplying()
+			return m, m.updateEdited(false)
+		}
+	}
+	return m, nil
+}
+
+// stripEditHeader removes the comment block written by editHeader from an edited file
+func stripEditHeader(content string) string {
+	lines := strings.SplitAfter(content, "\n")
+	i := 0
+	for i < len(lines) && strings.HasPrefix(lines[i], "#") {
+		i++
+	}
+	return strings.Join(lines[i:], "")
+}
+
+// handleNamespaceSelector handles input when namespace selector is visible
+func (m Model) handleNamespaceSelector(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.keyMap.Up):
+			m.namespaceSelector.MoveUp()
+
+		case key.Matches(msg, m.keyMap.Down):
+			m.namespaceSelector.MoveDown()
+
+		case key.Matches(msg, m.keyMap.Enter):
+			// Select namespace and reload resources
+			selectedNS := m.namespaceSelector.GetSelected()
+			if selectedNS != "" {
+				m.client.SetNamespace(selectedNS)
+				m.header.SetNamespace(selectedNS)
+				m.namespaceSelector.Hide()
+				m.loading = true
+
+				// Watchers restart in the new namespace, so earlier failures no longer apply
+				m.watchErrors = nil
+
+				// In multi-cluster mode, switch the namespace in every cluster
+				if m.multiCluster != nil {
+					m.resourceList.Clear()
+					if err := m.multiCluster.UpdateNamespace(selectedNS); err != nil {
+						m.err = fmt.Errorf("failed to switch namespace: %w", err)
+					}
+					if !m.useWatchAPI {
+						return m, m.loadResources()
+					}
+					return m, nil
+				}
+
+				// If using watch mode, restart watchers with new namespace
+				if m.useWatchAPI {
+					// Restart watch manager with new namespace
+					if err := m.watchManager.UpdateNamespace(selectedNS); err != nil {
+						m.err = fmt.Errorf("failed to switch namespace: %w", err)
+					}
+					return m, nil
+				}
+
+				// Otherwise use polling mode to reload resources
+				return m, m.loadResources()
+			}
+
+		case key.Matches(msg, m.keyMap.Back), key.Matches(msg, m.keyMap.Quit):
+			// Cancel namespace selection
+			m.namespaceSelector.Hide()
+		}
+
+	case namespacesLoadedMsg:
+		if msg.err == nil && len(msg.namespaces) > 0 {
+			m.namespaceSelector.SetOptions(msg.namespaces)
+		}
+	}
+
+	return m, nil
+}
+
+// handleContextSelector handles input when context selector is visible
+func (m Model) handleContextSelector(keyMsg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(keyMsg, m.keyMap.Up):
+		m.contextSelector.MoveUp()
+
+	case key.Matches(keyMsg, m.keyMap.Down):
+		m.contextSelector.MoveDown()
+
+	case key.Matches(keyMsg, m.keyMap.Enter):
+		selectedContext := m.contextSelector.GetSelected()
+		m.contextSelector.Hide()
+		if selectedContext != "" && selectedContext != m.client.GetCurrentContext() {
+			m.loading = true
+			return m, m.switchContext(selectedContext)
+		}
+
+	case key.Matches(keyMsg, m.keyMap.Back), key.Matches(keyMsg, m.keyMap.Quit):
+		// Cancel context selection
+		m.contextSelector.Hide()
+	}
+
+	return m, nil
+}
+
+// needsResourceSelection reports whether the generic tab is active without a resource kind chosen
+func (m Model) needsResourceSelection() bool {
+	return components.ResourceType(m.tabs.GetActiveTab()) == components.ResourceTypeGeneric && m.genericResource == nil
+}
+
+// showResourceSelector opens the resource kind picker and starts discovery
+func (m Model) showResourceSelector() tea.Cmd {
+	m.resourceSelector.Show()
+	return m.loadAPIResources()
+}
+
+// handleResourceSelector handles input when the resource kind selector is visible
+func (m Model) handleResourceSelector(keyMsg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(keyMsg, m.keyMap.Up):
+		m.resourceSelector.MoveUp()
+
+	case key.Matches(keyMsg, m.keyMap.Down):
+		m.resourceSelector.MoveDown()
+
+	case key.Matches(keyMsg, m.keyMap.Enter):
+		m.resourceSelector.Hide()
+		resource, ok := m.apiResources[m.resourceSelector.GetSelected()]
+		if ok {
+			return m, m.selectGenericResource(resource)
+		}
+
+	case key.Matches(keyMsg, m.keyMap.Back), key.Matches(keyMsg, m.keyMap.Quit):
+		// Cancel resource selection
+		m.resourceSelector.Hide()
+	}
+
+	return m, nil
+}
+
+// selectGenericResource switches the generic tab to a resource kind and starts watching it
+func (m *Model) selectGenericResource(resource k8s.APIResource) tea.Cmd {
+	m.genericResource = &resource
+	m.tabs.SetActiveTab(int(components.ResourceTypeGeneric))
+	m.tabs.SetTitle(int(components.ResourceTypeGeneric), "✦ "+resource.DisplayName())
+	m.resourceList.SetResourceType(components.ResourceTypeGeneric)
+	m.resourceList.ClearGenericResources()
+	m.viewMode = ViewModeList
+	m.loading = true
+
+	if m.useWatchAPI {
+		delete(m.watchErrors, components.ResourceTypeGeneric)
+
+		var err error
+		if m.multiCluster != nil {
+			err = m.multiCluster.WatchDynamic(resource)
+		} else {
+			err = m.watchManager.WatchDynamic(resource)
+		}
+		if err != nil {
+			m.err = fmt.Errorf("failed to watch %s: %w", resource.DisplayName(), err)
+		}
+	}
+
+	return m.loadResources()
+}
+
+// loadAPIResources discovers the resource types served by the cluster.
+// In multi-cluster mode the first cluster's resources are offered.
+func (m Model) loadAPIResources() tea.Cmd {
+	client := m.client
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		resources, err := client.DiscoverResources(ctx)
+		return apiResourcesLoadedMsg{resources: resources, err: err}
+	}
+}
+
+// switchContext builds and verifies a client for the given kube context
+func (m Model) switchContext(contextName string) tea.Cmd {
+	client := m.client
+
+	return func() tea.Msg {
+		newClient, err := client.ForContext(contextName)
+		if err != nil {
+			return contextSwitchedMsg{err: err}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if err := newClient.TestConnection(ctx); err != nil {
+			return contextSwitchedMsg{err: err}
+		}
+
+		return contextSwitchedMsg{client: newClient}
+	}
+}
+
+// applyClient replaces the active client after a context switch, resetting
+// all cluster-specific state and restarting watchers against the new cluster
+func (m *Model) applyClient(client *k8s.Client) tea.Cmd {
+	// Stop any log stream from the previous cluster
+	if m.logStreamCancel != nil {
+		m.logStreamCancel()
+		m.logStreamCancel = nil
+	}
+	m.logStreamActive = false
+
+	m.client = client
+	m.viewMode = ViewModeList
+	m.valueViewer.Clear()
+	m.previousViewMode = ViewModeList
+	m.connected = false
+	m.loading = true
+	m.err = nil
+	m.watchErrors = nil
+
+	m.header = components.NewHeader(client.GetCurrentContext(), client.GetNamespace(), false)
+	m.header.SetWidth(m.width)
+	m.resourceList.Clear()
+	m.namespaceSelector.SetOptions([]string{})
+
+	// The new cluster may not serve the resource chosen for the generic tab
+	m.genericResource = nil
+	m.apiResources = nil
+	m.tabs.SetTitle(int(components.ResourceTypeGeneric), components.GenericTabTitle)
+	m.resourceList.ClearGenericResources()
+
+	cmds := []tea.Cmd{m.loadNamespaces()}
+	if m.needsResourceSelection() {
+		cmds = append(cmds, m.showResourceSelector())
+	}
+
+	if m.useWatchAPI {
+		m.watchManager.ClearDynamic()
+		if err := m.watchManager.SwitchClient(client); err != nil {
+			m.err = fmt.Errorf("failed to restart watchers: %w", err)
+		}
+		return tea.Batch(cmds...)
+	}
+
+	return tea.Batch(append(cmds, m.loadResources())...)
+}
+
+// handleSearchMode handles input when in search mode
+func (m Model) handleSearchMode(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		switch keyMsg.Type {
+		case tea.KeyEsc:
+			// Exit search mode
+			m.searchMode = false
+			m.searchQuery = ""
+			m.resourceList.SetSearchFilter("")
+
+		case tea.KeyEnter:
+			// Apply search and exit search mode
+			m.searchMode = false
+			m.resourceList.SetSearchFilter(m.searchQuery)
+
+		case tea.KeyBackspace:
+			// Remove last character
+			if len(m.searchQuery) > 0 {
+				m.searchQuery = m.searchQuery[:len(m.searchQuery)-1]
+			}
+
+		case tea.KeyRunes:
+			// Add character to search query
+			m.searchQuery += string(keyMsg.Runes)
+		}
+	}
+
+	return m, nil
+}
+
+// View renders the UI
+func (m Model) View() string {
+	if m.width == 0 {
+		return "Loading..."
+	}
+
+	// Show namespace selector if visible
+	if m.namespaceSelector.IsVisible() {
+		return m.viewNamespaceSelector()
+	}
+
+	// Show context selector if visible
+	if m.contextSelector.IsVisible() {
+		return m.viewContextSelector()
+	}
+
+	// Show resource kind selector if visible
+	if m.resourceSelector.IsVisible() {
+		return m.viewResourceSelector()
+	}
+
+	// Show confirm dialog if visible
+	if m.confirmDialog.IsVisible() {
+		return m.viewConfirmDialog()
+	}
+
+	// Show delete dialog if visible
+	if m.deleteDialog.IsVisible() {
+		return m.viewDeleteDialog()
+	}
+
+	// Show scale dialog if visible
+	if m.scaleDialog.IsVisible() {
+		return m.viewScaleDialog()
+	}
+
+	// Show rollout dialog if visible
+	if m.rolloutDialog.IsVisible() {
+		return m.viewRolloutDialog()
+	}
+
+	// Show container selector if visible
+	if m.viewMode == ViewModeContainerSelect && m.containerSelector != nil && m.containerSelector.IsVisible() {
+		return m.viewContainerSelector()
+	}
+
+	// Show help if requested
+	if m.showHelp {
+		return m.viewHelp()
+	}
+
+	// Show error if present
+	if m.err != nil {
+		return m.viewError()
+	}
+
+	// Handle special view modes
+	switch m.viewMode {
+	case ViewModeLogStream:
+		if m.logViewer != nil {
+			return m.logViewer.View()
+		}
+		return "Log viewer not initialized"
+
+	case ViewModeDescribe:
+		return m.describeViewer.View()
+
+	case ViewModeValue:
+		return m.valueViewer.View()
+
+	case ViewModeRevisions:
+		return m.revisionViewer.View()
+
+	case ViewModeEditReview:
+		return m.editReview.View()
+	}
+
+	// Build the main view for list and detail modes
+	return m.renderMainView()
+}
+
+// renderMainView renders the main view with header, tabs, content, and footer
+func (m Model) renderMainView() string {
+	header := m.header.View()
+	tabs := m.tabs.View()
+	footer := m.footer.View()
+
+	var mainContent string
+	switch m.viewMode {
+	case ViewModeDetail:
+		mainContent = m.viewDetail()
+	case ViewModeAccessQuery:
+		mainContent = m.accessQuery.View()
+	case ViewModeNetworkPolicies:
+		mainContent = m.detailView.ViewPodNetworkPolicies(m.resourceList.GetSelectedPod(), m.networkAnalysis)
+	case ViewModeQuotas:
+		mainContent = m.detailView.ViewNamespaceQuotas(m.client.GetNamespace(), m.namespaceQuotas, m.limitRanges)
+	default:
+		mainContent = m.resourceList.View()
+	}
+
+	// Add search indicator if in search mode
+	if m.searchMode {
+		searchPrompt := fmt.Sprintf("Search: %s_", m.searchQuery)
+		mainContent = mainContent + "\n" + searchPrompt
+	}
+
+	// Stack components vertically
+	view := header + "\n" + tabs + "\n" + mainContent + "\n" + footer
+
+	return view
+}
+
+// viewDetail renders the detail view based on current resource type
+func (m Model) viewDetail() string {
+	switch components.ResourceType(m.tabs.GetActiveTab()) {
+	case components.ResourceTypePod:
+		pod := m.resourceList.GetSelectedPod()
+		return m.detailView.ViewPod(pod)
+
+	case components.ResourceTypeService:
+		service := m.resourceList.GetSelectedService()
+		return m.detailView.ViewService(service, m.serviceEndpoints, m.selectedKey)
+
+	case components.ResourceTypeDeployment:
+		deployment := m.resourceList.GetSelectedDeployment()
+		return m.detailView.ViewDeployment(deployment, m.workloadHPAs, m.workloadPDBs, m.workloadRevisions)
+
+	case components.ResourceTypeStatefulSet:
+		statefulSet := m.resourceList.GetSelectedStatefulSet()
+		return m.detailView.ViewStatefulSet(statefulSet, m.workloadHPAs, m.workloadPDBs, m.workloadRevisions)
+
+	case components.ResourceTypeEvent:
+		event := m.resourceList.GetSelectedEvent()
+		return m.detailView.ViewEvent(event)
+
+	case components.ResourceTypeConfigMap:
+		configMap := m.resourceList.GetSelectedConfigMap()
+		return m.detailView.ViewConfigMap(configMap, m.selectedKey)
+
+	case components.ResourceTypeSecret:
+		secret := m.resourceList.GetSelectedSecret()
+		return m.detailView.ViewSecret(secret, m.selectedKey)
+
+	case components.ResourceTypeNode:
+		node := m.resourceList.GetSelectedNode()
+		return m.detailView.ViewNode(node, m.nodeAllocation)
+
+	case components.ResourceTypeJob:
+		job := m.resourceList.GetSelectedJob()
+		return m.detailView.ViewJob(job)
+
+	case components.ResourceTypeCronJob:
+		cronJob := m.resourceList.GetSelectedCronJob()
+		return m.detailView.ViewCronJob(cronJob, m.cronJobJobs)
+
+	case components.ResourceTypeDaemonSet:
+		daemonSet := m.resourceList.GetSelectedDaemonSet()
+		return m.detailView.ViewDaemonSet(daemonSet, m.daemonSetNodes)
+
+	case components.ResourceTypeReplicaSet:
+		replicaSet := m.resourceList.GetSelectedReplicaSet()
+		return m.detailView.ViewReplicaSet(replicaSet)
+
+	case components.ResourceTypeIngress:
+		ingress := m.resourceList.GetSelectedIngress()
+		return m.detailView.ViewIngress(ingress, m.ingressProblems)
+
+	case components.ResourceTypePersistentVolumeClaim:
+		claim := m.resourceList.GetSelectedPersistentVolumeClaim()
+		return m.detailView.ViewPersistentVolumeClaim(claim, m.claimPods)
+
+	case components.ResourceTypePersistentVolume:
+		volume := m.resourceList.GetSelectedPersistentVolume()
+		return m.detailView.ViewPersistentVolume(volume)
+
+	case components.ResourceTypeStorageClass:
+		storageClass := m.resourceList.GetSelectedStorageClass()
+		return m.detailView.ViewStorageClass(storageClass)
+
+	case components.ResourceTypeHorizontalPodAutoscaler:
+		hpa := m.resourceList.GetSelectedHorizontalPodAutoscaler()
+		return m.detailView.ViewHorizontalPodAutoscaler(hpa)
+
+	case components.ResourceTypeServiceAccount:
+		serviceAccount := m.resourceList.GetSelectedServiceAccount()
+		return m.detailView.ViewServiceAccount(serviceAccount, m.subjectBindings)
+
+	case components.ResourceTypeRole:
+		role := m.resourceList.GetSelectedRole()
+		return m.detailView.ViewRole(role)
+
+	case components.ResourceTypeClusterRole:
+		clusterRole := m.resourceList.GetSelectedClusterRole()
+		return m.detailView.ViewRole(clusterRole)
+
+	case components.ResourceTypeRoleBinding:
+		binding := m.resourceList.GetSelectedRoleBinding()
+		return m.detailView.ViewRoleBinding(binding)
+
+	case components.ResourceTypeClusterRoleBinding:
+		clusterBinding := m.resourceList.GetSelectedClusterRoleBinding()
+		return m.detailView.ViewRoleBinding(clusterBinding)
+
+	case components.ResourceTypeNetworkPolicy:
+		policy := m.resourceList.GetSelectedNetworkPolicy()
+		return m.detailView.ViewNetworkPolicy(policy)
+
+	case components.ResourceTypePodDisruptionBudget:
+		budget := m.resourceList.GetSelectedPodDisruptionBudget()
+		return m.detailView.ViewPodDisruptionBudget(budget)
+
+	case components.ResourceTypeGeneric:
+		obj := m.resourceList.GetSelectedGeneric()
+		return m.detailView.ViewGeneric(obj)
+
+	default:
+		return "Unknown resource type"
+	}
+}
+
+// viewNamespaceSelector renders the namespace selector
+func (m Model) viewNamespaceSelector() string {
+	// Render the selector centered on screen
+	selector := m.namespaceSelector.View()
+
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		selector,
+		lipgloss.WithWhitespaceChars(" "),
+		lipgloss.WithWhitespaceForeground(lipgloss.AdaptiveColor{Light: "0", Dark: "0"}),
+		lipgloss.WithWhitespaceBackground(lipgloss.AdaptiveColor{Light: "0", Dark: "0"}),
+	)
+}
+
+// viewContextSelector renders the context selector
+func (m Model) viewContextSelector() string {
+	// Render the selector centered on screen
+	selector := m.contextSelector.View()
+
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		selector,
+		lipgloss.WithWhitespaceChars(" "),
+		lipgloss.WithWhitespaceForeground(lipgloss.AdaptiveColor{Light: "0", Dark: "0"}),
+		lipgloss.WithWhitespaceBackground(lipgloss.AdaptiveColor{Light: "0", Dark: "0"}),
+	)
+}
+
+// viewResourceSelector renders the resource kind selector
+func (m Model) viewResourceSelector() string {
+	// Render the selector centered on screen
+	selector := m.resourceSelector.View()
+
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		selector,
+		lipgloss.WithWhitespaceChars(" "),
+		lipgloss.WithWhitespaceForeground(lipgloss.AdaptiveColor{Light: "0", Dark: "0"}),
+		lipgloss.WithWhitespaceBackground(lipgloss.AdaptiveColor{Light: "0", Dark: "0"}),
+	)
+}
+
+// viewConfirmDialog renders the confirm dialog
+func (m Model) viewConfirmDialog() string {
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		m.confirmDialog.View(),
+		lipgloss.WithWhitespaceChars(" "),
+		lipgloss.WithWhitespaceForeground(lipgloss.AdaptiveColor{Light: "0", Dark: "0"}),
+		lipgloss.WithWhitespaceBackground(lipgloss.AdaptiveColor{Light: "0", Dark: "0"}),
+	)
+}
+
+// viewDeleteDialog renders the delete dialog
+func (m Model) viewDeleteDialog() string {
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		m.deleteDialog.View(),
+		lipgloss.WithWhitespaceChars(" "),
+		lipgloss.WithWhitespaceForeground(lipgloss.AdaptiveColor{Light: "0", Dark: "0"}),
+		lipgloss.WithWhitespaceBackground(lipgloss.AdaptiveColor{Light: "0", Dark: "0"}),
+	)
+}
+
+// viewScaleDialog renders the scale dialog
+func (m Model) viewScaleDialog() string {
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		m.scaleDialog.View(),
+		lipgloss.WithWhitespaceChars(" "),
+		lipgloss.WithWhitespaceForeground(lipgloss.AdaptiveColor{Light: "0", Dark: "0"}),
+		lipgloss.WithWhitespaceBackground(lipgloss.AdaptiveColor{Light: "0", Dark: "0"}),
+	)
+}
+
+// viewRolloutDialog renders the rollout dialog
+func (m Model) viewRolloutDialog() string {
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		m.rolloutDialog.View(),
+		lipgloss.WithWhitespaceChars(" "),
+		lipgloss.WithWhitespaceForeground(lipgloss.AdaptiveColor{Light: "0", Dark: "0"}),
+		lipgloss.WithWhitespaceBackground(lipgloss.AdaptiveColor{Light: "0", Dark: "0"}),
+	)
+}
+
+// viewContainerSelector renders the container selector
+func (m Model) viewContainerSelector() string {
+	// Render the selector centered on screen
+	selector := m.containerSelector.View()
+
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		selector,
+		lipgloss.WithWhitespaceChars(" "),
+		lipgloss.WithWhitespaceForeground(lipgloss.AdaptiveColor{Light: "0", Dark: "0"}),
+		lipgloss.WithWhitespaceBackground(lipgloss.AdaptiveColor{Light: "0", Dark: "0"}),
+	)
+}
+
+// viewHelp renders the help screen
+func (m Model) viewHelp() string {
+	header := m.header.View()
+	help := m.footer.ViewDetailed()
+	helpPrompt := "\nPress ? or ESC to close help"
+
+	view := header + "\n" + help + helpPrompt
+
+	return view
+}
+
+// viewError renders an error message
+func (m Model) viewError() string {
+	header := m.header.View()
+	footer := m.footer.View()
+
+	errorMsg := fmt.Sprintf(
+		"Error: %v\n\nPress 'r' to retry or 'q' to quit",
+		m.err,
+	)
+
+	view := header + "\n" + errorMsg + "\n" + footer
+
+	return view
+}
+
+// loadResources fetches resources from Kubernetes based on current tab
+// In multi-cluster mode, resources from every cluster are merged and tagged with their source
+func (m Model) loadResources() tea.Cmd {
+	resourceType := components.ResourceType(m.tabs.GetActiveTab())
+	clients := m.clients()
+	genericResource := m.genericResource
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		msg := resourcesLoadedMsg{
+			resourceType: resourceType,
+		}
+
+		for _, client := range clients {
+			var err error
+			cluster := m.clusterLabel(client)
+			namespace := client.GetNamespace()
+
+			switch resourceType {
+			case components.ResourceTypePod:
+				var pods []models.PodInfo
+				pods, err = m.loadPods(ctx, client, namespace, cluster)
+				msg.pods = append(msg.pods, pods...)
+			case components.ResourceTypeService:
+				var services []models.ServiceInfo
+				services, err = m.loadServices(ctx, client, namespace, cluster)
+				msg.services = append(msg.services, services...)
+			case components.ResourceTypeDeployment:
+				var deployments []models.DeploymentInfo
+				deployments, err = m.loadDeployments(ctx, client, namespace, cluster)
+				msg.deployments = append(msg.deployments, deployments...)
+			case components.ResourceTypeStatefulSet:
+				var statefulSets []models.StatefulSetInfo
+				statefulSets, err = m.loadStatefulSets(ctx, client, namespace, cluster)
+				msg.statefulSets = append(msg.statefulSets, statefulSets...)
+			case components.ResourceTypeEvent:
+				var events []models.EventInfo
+				events, err = m.loadEvents(ctx, client, namespace, cluster)
+				msg.events = append(msg.events, events...)
+			case components.ResourceTypeConfigMap:
+				var configMaps []models.ConfigMapInfo
+				configMaps, err = m.loadConfigMaps(ctx, client, namespace, cluster)
+				msg.configMaps = append(msg.configMaps, configMaps...)
+			case components.ResourceTypeSecret:
+				var secrets []models.SecretInfo
+				secrets, err = m.loadSecrets(ctx, client, namespace, cluster)
+				msg.secrets = append(msg.secrets, secrets...)
+			case components.ResourceTypeNode:
+				var nodes []models.NodeInfo
+				nodes, err = m.loadNodes(ctx, client, cluster)
+				msg.nodes = append(msg.nodes, nodes...)
+			case components.ResourceTypeJob:
+				var jobs []models.JobInfo
+				jobs, err = m.loadJobs(ctx, client, namespace, cluster)
+				msg.jobs = append(msg.jobs, jobs...)
+			case components.ResourceTypeCronJob:
+				var cronJobs []models.CronJobInfo
+				cronJobs, err = m.loadCronJobs(ctx, client, namespace, cluster)
+				msg.cronJobs = append(msg.cronJobs, cronJobs...)
+			case components.ResourceTypeDaemonSet:
+				var daemonSets []models.DaemonSetInfo
+				daemonSets, err = m.loadDaemonSets(ctx, client, namespace, cluster)
+				msg.daemonSets = append(msg.daemonSets, daemonSets...)
+			case components.ResourceTypeReplicaSet:
+				var replicaSets []models.ReplicaSetInfo
+				replicaSets, err = m.loadReplicaSets(ctx, client, namespace, cluster)
+				msg.replicaSets = append(msg.replicaSets, replicaSets...)
+			case components.ResourceTypeIngress:
+				var ingresses []models.IngressInfo
+				ingresses, err = m.loadIngresses(ctx, client, namespace, cluster)
+				msg.ingresses = append(msg.ingresses, ingresses...)
+			case components.ResourceTypePersistentVolumeClaim:
+				var persistentVolumeClaims []models.PersistentVolumeClaimInfo
+				persistentVolumeClaims, err = m.loadPersistentVolumeClaims(ctx, client, namespace, cluster)
+				msg.persistentVolumeClaims = append(msg.persistentVolumeClaims, persistentVolumeClaims...)
+			case components.ResourceTypePersistentVolume:
+				var persistentVolumes []models.PersistentVolumeInfo
+				persistentVolumes, err = m.loadPersistentVolumes(ctx, client, cluster)
+				msg.persistentVolumes = append(msg.persistentVolumes, persistentVolumes...)
+			case components.ResourceTypeStorageClass:
+				var storageClasses []models.StorageClassInfo
+				storageClasses, err = m.loadStorageClasses(ctx, client, cluster)
+				msg.storageClasses = append(msg.storageClasses, storageClasses...)
+			case components.ResourceTypeHorizontalPodAutoscaler:
+				var horizontalPodAutoscalers []models.HorizontalPodAutoscalerInfo
+				horizontalPodAutoscalers, err = m.loadHorizontalPodAutoscalers(ctx, client, namespace, cluster)
+				msg.horizontalPodAutoscalers = append(msg.horizontalPodAutoscalers, horizontalPodAutoscalers...)
+			case components.ResourceTypeServiceAccount:
+				var serviceAccounts []models.ServiceAccountInfo
+				serviceAccounts, err = m.loadServiceAccounts(ctx, client, namespace, cluster)
+				msg.serviceAccounts = append(msg.serviceAccounts, serviceAccounts...)
+			case components.ResourceTypeRole:
+				var roles []models.RoleInfo
+				roles, err = m.loadRoles(ctx, client, namespace, cluster)
+				msg.roles = append(msg.roles, roles...)
+			case components.ResourceTypeClusterRole:
+				var clusterRoles []models.RoleInfo
+				clusterRoles, err = m.loadClusterRoles(ctx, client, cluster)
+				msg.clusterRoles = append(msg.clusterRoles, clusterRoles...)
+			case components.ResourceTypeRoleBinding:
+				var roleBindings []models.RoleBindingInfo
+				roleBindings, err = m.loadRoleBindings(ctx, client, namespace, cluster)
+				msg.roleBindings = append(msg.roleBindings, roleBindings...)
+			case components.ResourceTypeClusterRoleBinding:
+				var clusterRoleBindings []models.RoleBindingInfo
+				clusterRoleBindings, err = m.loadClusterRoleBindings(ctx, client, cluster)
+				msg.clusterRoleBindings = append(msg.clusterRoleBindings, clusterRoleBindings...)
+			case components.ResourceTypeNetworkPolicy:
+				var networkPolicies []models.NetworkPolicyInfo
+				networkPolicies, err = m.loadNetworkPolicies(ctx, client, namespace, cluster)
+				msg.networkPolicies = append(msg.networkPolicies, networkPolicies...)
+			case components.ResourceTypePodDisruptionBudget:
+				var podDisruptionBudgets []models.PodDisruptionBudgetInfo
+				podDisruptionBudgets, err = m.loadPodDisruptionBudgets(ctx, client, namespace, cluster)
+				msg.podDisruptionBudgets = append(msg.podDisruptionBudgets, podDisruptionBudgets...)
+			case components.ResourceTypeGeneric:
+				if genericResource == nil {
+					continue
+				}
+				var columns []string
+				var generic []models.GenericResourceInfo
+				columns, generic, err = m.loadGenericResources(ctx, client, *genericResource, namespace, cluster)
+				msg.generic = append(msg.generic, generic...)
+				if msg.tableColumns == nil {
+					msg.tableColumns = columns
+				}
+			}
+
+			if err != nil {
+				msg.err = err
+				break
+			}
+		}
+
+		return msg
+	}
+}
+
+func (m Model) loadPods(ctx context.Context, client *k8s.Client, namespace, cluster string) ([]models.PodInfo, error) {
+	podList, err := client.GetPods(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	pods := make([]models.PodInfo, len(podList.Items))
+	for i, pod := range podList.Items {
+		pods[i] = models.NewPodInfo(&pod)
+		pods[i].Cluster = cluster
+	}
+	return pods, nil
+}
+
+func (m Model) loadServices(ctx context.Context, client *k8s.Client, namespace, cluster string) ([]models.ServiceInfo, error) {
+	serviceList, err := client.GetServices(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	services := make([]models.ServiceInfo, len(serviceList.Items))
+	for i, svc := range serviceList.Items {
+		services[i] = models.NewServiceInfo(&svc)
+		services[i].Cluster = cluster
+	}
+	return services, nil
+}
+
+func (m Model) loadDeployments(ctx context.Context, client *k8s.Client, namespace, cluster string) ([]models.DeploymentInfo, error) {
+	deploymentList, err := client.GetDeployments(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	deployments := make([]models.DeploymentInfo, len(deploymentList.Items))
+	for i, dep := range deploymentList.Items {
+		deployments[i] = models.NewDeploymentInfo(&dep)
+		deployments[i].Cluster = cluster
+	}
+	return deployments, nil
+}
+
+func (m Model) loadStatefulSets(ctx context.Context, client *k8s.Client, namespace, cluster string) ([]models.StatefulSetInfo, error) {
+	statefulSetList, err := client.GetStatefulSets(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	statefulSets := make([]models.StatefulSetInfo, len(statefulSetList.Items))
+	for i, sts := range statefulSetList.Items {
+		statefulSets[i] = models.NewStatefulSetInfo(&sts)
+		statefulSets[i].Cluster = cluster
+	}
+	return statefulSets, nil
+}
+
+func (m Model) loadEvents(ctx context.Context, client *k8s.Client, namespace, cluster string) ([]models.EventInfo, error) {
+	eventList, err := client.GetEvents(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]models.EventInfo, len(eventList.Items))
+	for i, evt := range eventList.Items {
+		events[i] = models.NewEventInfo(&evt)
+		events[i].Cluster = cluster
+	}
+	return events, nil
+}
+
+func (m Model) loadConfigMaps(ctx context.Context, client *k8s.Client, namespace, cluster string) ([]models.ConfigMapInfo, error) {
+	configMapList, err := client.GetConfigMaps(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	configMaps := make([]models.ConfigMapInfo, len(configMapList.Items))
+	for i := range configMapList.Items {
+		configMaps[i] = models.NewConfigMapInfo(&configMapList.Items[i])
+		configMaps[i].Cluster = cluster
+	}
+	return configMaps, nil
+}
+
+func (m Model) loadSecrets(ctx context.Context, client *k8s.Client, namespace, cluster string) ([]models.SecretInfo, error) {
+	secretList, err := client.GetSecrets(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	secrets := make([]models.SecretInfo, len(secretList.Items))
+	for i := range secretList.Items {
+		secrets[i] = models.NewSecretInfo(&secretList.Items[i])
+		secrets[i].Cluster = cluster
+	}
+	return secrets, nil
+}
+
+// loadNodes lists the nodes of a cluster; nodes are cluster-scoped, so the namespace is ignored
+func (m Model) loadNodes(ctx context.Context, client *k8s.Client, cluster string) ([]models.NodeInfo, error) {
+	nodeList, err := client.GetNodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]models.NodeInfo, len(nodeList.Items))
+	for i := range nodeList.Items {
+		nodes[i] = models.NewNodeInfo(&nodeList.Items[i])
+		nodes[i].Cluster = cluster
+	}
+	return nodes, nil
+}
+
+// loadNodeAllocation adds up the requests and limits of the pods scheduled on a node
+func (m Model) loadNodeAllocation(cluster, name string) tea.Cmd {
+	client := m.clientFor(cluster)
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		node, err := client.GetNode(ctx, name)
+		if err != nil {
+			return nodeAllocationLoadedMsg{cluster: cluster, name: name, err: err}
+		}
+		pods, err := client.GetNodePods(ctx, name)
+		if err != nil {
+			return nodeAllocationLoadedMsg{cluster: cluster, name: name, err: err}
+		}
+
+		return nodeAllocationLoadedMsg{
+			cluster:    cluster,
+			name:       name,
+			allocation: models.NewNodeAllocation(node, pods),
+		}
+	}
+}
+
+func (m Model) loadJobs(ctx context.Context, client *k8s.Client, namespace, cluster string) ([]models.JobInfo, error) {
+	jobList, err := client.GetJobs(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	jobs := make([]models.JobInfo, len(jobList.Items))
+	for i := range jobList.Items {
+		jobs[i] = models.NewJobInfo(&jobList.Items[i])
+		jobs[i].Cluster = cluster
+	}
+	return jobs, nil
+}
+
+func (m Model) loadCronJobs(ctx context.Context, client *k8s.Client, namespace, cluster string) ([]models.CronJobInfo, error) {
+	cronJobList, err := client.GetCronJobs(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	cronJobs := make([]models.CronJobInfo, len(cronJobList.Items))
+	for i := range cronJobList.Items {
+		cronJobs[i] = models.NewCronJobInfo(&cronJobList.Items[i])
+		cronJobs[i].Cluster = cluster
+	}
+	return cronJobs, nil
+}
+
+// loadCronJobJobs lists the jobs created by a cronjob, newest first
+func (m Model) loadCronJobJobs(cronJob *models.CronJobInfo) tea.Cmd {
+	client := m.clientFor(cronJob.Cluster)
+	cluster, namespace, name := cronJob.Cluster, cronJob.Namespace, cronJob.Name
+	owner := cronJob.CronJob
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		jobList, err := client.GetJobs(ctx, namespace)
+		if err != nil {
+			return cronJobJobsLoadedMsg{cluster: cluster, namespace: namespace, name: name, err: err}
+		}
+
+		return cronJobJobsLoadedMsg{
+			cluster:   cluster,
+			namespace: namespace,
+			name:      name,
+			jobs:      models.CronJobJobs(owner, jobList.Items),
+		}
+	}
+}
+
+func (m Model) loadDaemonSets(ctx context.Context, client *k8s.Client, namespace, cluster string) ([]models.DaemonSetInfo, error) {
+	daemonSetList, err := client.GetDaemonSets(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	daemonSets := make([]models.DaemonSetInfo, len(daemonSetList.Items))
+	for i := range daemonSetList.Items {
+		daemonSets[i] = models.NewDaemonSetInfo(&daemonSetList.Items[i])
+		daemonSets[i].Cluster = cluster
+	}
+	return daemonSets, nil
+}
+
+// loadDaemonSetNodes matches the pods of a daemonset against the nodes it should run on
+func (m Model) loadDaemonSetNodes(daemonSet *models.DaemonSetInfo) tea.Cmd {
+	client := m.clientFor(daemonSet.Cluster)
+	cluster, namespace, name := daemonSet.Cluster, daemonSet.Namespace, daemonSet.Name
+	owner := daemonSet.DaemonSet
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		nodeList, err := client.GetNodes(ctx)
+		if err != nil {
+			return daemonSetNodesLoadedMsg{cluster: cluster, namespace: namespace, name: name, err: err}
+		}
+		pods, err := client.GetDaemonSetPods(ctx, owner)
+		if err != nil {
+			return daemonSetNodesLoadedMsg{cluster: cluster, namespace: namespace, name: name, err: err}
+		}
+
+		return daemonSetNodesLoadedMsg{
+			cluster:   cluster,
+			namespace: namespace,
+			name:      name,
+			nodes:     models.NewDaemonSetNodeStatuses(owner, nodeList.Items, pods),
+		}
+	}
+}
+
+func (m Model) loadReplicaSets(ctx context.Context, client *k8s.Client, namespace, cluster string) ([]models.ReplicaSetInfo, error) {
+	replicaSetList, err := client.GetReplicaSets(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	replicaSets := make([]models.ReplicaSetInfo, len(replicaSetList.Items))
+	for i := range replicaSetList.Items {
+		replicaSets[i] = models.NewReplicaSetInfo(&replicaSetList.Items[i])
+		replicaSets[i].Cluster = cluster
+	}
+	return replicaSets, nil
+}
+
+func (m Model) loadIngresses(ctx context.Context, client *k8s.Client, namespace, cluster string) ([]models.IngressInfo, error) {
+	ingressList, err := client.GetIngresses(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	ingresses := make([]models.IngressInfo, len(ingressList.Items))
+	for i := range ingressList.Items {
+		ingresses[i] = models.NewIngressInfo(&ingressList.Items[i])
+		ingresses[i].Cluster = cluster
+	}
+	return ingresses, nil
+}
+
+// loadIngressProblems cross-checks the backends of an ingress against the services in its namespace
+func (m Model) loadIngressProblems(ingress *models.IngressInfo) tea.Cmd {
+	client := m.clientFor(ingress.Cluster)
+	cluster, namespace, name := ingress.Cluster, ingress.Namespace, ingress.Name
+	info := *ingress
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		serviceList, err := client.GetServices(ctx, namespace)
+		if err != nil {
+			return ingressProblemsLoadedMsg{cluster: cluster, namespace: namespace, name: name, err: err}
+		}
+
+		return ingressProblemsLoadedMsg{
+			cluster:   cluster,
+			namespace: namespace,
+			name:      name,
+			problems:  models.IngressBackendProblems(&info, serviceList.Items),
+		}
+	}
+}
+
+// loadClaimPods finds the pods in a claim's namespace that mount it
+func (m Model) loadClaimPods(claim *models.PersistentVolumeClaimInfo) tea.Cmd {
+	client := m.clientFor(claim.Cluster)
+	cluster, namespace, name := claim.Cluster, claim.Namespace, claim.Name
+	pvc := claim.Claim
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		podList, err := client.GetPods(ctx, namespace)
+		if err != nil {
+			return claimPodsLoadedMsg{cluster: cluster, namespace: namespace, name: name, err: err}
+		}
+
+		return claimPodsLoadedMsg{
+			cluster:   cluster,
+			namespace: namespace,
+			name:      name,
+			pods:      models.ClaimPods(pvc, podList.Items),
+		}
+	}
+}
+
+// loadWorkloadHPAs finds the autoscalers in a workload's namespace that scale it
+func (m Model) loadWorkloadHPAs(cluster, namespace, kind, name string) tea.Cmd {
+	client := m.clientFor(cluster)
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		hpaList, err := client.GetHorizontalPodAutoscalers(ctx, namespace)
+		if err != nil {
+			return workloadHPAsLoadedMsg{cluster: cluster, namespace: namespace, kind: kind, name: name, err: err}
+		}
+
+		hpas := make([]models.HorizontalPodAutoscalerInfo, len(hpaList.Items))
+		for i := range hpaList.Items {
+			hpas[i] = models.NewHorizontalPodAutoscalerInfo(&hpaList.Items[i])
+			hpas[i].Cluster = cluster
+		}
+		return workloadHPAsLoadedMsg{
+			cluster:     cluster,
+			namespace:   namespace,
+			kind:        kind,
+			name:        name,
+			autoscalers: models.AutoscalersFor(kind, name, hpas),
+		}
+	}
+}
+
+// loadWorkloadPDBs finds the disruption budgets in a workload's namespace that cover
+// the pods of its template
+func (m Model) loadWorkloadPDBs(cluster, namespace, kind, name string, podLabels map[string]string) tea.Cmd {
+	client := m.clientFor(cluster)
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		budgetList, err := client.GetPodDisruptionBudgets(ctx, namespace)
+		if err != nil {
+			return workloadPDBsLoadedMsg{cluster: cluster, namespace: namespace, kind: kind, name: name, err: err}
+		}
+
+		budgets := make([]models.PodDisruptionBudgetInfo, len(budgetList.Items))
+		for i := range budgetList.Items {
+			budgets[i] = models.NewPodDisruptionBudgetInfo(&budgetList.Items[i])
+			budgets[i].Cluster = cluster
+		}
+		return workloadPDBsLoadedMsg{
+			cluster:   cluster,
+			namespace: namespace,
+			kind:      kind,
+			name:      name,
+			budgets:   models.BudgetsFor(namespace, podLabels, budgets),
+		}
+	}
+}
+
+// loadWorkloadRevisions builds the revision history of a deployment from the
+// replicasets it owns, or of a statefulset from its controller revisions
+func (m Model) loadWorkloadRevisions(cluster, namespace, kind, name string) tea.Cmd {
+	client := m.clientFor(cluster)
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		var revisions []models.RevisionInfo
+		var err error
+		switch kind {
+		case "Deployment":
+			revisions, err = deploymentRevisions(ctx, client, namespace, name)
+		case "StatefulSet":
+			revisions, err = statefulSetRevisions(ctx, client, namespace, name)
+		}
+		return workloadRevisionsLoadedMsg{cluster: cluster, namespace: namespace, kind: kind, name: name, revisions: revisions, err: err}
+	}
+}
+
+// deploymentRevisions fetches a deployment and the replicasets holding its revisions
+func deploymentRevisions(ctx context.Context, client *k8s.Client, namespace, name string) ([]models.RevisionInfo, error) {
+	deployment, err := client.GetDeployment(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	replicaSets, err := client.GetReplicaSets(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return models.DeploymentRevisions(deployment, replicaSets.Items), nil
+}
+
+// statefulSetRevisions fetches a statefulset and the controller revisions holding its revisions
+func statefulSetRevisions(ctx context.Context, client *k8s.Client, namespace, name string) ([]models.RevisionInfo, error) {
+	statefulSet, err := client.GetStatefulSet(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	controllerRevisions, err := client.GetControllerRevisions(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return models.StatefulSetRevisions(statefulSet, controllerRevisions.Items), nil
+}
+
+// loadServiceEndpoints lists the endpoints of a service from its EndpointSlices
+func (m Model) loadServiceEndpoints(service *models.ServiceInfo) tea.Cmd {
+	client := m.clientFor(service.Cluster)
+	cluster, namespace, name := service.Cluster, service.Namespace, service.Name
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		slices, err := client.GetServiceEndpointSlices(ctx, namespace, name)
+		if err != nil {
+			return serviceEndpointsLoadedMsg{cluster: cluster, namespace: namespace, name: name, err: err}
+		}
+
+		return serviceEndpointsLoadedMsg{
+			cluster:   cluster,
+			namespace: namespace,
+			name:      name,
+			endpoints: models.NewEndpointInfos(slices.Items),
+		}
+	}
+}
+
+// loadNetworkAnalysis computes the effective network policy of a pod from the
+// policies in its namespace. Namespaces are listed to show which ones a
+// namespaceSelector matches, if the user may list them.
+func (m Model) loadNetworkAnalysis(pod *models.PodInfo) tea.Cmd {
+	client := m.clientFor(pod.Cluster)
+	cluster, namespace, name := pod.Cluster, pod.Namespace, pod.Name
+	target := pod.Pod
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		policyList, err := client.GetNetworkPolicies(ctx, namespace)
+		if err != nil {
+			return networkAnalysisLoadedMsg{cluster: cluster, namespace: namespace, name: name, err: err}
+		}
+		var namespaces []corev1.Namespace
+		if namespaceList, err := client.GetNamespaces(ctx); err == nil {
+			namespaces = namespaceList.Items
+		}
+
+		return networkAnalysisLoadedMsg{
+			cluster:   cluster,
+			namespace: namespace,
+			name:      name,
+			analysis:  models.AnalyzePodNetworkPolicies(target, policyList.Items, namespaces),
+		}
+	}
+}
+
+// loadNamespaceQuotas lists the resource quotas and limit ranges of the current namespace
+func (m Model) loadNamespaceQuotas() tea.Cmd {
+	client := m.client
+	namespace := client.GetNamespace()
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		quotaList, err := client.GetResourceQuotas(ctx, namespace)
+		if err != nil {
+			return namespaceQuotasLoadedMsg{namespace: namespace, err: err}
+		}
+		limitRangeList, err := client.GetLimitRanges(ctx, namespace)
+		if err != nil {
+			return namespaceQuotasLoadedMsg{namespace: namespace, err: err}
+		}
+
+		quotas := make([]models.ResourceQuotaInfo, len(quotaList.Items))
+		for i := range quotaList.Items {
+			quotas[i] = models.NewResourceQuotaInfo(&quotaList.Items[i])
+		}
+		limitRanges := make([]models.LimitRangeInfo, len(limitRangeList.Items))
+		for i := range limitRangeList.Items {
+			limitRanges[i] = models.NewLimitRangeInfo(&limitRangeList.Items[i])
+		}
+		return namespaceQuotasLoadedMsg{namespace: namespace, quotas: quotas, limitRanges: limitRanges}
+	}
+}
+
+// loadSubjectBindings finds the bindings that apply to a serviceaccount
+func (m Model) loadSubjectBindings(serviceAccount *models.ServiceAccountInfo) tea.Cmd {
+	client := m.clientFor(serviceAccount.Cluster)
+	cluster, namespace, name := serviceAccount.Cluster, serviceAccount.Namespace, serviceAccount.Name
+	subject := serviceAccount.Subject()
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		policy, err := client.GetRBACPolicy(ctx, namespace)
+		if err != nil {
+			return subjectBindingsLoadedMsg{cluster: cluster, namespace: namespace, name: name, err: err}
+		}
 
-	return view
+		return subjectBindingsLoadedMsg{
+			cluster:   cluster,
+			namespace: namespace,
+			name:      name,
+			bindings:  policy.BindingsFor(subject, namespace),
+		}
+	}
 }
 
-// loadResources fetches resources from Kubernetes based on current tab
-func (m Model) loadResources() tea.Cmd {
-	resourceType := components.ResourceType(m.tabs.GetActiveTab())
+// runAccessQuery answers the question in the access query panel, from the
+// bindings or, in "can I" mode, with a SelfSubjectAccessReview
+func (m Model) runAccessQuery() tea.Cmd {
+	client := m.client
+	mode := m.accessQuery.Mode()
+	subjectText := m.accessQuery.Subject()
+	query := models.AccessQuery{
+		Verb:      m.accessQuery.Verb(),
+		Namespace: m.accessQuery.Namespace(),
+	}
+	resourceText := m.accessQuery.Resource()
 
 	return func() tea.Msg {
+		if query.Verb == "" || resourceText == "" {
+			return accessAnsweredMsg{err: fmt.Errorf("verb and resource are required")}
+		}
+		query.Resource, query.Group, query.Subresource = models.ParseResource(resourceText)
+
+		// Service accounts typed without a namespace belong to the selected one,
+		// even when the resource turns out to be cluster-scoped
+		if mode != components.AccessQueryCanI && subjectText != "" {
+			subject, err := models.ParseSubject(subjectText, query.Namespace)
+			if err != nil {
+				return accessAnsweredMsg{err: err}
+			}
+			query.Subject = subject
+		}
+
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
 
-		msg := resourcesLoadedMsg{
-			resourceType: resourceType,
+		// Resolve short names, kinds and groups, and drop the namespace of cluster-scoped resources
+		if resources, err := client.DiscoverResources(ctx); err == nil {
+			if resource, ok := k8s.FindAPIResource(resources, query.Group, query.Resource); ok {
+				query.Resource, query.Group = resource.Resource, resource.Group
+				if !resource.Namespaced {
+					query.Namespace = ""
+				}
+			}
 		}
 
-		namespace := m.client.GetNamespace()
+		if mode == components.AccessQueryCanI {
+			answer, err := client.CanI(ctx, query)
+			return accessAnsweredMsg{answer: answer, err: err}
+		}
 
-		switch resourceType {
-		case components.ResourceTypePod:
-			msg.pods, msg.err = m.loadPods(ctx, namespace)
-		case components.ResourceTypeService:
-			msg.services, msg.err = m.loadServices(ctx, namespace)
-		case components.ResourceTypeDeployment:
-			msg.deployments, msg.err = m.loadDeployments(ctx, namespace)
-		case components.ResourceTypeStatefulSet:
-			msg.statefulSets, msg.err = m.loadStatefulSets(ctx, namespace)
-		case components.ResourceTypeEvent:
-			msg.events, msg.err = m.loadEvents(ctx, namespace)
+		policy, err := client.GetRBACPolicy(ctx, query.Namespace)
+		if err != nil {
+			return accessAnsweredMsg{err: err}
 		}
+		return accessAnsweredMsg{answer: policy.Evaluate(query)}
+	}
+}
 
-		return msg
+func (m Model) loadPersistentVolumeClaims(ctx context.Context, client *k8s.Client, namespace, cluster string) ([]models.PersistentVolumeClaimInfo, error) {
+	claimList, err := client.GetPersistentVolumeClaims(ctx, namespace)
+	if err != nil {
+		return nil, err
 	}
+	persistentVolumeClaims := make([]models.PersistentVolumeClaimInfo, len(claimList.Items))
+	for i := range claimList.Items {
+		persistentVolumeClaims[i] = models.NewPersistentVolumeClaimInfo(&claimList.Items[i])
+		persistentVolumeClaims[i].Cluster = cluster
+	}
+	return persistentVolumeClaims, nil
 }
 
-func (m Model) loadPods(ctx context.Context, namespace string) ([]models.PodInfo, error) {
-	podList, err := m.client.GetPods(ctx, namespace)
+func (m Model) loadPersistentVolumes(ctx context.Context, client *k8s.Client, cluster string) ([]models.PersistentVolumeInfo, error) {
+	volumeList, err := client.GetPersistentVolumes(ctx)
 	if err != nil {
 		return nil, err
 	}
-	pods := make([]models.PodInfo, len(podList.Items))
-	for i, pod := range podList.Items {
-		pods[i] = models.NewPodInfo(&pod)
+	persistentVolumes := make([]models.PersistentVolumeInfo, len(volumeList.Items))
+	for i := range volumeList.Items {
+		persistentVolumes[i] = models.NewPersistentVolumeInfo(&volumeList.Items[i])
+		persistentVolumes[i].Cluster = cluster
 	}
-	return pods, nil
+	return persistentVolumes, nil
 }
 
-func (m Model) loadServices(ctx context.Context, namespace string) ([]models.ServiceInfo, error) {
-	serviceList, err := m.client.GetServices(ctx, namespace)
+func (m Model) loadStorageClasses(ctx context.Context, client *k8s.Client, cluster string) ([]models.StorageClassInfo, error) {
+	storageClassList, err := client.GetStorageClasses(ctx)
 	if err != nil {
 		return nil, err
 	}
-	services := make([]models.ServiceInfo, len(serviceList.Items))
-	for i, svc := range serviceList.Items {
-		services[i] = models.NewServiceInfo(&svc)
+	storageClasses := make([]models.StorageClassInfo, len(storageClassList.Items))
+	for i := range storageClassList.Items {
+		storageClasses[i] = models.NewStorageClassInfo(&storageClassList.Items[i])
+		storageClasses[i].Cluster = cluster
 	}
-	return services, nil
+	return storageClasses, nil
 }
 
-func (m Model) loadDeployments(ctx context.Context, namespace string) ([]models.DeploymentInfo, error) {
-	deploymentList, err := m.client.GetDeployments(ctx, namespace)
+func (m Model) loadHorizontalPodAutoscalers(ctx context.Context, client *k8s.Client, namespace, cluster string) ([]models.HorizontalPodAutoscalerInfo, error) {
+	hpaList, err := client.GetHorizontalPodAutoscalers(ctx, namespace)
 	if err != nil {
 		return nil, err
 	}
-	deployments := make([]models.DeploymentInfo, len(deploymentList.Items))
-	for i, dep := range deploymentList.Items {
-		deployments[i] = models.NewDeploymentInfo(&dep)
+	horizontalPodAutoscalers := make([]models.HorizontalPodAutoscalerInfo, len(hpaList.Items))
+	for i := range hpaList.Items {
+		horizontalPodAutoscalers[i] = models.NewHorizontalPodAutoscalerInfo(&hpaList.Items[i])
+		horizontalPodAutoscalers[i].Cluster = cluster
 	}
-	return deployments, nil
+	return horizontalPodAutoscalers, nil
 }
 
-func (m Model) loadStatefulSets(ctx context.Context, namespace string) ([]models.StatefulSetInfo, error) {
-	statefulSetList, err := m.client.GetStatefulSets(ctx, namespace)
+func (m Model) loadServiceAccounts(ctx context.Context, client *k8s.Client, namespace, cluster string) ([]models.ServiceAccountInfo, error) {
+	serviceAccountList, err := client.GetServiceAccounts(ctx, namespace)
 	if err != nil {
 		return nil, err
 	}
-	statefulSets := make([]models.StatefulSetInfo, len(statefulSetList.Items))
-	for i, sts := range statefulSetList.Items {
-		statefulSets[i] = models.NewStatefulSetInfo(&sts)
+	serviceAccounts := make([]models.ServiceAccountInfo, len(serviceAccountList.Items))
+	for i := range serviceAccountList.Items {
+		serviceAccounts[i] = models.NewServiceAccountInfo(&serviceAccountList.Items[i])
+		serviceAccounts[i].Cluster = cluster
 	}
-	return statefulSets, nil
+	return serviceAccounts, nil
 }
 
-func (m Model) loadEvents(ctx context.Context, namespace string) ([]models.EventInfo, error) {
-	eventList, err := m.client.GetEvents(ctx, namespace)
+func (m Model) loadRoles(ctx context.Context, client *k8s.Client, namespace, cluster string) ([]models.RoleInfo, error) {
+	roleList, err := client.GetRoles(ctx, namespace)
 	if err != nil {
 		return nil, err
 	}
-	events := make([]models.EventInfo, len(eventList.Items))
-	for i, evt := range eventList.Items {
-		events[i] = models.NewEventInfo(&evt)
+	roles := make([]models.RoleInfo, len(roleList.Items))
+	for i := range roleList.Items {
+		roles[i] = models.NewRoleInfo(&roleList.Items[i])
+		roles[i].Cluster = cluster
+	}
+	return roles, nil
+}
+
+func (m Model) loadClusterRoles(ctx context.Context, client *k8s.Client, cluster string) ([]models.RoleInfo, error) {
+	clusterRoleList, err := client.GetClusterRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	clusterRoles := make([]models.RoleInfo, len(clusterRoleList.Items))
+	for i := range clusterRoleList.Items {
+		clusterRoles[i] = models.NewClusterRoleInfo(&clusterRoleList.Items[i])
+		clusterRoles[i].Cluster = cluster
+	}
+	return clusterRoles, nil
+}
+
+func (m Model) loadRoleBindings(ctx context.Context, client *k8s.Client, namespace, cluster string) ([]models.RoleBindingInfo, error) {
+	bindingList, err := client.GetRoleBindings(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	roleBindings := make([]models.RoleBindingInfo, len(bindingList.Items))
+	for i := range bindingList.Items {
+		roleBindings[i] = models.NewRoleBindingInfo(&bindingList.Items[i])
+		roleBindings[i].Cluster = cluster
+	}
+	return roleBindings, nil
+}
+
+func (m Model) loadClusterRoleBindings(ctx context.Context, client *k8s.Client, cluster string) ([]models.RoleBindingInfo, error) {
+	clusterBindingList, err := client.GetClusterRoleBindings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	clusterRoleBindings := make([]models.RoleBindingInfo, len(clusterBindingList.Items))
+	for i := range clusterBindingList.Items {
+		clusterRoleBindings[i] = models.NewClusterRoleBindingInfo(&clusterBindingList.Items[i])
+		clusterRoleBindings[i].Cluster = cluster
+	}
+	return clusterRoleBindings, nil
+}
+
+func (m Model) loadNetworkPolicies(ctx context.Context, client *k8s.Client, namespace, cluster string) ([]models.NetworkPolicyInfo, error) {
+	policyList, err := client.GetNetworkPolicies(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	networkPolicies := make([]models.NetworkPolicyInfo, len(policyList.Items))
+	for i := range policyList.Items {
+		networkPolicies[i] = models.NewNetworkPolicyInfo(&policyList.Items[i])
+		networkPolicies[i].Cluster = cluster
+	}
+	return networkPolicies, nil
+}
+
+func (m Model) loadPodDisruptionBudgets(ctx context.Context, client *k8s.Client, namespace, cluster string) ([]models.PodDisruptionBudgetInfo, error) {
+	budgetList, err := client.GetPodDisruptionBudgets(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	podDisruptionBudgets := make([]models.PodDisruptionBudgetInfo, len(budgetList.Items))
+	for i := range budgetList.Items {
+		podDisruptionBudgets[i] = models.NewPodDisruptionBudgetInfo(&budgetList.Items[i])
+		podDisruptionBudgets[i].Cluster = cluster
+	}
+	return podDisruptionBudgets, nil
+}
+
+// loadGenericResources lists objects of a discovered resource type. Live clusters render
+// them as a server-side Table so the columns match kubectl get; offline clients fall back
+// to a fixed layout with no table columns.
+func (m Model) loadGenericResources(ctx context.Context, client *k8s.Client, resource k8s.APIResource, namespace, cluster string) ([]string, []models.GenericResourceInfo, error) {
+	if client.SupportsTable() {
+		table, err := client.ListTable(ctx, resource, namespace)
+		if err != nil {
+			return nil, nil, err
+		}
+		columns, generic, err := models.NewGenericResourceTable(table)
+		if err != nil {
+			return nil, nil, err
+		}
+		for i := range generic {
+			generic[i].Cluster = cluster
+		}
+		return columns, generic, nil
+	}
+
+	list, err := client.ListDynamic(ctx, resource, namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+	generic := make([]models.GenericResourceInfo, len(list.Items))
+	for i := range list.Items {
+		generic[i] = models.NewGenericResourceInfo(&list.Items[i])
+		generic[i].Cluster = cluster
+	}
+	return nil, generic, nil
+}
+
+// genericCellsStale reports whether a watch event changed a generic object whose table row
+// the server has to re-render, since watch events carry the object but not the printer
+// columns. Rows listed at the same resource version, such as the ADDED events of the
+// watcher's initial list, are already current.
+func (m Model) genericCellsStale(event k8s.WatchEvent) bool {
+	if event.ResourceType != k8s.ResourceTypeGeneric || event.EventType == "DELETED" || m.genericResource == nil {
+		return false
+	}
+	obj, ok := event.Object.(*unstructured.Unstructured)
+	if !ok || !m.isGenericResource(obj) || !m.clientFor(event.Cluster).SupportsTable() {
+		return false
+	}
+
+	existing := m.resourceList.GetGeneric(event.Cluster, obj.GetNamespace(), obj.GetName())
+	return existing == nil || len(existing.Cells) == 0 || existing.Object == nil ||
+		existing.Object.GetResourceVersion() != obj.GetResourceVersion()
+}
+
+// scheduleGenericRefresh starts the delay before the next generic table refresh,
+// unless one is already pending, so a burst of events costs a single list
+func (m *Model) scheduleGenericRefresh() tea.Cmd {
+	if m.genericRefresh {
+		return nil
+	}
+	m.genericRefresh = true
+	return tea.Tick(genericRefreshDelay, func(time.Time) tea.Msg {
+		return genericRefreshMsg{}
+	})
+}
+
+// loadGenericCells re-lists the generic resource as a server-side table to refresh the
+// cells of the rows already shown; the watch adds and removes the rows themselves
+func (m Model) loadGenericCells() tea.Cmd {
+	if m.genericResource == nil {
+		return nil
+	}
+	resource := *m.genericResource
+	clients := m.clients()
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		msg := genericCellsLoadedMsg{resource: resource}
+		for _, client := range clients {
+			if !client.SupportsTable() {
+				continue
+			}
+			_, rows, err := m.loadGenericResources(ctx, client, resource, client.GetNamespace(), m.clusterLabel(client))
+			if err != nil {
+				continue // Keep the previous cells; the next event will retry
+			}
+			msg.rows = append(msg.rows, rows...)
+		}
+		return msg
 	}
-	return events, nil
 }
 
 // loadNamespaces fetches all namespaces
@@ -882,12 +4045,14 @@ func minInt(a, b int) int {
 }
 
 // loadContainers fetches containers for a pod
-func (m Model) loadContainers(namespace, podName string) tea.Cmd {
+func (m Model) loadContainers(cluster, namespace, podName string) tea.Cmd {
+	client := m.clientFor(cluster)
+
 	return func() tea.Msg {
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
 
-		containers, err := m.client.GetPodContainers(ctx, namespace, podName)
+		containers, err := client.GetPodContainers(ctx, namespace, podName)
 		if err != nil {
 			return containersLoadedMsg{err: err}
 		}
@@ -906,11 +4071,11 @@ func (m Model) startLogStream(containerName string) tea.Cmd {
 	}
 
 	// streamLogs will handle sending logStreamStartedMsg and starting the read chain
-	return m.streamLogs(pod.Namespace, pod.Name, containerName)
+	return m.streamLogs(m.clientFor(pod.Cluster), pod.Namespace, pod.Name, containerName)
 }
 
 // streamLogs streams logs from a pod container
-func (m Model) streamLogs(namespace, podName, containerName string) tea.Cmd {
+func (m Model) streamLogs(client *k8s.Client, namespace, podName, containerName string) tea.Cmd {
 	// Create context with cancel for this stream
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -919,7 +4084,7 @@ func (m Model) streamLogs(namespace, podName, containerName string) tea.Cmd {
 	opts.Container = containerName
 
 	// Start streaming
-	logChan, errChan := m.client.GetPodLogsStream(ctx, namespace, podName, containerName, opts)
+	logChan, errChan := client.GetPodLogsStream(ctx, namespace, podName, containerName, opts)
 
 	// Create recursive reader function
 	var readNext func() tea.Cmd
@@ -971,40 +4136,266 @@ func (m Model) loadDescribe() tea.Cmd {
 		case components.ResourceTypePod:
 			pod := m.resourceList.GetSelectedPod()
 			if pod != nil {
-				data, err = m.client.DescribePod(ctx, pod.Namespace, pod.Name)
+				client := m.clientFor(pod.Cluster)
+				data, err = client.DescribePod(ctx, pod.Namespace, pod.Name)
 				if err == nil {
-					yaml, _ = m.client.GetResourceYAML(ctx, "Pod", pod.Namespace, pod.Name)
-					json, _ = m.client.GetResourceJSON(ctx, "Pod", pod.Namespace, pod.Name)
+					yaml, _ = client.GetResourceYAML(ctx, "Pod", pod.Namespace, pod.Name)
+					json, _ = client.GetResourceJSON(ctx, "Pod", pod.Namespace, pod.Name)
 				}
 			}
 
 		case components.ResourceTypeService:
 			svc := m.resourceList.GetSelectedService()
 			if svc != nil {
-				data, err = m.client.DescribeService(ctx, svc.Namespace, svc.Name)
+				client := m.clientFor(svc.Cluster)
+				data, err = client.DescribeService(ctx, svc.Namespace, svc.Name)
 				if err == nil {
-					yaml, _ = m.client.GetResourceYAML(ctx, "Service", svc.Namespace, svc.Name)
-					json, _ = m.client.GetResourceJSON(ctx, "Service", svc.Namespace, svc.Name)
+					yaml, _ = client.GetResourceYAML(ctx, "Service", svc.Namespace, svc.Name)
+					json, _ = client.GetResourceJSON(ctx, "Service", svc.Namespace, svc.Name)
 				}
 			}
 
 		case components.ResourceTypeDeployment:
 			dep := m.resourceList.GetSelectedDeployment()
 			if dep != nil {
-				data, err = m.client.DescribeDeployment(ctx, dep.Namespace, dep.Name)
+				client := m.clientFor(dep.Cluster)
+				data, err = client.DescribeDeployment(ctx, dep.Namespace, dep.Name)
 				if err == nil {
-					yaml, _ = m.client.GetResourceYAML(ctx, "Deployment", dep.Namespace, dep.Name)
-					json, _ = m.client.GetResourceJSON(ctx, "Deployment", dep.Namespace, dep.Name)
+					yaml, _ = client.GetResourceYAML(ctx, "Deployment", dep.Namespace, dep.Name)
+					json, _ = client.GetResourceJSON(ctx, "Deployment", dep.Namespace, dep.Name)
 				}
 			}
 
 		case components.ResourceTypeStatefulSet:
 			sts := m.resourceList.GetSelectedStatefulSet()
 			if sts != nil {
-				data, err = m.client.DescribeStatefulSet(ctx, sts.Namespace, sts.Name)
+				client := m.clientFor(sts.Cluster)
+				data, err = client.DescribeStatefulSet(ctx, sts.Namespace, sts.Name)
+				if err == nil {
+					yaml, _ = client.GetResourceYAML(ctx, "StatefulSet", sts.Namespace, sts.Name)
+					json, _ = client.GetResourceJSON(ctx, "StatefulSet", sts.Namespace, sts.Name)
+				}
+			}
+
+		case components.ResourceTypeConfigMap:
+			cm := m.resourceList.GetSelectedConfigMap()
+			if cm != nil {
+				client := m.clientFor(cm.Cluster)
+				data, err = client.DescribeConfigMap(ctx, cm.Namespace, cm.Name)
+				if err == nil {
+					yaml, _ = client.GetResourceYAML(ctx, "ConfigMap", cm.Namespace, cm.Name)
+					json, _ = client.GetResourceJSON(ctx, "ConfigMap", cm.Namespace, cm.Name)
+				}
+			}
+
+		case components.ResourceTypeSecret:
+			secret := m.resourceList.GetSelectedSecret()
+			if secret != nil {
+				client := m.clientFor(secret.Cluster)
+				data, err = client.DescribeSecret(ctx, secret.Namespace, secret.Name)
+				if err == nil {
+					// Values are redacted in the YAML and JSON output
+					yaml, _ = client.GetResourceYAML(ctx, "Secret", secret.Namespace, secret.Name)
+					json, _ = client.GetResourceJSON(ctx, "Secret", secret.Namespace, secret.Name)
+				}
+			}
+
+		case components.ResourceTypeNode:
+			node := m.resourceList.GetSelectedNode()
+			if node != nil {
+				client := m.clientFor(node.Cluster)
+				data, err = client.DescribeNode(ctx, node.Name)
 				if err == nil {
-					yaml, _ = m.client.GetResourceYAML(ctx, "StatefulSet", sts.Namespace, sts.Name)
-					json, _ = m.client.GetResourceJSON(ctx, "StatefulSet", sts.Namespace, sts.Name)
+					yaml, _ = client.GetResourceYAML(ctx, "Node", "", node.Name)
+					json, _ = client.GetResourceJSON(ctx, "Node", "", node.Name)
+				}
+			}
+
+		case components.ResourceTypeJob:
+			job := m.resourceList.GetSelectedJob()
+			if job != nil {
+				client := m.clientFor(job.Cluster)
+				data, err = client.DescribeJob(ctx, job.Namespace, job.Name)
+				if err == nil {
+					yaml, _ = client.GetResourceYAML(ctx, "Job", job.Namespace, job.Name)
+					json, _ = client.GetResourceJSON(ctx, "Job", job.Namespace, job.Name)
+				}
+			}
+
+		case components.ResourceTypeCronJob:
+			cronJob := m.resourceList.GetSelectedCronJob()
+			if cronJob != nil {
+				client := m.clientFor(cronJob.Cluster)
+				data, err = client.DescribeCronJob(ctx, cronJob.Namespace, cronJob.Name)
+				if err == nil {
+					yaml, _ = client.GetResourceYAML(ctx, "CronJob", cronJob.Namespace, cronJob.Name)
+					json, _ = client.GetResourceJSON(ctx, "CronJob", cronJob.Namespace, cronJob.Name)
+				}
+			}
+
+		case components.ResourceTypeDaemonSet:
+			daemonSet := m.resourceList.GetSelectedDaemonSet()
+			if daemonSet != nil {
+				client := m.clientFor(daemonSet.Cluster)
+				data, err = client.DescribeDaemonSet(ctx, daemonSet.Namespace, daemonSet.Name)
+				if err == nil {
+					yaml, _ = client.GetResourceYAML(ctx, "DaemonSet", daemonSet.Namespace, daemonSet.Name)
+					json, _ = client.GetResourceJSON(ctx, "DaemonSet", daemonSet.Namespace, daemonSet.Name)
+				}
+			}
+
+		case components.ResourceTypeReplicaSet:
+			replicaSet := m.resourceList.GetSelectedReplicaSet()
+			if replicaSet != nil {
+				client := m.clientFor(replicaSet.Cluster)
+				data, err = client.DescribeReplicaSet(ctx, replicaSet.Namespace, replicaSet.Name)
+				if err == nil {
+					yaml, _ = client.GetResourceYAML(ctx, "ReplicaSet", replicaSet.Namespace, replicaSet.Name)
+					json, _ = client.GetResourceJSON(ctx, "ReplicaSet", replicaSet.Namespace, replicaSet.Name)
+				}
+			}
+
+		case components.ResourceTypeIngress:
+			ingress := m.resourceList.GetSelectedIngress()
+			if ingress != nil {
+				client := m.clientFor(ingress.Cluster)
+				data, err = client.DescribeIngress(ctx, ingress.Namespace, ingress.Name)
+				if err == nil {
+					yaml, _ = client.GetResourceYAML(ctx, "Ingress", ingress.Namespace, ingress.Name)
+					json, _ = client.GetResourceJSON(ctx, "Ingress", ingress.Namespace, ingress.Name)
+				}
+			}
+
+		case components.ResourceTypePersistentVolumeClaim:
+			claim := m.resourceList.GetSelectedPersistentVolumeClaim()
+			if claim != nil {
+				client := m.clientFor(claim.Cluster)
+				data, err = client.DescribePersistentVolumeClaim(ctx, claim.Namespace, claim.Name)
+				if err == nil {
+					yaml, _ = client.GetResourceYAML(ctx, "PersistentVolumeClaim", claim.Namespace, claim.Name)
+					json, _ = client.GetResourceJSON(ctx, "PersistentVolumeClaim", claim.Namespace, claim.Name)
+				}
+			}
+
+		case components.ResourceTypePersistentVolume:
+			volume := m.resourceList.GetSelectedPersistentVolume()
+			if volume != nil {
+				client := m.clientFor(volume.Cluster)
+				data, err = client.DescribePersistentVolume(ctx, volume.Name)
+				if err == nil {
+					yaml, _ = client.GetResourceYAML(ctx, "PersistentVolume", "", volume.Name)
+					json, _ = client.GetResourceJSON(ctx, "PersistentVolume", "", volume.Name)
+				}
+			}
+
+		case components.ResourceTypeStorageClass:
+			storageClass := m.resourceList.GetSelectedStorageClass()
+			if storageClass != nil {
+				client := m.clientFor(storageClass.Cluster)
+				data, err = client.DescribeStorageClass(ctx, storageClass.Name)
+				if err == nil {
+					yaml, _ = client.GetResourceYAML(ctx, "StorageClass", "", storageClass.Name)
+					json, _ = client.GetResourceJSON(ctx, "StorageClass", "", storageClass.Name)
+				}
+			}
+
+		case components.ResourceTypeHorizontalPodAutoscaler:
+			hpa := m.resourceList.GetSelectedHorizontalPodAutoscaler()
+			if hpa != nil {
+				client := m.clientFor(hpa.Cluster)
+				data, err = client.DescribeHorizontalPodAutoscaler(ctx, hpa.Namespace, hpa.Name)
+				if err == nil {
+					yaml, _ = client.GetResourceYAML(ctx, "HorizontalPodAutoscaler", hpa.Namespace, hpa.Name)
+					json, _ = client.GetResourceJSON(ctx, "HorizontalPodAutoscaler", hpa.Namespace, hpa.Name)
+				}
+			}
+
+		case components.ResourceTypeServiceAccount:
+			serviceAccount := m.resourceList.GetSelectedServiceAccount()
+			if serviceAccount != nil {
+				client := m.clientFor(serviceAccount.Cluster)
+				data, err = client.DescribeServiceAccount(ctx, serviceAccount.Namespace, serviceAccount.Name)
+				if err == nil {
+					yaml, _ = client.GetResourceYAML(ctx, "ServiceAccount", serviceAccount.Namespace, serviceAccount.Name)
+					json, _ = client.GetResourceJSON(ctx, "ServiceAccount", serviceAccount.Namespace, serviceAccount.Name)
+				}
+			}
+
+		case components.ResourceTypeRole:
+			role := m.resourceList.GetSelectedRole()
+			if role != nil {
+				client := m.clientFor(role.Cluster)
+				data, err = client.DescribeRole(ctx, role.Namespace, role.Name)
+				if err == nil {
+					yaml, _ = client.GetResourceYAML(ctx, "Role", role.Namespace, role.Name)
+					json, _ = client.GetResourceJSON(ctx, "Role", role.Namespace, role.Name)
+				}
+			}
+
+		case components.ResourceTypeClusterRole:
+			clusterRole := m.resourceList.GetSelectedClusterRole()
+			if clusterRole != nil {
+				client := m.clientFor(clusterRole.Cluster)
+				data, err = client.DescribeClusterRole(ctx, clusterRole.Name)
+				if err == nil {
+					yaml, _ = client.GetResourceYAML(ctx, "ClusterRole", "", clusterRole.Name)
+					json, _ = client.GetResourceJSON(ctx, "ClusterRole", "", clusterRole.Name)
+				}
+			}
+
+		case components.ResourceTypeRoleBinding:
+			binding := m.resourceList.GetSelectedRoleBinding()
+			if binding != nil {
+				client := m.clientFor(binding.Cluster)
+				data, err = client.DescribeRoleBinding(ctx, binding.Namespace, binding.Name)
+				if err == nil {
+					yaml, _ = client.GetResourceYAML(ctx, "RoleBinding", binding.Namespace, binding.Name)
+					json, _ = client.GetResourceJSON(ctx, "RoleBinding", binding.Namespace, binding.Name)
+				}
+			}
+
+		case components.ResourceTypeClusterRoleBinding:
+			clusterBinding := m.resourceList.GetSelectedClusterRoleBinding()
+			if clusterBinding != nil {
+				client := m.clientFor(clusterBinding.Cluster)
+				data, err = client.DescribeClusterRoleBinding(ctx, clusterBinding.Name)
+				if err == nil {
+					yaml, _ = client.GetResourceYAML(ctx, "ClusterRoleBinding", "", clusterBinding.Name)
+					json, _ = client.GetResourceJSON(ctx, "ClusterRoleBinding", "", clusterBinding.Name)
+				}
+			}
+
+		case components.ResourceTypeNetworkPolicy:
+			policy := m.resourceList.GetSelectedNetworkPolicy()
+			if policy != nil {
+				client := m.clientFor(policy.Cluster)
+				data, err = client.DescribeNetworkPolicy(ctx, policy.Namespace, policy.Name)
+				if err == nil {
+					yaml, _ = client.GetResourceYAML(ctx, "NetworkPolicy", policy.Namespace, policy.Name)
+					json, _ = client.GetResourceJSON(ctx, "NetworkPolicy", policy.Namespace, policy.Name)
+				}
+			}
+
+		case components.ResourceTypePodDisruptionBudget:
+			budget := m.resourceList.GetSelectedPodDisruptionBudget()
+			if budget != nil {
+				client := m.clientFor(budget.Cluster)
+				data, err = client.DescribePodDisruptionBudget(ctx, budget.Namespace, budget.Name)
+				if err == nil {
+					yaml, _ = client.GetResourceYAML(ctx, "PodDisruptionBudget", budget.Namespace, budget.Name)
+					json, _ = client.GetResourceJSON(ctx, "PodDisruptionBudget", budget.Namespace, budget.Name)
+				}
+			}
+
+		case components.ResourceTypeGeneric:
+			obj := m.resourceList.GetSelectedGeneric()
+			if obj != nil && m.genericResource != nil {
+				client := m.clientFor(obj.Cluster)
+				resource := *m.genericResource
+				data, err = client.DescribeDynamic(ctx, resource, obj.Namespace, obj.Name)
+				if err == nil {
+					yaml, _ = client.GetDynamicYAML(ctx, resource, obj.Namespace, obj.Name)
+					json, _ = client.GetDynamicJSON(ctx, resource, obj.Namespace, obj.Name)
 				}
 			}
 		}
@@ -1043,6 +4434,11 @@ func (m Model) handleLogViewerKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 // handleDescribeViewerKeys handles key presses in describe viewer mode
 func (m Model) handleDescribeViewerKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch {
+	case key.Matches(msg, m.keyMap.Edit):
+		// Edit the object from its YAML; snapshots and replays are read-only
+		if m.describeViewer.Format() == models.FormatYAML && !m.selectedReadOnly() {
+			return m, m.openEditor()
+		}
 	case key.Matches(msg, m.keyMap.YAML):
 		m.describeViewer.SetFormat(models.FormatYAML)
 	case key.Matches(msg, m.keyMap.JSON):
@@ -1105,9 +4501,33 @@ func (m Model) startWatchMode() tea.Cmd {
 			k8s.ResourceTypeDeployment,
 			k8s.ResourceTypeStatefulSet,
 			k8s.ResourceTypeEvent,
+			k8s.ResourceTypeConfigMap,
+			k8s.ResourceTypeSecret,
+			k8s.ResourceTypeNode,
+			k8s.ResourceTypeJob,
+			k8s.ResourceTypeCronJob,
+			k8s.ResourceTypeDaemonSet,
+			k8s.ResourceTypeReplicaSet,
+			k8s.ResourceTypeIngress,
+			k8s.ResourceTypePersistentVolumeClaim,
+			k8s.ResourceTypePersistentVolume,
+			k8s.ResourceTypeStorageClass,
+			k8s.ResourceTypeHorizontalPodAutoscaler,
+			k8s.ResourceTypeServiceAccount,
+			k8s.ResourceTypeRole,
+			k8s.ResourceTypeClusterRole,
+			k8s.ResourceTypeRoleBinding,
+			k8s.ResourceTypeClusterRoleBinding,
+			k8s.ResourceTypeNetworkPolicy,
+			k8s.ResourceTypePodDisruptionBudget,
 		}
 
-		err := m.watchManager.Start(ctx, resourceTypes)
+		var err error
+		if m.multiCluster != nil {
+			err = m.multiCluster.Start(ctx, resourceTypes)
+		} else {
+			err = m.watchManager.Start(ctx, resourceTypes)
+		}
 		if err != nil {
 			return errMsg{err: fmt.Errorf("failed to start watch manager: %w", err)}
 		}
@@ -1118,21 +4538,48 @@ func (m Model) startWatchMode() tea.Cmd {
 
 // waitForWatchEvents waits for the next watch event from any resource watcher
 func (m Model) waitForWatchEvents() tea.Cmd {
+	eventChan := m.watchManager.GetEventChannel()
+	errorChan := m.watchManager.GetErrorChannel()
+	if m.multiCluster != nil {
+		eventChan = m.multiCluster.GetEventChannel()
+		errorChan = m.multiCluster.GetErrorChannel()
+	}
+
 	return func() tea.Msg {
 		select {
-		case event := <-m.watchManager.GetEventChannel():
+		case event := <-eventChan:
 			return watchEventMsg{event: event}
-		case err := <-m.watchManager.GetErrorChannel():
+		case err := <-errorChan:
 			return watchErrorMsg{error: err}
 		}
 	}
 }
 
+// activeWatchError returns the fatal error that stopped the watcher of the active tab, if any
+func (m Model) activeWatchError() error {
+	return m.watchErrors[components.ResourceType(m.tabs.GetActiveTab())]
+}
+
+// retryWatch restarts the watcher of the active tab if a fatal error stopped it.
+// Multi-cluster watchers are only restarted by switching namespace.
+func (m *Model) retryWatch() {
+	resourceType := components.ResourceType(m.tabs.GetActiveTab())
+	if m.watchErrors[resourceType] == nil || !m.useWatchAPI || m.multiCluster != nil {
+		return
+	}
+
+	delete(m.watchErrors, resourceType)
+	m.err = nil
+	if err := m.watchManager.RestartWatcher(k8s.ResourceType(resourceType)); err != nil {
+		m.err = fmt.Errorf("failed to restart %s watcher: %w", k8s.ResourceType(resourceType), err)
+	}
+}
+
 // handleWatchEvent processes a watch event and updates the resource list
 func (m *Model) handleWatchEvent(event k8s.WatchEvent) {
 	m.loading = false
 	m.connected = true
-	m.err = nil
+	m.err = m.activeWatchError()
 	m.header.SetConnected(true)
 
 	// Convert k8s.ResourceType to components.ResourceType
@@ -1141,73 +4588,289 @@ func (m *Model) handleWatchEvent(event k8s.WatchEvent) {
 
 	switch event.EventType {
 	case "ADDED":
-		m.handleResourceAdded(componentResourceType, event.Object)
+		m.handleResourceAdded(componentResourceType, event.Cluster, event.Object)
 	case "MODIFIED":
-		m.handleResourceModified(componentResourceType, event.Object)
+		m.handleResourceModified(componentResourceType, event.Cluster, event.Object)
 	case "DELETED":
-		m.handleResourceDeleted(componentResourceType, event.Object)
+		m.handleResourceDeleted(componentResourceType, event.Cluster, event.Object)
 	}
+	m.refreshWorkloadProgress()
 }
 
 // handleResourceAdded adds or updates a resource in the list
-func (m *Model) handleResourceAdded(resourceType components.ResourceType, obj interface{}) {
+func (m *Model) handleResourceAdded(resourceType components.ResourceType, cluster string, obj interface{}) {
 	switch resourceType {
 	case components.ResourceTypePod:
 		if pod, ok := obj.(*corev1.Pod); ok {
 			podInfo := models.NewPodInfo(pod)
+			podInfo.Cluster = cluster
 			m.resourceList.AddOrUpdatePod(podInfo)
 		}
 	case components.ResourceTypeService:
 		if svc, ok := obj.(*corev1.Service); ok {
 			svcInfo := models.NewServiceInfo(svc)
+			svcInfo.Cluster = cluster
 			m.resourceList.AddOrUpdateService(svcInfo)
 		}
 	case components.ResourceTypeDeployment:
 		if dep, ok := obj.(*appsv1.Deployment); ok {
 			depInfo := models.NewDeploymentInfo(dep)
+			depInfo.Cluster = cluster
 			m.resourceList.AddOrUpdateDeployment(depInfo)
 		}
 	case components.ResourceTypeStatefulSet:
 		if sts, ok := obj.(*appsv1.StatefulSet); ok {
 			stsInfo := models.NewStatefulSetInfo(sts)
+			stsInfo.Cluster = cluster
 			m.resourceList.AddOrUpdateStatefulSet(stsInfo)
 		}
 	case components.ResourceTypeEvent:
 		if evt, ok := obj.(*corev1.Event); ok {
 			evtInfo := models.NewEventInfo(evt)
+			evtInfo.Cluster = cluster
 			m.resourceList.AddOrUpdateEvent(evtInfo)
 		}
+	case components.ResourceTypeConfigMap:
+		if cm, ok := obj.(*corev1.ConfigMap); ok {
+			cmInfo := models.NewConfigMapInfo(cm)
+			cmInfo.Cluster = cluster
+			m.resourceList.AddOrUpdateConfigMap(cmInfo)
+		}
+	case components.ResourceTypeSecret:
+		if secret, ok := obj.(*corev1.Secret); ok {
+			secretInfo := models.NewSecretInfo(secret)
+			secretInfo.Cluster = cluster
+			m.resourceList.AddOrUpdateSecret(secretInfo)
+		}
+	case components.ResourceTypeNode:
+		if node, ok := obj.(*corev1.Node); ok {
+			nodeInfo := models.NewNodeInfo(node)
+			nodeInfo.Cluster = cluster
+			m.resourceList.AddOrUpdateNode(nodeInfo)
+		}
+	case components.ResourceTypeJob:
+		if job, ok := obj.(*batchv1.Job); ok {
+			jobInfo := models.NewJobInfo(job)
+			jobInfo.Cluster = cluster
+			m.resourceList.AddOrUpdateJob(jobInfo)
+		}
+	case components.ResourceTypeCronJob:
+		if cronJob, ok := obj.(*batchv1.CronJob); ok {
+			cronJobInfo := models.NewCronJobInfo(cronJob)
+			cronJobInfo.Cluster = cluster
+			m.resourceList.AddOrUpdateCronJob(cronJobInfo)
+		}
+	case components.ResourceTypeDaemonSet:
+		if daemonSet, ok := obj.(*appsv1.DaemonSet); ok {
+			daemonSetInfo := models.NewDaemonSetInfo(daemonSet)
+			daemonSetInfo.Cluster = cluster
+			m.resourceList.AddOrUpdateDaemonSet(daemonSetInfo)
+		}
+	case components.ResourceTypeReplicaSet:
+		if replicaSet, ok := obj.(*appsv1.ReplicaSet); ok {
+			replicaSetInfo := models.NewReplicaSetInfo(replicaSet)
+			replicaSetInfo.Cluster = cluster
+			m.resourceList.AddOrUpdateReplicaSet(replicaSetInfo)
+		}
+	case components.ResourceTypeIngress:
+		if ingress, ok := obj.(*networkingv1.Ingress); ok {
+			ingressInfo := models.NewIngressInfo(ingress)
+			ingressInfo.Cluster = cluster
+			m.resourceList.AddOrUpdateIngress(ingressInfo)
+		}
+	case components.ResourceTypePersistentVolumeClaim:
+		if claim, ok := obj.(*corev1.PersistentVolumeClaim); ok {
+			claimInfo := models.NewPersistentVolumeClaimInfo(claim)
+			claimInfo.Cluster = cluster
+			m.resourceList.AddOrUpdatePersistentVolumeClaim(claimInfo)
+		}
+	case components.ResourceTypePersistentVolume:
+		if volume, ok := obj.(*corev1.PersistentVolume); ok {
+			volumeInfo := models.NewPersistentVolumeInfo(volume)
+			volumeInfo.Cluster = cluster
+			m.resourceList.AddOrUpdatePersistentVolume(volumeInfo)
+		}
+	case components.ResourceTypeStorageClass:
+		if storageClass, ok := obj.(*storagev1.StorageClass); ok {
+			storageClassInfo := models.NewStorageClassInfo(storageClass)
+			storageClassInfo.Cluster = cluster
+			m.resourceList.AddOrUpdateStorageClass(storageClassInfo)
+		}
+	case components.ResourceTypeHorizontalPodAutoscaler:
+		if hpa, ok := obj.(*autoscalingv2.HorizontalPodAutoscaler); ok {
+			hpaInfo := models.NewHorizontalPodAutoscalerInfo(hpa)
+			hpaInfo.Cluster = cluster
+			m.resourceList.AddOrUpdateHorizontalPodAutoscaler(hpaInfo)
+		}
+	case components.ResourceTypeServiceAccount:
+		if serviceAccount, ok := obj.(*corev1.ServiceAccount); ok {
+			serviceAccountInfo := models.NewServiceAccountInfo(serviceAccount)
+			serviceAccountInfo.Cluster = cluster
+			m.resourceList.AddOrUpdateServiceAccount(serviceAccountInfo)
+		}
+	case components.ResourceTypeRole:
+		if role, ok := obj.(*rbacv1.Role); ok {
+			roleInfo := models.NewRoleInfo(role)
+			roleInfo.Cluster = cluster
+			m.resourceList.AddOrUpdateRole(roleInfo)
+		}
+	case components.ResourceTypeClusterRole:
+		if clusterRole, ok := obj.(*rbacv1.ClusterRole); ok {
+			clusterRoleInfo := models.NewClusterRoleInfo(clusterRole)
+			clusterRoleInfo.Cluster = cluster
+			m.resourceList.AddOrUpdateClusterRole(clusterRoleInfo)
+		}
+	case components.ResourceTypeRoleBinding:
+		if binding, ok := obj.(*rbacv1.RoleBinding); ok {
+			bindingInfo := models.NewRoleBindingInfo(binding)
+			bindingInfo.Cluster = cluster
+			m.resourceList.AddOrUpdateRoleBinding(bindingInfo)
+		}
+	case components.ResourceTypeClusterRoleBinding:
+		if clusterBinding, ok := obj.(*rbacv1.ClusterRoleBinding); ok {
+			clusterBindingInfo := models.NewClusterRoleBindingInfo(clusterBinding)
+			clusterBindingInfo.Cluster = cluster
+			m.resourceList.AddOrUpdateClusterRoleBinding(clusterBindingInfo)
+		}
+	case components.ResourceTypeNetworkPolicy:
+		if policy, ok := obj.(*networkingv1.NetworkPolicy); ok {
+			policyInfo := models.NewNetworkPolicyInfo(policy)
+			policyInfo.Cluster = cluster
+			m.resourceList.AddOrUpdateNetworkPolicy(policyInfo)
+		}
+	case components.ResourceTypePodDisruptionBudget:
+		if budget, ok := obj.(*policyv1.PodDisruptionBudget); ok {
+			budgetInfo := models.NewPodDisruptionBudgetInfo(budget)
+			budgetInfo.Cluster = cluster
+			m.resourceList.AddOrUpdatePodDisruptionBudget(budgetInfo)
+		}
+	case components.ResourceTypeGeneric:
+		if u, ok := obj.(*unstructured.Unstructured); ok && m.isGenericResource(u) {
+			info := models.NewGenericResourceInfo(u)
+			info.Cluster = cluster
+			m.resourceList.AddOrUpdateGeneric(info)
+		}
 	}
 }
 
+// isGenericResource reports whether obj is of the kind shown in the generic tab.
+// Events for a previously selected kind can still be queued after switching.
+func (m *Model) isGenericResource(obj *unstructured.Unstructured) bool {
+	if m.genericResource == nil {
+		return false
+	}
+	gvk := obj.GroupVersionKind()
+	return gvk.Group == m.genericResource.Group && gvk.Kind == m.genericResource.Kind
+}
+
 // handleResourceModified updates a resource in the list
-func (m *Model) handleResourceModified(resourceType components.ResourceType, obj interface{}) {
+func (m *Model) handleResourceModified(resourceType components.ResourceType, cluster string, obj interface{}) {
 	// Modified is handled the same as added for our use case
-	m.handleResourceAdded(resourceType, obj)
+	m.handleResourceAdded(resourceType, cluster, obj)
 }
 
 // handleResourceDeleted removes a resource from the list
-func (m *Model) handleResourceDeleted(resourceType components.ResourceType, obj interface{}) {
+func (m *Model) handleResourceDeleted(resourceType components.ResourceType, cluster string, obj interface{}) {
 	switch resourceType {
 	case components.ResourceTypePod:
 		if pod, ok := obj.(*corev1.Pod); ok {
-			m.resourceList.RemovePod(pod.Namespace, pod.Name)
+			m.resourceList.RemovePodFromCluster(cluster, pod.Namespace, pod.Name)
 		}
 	case components.ResourceTypeService:
 		if svc, ok := obj.(*corev1.Service); ok {
-			m.resourceList.RemoveService(svc.Namespace, svc.Name)
+			m.resourceList.RemoveServiceFromCluster(cluster, svc.Namespace, svc.Name)
 		}
 	case components.ResourceTypeDeployment:
 		if dep, ok := obj.(*appsv1.Deployment); ok {
-			m.resourceList.RemoveDeployment(dep.Namespace, dep.Name)
+			m.resourceList.RemoveDeploymentFromCluster(cluster, dep.Namespace, dep.Name)
 		}
 	case components.ResourceTypeStatefulSet:
 		if sts, ok := obj.(*appsv1.StatefulSet); ok {
-			m.resourceList.RemoveStatefulSet(sts.Namespace, sts.Name)
+			m.resourceList.RemoveStatefulSetFromCluster(cluster, sts.Namespace, sts.Name)
 		}
 	case components.ResourceTypeEvent:
 		if evt, ok := obj.(*corev1.Event); ok {
-			m.resourceList.RemoveEvent(evt.Namespace, evt.Name)
+			m.resourceList.RemoveEventFromCluster(cluster, evt.Namespace, evt.Name)
+		}
+	case components.ResourceTypeConfigMap:
+		if cm, ok := obj.(*corev1.ConfigMap); ok {
+			m.resourceList.RemoveConfigMapFromCluster(cluster, cm.Namespace, cm.Name)
+		}
+	case components.ResourceTypeSecret:
+		if secret, ok := obj.(*corev1.Secret); ok {
+			m.resourceList.RemoveSecretFromCluster(cluster, secret.Namespace, secret.Name)
+		}
+	case components.ResourceTypeNode:
+		if node, ok := obj.(*corev1.Node); ok {
+			m.resourceList.RemoveNodeFromCluster(cluster, node.Name)
+		}
+	case components.ResourceTypeJob:
+		if job, ok := obj.(*batchv1.Job); ok {
+			m.resourceList.RemoveJobFromCluster(cluster, job.Namespace, job.Name)
+		}
+	case components.ResourceTypeCronJob:
+		if cronJob, ok := obj.(*batchv1.CronJob); ok {
+			m.resourceList.RemoveCronJobFromCluster(cluster, cronJob.Namespace, cronJob.Name)
+		}
+	case components.ResourceTypeDaemonSet:
+		if daemonSet, ok := obj.(*appsv1.DaemonSet); ok {
+			m.resourceList.RemoveDaemonSetFromCluster(cluster, daemonSet.Namespace, daemonSet.Name)
+		}
+	case components.ResourceTypeReplicaSet:
+		if replicaSet, ok := obj.(*appsv1.ReplicaSet); ok {
+			m.resourceList.RemoveReplicaSetFromCluster(cluster, replicaSet.Namespace, replicaSet.Name)
+		}
+	case components.ResourceTypeIngress:
+		if ingress, ok := obj.(*networkingv1.Ingress); ok {
+			m.resourceList.RemoveIngressFromCluster(cluster, ingress.Namespace, ingress.Name)
+		}
+	case components.ResourceTypePersistentVolumeClaim:
+		if claim, ok := obj.(*corev1.PersistentVolumeClaim); ok {
+			m.resourceList.RemovePersistentVolumeClaimFromCluster(cluster, claim.Namespace, claim.Name)
+		}
+	case components.ResourceTypePersistentVolume:
+		if volume, ok := obj.(*corev1.PersistentVolume); ok {
+			m.resourceList.RemovePersistentVolumeFromCluster(cluster, volume.Name)
+		}
+	case components.ResourceTypeStorageClass:
+		if storageClass, ok := obj.(*storagev1.StorageClass); ok {
+			m.resourceList.RemoveStorageClassFromCluster(cluster, storageClass.Name)
+		}
+	case components.ResourceTypeHorizontalPodAutoscaler:
+		if hpa, ok := obj.(*autoscalingv2.HorizontalPodAutoscaler); ok {
+			m.resourceList.RemoveHorizontalPodAutoscalerFromCluster(cluster, hpa.Namespace, hpa.Name)
+		}
+	case components.ResourceTypeServiceAccount:
+		if serviceAccount, ok := obj.(*corev1.ServiceAccount); ok {
+			m.resourceList.RemoveServiceAccountFromCluster(cluster, serviceAccount.Namespace, serviceAccount.Name)
+		}
+	case components.ResourceTypeRole:
+		if role, ok := obj.(*rbacv1.Role); ok {
+			m.resourceList.RemoveRoleFromCluster(cluster, role.Namespace, role.Name)
+		}
+	case components.ResourceTypeClusterRole:
+		if clusterRole, ok := obj.(*rbacv1.ClusterRole); ok {
+			m.resourceList.RemoveClusterRoleFromCluster(cluster, clusterRole.Name)
+		}
+	case components.ResourceTypeRoleBinding:
+		if binding, ok := obj.(*rbacv1.RoleBinding); ok {
+			m.resourceList.RemoveRoleBindingFromCluster(cluster, binding.Namespace, binding.Name)
+		}
+	case components.ResourceTypeClusterRoleBinding:
+		if clusterBinding, ok := obj.(*rbacv1.ClusterRoleBinding); ok {
+			m.resourceList.RemoveClusterRoleBindingFromCluster(cluster, clusterBinding.Name)
+		}
+	case components.ResourceTypeNetworkPolicy:
+		if policy, ok := obj.(*networkingv1.NetworkPolicy); ok {
+			m.resourceList.RemoveNetworkPolicyFromCluster(cluster, policy.Namespace, policy.Name)
+		}
+	case components.ResourceTypePodDisruptionBudget:
+		if budget, ok := obj.(*policyv1.PodDisruptionBudget); ok {
+			m.resourceList.RemovePodDisruptionBudgetFromCluster(cluster, budget.Namespace, budget.Name)
+		}
+	case components.ResourceTypeGeneric:
+		if u, ok := obj.(*unstructured.Unstructured); ok && m.isGenericResource(u) {
+			m.resourceList.RemoveGenericFromCluster(cluster, u.GetNamespace(), u.GetName())
 		}
 	}
 }
@@ -1215,6 +4878,9 @@ func (m *Model) handleResourceDeleted(resourceType components.ResourceType, obj
 // checkConnectionState checks the connection state of all watchers
 func (m Model) checkConnectionState() tea.Cmd {
 	return func() tea.Msg {
+		if m.multiCluster != nil {
+			return connectionStateMsg{state: m.multiCluster.GetOverallConnectionState()}
+		}
 		state := m.watchManager.GetOverallConnectionState()
 		return connectionStateMsg{state: state}
 	}
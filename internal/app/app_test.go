@@ -1,13 +1,43 @@
 package app
 
 import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	tea "github.com/charmbracelet/bubbletea"
+
 	"github.com/williajm/k8s-tui/internal/config"
 	"github.com/williajm/k8s-tui/internal/k8s"
 	"github.com/williajm/k8s-tui/internal/models"
 	"github.com/williajm/k8s-tui/internal/ui/components"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
 )
 
 // TestViewModeConstants verifies ViewMode constants are correct
@@ -22,6 +52,7 @@ func TestViewModeConstants(t *testing.T) {
 		{"ViewModeLogStream", ViewModeLogStream, 2},
 		{"ViewModeDescribe", ViewModeDescribe, 3},
 		{"ViewModeContainerSelect", ViewModeContainerSelect, 4},
+		{"ViewModeValue", ViewModeValue, 5},
 	}
 
 	for _, tt := range tests {
@@ -188,3 +219,2440 @@ func TestConnectionStateMsg(t *testing.T) {
 		t.Errorf("state = %v, want StateConnected", msg.state)
 	}
 }
+
+// newTestModel creates a model backed by a fake clientset
+func newTestModel() Model {
+	client := &k8s.Client{}
+	client.SetClientsetForTesting(fake.NewSimpleClientset())
+	return NewModelWithConfig(client, config.DefaultConfig())
+}
+
+// TestContextKeyShowsSelector tests that the context key opens the context selector
+func TestContextKeyShowsSelector(t *testing.T) {
+	model := newTestModel()
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	m := updated.(Model)
+
+	if !m.contextSelector.IsVisible() {
+		t.Error("Expected context selector to be visible after pressing c")
+	}
+
+	// Esc closes it again
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updated.(Model)
+	if m.contextSelector.IsVisible() {
+		t.Error("Expected context selector to be hidden after esc")
+	}
+}
+
+// TestContextSwitchedMsg tests that a successful context switch replaces the client and resets state
+func TestContextSwitchedMsg(t *testing.T) {
+	model := newTestModel()
+	model.resourceList.SetPods([]models.PodInfo{{Name: "old-pod", Namespace: "default"}})
+	model.viewMode = ViewModeDetail
+
+	newClient := &k8s.Client{}
+	newClient.SetClientsetForTesting(fake.NewSimpleClientset())
+
+	updated, cmd := model.Update(contextSwitchedMsg{client: newClient})
+	m := updated.(Model)
+
+	if m.client != newClient {
+		t.Error("Expected model to use the new client")
+	}
+	if m.watchManager.GetClient() != newClient {
+		t.Error("Expected watch manager to use the new client")
+	}
+	if m.viewMode != ViewModeList {
+		t.Errorf("viewMode = %v, want ViewModeList", m.viewMode)
+	}
+	if m.resourceList.GetSelectedPod() != nil {
+		t.Error("Expected resource list to be cleared")
+	}
+	if cmd == nil {
+		t.Error("Expected a command to reload namespaces")
+	}
+}
+
+// TestContextSwitchedMsgError tests that a failed context switch keeps the old client
+func TestContextSwitchedMsgError(t *testing.T) {
+	model := newTestModel()
+	oldClient := model.client
+
+	updated, _ := model.Update(contextSwitchedMsg{err: errors.New("unreachable")})
+	m := updated.(Model)
+
+	if m.client != oldClient {
+		t.Error("Expected model to keep the old client")
+	}
+	if m.err == nil {
+		t.Error("Expected error to be set")
+	}
+}
+
+// newTestClusterClient creates a client for the named context backed by a fake clientset
+func newTestClusterClient(contextName string, objects ...runtime.Object) *k8s.Client {
+	client := &k8s.Client{}
+	client.SetClientsetForTesting(fake.NewSimpleClientset(objects...))
+	client.SetCurrentContextForTesting(contextName)
+	client.SetNamespace("default")
+	return client
+}
+
+// runCmd runs a command, including every command of a batch, and feeds the
+// resulting messages to the model
+func runCmd(m Model, cmd tea.Cmd) Model {
+	if cmd == nil {
+		return m
+	}
+	msg := cmd()
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		for _, batched := range batch {
+			m = runCmd(m, batched)
+		}
+		return m
+	}
+	updated, _ := m.Update(msg)
+	return updated.(Model)
+}
+
+// TestMultiClusterLoadResources tests that polling merges resources from every cluster
+func TestMultiClusterLoadResources(t *testing.T) {
+	east := newTestClusterClient("east", &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}})
+	west := newTestClusterClient("west", &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}})
+
+	model := NewMultiClusterModelWithConfig([]*k8s.Client{east, west}, config.DefaultConfig())
+
+	msg, ok := model.loadResources()().(resourcesLoadedMsg)
+	if !ok {
+		t.Fatal("Expected resourcesLoadedMsg")
+	}
+	if msg.err != nil {
+		t.Fatalf("Unexpected error: %v", msg.err)
+	}
+	if len(msg.pods) != 2 {
+		t.Fatalf("len(pods) = %d, want 2", len(msg.pods))
+	}
+	if msg.pods[0].Cluster != "east" || msg.pods[1].Cluster != "west" {
+		t.Errorf("Expected pods tagged east and west, got %q and %q", msg.pods[0].Cluster, msg.pods[1].Cluster)
+	}
+}
+
+// TestMultiClusterWatchEvents tests that watch events are applied per cluster
+func TestMultiClusterWatchEvents(t *testing.T) {
+	east := newTestClusterClient("east")
+	west := newTestClusterClient("west")
+	model := NewMultiClusterModelWithConfig([]*k8s.Client{east, west}, config.DefaultConfig())
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+	for _, cluster := range []string{"east", "west"} {
+		model.handleWatchEvent(k8s.WatchEvent{
+			ResourceType: k8s.ResourceTypePod,
+			EventType:    "ADDED",
+			Object:       pod,
+			Cluster:      cluster,
+		})
+	}
+
+	model.handleWatchEvent(k8s.WatchEvent{
+		ResourceType: k8s.ResourceTypePod,
+		EventType:    "DELETED",
+		Object:       pod,
+		Cluster:      "east",
+	})
+
+	selected := model.resourceList.GetSelectedPod()
+	if selected == nil {
+		t.Fatal("Expected a pod to remain")
+	}
+	if selected.Cluster != "west" {
+		t.Errorf("Expected remaining pod from west, got %q", selected.Cluster)
+	}
+	if model.clientFor(selected.Cluster) != west {
+		t.Error("Expected clientFor to return the west client")
+	}
+}
+
+// TestContextKeyIgnoredInSnapshotMode tests that the context selector stays closed for snapshots
+func TestContextKeyIgnoredInSnapshotMode(t *testing.T) {
+	client, err := k8s.NewSnapshotClient(t.TempDir(), "")
+	if err != nil {
+		t.Fatalf("NewSnapshotClient failed: %v", err)
+	}
+	model := NewModelWithConfig(client, config.DefaultConfig())
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	m := updated.(Model)
+
+	if m.contextSelector.IsVisible() {
+		t.Error("Expected context selector to stay hidden in snapshot mode")
+	}
+}
+
+// replayIntoModel feeds every event of a recording through the model's watch event handler
+func replayIntoModel(t *testing.T, model *Model, path string) {
+	t.Helper()
+
+	replayer, err := k8s.NewEventReplayer(path, 0)
+	if err != nil {
+		t.Fatalf("NewEventReplayer failed: %v", err)
+	}
+	for _, event := range replayer.Events() {
+		model.handleWatchEvent(event)
+	}
+}
+
+// TestReplayPodFlapping replays a pod being deleted and recreated and checks the final list
+func TestReplayPodFlapping(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "flapping.jsonl")
+	recorder, err := k8s.NewEventRecorder(path)
+	if err != nil {
+		t.Fatalf("NewEventRecorder failed: %v", err)
+	}
+
+	pod := func(phase corev1.PodPhase) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Status:     corev1.PodStatus{Phase: phase},
+		}
+	}
+	for _, event := range []k8s.WatchEvent{
+		{ResourceType: k8s.ResourceTypePod, EventType: watch.Added, Object: pod(corev1.PodRunning)},
+		{ResourceType: k8s.ResourceTypePod, EventType: watch.Deleted, Object: pod(corev1.PodRunning)},
+		{ResourceType: k8s.ResourceTypePod, EventType: watch.Added, Object: pod(corev1.PodPending)},
+		{ResourceType: k8s.ResourceTypePod, EventType: watch.Modified, Object: pod(corev1.PodRunning)},
+	} {
+		if err := recorder.Record(event); err != nil {
+			t.Fatalf("Record failed: %v", err)
+		}
+	}
+	if err := recorder.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+
+	model := newTestModel()
+	replayIntoModel(t, &model, path)
+
+	selected := model.resourceList.GetSelectedPod()
+	if selected == nil {
+		t.Fatal("Expected pod web after replay")
+	}
+	if selected.Status != "Running" {
+		t.Errorf("Expected final status Running, got %s", selected.Status)
+	}
+}
+
+const widgetManifests = `apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: gizmo
+  namespace: default
+status:
+  conditions:
+  - type: Ready
+    status: "True"
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: web
+  namespace: default
+`
+
+// newWidgetModel creates a model backed by a snapshot holding a custom resource
+func newWidgetModel(t *testing.T) Model {
+	t.Helper()
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "widgets.yaml"), []byte(widgetManifests), 0o600); err != nil {
+		t.Fatalf("failed to write snapshot: %v", err)
+	}
+	client, err := k8s.NewSnapshotClient(dir, "default")
+	if err != nil {
+		t.Fatalf("NewSnapshotClient failed: %v", err)
+	}
+	return NewModelWithConfig(client, config.DefaultConfig())
+}
+
+// TestGenericResourceBrowser tests choosing a resource kind and listing its objects
+func TestGenericResourceBrowser(t *testing.T) {
+	model := newWidgetModel(t)
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{':'}})
+	m := updated.(Model)
+	if !m.resourceSelector.IsVisible() {
+		t.Fatal("Expected resource selector to be visible")
+	}
+
+	loaded, ok := cmd().(apiResourcesLoadedMsg)
+	if !ok {
+		t.Fatal("Expected apiResourcesLoadedMsg")
+	}
+	if loaded.err != nil {
+		t.Fatalf("Unexpected discovery error: %v", loaded.err)
+	}
+	updated, _ = m.Update(loaded)
+	m = updated.(Model)
+
+	m.resourceSelector.SelectOption("widgets.example.com")
+	updated, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+
+	if m.resourceSelector.IsVisible() {
+		t.Error("Expected resource selector to close after selection")
+	}
+	if m.tabs.GetActiveTab() != int(components.ResourceTypeGeneric) {
+		t.Errorf("Expected generic tab to be active, got %d", m.tabs.GetActiveTab())
+	}
+	if m.genericResource == nil || m.genericResource.Kind != "Widget" {
+		t.Fatalf("Expected Widget to be selected, got %v", m.genericResource)
+	}
+
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+
+	selected := m.resourceList.GetSelectedGeneric()
+	if selected == nil || selected.Name != "gizmo" || selected.Status != "Ready" {
+		t.Fatalf("Expected ready widget gizmo, got %v", selected)
+	}
+
+	m.viewMode = ViewModeDetail
+	msg, ok := m.loadDescribe()().(describeLoadedMsg)
+	if !ok {
+		t.Fatal("Expected describeLoadedMsg")
+	}
+	if msg.data == nil || msg.data.Name != "gizmo" || msg.yaml == "" {
+		t.Errorf("Expected describe data and YAML for gizmo, got %v", msg.data)
+	}
+}
+
+// TestContextSwitchResetsGenericResource tests that a context switch forgets the
+// resource chosen for the generic tab and asks for one on the new cluster
+func TestContextSwitchResetsGenericResource(t *testing.T) {
+	model := newWidgetModel(t)
+	model.apiResources = map[string]k8s.APIResource{"widgets.example.com": {}}
+	widgets := k8s.APIResource{Group: "example.com", Version: "v1", Resource: "widgets", Kind: "Widget", Namespaced: true}
+	model.selectGenericResource(widgets)
+	if !strings.Contains(model.tabs.View(), "widgets.example.com") {
+		t.Fatal("Expected the generic tab to be titled after the chosen resource")
+	}
+
+	newClient := &k8s.Client{}
+	newClient.SetClientsetForTesting(fake.NewSimpleClientset())
+	updated, _ := model.Update(contextSwitchedMsg{client: newClient})
+	m := updated.(Model)
+
+	if m.genericResource != nil || m.apiResources != nil {
+		t.Errorf("Expected the generic resource state to be cleared, got %v %v", m.genericResource, m.apiResources)
+	}
+	if strings.Contains(m.tabs.View(), "widgets.example.com") {
+		t.Error("Expected the generic tab title to be reset")
+	}
+	if !m.resourceSelector.IsVisible() {
+		t.Error("Expected the resource selector to be shown for the new cluster")
+	}
+}
+
+// TestGenericWatchEventsFilteredByKind tests that events for other kinds are ignored
+func TestGenericWatchEventsFilteredByKind(t *testing.T) {
+	model := newWidgetModel(t)
+	model.genericResource = &k8s.APIResource{Group: "example.com", Version: "v1", Resource: "widgets", Kind: "Widget", Namespaced: true}
+	model.resourceList.SetResourceType(components.ResourceTypeGeneric)
+
+	newObject := func(kind, name string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("example.com/v1")
+		obj.SetKind(kind)
+		obj.SetName(name)
+		obj.SetNamespace("default")
+		return obj
+	}
+
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeGeneric, EventType: "ADDED", Object: newObject("Widget", "gizmo")})
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeGeneric, EventType: "ADDED", Object: newObject("Gadget", "stale")})
+
+	selected := model.resourceList.GetSelectedGeneric()
+	if selected == nil || selected.Name != "gizmo" {
+		t.Fatalf("Expected widget gizmo, got %v", selected)
+	}
+	model.resourceList.MoveDown()
+	if model.resourceList.GetSelectedGeneric().Name != "gizmo" {
+		t.Error("Expected events for other kinds to be ignored")
+	}
+
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeGeneric, EventType: "DELETED", Object: newObject("Widget", "gizmo")})
+	if model.resourceList.GetSelectedGeneric() != nil {
+		t.Error("Expected widget to be removed")
+	}
+}
+
+// TestTabToGenericShowsResourceSelector tests that the kind picker opens when no kind is chosen
+func TestTabToGenericShowsResourceSelector(t *testing.T) {
+	model := newWidgetModel(t)
+	model.tabs.SetActiveTab(int(components.ResourceTypeGeneric) - 1)
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m := updated.(Model)
+
+	if !m.resourceSelector.IsVisible() {
+		t.Error("Expected resource selector when entering the generic tab")
+	}
+}
+
+const certificateTable = `{
+  "kind": "Table",
+  "apiVersion": "meta.k8s.io/v1",
+  "columnDefinitions": [
+    {"name": "Name", "type": "string", "format": "name"},
+    {"name": "Ready", "type": "string"},
+    {"name": "Age", "type": "date"}
+  ],
+  "rows": [
+    {
+      "cells": ["web-tls", "True", "3d"],
+      "object": {"apiVersion": "cert-manager.io/v1", "kind": "Certificate", "metadata": {"name": "web-tls", "namespace": "default", "resourceVersion": "1"}}
+    }
+  ]
+}`
+
+// TestGenericResourcesUseServerTable tests that live clusters render generic resources as tables
+func TestGenericResourcesUseServerTable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(certificateTable))
+	}))
+	defer server.Close()
+
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create clientset: %v", err)
+	}
+	client := &k8s.Client{}
+	client.SetClientsetForTesting(clientset)
+	client.SetNamespace("default")
+
+	model := NewModelWithConfig(client, config.DefaultConfig())
+	model.genericResource = &k8s.APIResource{Group: "cert-manager.io", Version: "v1", Resource: "certificates", Kind: "Certificate", Namespaced: true}
+	model.tabs.SetActiveTab(int(components.ResourceTypeGeneric))
+	model.resourceList.SetResourceType(components.ResourceTypeGeneric)
+
+	msg, ok := model.loadResources()().(resourcesLoadedMsg)
+	if !ok {
+		t.Fatal("Expected resourcesLoadedMsg")
+	}
+	if msg.err != nil {
+		t.Fatalf("Unexpected error: %v", msg.err)
+	}
+	if len(msg.tableColumns) != 3 || msg.tableColumns[1] != "Ready" {
+		t.Errorf("Unexpected table columns %v", msg.tableColumns)
+	}
+	if len(msg.generic) != 1 || msg.generic[0].Cells[1] != "True" {
+		t.Fatalf("Unexpected rows %v", msg.generic)
+	}
+
+	updated, _ := model.Update(msg)
+	m := updated.(Model)
+
+	// The watcher's initial list repeats the listed version, which needs no re-render
+	obj := msg.generic[0].Object
+	if m.genericCellsStale(k8s.WatchEvent{ResourceType: k8s.ResourceTypeGeneric, EventType: "ADDED", Object: obj}) {
+		t.Error("Expected no refresh for an object at the listed version")
+	}
+
+	// A watch event only carries the object, so changed rows are re-rendered by the server
+	changed := obj.DeepCopy()
+	changed.SetResourceVersion("2")
+	event := k8s.WatchEvent{ResourceType: k8s.ResourceTypeGeneric, EventType: "MODIFIED", Object: changed}
+	if !m.genericCellsStale(event) {
+		t.Fatal("Expected a refresh for a changed object")
+	}
+	if m.genericCellsStale(k8s.WatchEvent{ResourceType: k8s.ResourceTypeGeneric, EventType: "DELETED", Object: changed}) {
+		t.Error("Expected no refresh for deleted objects")
+	}
+
+	// A burst of events is batched into a single table list
+	updated, _ = m.Update(watchEventMsg{event: event})
+	m = updated.(Model)
+	if !m.genericRefresh {
+		t.Fatal("Expected a batched refresh to be scheduled")
+	}
+	if m.scheduleGenericRefresh() != nil {
+		t.Error("Expected no second refresh while one is pending")
+	}
+
+	updated, cmd := m.Update(genericRefreshMsg{})
+	m = updated.(Model)
+	if m.genericRefresh || cmd == nil {
+		t.Fatal("Expected the pending refresh to list the table")
+	}
+	cells, ok := cmd().(genericCellsLoadedMsg)
+	if !ok {
+		t.Fatal("Expected genericCellsLoadedMsg")
+	}
+	if len(cells.rows) != 1 || cells.rows[0].Name != "web-tls" || len(cells.rows[0].Cells) != 3 {
+		t.Errorf("Unexpected refreshed rows %+v", cells.rows)
+	}
+}
+
+// TestConfigMapKeyViewer tests browsing configmap keys and opening a full value
+func TestConfigMapKeyViewer(t *testing.T) {
+	client := newTestClusterClient("", &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-config", Namespace: "default"},
+		Data: map[string]string{
+			"LOG_LEVEL":     "info",
+			"settings.yaml": "debug: true\nport: 8080\n",
+		},
+		BinaryData: map[string][]byte{"logo.png": {0x89, 'P', 'N', 'G'}},
+	})
+	model := NewModelWithConfig(client, config.DefaultConfig())
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m := updated.(Model)
+
+	m.tabs.SetActiveTab(int(components.ResourceTypeConfigMap))
+	m.resourceList.SetResourceType(components.ResourceTypeConfigMap)
+	updated, _ = m.Update(m.loadResources()())
+	m = updated.(Model)
+
+	cm := m.resourceList.GetSelectedConfigMap()
+	if cm == nil || cm.DataCount != 3 {
+		t.Fatalf("Expected app-config with 3 keys, got %v", cm)
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if m.viewMode != ViewModeDetail {
+		t.Fatalf("viewMode = %v, want ViewModeDetail", m.viewMode)
+	}
+
+	// Keys are sorted: LOG_LEVEL, logo.png, settings.yaml
+	for i := 0; i < 3; i++ {
+		updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+		m = updated.(Model)
+	}
+	if m.selectedKey != 2 {
+		t.Fatalf("selectedKey = %d, want 2 (clamped to the last key)", m.selectedKey)
+	}
+	if !strings.Contains(m.View(), "▸ settings.yaml") {
+		t.Error("Expected the detail view to highlight settings.yaml")
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if m.viewMode != ViewModeValue {
+		t.Fatalf("viewMode = %v, want ViewModeValue", m.viewMode)
+	}
+	view := m.View()
+	if !strings.Contains(view, "port: 8080") || !strings.Contains(view, "settings.yaml") {
+		t.Errorf("Expected the full value of settings.yaml, got:\n%s", view)
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updated.(Model)
+	if m.viewMode != ViewModeDetail {
+		t.Fatalf("viewMode = %v, want ViewModeDetail after esc", m.viewMode)
+	}
+
+	// Binary keys open as a hex dump labelled with their size
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyUp})
+	m = updated.(Model)
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	view = m.View()
+	if !strings.Contains(view, "binary, 4 B") || !strings.Contains(view, "89 50 4e 47") {
+		t.Errorf("Expected a hex dump of logo.png, got:\n%s", view)
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+	m = updated.(Model)
+	if m.viewMode != ViewModeDetail {
+		t.Errorf("viewMode = %v, want ViewModeDetail after q", m.viewMode)
+	}
+
+	msg, ok := m.loadDescribe()().(describeLoadedMsg)
+	if !ok || msg.data == nil || msg.data.Kind != "ConfigMap" || msg.yaml == "" {
+		t.Errorf("Expected describe data and YAML for app-config, got %v", msg)
+	}
+}
+
+// TestConfigMapWatchEvents tests that configmap watch events update the list
+func TestConfigMapWatchEvents(t *testing.T) {
+	model := newTestModel()
+	model.resourceList.SetResourceType(components.ResourceTypeConfigMap)
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-config", Namespace: "default"},
+		Data:       map[string]string{"LOG_LEVEL": "info"},
+	}
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeConfigMap, EventType: "ADDED", Object: cm})
+	if selected := model.resourceList.GetSelectedConfigMap(); selected == nil || selected.DataCount != 1 {
+		t.Fatalf("Expected app-config with 1 key, got %v", selected)
+	}
+
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeConfigMap, EventType: "DELETED", Object: cm})
+	if model.resourceList.GetSelectedConfigMap() != nil {
+		t.Error("Expected app-config to be removed")
+	}
+}
+
+// TestSecretRevealFlow tests that secret values are only shown after confirmation
+// and are masked again when leaving the value view
+func TestSecretRevealFlow(t *testing.T) {
+	client := newTestClusterClient("", &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "db-credentials", Namespace: "default"},
+		Type:       corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			"password": []byte("hunter2"),
+			"username": []byte("admin"),
+		},
+	})
+	model := NewModelWithConfig(client, config.DefaultConfig())
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m := updated.(Model)
+
+	m.tabs.SetActiveTab(int(components.ResourceTypeSecret))
+	m.resourceList.SetResourceType(components.ResourceTypeSecret)
+	updated, _ = m.Update(m.loadResources()())
+	m = updated.(Model)
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	view := m.View()
+	if !strings.Contains(view, "▸ password") || strings.Contains(view, "hunter2") {
+		t.Fatalf("Expected masked keys in the detail view, got:\n%s", view)
+	}
+
+	reveal := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'v'}}
+
+	// Declining the prompt keeps the value hidden
+	updated, _ = m.Update(reveal)
+	m = updated.(Model)
+	if !m.confirmDialog.IsVisible() || !strings.Contains(m.View(), "Reveal key password of secret default/db-credentials?") {
+		t.Fatalf("Expected a reveal confirmation, got:\n%s", m.View())
+	}
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	m = updated.(Model)
+	if m.confirmDialog.IsVisible() || m.viewMode != ViewModeDetail || strings.Contains(m.View(), "hunter2") {
+		t.Fatal("Expected declining to return to the masked detail view")
+	}
+
+	// Accepting shows the decoded value
+	updated, _ = m.Update(reveal)
+	m = updated.(Model)
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	m = updated.(Model)
+	if m.viewMode != ViewModeValue || !strings.Contains(m.View(), "hunter2") {
+		t.Fatalf("Expected the revealed value, got:\n%s", m.View())
+	}
+
+	// Leaving the value view masks the value again
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updated.(Model)
+	if m.viewMode != ViewModeDetail {
+		t.Fatalf("viewMode = %v, want ViewModeDetail after esc", m.viewMode)
+	}
+	if strings.Contains(m.valueViewer.View(), "hunter2") {
+		t.Error("Expected the value viewer to be cleared after leaving it")
+	}
+
+	msg, ok := m.loadDescribe()().(describeLoadedMsg)
+	if !ok || msg.data == nil || msg.data.Kind != "Secret" {
+		t.Fatalf("Expected describe data for db-credentials, got %v", msg)
+	}
+	if strings.Contains(msg.yaml, "aHVudGVyMg==") || strings.Contains(msg.json, "aHVudGVyMg==") {
+		t.Error("Expected secret values to be redacted in YAML and JSON")
+	}
+}
+
+// TestSecretRevealMaskedOnTabSwitch tests that switching tabs clears a revealed value
+func TestSecretRevealMaskedOnTabSwitch(t *testing.T) {
+	model := newTestModel()
+	model.tabs.SetActiveTab(int(components.ResourceTypeSecret))
+	model.resourceList.SetResourceType(components.ResourceTypeSecret)
+	model.resourceList.SetSecrets([]models.SecretInfo{models.NewSecretInfo(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "api-token", Namespace: "default"},
+		Data:       map[string][]byte{"token": []byte("s3cr3t-token")},
+	})})
+	model.viewMode = ViewModeDetail
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'v'}})
+	updated, _ = updated.(Model).Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	m := updated.(Model)
+	if !strings.Contains(m.valueViewer.View(), "s3cr3t-token") {
+		t.Fatal("Expected the token to be revealed")
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m = updated.(Model)
+	if strings.Contains(m.valueViewer.View(), "s3cr3t-token") {
+		t.Error("Expected switching tabs to mask the revealed value")
+	}
+}
+
+// TestSecretWatchEvents tests that secret watch events update the list
+func TestSecretWatchEvents(t *testing.T) {
+	model := newTestModel()
+	model.resourceList.SetResourceType(components.ResourceTypeSecret)
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "api-token", Namespace: "default"},
+		Data:       map[string][]byte{"token": []byte("s3cr3t")},
+	}
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeSecret, EventType: "ADDED", Object: secret})
+	if selected := model.resourceList.GetSelectedSecret(); selected == nil || selected.DataCount != 1 {
+		t.Fatalf("Expected api-token with 1 key, got %v", selected)
+	}
+
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeSecret, EventType: "DELETED", Object: secret})
+	if model.resourceList.GetSelectedSecret() != nil {
+		t.Error("Expected api-token to be removed")
+	}
+}
+
+// TestFatalWatchErrorForInactiveTab tests that a forbidden watch on another tab does not hide the UI
+func TestFatalWatchErrorForInactiveTab(t *testing.T) {
+	model := newTestModel()
+	forbidden := errors.New("secrets is forbidden")
+
+	updated, _ := model.Update(watchErrorMsg{error: k8s.WatchError{ResourceType: k8s.ResourceTypeSecret, Err: forbidden, Fatal: true}})
+	m := updated.(Model)
+	if m.err != nil {
+		t.Errorf("Expected no error while the Pods tab is active, got %v", m.err)
+	}
+
+	// The error is shown once the Secrets tab becomes active, even though the watcher has stopped
+	m.tabs.SetActiveTab(int(components.ResourceTypeSecret))
+	updated, _ = m.Update(resourcesLoadedMsg{resourceType: components.ResourceTypeSecret})
+	m = updated.(Model)
+	if !errors.Is(m.err, forbidden) {
+		t.Errorf("Expected the error to be shown on the Secrets tab, got %v", m.err)
+	}
+
+	// Events from other watchers do not hide it
+	m.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypePod, EventType: "ADDED", Object: &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+	}})
+	if !errors.Is(m.err, forbidden) {
+		t.Errorf("Expected the error to stay on the Secrets tab, got %v", m.err)
+	}
+
+	m.tabs.SetActiveTab(int(components.ResourceTypePod))
+	updated, _ = m.Update(resourcesLoadedMsg{resourceType: components.ResourceTypePod})
+	m = updated.(Model)
+	if m.err != nil {
+		t.Errorf("Expected no error back on the Pods tab, got %v", m.err)
+	}
+}
+
+// newTestNode returns a ready node with 4 allocatable CPUs
+func newTestNode(name string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("4"),
+				corev1.ResourceMemory: resource.MustParse("8Gi"),
+			},
+		},
+	}
+}
+
+// TestNodeDetailAllocatedResources tests that the node detail view sums the requests of its pods
+func TestNodeDetailAllocatedResources(t *testing.T) {
+	client := newTestClusterClient("",
+		newTestNode("worker-1"),
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "shop"},
+			Spec: corev1.PodSpec{
+				NodeName: "worker-1",
+				Containers: []corev1.Container{{
+					Name: "web",
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+					},
+				}},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		},
+	)
+	model := NewModelWithConfig(client, config.DefaultConfig())
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m := updated.(Model)
+
+	m.tabs.SetActiveTab(int(components.ResourceTypeNode))
+	m.resourceList.SetResourceType(components.ResourceTypeNode)
+	updated, _ = m.Update(m.loadResources()())
+	m = updated.(Model)
+	if node := m.resourceList.GetSelectedNode(); node == nil || node.Name != "worker-1" {
+		t.Fatalf("Expected worker-1 to be listed, got %v", node)
+	}
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if m.viewMode != ViewModeDetail || cmd == nil {
+		t.Fatal("Expected the detail view and an allocation load command")
+	}
+	if !strings.Contains(m.View(), "Loading...") {
+		t.Errorf("Expected a loading placeholder before the allocation arrives, got:\n%s", m.View())
+	}
+
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if m.nodeAllocation == nil || m.nodeAllocation.PodCount != 1 {
+		t.Fatalf("Expected 1 pod on worker-1, got %v", m.nodeAllocation)
+	}
+	if got := m.nodeAllocation.Resources[0]; got.Requests != "1" || got.RequestsPercent != 25 {
+		t.Errorf("Expected 1 CPU (25%%) requested, got %+v", got)
+	}
+
+	msg, ok := m.loadDescribe()().(describeLoadedMsg)
+	if !ok || msg.data == nil || msg.data.Kind != "Node" {
+		t.Fatalf("Expected describe data for worker-1, got %v", msg)
+	}
+}
+
+// TestGoToNodeFromPod tests that N on a pod opens the detail view of its node
+func TestGoToNodeFromPod(t *testing.T) {
+	client := newTestClusterClient("",
+		newTestNode("worker-1"),
+		newTestNode("worker-2"),
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Spec:       corev1.PodSpec{NodeName: "worker-2"},
+		},
+	)
+	model := NewModelWithConfig(client, config.DefaultConfig())
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m := updated.(Model)
+	updated, _ = m.Update(m.loadResources()())
+	m = updated.(Model)
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'N'}})
+	m = updated.(Model)
+	if m.tabs.GetActiveTab() != int(components.ResourceTypeNode) || cmd == nil {
+		t.Fatalf("Expected to switch to the Nodes tab, active tab is %d", m.tabs.GetActiveTab())
+	}
+
+	updated, cmd = m.Update(cmd())
+	m = updated.(Model)
+	if m.viewMode != ViewModeDetail || cmd == nil {
+		t.Fatal("Expected the node detail view and an allocation load command")
+	}
+	if node := m.resourceList.GetSelectedNode(); node == nil || node.Name != "worker-2" {
+		t.Errorf("Expected worker-2 to be selected, got %v", node)
+	}
+	if m.pendingNode != nil {
+		t.Error("Expected the pending node to be cleared")
+	}
+}
+
+// TestNodeWatchEvents tests that node watch events update the list
+func TestNodeWatchEvents(t *testing.T) {
+	model := newTestModel()
+	model.resourceList.SetResourceType(components.ResourceTypeNode)
+
+	node := newTestNode("worker-1")
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeNode, EventType: "ADDED", Object: node})
+	if selected := model.resourceList.GetSelectedNode(); selected == nil || selected.Status != "Ready" {
+		t.Fatalf("Expected ready worker-1, got %v", selected)
+	}
+
+	cordoned := node.DeepCopy()
+	cordoned.Spec.Unschedulable = true
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeNode, EventType: "MODIFIED", Object: cordoned})
+	if selected := model.resourceList.GetSelectedNode(); selected == nil || !selected.Unschedulable {
+		t.Fatalf("Expected worker-1 to be cordoned, got %v", selected)
+	}
+
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeNode, EventType: "DELETED", Object: node})
+	if model.resourceList.GetSelectedNode() != nil {
+		t.Error("Expected worker-1 to be removed")
+	}
+}
+
+// TestCronJobDetailListsJobs tests that the cronjob detail view lists the jobs it created
+func TestCronJobDetailListsJobs(t *testing.T) {
+	ownedBy := []metav1.OwnerReference{{Kind: "CronJob", Name: "nightly-report", UID: "cron-uid"}}
+	client := newTestClusterClient("",
+		&batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{Name: "nightly-report", Namespace: "default", UID: "cron-uid"},
+			Spec:       batchv1.CronJobSpec{Schedule: "0 2 * * *"},
+		},
+		&batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: "nightly-report-29012345", Namespace: "default", OwnerReferences: ownedBy},
+			Status: batchv1.JobStatus{
+				Succeeded:  1,
+				Conditions: []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}},
+			},
+		},
+		&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "manual-run", Namespace: "default"}},
+	)
+	model := NewModelWithConfig(client, config.DefaultConfig())
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m := updated.(Model)
+
+	m.tabs.SetActiveTab(int(components.ResourceTypeCronJob))
+	m.resourceList.SetResourceType(components.ResourceTypeCronJob)
+	updated, _ = m.Update(m.loadResources()())
+	m = updated.(Model)
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if m.viewMode != ViewModeDetail || cmd == nil {
+		t.Fatal("Expected the detail view and a job history load command")
+	}
+
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	view := m.View()
+	if !strings.Contains(view, "nightly-report-29012345") || !strings.Contains(view, "Complete") {
+		t.Errorf("Expected the cronjob's job in the detail view, got:\n%s", view)
+	}
+	if strings.Contains(view, "manual-run") {
+		t.Error("Expected jobs not owned by the cronjob to be excluded")
+	}
+
+	msg, ok := m.loadDescribe()().(describeLoadedMsg)
+	if !ok || msg.data == nil || msg.data.Kind != "CronJob" {
+		t.Fatalf("Expected describe data for nightly-report, got %v", msg)
+	}
+}
+
+// TestJobWatchEvents tests that job and cronjob watch events update the list
+func TestJobWatchEvents(t *testing.T) {
+	model := newTestModel()
+	model.resourceList.SetResourceType(components.ResourceTypeJob)
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "migrate-db", Namespace: "default"},
+		Status:     batchv1.JobStatus{Active: 1},
+	}
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeJob, EventType: "ADDED", Object: job})
+	if selected := model.resourceList.GetSelectedJob(); selected == nil || selected.Status != "Running" {
+		t.Fatalf("Expected running migrate-db, got %v", selected)
+	}
+
+	completed := job.DeepCopy()
+	completed.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeJob, EventType: "MODIFIED", Object: completed})
+	if selected := model.resourceList.GetSelectedJob(); selected == nil || selected.Status != "Complete" {
+		t.Fatalf("Expected migrate-db to be complete, got %v", selected)
+	}
+
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeJob, EventType: "DELETED", Object: job})
+	if model.resourceList.GetSelectedJob() != nil {
+		t.Error("Expected migrate-db to be removed")
+	}
+
+	model.resourceList.SetResourceType(components.ResourceTypeCronJob)
+	cronJob := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly-report", Namespace: "default"},
+		Spec:       batchv1.CronJobSpec{Schedule: "0 2 * * *"},
+	}
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeCronJob, EventType: "ADDED", Object: cronJob})
+	if selected := model.resourceList.GetSelectedCronJob(); selected == nil || selected.Schedule != "0 2 * * *" {
+		t.Fatalf("Expected nightly-report, got %v", selected)
+	}
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeCronJob, EventType: "DELETED", Object: cronJob})
+	if model.resourceList.GetSelectedCronJob() != nil {
+		t.Error("Expected nightly-report to be removed")
+	}
+}
+
+// TestDaemonSetDetailShowsMissingNodes tests that the daemonset detail view flags nodes without a daemon pod
+func TestDaemonSetDetailShowsMissingNodes(t *testing.T) {
+	labels := map[string]string{"app": "fluent-bit"}
+	client := newTestClusterClient("",
+		&appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "fluent-bit", Namespace: "default", UID: "ds-uid"},
+			Spec: appsv1.DaemonSetSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: labels},
+			},
+			Status: appsv1.DaemonSetStatus{DesiredNumberScheduled: 2, CurrentNumberScheduled: 1, NumberReady: 1},
+		},
+		newTestNode("worker-1"),
+		newTestNode("worker-2"),
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "fluent-bit-abcde",
+				Namespace:       "default",
+				Labels:          labels,
+				OwnerReferences: []metav1.OwnerReference{{Kind: "DaemonSet", Name: "fluent-bit", UID: "ds-uid"}},
+			},
+			Spec:   corev1.PodSpec{NodeName: "worker-1"},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		},
+	)
+	model := NewModelWithConfig(client, config.DefaultConfig())
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+	m := updated.(Model)
+
+	m.tabs.SetActiveTab(int(components.ResourceTypeDaemonSet))
+	m.resourceList.SetResourceType(components.ResourceTypeDaemonSet)
+	updated, _ = m.Update(m.loadResources()())
+	m = updated.(Model)
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if m.viewMode != ViewModeDetail || cmd == nil {
+		t.Fatal("Expected the detail view and a node status load command")
+	}
+
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	view := m.View()
+	for _, expected := range []string{"fluent-bit-abcde", "worker-2", "Missing on 1 of 2 nodes"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected %q in the daemonset detail view, got:\n%s", expected, view)
+		}
+	}
+
+	msg, ok := m.loadDescribe()().(describeLoadedMsg)
+	if !ok || msg.data == nil || msg.data.Kind != "DaemonSet" {
+		t.Fatalf("Expected describe data for fluent-bit, got %v", msg)
+	}
+}
+
+// TestDaemonSetAndReplicaSetWatchEvents tests that daemonset and replicaset watch events update the list
+func TestDaemonSetAndReplicaSetWatchEvents(t *testing.T) {
+	model := newTestModel()
+	model.resourceList.SetResourceType(components.ResourceTypeDaemonSet)
+
+	daemonSet := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "calico-node", Namespace: "kube-system"},
+		Status:     appsv1.DaemonSetStatus{DesiredNumberScheduled: 3, NumberReady: 2},
+	}
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeDaemonSet, EventType: "ADDED", Object: daemonSet})
+	if selected := model.resourceList.GetSelectedDaemonSet(); selected == nil || selected.Ready != 2 {
+		t.Fatalf("Expected calico-node with 2 ready, got %v", selected)
+	}
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeDaemonSet, EventType: "DELETED", Object: daemonSet})
+	if model.resourceList.GetSelectedDaemonSet() != nil {
+		t.Error("Expected calico-node to be removed")
+	}
+
+	model.resourceList.SetResourceType(components.ResourceTypeReplicaSet)
+	replicaSet := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "web-7d4b9c8f5",
+			Namespace:       "shop",
+			OwnerReferences: []metav1.OwnerReference{{Kind: "Deployment", Name: "web"}},
+		},
+	}
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeReplicaSet, EventType: "ADDED", Object: replicaSet})
+	if selected := model.resourceList.GetSelectedReplicaSet(); selected == nil || selected.Owner != "web" {
+		t.Fatalf("Expected web-7d4b9c8f5 owned by web, got %v", selected)
+	}
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeReplicaSet, EventType: "DELETED", Object: replicaSet})
+	if model.resourceList.GetSelectedReplicaSet() != nil {
+		t.Error("Expected web-7d4b9c8f5 to be removed")
+	}
+}
+
+// TestIngressDetailFlagsMissingBackends tests that the ingress detail view flags backends without a service
+func TestIngressDetailFlagsMissingBackends(t *testing.T) {
+	backend := func(service string, port int32) networkingv1.IngressBackend {
+		return networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{
+			Name: service,
+			Port: networkingv1.ServiceBackendPort{Number: port},
+		}}
+	}
+	client := newTestClusterClient("",
+		&networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{Name: "shop", Namespace: "default"},
+			Spec: networkingv1.IngressSpec{Rules: []networkingv1.IngressRule{{
+				Host: "shop.example.com",
+				IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+					Paths: []networkingv1.HTTPIngressPath{
+						{Path: "/", Backend: backend("web", 80)},
+						{Path: "/checkout", Backend: backend("checkout", 80)},
+					},
+				}},
+			}}},
+		},
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: 80}}},
+		},
+	)
+	model := NewModelWithConfig(client, config.DefaultConfig())
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+	m := updated.(Model)
+
+	m.tabs.SetActiveTab(int(components.ResourceTypeIngress))
+	m.resourceList.SetResourceType(components.ResourceTypeIngress)
+	updated, _ = m.Update(m.loadResources()())
+	m = updated.(Model)
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if m.viewMode != ViewModeDetail || cmd == nil {
+		t.Fatal("Expected the detail view and a backend check command")
+	}
+
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	view := m.View()
+	if !strings.Contains(view, `checkout:80 (service "checkout" not found)`) {
+		t.Errorf("Expected the missing checkout service to be flagged, got:\n%s", view)
+	}
+	if strings.Contains(view, "web:80 (") {
+		t.Error("Expected the existing web service not to be flagged")
+	}
+
+	msg, ok := m.loadDescribe()().(describeLoadedMsg)
+	if !ok || msg.data == nil || msg.data.Kind != "Ingress" {
+		t.Fatalf("Expected describe data for shop, got %v", msg)
+	}
+}
+
+// TestIngressWatchEvents tests that ingress watch events update the list
+func TestIngressWatchEvents(t *testing.T) {
+	model := newTestModel()
+	model.resourceList.SetResourceType(components.ResourceTypeIngress)
+
+	ingress := &networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Name: "shop", Namespace: "default"}}
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeIngress, EventType: "ADDED", Object: ingress})
+	if selected := model.resourceList.GetSelectedIngress(); selected == nil || selected.Addresses != "<none>" {
+		t.Fatalf("Expected shop without an address, got %v", selected)
+	}
+
+	assigned := ingress.DeepCopy()
+	assigned.Status.LoadBalancer.Ingress = []networkingv1.IngressLoadBalancerIngress{{IP: "203.0.113.10"}}
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeIngress, EventType: "MODIFIED", Object: assigned})
+	if selected := model.resourceList.GetSelectedIngress(); selected == nil || selected.Addresses != "203.0.113.10" {
+		t.Fatalf("Expected shop at 203.0.113.10, got %v", selected)
+	}
+
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeIngress, EventType: "DELETED", Object: ingress})
+	if model.resourceList.GetSelectedIngress() != nil {
+		t.Error("Expected shop to be removed")
+	}
+}
+
+// TestClaimDetailListsPodsAndGoesToVolume tests that the claim detail view lists the pods
+// mounting the claim and that V opens the bound volume
+func TestClaimDetailListsPodsAndGoesToVolume(t *testing.T) {
+	client := newTestClusterClient("",
+		&corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "data", Namespace: "default"},
+			Spec:       corev1.PersistentVolumeClaimSpec{VolumeName: "pv-data"},
+			Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimBound},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"},
+			Spec: corev1.PodSpec{Volumes: []corev1.Volume{{
+				Name: "data",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "data"},
+				},
+			}}},
+		},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}},
+		&corev1.PersistentVolume{ObjectMeta: metav1.ObjectMeta{Name: "pv-other"}},
+		&corev1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: "pv-data"},
+			Spec:       corev1.PersistentVolumeSpec{ClaimRef: &corev1.ObjectReference{Namespace: "default", Name: "data"}},
+			Status:     corev1.PersistentVolumeStatus{Phase: corev1.VolumeBound},
+		},
+	)
+	model := NewModelWithConfig(client, config.DefaultConfig())
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m := updated.(Model)
+
+	m.tabs.SetActiveTab(int(components.ResourceTypePersistentVolumeClaim))
+	m.resourceList.SetResourceType(components.ResourceTypePersistentVolumeClaim)
+	updated, _ = m.Update(m.loadResources()())
+	m = updated.(Model)
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if m.viewMode != ViewModeDetail || cmd == nil {
+		t.Fatal("Expected the detail view and a claim pods command")
+	}
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if len(m.claimPods) != 1 || m.claimPods[0].Name != "db" {
+		t.Fatalf("Expected only db to mount the claim, got %v", m.claimPods)
+	}
+
+	msg, ok := m.loadDescribe()().(describeLoadedMsg)
+	if !ok || msg.data == nil || msg.data.Kind != "PersistentVolumeClaim" {
+		t.Fatalf("Expected describe data for data, got %v", msg)
+	}
+
+	updated, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'V'}})
+	m = updated.(Model)
+	if m.tabs.GetActiveTab() != int(components.ResourceTypePersistentVolume) || cmd == nil {
+		t.Fatalf("Expected to switch to the PVs view, active tab is %d", m.tabs.GetActiveTab())
+	}
+
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if m.viewMode != ViewModeDetail {
+		t.Fatal("Expected the volume detail view")
+	}
+	if volume := m.resourceList.GetSelectedPersistentVolume(); volume == nil || volume.Name != "pv-data" {
+		t.Errorf("Expected pv-data to be selected, got %v", volume)
+	}
+	if m.pendingVolume != nil {
+		t.Error("Expected the pending volume to be cleared")
+	}
+}
+
+// TestStorageTabViews tests that claims, volumes and storage classes share one tab whose
+// views are switched with [ and ]
+func TestStorageTabViews(t *testing.T) {
+	client := newTestClusterClient("",
+		&corev1.PersistentVolume{ObjectMeta: metav1.ObjectMeta{Name: "pv-data"}},
+	)
+	model := NewModelWithConfig(client, config.DefaultConfig())
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m := updated.(Model)
+
+	// The Pods tab has no views
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{']'}})
+	m = updated.(Model)
+	if m.tabs.GetActiveTab() != int(components.ResourceTypePod) || cmd != nil {
+		t.Fatalf("Expected ] to do nothing on the Pods tab, active tab is %d", m.tabs.GetActiveTab())
+	}
+
+	m.tabs.SetActiveTab(int(components.ResourceTypeIngress))
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m = updated.(Model)
+	if m.tabs.GetActiveTab() != int(components.ResourceTypePersistentVolumeClaim) {
+		t.Fatalf("Expected the Storage tab to open on PVCs, active tab is %d", m.tabs.GetActiveTab())
+	}
+
+	updated, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{']'}})
+	m = updated.(Model)
+	if m.tabs.GetActiveTab() != int(components.ResourceTypePersistentVolume) || cmd == nil {
+		t.Fatalf("Expected ] to switch to the PVs view, active tab is %d", m.tabs.GetActiveTab())
+	}
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if volume := m.resourceList.GetSelectedPersistentVolume(); volume == nil || volume.Name != "pv-data" {
+		t.Errorf("Expected pv-data to be listed, got %v", volume)
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'['}})
+	m = updated.(Model)
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'['}})
+	m = updated.(Model)
+	if m.tabs.GetActiveTab() != int(components.ResourceTypeStorageClass) {
+		t.Errorf("Expected [ to wrap around to the StorageClasses view, active tab is %d", m.tabs.GetActiveTab())
+	}
+	if !strings.Contains(m.View(), "[StorageClasses]") {
+		t.Error("Expected the tab bar to show the selected view")
+	}
+}
+
+// TestStorageWatchEvents tests that claim, volume and storage class watch events update the lists
+func TestStorageWatchEvents(t *testing.T) {
+	model := newTestModel()
+	model.resourceList.SetResourceType(components.ResourceTypePersistentVolumeClaim)
+
+	claim := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "data", Namespace: "default"},
+		Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimPending},
+	}
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypePersistentVolumeClaim, EventType: "ADDED", Object: claim})
+	if selected := model.resourceList.GetSelectedPersistentVolumeClaim(); selected == nil || selected.Status != "Pending" {
+		t.Fatalf("Expected pending data claim, got %v", selected)
+	}
+
+	bound := claim.DeepCopy()
+	bound.Spec.VolumeName = "pv-data"
+	bound.Status.Phase = corev1.ClaimBound
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypePersistentVolumeClaim, EventType: "MODIFIED", Object: bound})
+	if selected := model.resourceList.GetSelectedPersistentVolumeClaim(); selected == nil || selected.Volume != "pv-data" {
+		t.Fatalf("Expected data to be bound to pv-data, got %v", selected)
+	}
+
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypePersistentVolumeClaim, EventType: "DELETED", Object: bound})
+	if model.resourceList.GetSelectedPersistentVolumeClaim() != nil {
+		t.Error("Expected data to be removed")
+	}
+
+	model.resourceList.SetResourceType(components.ResourceTypePersistentVolume)
+	volume := &corev1.PersistentVolume{ObjectMeta: metav1.ObjectMeta{Name: "pv-data"}}
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypePersistentVolume, EventType: "ADDED", Object: volume})
+	if selected := model.resourceList.GetSelectedPersistentVolume(); selected == nil || selected.Name != "pv-data" {
+		t.Fatalf("Expected pv-data, got %v", selected)
+	}
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypePersistentVolume, EventType: "DELETED", Object: volume})
+	if model.resourceList.GetSelectedPersistentVolume() != nil {
+		t.Error("Expected pv-data to be removed")
+	}
+
+	model.resourceList.SetResourceType(components.ResourceTypeStorageClass)
+	storageClass := &storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: "gp3"}, Provisioner: "ebs.csi.aws.com"}
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeStorageClass, EventType: "ADDED", Object: storageClass})
+	if selected := model.resourceList.GetSelectedStorageClass(); selected == nil || selected.Provisioner != "ebs.csi.aws.com" {
+		t.Fatalf("Expected gp3, got %v", selected)
+	}
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeStorageClass, EventType: "DELETED", Object: storageClass})
+	if model.resourceList.GetSelectedStorageClass() != nil {
+		t.Error("Expected gp3 to be removed")
+	}
+}
+
+// TestHPAGoesToTargetAndShowsInDeploymentDetail tests that T opens the HPA's deployment with its autoscaling status
+func TestHPAGoesToTargetAndShowsInDeploymentDetail(t *testing.T) {
+	client := newTestClusterClient("",
+		&autoscalingv2.HorizontalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+				ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{Kind: "Deployment", Name: "web"},
+				MaxReplicas:    10,
+			},
+			Status: autoscalingv2.HorizontalPodAutoscalerStatus{CurrentReplicas: 10, DesiredReplicas: 10},
+		},
+		&autoscalingv2.HorizontalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "default"},
+			Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+				ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{Kind: "Deployment", Name: "api"},
+				MaxReplicas:    3,
+			},
+		},
+		&policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Spec:       policyv1.PodDisruptionBudgetSpec{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}},
+		},
+		&policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "default"},
+			Spec:       policyv1.PodDisruptionBudgetSpec{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "api"}}},
+		},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "default"}},
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web"}},
+			}},
+		},
+	)
+	model := NewModelWithConfig(client, config.DefaultConfig())
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m := updated.(Model)
+
+	m.tabs.SetActiveTab(int(components.ResourceTypeHorizontalPodAutoscaler))
+	m.resourceList.SetResourceType(components.ResourceTypeHorizontalPodAutoscaler)
+	updated, _ = m.Update(m.loadResources()())
+	m = updated.(Model)
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m = updated.(Model)
+	if hpa := m.resourceList.GetSelectedHorizontalPodAutoscaler(); hpa == nil || hpa.Name != "web" {
+		t.Fatalf("Expected web to be selected, got %v", hpa)
+	}
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'T'}})
+	m = updated.(Model)
+	if m.tabs.GetActiveTab() != int(components.ResourceTypeDeployment) || cmd == nil {
+		t.Fatalf("Expected to switch to the Deployments tab, active tab is %d", m.tabs.GetActiveTab())
+	}
+
+	updated, cmd = m.Update(cmd())
+	m = updated.(Model)
+	if m.viewMode != ViewModeDetail || cmd == nil {
+		t.Fatal("Expected the deployment detail view and a command loading its autoscalers and budgets")
+	}
+	if deployment := m.resourceList.GetSelectedDeployment(); deployment == nil || deployment.Name != "web" {
+		t.Fatalf("Expected web to be selected, got %v", deployment)
+	}
+	if m.pendingTarget != nil {
+		t.Error("Expected the pending target to be cleared")
+	}
+
+	m = runCmd(m, cmd)
+	if len(m.workloadHPAs) != 1 || m.workloadHPAs[0].Name != "web" {
+		t.Fatalf("Expected only the web HPA to scale web, got %v", m.workloadHPAs)
+	}
+	if len(m.workloadPDBs) != 1 || m.workloadPDBs[0].Name != "web" {
+		t.Fatalf("Expected only the web PDB to cover web, got %v", m.workloadPDBs)
+	}
+	view := m.View()
+	if !strings.Contains(view, "HPA web: 1-10 replicas") {
+		t.Error("Expected the deployment detail view to show the web HPA")
+	}
+	if !strings.Contains(view, "PDB web: min available N/A") {
+		t.Error("Expected the deployment detail view to show the web PDB")
+	}
+}
+
+// TestHPAWatchEvents tests that autoscaler watch events update the list
+func TestHPAWatchEvents(t *testing.T) {
+	model := newTestModel()
+	model.resourceList.SetResourceType(components.ResourceTypeHorizontalPodAutoscaler)
+
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec:       autoscalingv2.HorizontalPodAutoscalerSpec{MaxReplicas: 10},
+		Status:     autoscalingv2.HorizontalPodAutoscalerStatus{CurrentReplicas: 2},
+	}
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeHorizontalPodAutoscaler, EventType: "ADDED", Object: hpa})
+	if selected := model.resourceList.GetSelectedHorizontalPodAutoscaler(); selected == nil || selected.CurrentReplicas != 2 {
+		t.Fatalf("Expected web with 2 replicas, got %v", selected)
+	}
+
+	scaled := hpa.DeepCopy()
+	scaled.Status.CurrentReplicas = 6
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeHorizontalPodAutoscaler, EventType: "MODIFIED", Object: scaled})
+	if selected := model.resourceList.GetSelectedHorizontalPodAutoscaler(); selected == nil || selected.CurrentReplicas != 6 {
+		t.Fatalf("Expected web with 6 replicas, got %v", selected)
+	}
+
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeHorizontalPodAutoscaler, EventType: "DELETED", Object: scaled})
+	if model.resourceList.GetSelectedHorizontalPodAutoscaler() != nil {
+		t.Error("Expected web to be removed")
+	}
+}
+
+// TestPodDisruptionBudgetWatchEvents tests that poddisruptionbudget watch events update the list
+func TestPodDisruptionBudgetWatchEvents(t *testing.T) {
+	model := newTestModel()
+	model.resourceList.SetResourceType(components.ResourceTypePodDisruptionBudget)
+
+	budget := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Status:     policyv1.PodDisruptionBudgetStatus{DisruptionsAllowed: 1},
+	}
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypePodDisruptionBudget, EventType: "ADDED", Object: budget})
+	if selected := model.resourceList.GetSelectedPodDisruptionBudget(); selected == nil || selected.BlocksEvictions() {
+		t.Fatalf("Expected web to allow a disruption, got %v", selected)
+	}
+
+	blocked := budget.DeepCopy()
+	blocked.Status.DisruptionsAllowed = 0
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypePodDisruptionBudget, EventType: "MODIFIED", Object: blocked})
+	if selected := model.resourceList.GetSelectedPodDisruptionBudget(); selected == nil || !selected.BlocksEvictions() {
+		t.Fatalf("Expected web to block evictions, got %v", selected)
+	}
+
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypePodDisruptionBudget, EventType: "DELETED", Object: blocked})
+	if model.resourceList.GetSelectedPodDisruptionBudget() != nil {
+		t.Error("Expected web to be removed")
+	}
+}
+
+// TestNamespaceQuotas tests that Q shows the quota usage and limit ranges of the namespace
+func TestNamespaceQuotas(t *testing.T) {
+	client := newTestClusterClient("",
+		&corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: "compute", Namespace: "default"},
+			Status: corev1.ResourceQuotaStatus{
+				Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("10")},
+				Used: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("9")},
+			},
+		},
+		&corev1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{Name: "elsewhere", Namespace: "other"}},
+		&corev1.LimitRange{
+			ObjectMeta: metav1.ObjectMeta{Name: "limits", Namespace: "default"},
+			Spec: corev1.LimitRangeSpec{Limits: []corev1.LimitRangeItem{{
+				Type:    corev1.LimitTypeContainer,
+				Default: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+			}}},
+		},
+	)
+	model := NewModelWithConfig(client, config.DefaultConfig())
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+	m := updated.(Model)
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'Q'}})
+	m = updated.(Model)
+	if m.viewMode != ViewModeQuotas || cmd == nil {
+		t.Fatal("Expected the quota view and a quota command")
+	}
+	if view := m.View(); !strings.Contains(view, "Loading...") {
+		t.Error("Expected the quotas to be loading")
+	}
+
+	m = runCmd(m, cmd)
+	if len(m.namespaceQuotas) != 1 || m.namespaceQuotas[0].Name != "compute" || len(m.limitRanges) != 1 {
+		t.Fatalf("Expected the compute quota and one limit range, got %v and %v", m.namespaceQuotas, m.limitRanges)
+	}
+	view := m.View()
+	for _, expected := range []string{"Quotas: default", "pods", " 90%  9 / 10", "256Mi"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected view to contain %q, got:\n%s", expected, view)
+		}
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updated.(Model)
+	if m.viewMode != ViewModeList {
+		t.Errorf("Expected esc to return to the list, got mode %d", m.viewMode)
+	}
+}
+
+// TestServiceAccountDetailShowsBindings tests that the serviceaccount detail view lists its bindings
+func TestServiceAccountDetailShowsBindings(t *testing.T) {
+	client := newTestClusterClient("",
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}},
+		&rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-read", Namespace: "default"},
+			RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: "view"},
+			Subjects:   []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: "web", Namespace: "default"}},
+		},
+		&rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"},
+			RoleRef:    rbacv1.RoleRef{Kind: "Role", Name: "edit"},
+			Subjects:   []rbacv1.Subject{{Kind: rbacv1.UserKind, Name: "alice"}},
+		},
+	)
+	model := NewModelWithConfig(client, config.DefaultConfig())
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m := updated.(Model)
+
+	m.tabs.SetActiveTab(int(components.ResourceTypeServiceAccount))
+	m.resourceList.SetResourceType(components.ResourceTypeServiceAccount)
+	updated, _ = m.Update(m.loadResources()())
+	m = updated.(Model)
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if m.viewMode != ViewModeDetail || cmd == nil {
+		t.Fatal("Expected the serviceaccount detail view and a bindings command")
+	}
+	if m.subjectBindings != nil {
+		t.Error("Expected the bindings to be cleared while loading")
+	}
+
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if len(m.subjectBindings) != 1 || m.subjectBindings[0].Binding != "RoleBinding default/web-read" {
+		t.Fatalf("Expected only web-read to bind web, got %v", m.subjectBindings)
+	}
+	if view := m.View(); !strings.Contains(view, "RoleBinding default/web-read → ClusterRole/view") {
+		t.Error("Expected the detail view to show the web-read binding")
+	}
+}
+
+// TestAccessQuery tests the who-can, can-subject and can-i modes of the access query panel
+func TestAccessQuery(t *testing.T) {
+	client := newTestClusterClient("",
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}},
+		&rbacv1.Role{
+			ObjectMeta: metav1.ObjectMeta{Name: "pod-reader", Namespace: "default"},
+			Rules:      []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get", "list"}}},
+		},
+		&rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-read", Namespace: "default"},
+			RoleRef:    rbacv1.RoleRef{Kind: "Role", Name: "pod-reader"},
+			Subjects:   []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: "web", Namespace: "default"}},
+		},
+	)
+	model := NewModelWithConfig(client, config.DefaultConfig())
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m := updated.(Model)
+
+	m.tabs.SetActiveTab(int(components.ResourceTypeServiceAccount))
+	m.resourceList.SetResourceType(components.ResourceTypeServiceAccount)
+	updated, _ = m.Update(m.loadResources()())
+	m = updated.(Model)
+
+	// Opening from a serviceaccount asks about it
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	m = updated.(Model)
+	if m.viewMode != ViewModeAccessQuery || m.accessQuery.Subject() != "sa:default/web" {
+		t.Fatalf("Expected the access query for sa:default/web, got mode %d subject %q", m.viewMode, m.accessQuery.Subject())
+	}
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if cmd == nil {
+		t.Fatal("Expected a query command")
+	}
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	view := m.View()
+	if !strings.Contains(view, "Can ServiceAccount default/web get pods in default?") || !strings.Contains(view, "✔ yes") {
+		t.Errorf("Expected web to be allowed to get pods, got:\n%s", view)
+	}
+
+	// Typing "q" edits the verb rather than quitting
+	for range "get" {
+		updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+		m = updated.(Model)
+	}
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("delete")})
+	m = updated.(Model)
+	updated, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if view := m.View(); !strings.Contains(view, "✖ no") {
+		t.Errorf("Expected web not to be allowed to delete pods, got:\n%s", view)
+	}
+
+	// Clearing the subject asks who can
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyShiftTab})
+	m = updated.(Model)
+	for range "sa:default/web" {
+		updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+		m = updated.(Model)
+	}
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m = updated.(Model)
+	for range "delete" {
+		updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+		m = updated.(Model)
+	}
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("list")})
+	m = updated.(Model)
+	updated, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if view := m.View(); !strings.Contains(view, "Who can list pods in default?") ||
+		!strings.Contains(view, "ServiceAccount default/web via RoleBinding default/web-read → Role/pod-reader") {
+		t.Errorf("Expected web to be listed as able to list pods, got:\n%s", view)
+	}
+
+	// Can I asks the API server, which the fake clientset answers with a denial
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+	m = updated.(Model)
+	if m.accessQuery.Mode() != components.AccessQueryCanI {
+		t.Fatal("Expected ctrl+t to switch to can I mode")
+	}
+	updated, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if view := m.View(); !strings.Contains(view, "Can I list pods in default?") {
+		t.Errorf("Expected a can I answer, got:\n%s", view)
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updated.(Model)
+	if m.viewMode != ViewModeList {
+		t.Errorf("Expected esc to return to the list, got mode %d", m.viewMode)
+	}
+}
+
+// TestAccessQueryClusterScoped tests that resources are resolved through discovery,
+// matching the typed group, and that subjects use the selected namespace even for
+// cluster-scoped resources
+func TestAccessQueryClusterScoped(t *testing.T) {
+	clientset := fake.NewSimpleClientset(
+		&rbacv1.ClusterRole{
+			ObjectMeta: metav1.ObjectMeta{Name: "role-reader"},
+			Rules: []rbacv1.PolicyRule{{
+				APIGroups: []string{"rbac.authorization.k8s.io"}, Resources: []string{"clusterroles"}, Verbs: []string{"get"},
+			}},
+		},
+		&rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-role-reader"},
+			RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: "role-reader"},
+			Subjects:   []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: "web", Namespace: "default"}},
+		},
+	)
+	clientset.Resources = []*metav1.APIResourceList{
+		{GroupVersion: "apps/v1", APIResources: []metav1.APIResource{
+			{Name: "deployments", Kind: "Deployment", Namespaced: true, Verbs: metav1.Verbs{"get", "list"}},
+		}},
+		{GroupVersion: "example.com/v1", APIResources: []metav1.APIResource{
+			{Name: "deployments", Kind: "Deployment", Verbs: metav1.Verbs{"get", "list"}},
+		}},
+		{GroupVersion: "rbac.authorization.k8s.io/v1", APIResources: []metav1.APIResource{
+			{Name: "clusterroles", Kind: "ClusterRole", Verbs: metav1.Verbs{"get", "list"}},
+		}},
+	}
+	client := &k8s.Client{}
+	client.SetClientsetForTesting(clientset)
+	client.SetNamespace("default")
+	m := NewModelWithConfig(client, config.DefaultConfig())
+
+	ask := func(subject, resource string) models.AccessAnswer {
+		t.Helper()
+		m.accessQuery.Open(subject, "default")
+		if subject == "" {
+			m.accessQuery.NextField() // Who-can questions start on the subject
+		}
+		m.accessQuery.NextField()
+		for range m.accessQuery.Resource() {
+			m.accessQuery.DeleteChar()
+		}
+		m.accessQuery.InsertText(resource)
+
+		msg, ok := m.runAccessQuery()().(accessAnsweredMsg)
+		if !ok || msg.err != nil {
+			t.Fatalf("Expected an answer for %s, got %v", resource, msg.err)
+		}
+		return msg.answer
+	}
+
+	answer := ask("sa:web", "clusterroles.rbac.authorization.k8s.io")
+	if answer.Question != "Can ServiceAccount default/web get clusterroles.rbac.authorization.k8s.io cluster-wide?" {
+		t.Errorf("Question = %q", answer.Question)
+	}
+	if !answer.Allowed {
+		t.Error("Expected web to be allowed to get clusterroles")
+	}
+
+	// The group picks the cluster-scoped resource over the namespaced one of the same name
+	answer = ask("", "deployments.example.com")
+	if answer.Question != "Who can get deployments.example.com cluster-wide?" {
+		t.Errorf("Question = %q", answer.Question)
+	}
+	answer = ask("", "deployments.apps")
+	if answer.Question != "Who can get deployments.apps in default?" {
+		t.Errorf("Question = %q", answer.Question)
+	}
+}
+
+// TestRBACWatchEvents tests that role and binding watch events update the lists
+func TestRBACWatchEvents(t *testing.T) {
+	model := newTestModel()
+	model.resourceList.SetResourceType(components.ResourceTypeRoleBinding)
+
+	binding := &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-read", Namespace: "default"},
+		RoleRef:    rbacv1.RoleRef{Kind: "Role", Name: "pod-reader"},
+	}
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeRoleBinding, EventType: "ADDED", Object: binding})
+	if selected := model.resourceList.GetSelectedRoleBinding(); selected == nil || len(selected.Subjects) != 0 {
+		t.Fatalf("Expected web-read without subjects, got %v", selected)
+	}
+
+	bound := binding.DeepCopy()
+	bound.Subjects = []rbacv1.Subject{{Kind: rbacv1.UserKind, Name: "alice"}}
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeRoleBinding, EventType: "MODIFIED", Object: bound})
+	if selected := model.resourceList.GetSelectedRoleBinding(); selected == nil || len(selected.Subjects) != 1 {
+		t.Fatalf("Expected web-read with one subject, got %v", selected)
+	}
+
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeRoleBinding, EventType: "DELETED", Object: bound})
+	if model.resourceList.GetSelectedRoleBinding() != nil {
+		t.Error("Expected web-read to be removed")
+	}
+}
+
+// TestServiceEndpointsGoToPod tests that the service detail view lists its endpoints and opens their pods
+func TestServiceEndpointsGoToPod(t *testing.T) {
+	ready, notReady := true, false
+	client := newTestClusterClient("",
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Spec:       corev1.ServiceSpec{Selector: map[string]string{"app": "web"}},
+		},
+		&discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-abc", Namespace: "default", Labels: map[string]string{discoveryv1.LabelServiceName: "web"}},
+			Endpoints: []discoveryv1.Endpoint{
+				{Addresses: []string{"10.0.0.1"}, Conditions: discoveryv1.EndpointConditions{Ready: &ready}, TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "web-1", Namespace: "default"}},
+				{Addresses: []string{"10.0.0.2"}, Conditions: discoveryv1.EndpointConditions{Ready: &notReady}, TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "web-2", Namespace: "default"}},
+			},
+		},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "default"}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-2", Namespace: "default"}},
+	)
+	model := NewModelWithConfig(client, config.DefaultConfig())
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+	m := updated.(Model)
+
+	m.tabs.SetActiveTab(int(components.ResourceTypeService))
+	m.resourceList.SetResourceType(components.ResourceTypeService)
+	updated, _ = m.Update(m.loadResources()())
+	m = updated.(Model)
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if m.viewMode != ViewModeDetail || cmd == nil {
+		t.Fatal("Expected the service detail view and an endpoints command")
+	}
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if len(m.serviceEndpoints) != 2 {
+		t.Fatalf("Expected 2 endpoints, got %v", m.serviceEndpoints)
+	}
+	if view := m.View(); !strings.Contains(view, "1 ready, 0 terminating, 1 not ready") {
+		t.Error("Expected the service detail view to summarize the endpoints")
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m = updated.(Model)
+	updated, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if m.tabs.GetActiveTab() != int(components.ResourceTypePod) || cmd == nil {
+		t.Fatalf("Expected to switch to the Pods tab, active tab is %d", m.tabs.GetActiveTab())
+	}
+
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if m.viewMode != ViewModeDetail || m.pendingPod != nil {
+		t.Fatal("Expected the pod detail view with the pending pod cleared")
+	}
+	if pod := m.resourceList.GetSelectedPod(); pod == nil || pod.Name != "web-2" {
+		t.Errorf("Expected web-2 to be selected, got %v", pod)
+	}
+}
+
+// TestPodNetworkPolicies tests that the network policy view shows the effective policy of the selected pod
+func TestPodNetworkPolicies(t *testing.T) {
+	client := newTestClusterClient("",
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "default", Labels: map[string]string{"app": "web"}}},
+		&networkingv1.NetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "default-deny", Namespace: "default"},
+			Spec: networkingv1.NetworkPolicySpec{
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+			},
+		},
+		&networkingv1.NetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "allow-frontend", Namespace: "default"},
+			Spec: networkingv1.NetworkPolicySpec{
+				PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+				Ingress: []networkingv1.NetworkPolicyIngressRule{{
+					From: []networkingv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "frontend"}}}},
+				}},
+			},
+		},
+	)
+	model := NewModelWithConfig(client, config.DefaultConfig())
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+	m := updated.(Model)
+	updated, _ = m.Update(m.loadResources()())
+	m = updated.(Model)
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'P'}})
+	m = updated.(Model)
+	if m.viewMode != ViewModeNetworkPolicies || cmd == nil {
+		t.Fatal("Expected the network policy view and an analysis command")
+	}
+	if view := m.View(); !strings.Contains(view, "Loading...") {
+		t.Error("Expected the analysis to be loading")
+	}
+
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if m.networkAnalysis == nil {
+		t.Fatal("Expected the analysis to be loaded")
+	}
+	view := m.View()
+	for _, expected := range []string{"allow-frontend, default-deny", "from pods app=frontend in default on any port", "Default deny: all egress blocked"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected view to contain %q, got:\n%s", expected, view)
+		}
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updated.(Model)
+	if m.viewMode != ViewModeList {
+		t.Errorf("Expected esc to return to the list, got mode %d", m.viewMode)
+	}
+}
+
+// TestNetworkPolicyWatchEvents tests that networkpolicy watch events update the list
+func TestNetworkPolicyWatchEvents(t *testing.T) {
+	model := newTestModel()
+	model.resourceList.SetResourceType(components.ResourceTypeNetworkPolicy)
+
+	policy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeNetworkPolicy, EventType: "ADDED", Object: policy})
+	if selected := model.resourceList.GetSelectedNetworkPolicy(); selected == nil || !selected.DeniesAll() {
+		t.Fatalf("Expected web to deny all ingress, got %v", selected)
+	}
+
+	opened := policy.DeepCopy()
+	opened.Spec.Ingress = []networkingv1.NetworkPolicyIngressRule{{}}
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeNetworkPolicy, EventType: "MODIFIED", Object: opened})
+	if selected := model.resourceList.GetSelectedNetworkPolicy(); selected == nil || selected.DeniesAll() {
+		t.Fatalf("Expected web to allow ingress, got %v", selected)
+	}
+
+	model.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeNetworkPolicy, EventType: "DELETED", Object: opened})
+	if model.resourceList.GetSelectedNetworkPolicy() != nil {
+		t.Error("Expected web to be removed")
+	}
+}
+
+// TestDeleteDialog tests that D dry-runs the delete of the selected object and deletes
+// it once confirmed
+func TestDeleteDialog(t *testing.T) {
+	clientset := fake.NewSimpleClientset(
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "default"}},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}},
+	)
+	// The fake clientset ignores dry-run, so answer dry-run deletes without deleting
+	var dryRuns []metav1.DeleteOptions
+	clientset.PrependReactor("delete", "deployments", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		opts := action.(k8stesting.DeleteAction).GetDeleteOptions()
+		if len(opts.DryRun) == 0 {
+			return false, nil, nil
+		}
+		dryRuns = append(dryRuns, opts)
+		return true, nil, nil
+	})
+	client := &k8s.Client{}
+	client.SetClientsetForTesting(clientset)
+	client.SetNamespace("default")
+
+	model := NewModelWithConfig(client, config.DefaultConfig())
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+	m := updated.(Model)
+	m.tabs.SetActiveTab(int(components.ResourceTypeDeployment))
+	m.resourceList.SetResourceType(components.ResourceTypeDeployment)
+	updated, _ = m.Update(m.loadResources()())
+	m = updated.(Model)
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'D'}})
+	m = updated.(Model)
+	if !m.deleteDialog.IsVisible() || cmd == nil {
+		t.Fatal("Expected the delete dialog and a dry-run command")
+	}
+	if view := m.View(); !strings.Contains(view, "default/api") || !strings.Contains(view, "Running server-side dry run") {
+		t.Errorf("Expected the dry run of default/api to be running, got:\n%s", view)
+	}
+
+	// Confirming before the dry run has passed does nothing
+	updated, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	m = updated.(Model)
+	if cmd != nil || !m.deleteDialog.IsVisible() {
+		t.Fatal("Expected the delete to wait for the dry run")
+	}
+
+	m = runCmd(m, m.runDelete(true))
+	updated, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'p'}})
+	m = updated.(Model)
+	m = runCmd(m, cmd)
+	if view := m.View(); !strings.Contains(view, "Dry run passed") || !strings.Contains(view, "Background") {
+		t.Errorf("Expected a passed dry run with background propagation, got:\n%s", view)
+	}
+	if len(dryRuns) != 2 || dryRuns[0].PropagationPolicy != nil || *dryRuns[1].PropagationPolicy != metav1.DeletePropagationBackground {
+		t.Fatalf("Expected the server default, then background propagation, got %v", dryRuns)
+	}
+
+	updated, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	m = updated.(Model)
+	if cmd == nil || !strings.Contains(m.View(), "Deleting...") {
+		t.Fatal("Expected the delete to run")
+	}
+	updated, cmd = m.Update(cmd())
+	m = updated.(Model)
+	if m.deleteDialog.IsVisible() || cmd == nil {
+		t.Fatal("Expected the dialog to close and the list to reload")
+	}
+	if _, err := clientset.AppsV1().Deployments("default").Get(t.Context(), "api", metav1.GetOptions{}); err == nil {
+		t.Error("Expected api to be deleted")
+	}
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if _, _, name, _ := m.resourceList.GetSelectedRef(); name != "web" {
+		t.Errorf("Expected web to be left, got %q", name)
+	}
+
+	// Cancelling leaves the object alone
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'D'}})
+	m = updated.(Model)
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updated.(Model)
+	if m.deleteDialog.IsVisible() || m.deleteTarget != nil {
+		t.Error("Expected esc to close the delete dialog")
+	}
+}
+
+// TestDeleteDisabledForSnapshots tests that D does nothing on a read-only snapshot
+func TestDeleteDisabledForSnapshots(t *testing.T) {
+	dir := t.TempDir()
+	manifest := "apiVersion: v1\nkind: Pod\nmetadata:\n  name: web\n  namespace: default\n"
+	if err := os.WriteFile(filepath.Join(dir, "pods.yaml"), []byte(manifest), 0o600); err != nil {
+		t.Fatalf("failed to write snapshot: %v", err)
+	}
+	client, err := k8s.NewSnapshotClient(dir, "default")
+	if err != nil {
+		t.Fatalf("NewSnapshotClient failed: %v", err)
+	}
+	model := NewModelWithConfig(client, config.DefaultConfig())
+	updated, _ := model.Update(model.loadResources()())
+	m := updated.(Model)
+	if _, _, name, _ := m.resourceList.GetSelectedRef(); name != "web" {
+		t.Fatalf("Expected the web pod to be listed, got %q", name)
+	}
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'D'}})
+	m = updated.(Model)
+	if m.deleteDialog.IsVisible() || cmd != nil {
+		t.Error("Expected no delete dialog for a snapshot")
+	}
+}
+
+// TestReadOnlyChecksUseSelectedCluster tests that writes are gated by the cluster of the
+// selected object rather than the first client
+func TestReadOnlyChecksUseSelectedCluster(t *testing.T) {
+	dir := t.TempDir()
+	manifest := "apiVersion: v1\nkind: Pod\nmetadata:\n  name: web\n  namespace: default\n"
+	if err := os.WriteFile(filepath.Join(dir, "pods.yaml"), []byte(manifest), 0o600); err != nil {
+		t.Fatalf("failed to write snapshot: %v", err)
+	}
+	snapshot, err := k8s.NewSnapshotClient(dir, "default")
+	if err != nil {
+		t.Fatalf("NewSnapshotClient failed: %v", err)
+	}
+	east := newTestClusterClient("east", &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}})
+
+	model := NewMultiClusterModelWithConfig([]*k8s.Client{east, snapshot}, config.DefaultConfig())
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m := updated.(Model)
+	updated, _ = m.Update(m.loadResources()())
+	m = updated.(Model)
+
+	// Select the pod from the snapshot
+	if cluster, _, _, _ := m.resourceList.GetSelectedRef(); cluster == "east" {
+		updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+		m = updated.(Model)
+	}
+	if cluster, _, _, _ := m.resourceList.GetSelectedRef(); cluster != snapshot.GetCurrentContext() {
+		t.Fatalf("Expected the snapshot pod to be selected, got cluster %q", cluster)
+	}
+	if !m.selectedReadOnly() {
+		t.Error("Expected the snapshot pod to be read-only")
+	}
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'D'}})
+	m = updated.(Model)
+	if m.deleteDialog.IsVisible() || cmd != nil {
+		t.Error("Expected no delete dialog for the snapshot pod")
+	}
+
+	// The describe view of the snapshot pod offers no edit, even though the first client is live
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+	m = updated.(Model)
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	m = updated.(Model)
+	if m.viewMode != ViewModeDescribe || strings.Contains(m.View(), "Edit in $EDITOR") {
+		t.Error("Expected the describe view without the edit key")
+	}
+	if _, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}}); cmd != nil {
+		t.Error("Expected e to do nothing for the snapshot pod")
+	}
+
+	// The live pod can still be changed
+	m.viewMode = ViewModeList
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyUp})
+	m = updated.(Model)
+	if cluster, _, _, _ := m.resourceList.GetSelectedRef(); cluster != "east" || m.selectedReadOnly() {
+		t.Errorf("Expected the live east pod to be writable, got cluster %q", cluster)
+	}
+}
+
+// TestScaleDialog tests that S scales the selected deployment through the scale
+// subresource and follows the ready count through watch events
+func TestScaleDialog(t *testing.T) {
+	replicas := int32(2)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		Status:     appsv1.DeploymentStatus{Replicas: 2, ReadyReplicas: 2},
+	}
+	clientset := fake.NewSimpleClientset(deployment, &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{Kind: "Deployment", Name: "web"},
+			MaxReplicas:    10,
+		},
+	})
+	// The fake clientset has no scale subresource, so record the requested replicas
+	var scaled []int32
+	clientset.PrependReactor("update", "deployments", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		scale := action.(k8stesting.UpdateAction).GetObject().(*autoscalingv1.Scale)
+		scaled = append(scaled, scale.Spec.Replicas)
+		return true, scale, nil
+	})
+	client := &k8s.Client{}
+	client.SetClientsetForTesting(clientset)
+	client.SetNamespace("default")
+
+	model := NewModelWithConfig(client, config.DefaultConfig())
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+	m := updated.(Model)
+	m.tabs.SetActiveTab(int(components.ResourceTypeDeployment))
+	m.resourceList.SetResourceType(components.ResourceTypeDeployment)
+	updated, _ = m.Update(m.loadResources()())
+	m = updated.(Model)
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'S'}})
+	m = updated.(Model)
+	if !m.scaleDialog.IsVisible() || cmd == nil {
+		t.Fatal("Expected the scale dialog and an autoscaler command")
+	}
+	m = runCmd(m, cmd)
+	view := m.View()
+	for _, expected := range []string{"Scale Deployment", "default/web", "2 ready, 2 current", "HPA web", "override a manual scale"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected %q in the scale dialog, got:\n%s", expected, view)
+		}
+	}
+
+	for _, keyMsg := range []tea.KeyMsg{{Type: tea.KeyBackspace}, {Type: tea.KeyRunes, Runes: []rune{'4'}}, {Type: tea.KeyUp}} {
+		updated, _ = m.Update(keyMsg)
+		m = updated.(Model)
+	}
+	updated, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if cmd == nil {
+		t.Fatal("Expected a scale command")
+	}
+	m = runCmd(m, cmd)
+	if len(scaled) != 1 || scaled[0] != 5 {
+		t.Fatalf("Expected a scale to 5 replicas, got %v", scaled)
+	}
+	if view := m.View(); !strings.Contains(view, "Waiting for 5 ready replicas") {
+		t.Errorf("Expected the dialog to wait for the replicas, got:\n%s", view)
+	}
+
+	converging := deployment.DeepCopy()
+	five := int32(5)
+	converging.Spec.Replicas = &five
+	converging.Status = appsv1.DeploymentStatus{Replicas: 5, ReadyReplicas: 3}
+	m.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeDeployment, EventType: "MODIFIED", Object: converging})
+	if view := m.View(); !strings.Contains(view, "3 ready, 5 current") {
+		t.Errorf("Expected the live ready count, got:\n%s", view)
+	}
+
+	converged := converging.DeepCopy()
+	converged.Status.ReadyReplicas = 5
+	m.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeDeployment, EventType: "MODIFIED", Object: converged})
+	if view := m.View(); !strings.Contains(view, "Scaled to 5 ready replicas") {
+		t.Errorf("Expected the scale to complete, got:\n%s", view)
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updated.(Model)
+	if m.scaleDialog.IsVisible() || m.scaleTarget != nil {
+		t.Error("Expected esc to close the scale dialog")
+	}
+}
+
+// TestRolloutDialog tests that R restarts and pauses the selected deployment and
+// follows its rollout through watch events
+func TestRolloutDialog(t *testing.T) {
+	replicas := int32(2)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", Generation: 1},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		Status:     appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 2, UpdatedReplicas: 2, ReadyReplicas: 2, AvailableReplicas: 2},
+	}
+	clientset := fake.NewSimpleClientset(deployment)
+	client := &k8s.Client{}
+	client.SetClientsetForTesting(clientset)
+	client.SetNamespace("default")
+	model := NewModelWithConfig(client, config.DefaultConfig())
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+	m := updated.(Model)
+	m.tabs.SetActiveTab(int(components.ResourceTypeDeployment))
+	m.resourceList.SetResourceType(components.ResourceTypeDeployment)
+	updated, _ = m.Update(m.loadResources()())
+	m = updated.(Model)
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'R'}})
+	m = updated.(Model)
+	if !m.rolloutDialog.IsVisible() {
+		t.Fatal("Expected the rollout dialog")
+	}
+	view := m.View()
+	for _, expected := range []string{"Rollout Deployment", "default/web", "2/2", "successfully rolled out", "[p]"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected %q in the rollout dialog, got:\n%s", expected, view)
+		}
+	}
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+	m = updated.(Model)
+	if cmd == nil {
+		t.Fatal("Expected a restart command")
+	}
+	m = runCmd(m, cmd)
+	restarted, err := clientset.AppsV1().Deployments("default").Get(t.Context(), "web", metav1.GetOptions{})
+	if err != nil || restarted.Spec.Template.Annotations[models.RestartedAtAnnotation] == "" {
+		t.Fatalf("Expected the restartedAt annotation, got %v (%v)", restarted, err)
+	}
+
+	rolling := restarted.DeepCopy()
+	rolling.Generation = 2
+	rolling.Status = appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 3, UpdatedReplicas: 1, ReadyReplicas: 2, AvailableReplicas: 2}
+	m.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeDeployment, EventType: "MODIFIED", Object: rolling})
+	if view := m.View(); !strings.Contains(view, "1 of 2 new replicas have been updated") || !strings.Contains(view, "Restarted") {
+		t.Errorf("Expected the rollout in progress, got:\n%s", view)
+	}
+
+	stuck := rolling.DeepCopy()
+	stuck.Status.Conditions = []appsv1.DeploymentCondition{{Type: appsv1.DeploymentProgressing, Reason: "ProgressDeadlineExceeded"}}
+	m.handleWatchEvent(k8s.WatchEvent{ResourceType: k8s.ResourceTypeDeployment, EventType: "MODIFIED", Object: stuck})
+	if view := m.View(); !strings.Contains(view, "exceeded its progress deadline") {
+		t.Errorf("Expected the failed rollout, got:\n%s", view)
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updated.(Model)
+	if m.rolloutDialog.IsVisible() || m.rolloutTarget != nil {
+		t.Fatal("Expected esc to close the rollout dialog")
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'R'}})
+	m = updated.(Model)
+	updated, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'p'}})
+	m = updated.(Model)
+	m = runCmd(m, cmd)
+	paused, err := clientset.AppsV1().Deployments("default").Get(t.Context(), "web", metav1.GetOptions{})
+	if err != nil || !paused.Spec.Paused {
+		t.Errorf("Expected the deployment to be paused, got %v (%v)", paused, err)
+	}
+}
+
+func TestRevisionHistoryAndRollback(t *testing.T) {
+	podTemplate := func(image string) corev1.PodTemplateSpec {
+		return corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web"}},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "web", Image: image}}},
+		}
+	}
+	replicaSet := func(revision, image string) *appsv1.ReplicaSet {
+		template := podTemplate(image)
+		template.Labels[appsv1.DefaultDeploymentUniqueLabelKey] = "hash" + revision
+		return &appsv1.ReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "web-" + revision,
+				Namespace:       "default",
+				Annotations:     map[string]string{models.RevisionAnnotation: revision},
+				OwnerReferences: []metav1.OwnerReference{{Kind: "Deployment", Name: "web", UID: "web-uid"}},
+			},
+			Spec: appsv1.ReplicaSetSpec{Template: template},
+		}
+	}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", UID: "web-uid", Annotations: map[string]string{models.RevisionAnnotation: "2"}},
+		Spec:       appsv1.DeploymentSpec{Template: podTemplate("web:2")},
+	}
+	clientset := fake.NewSimpleClientset(deployment, replicaSet("1", "web:1"), replicaSet("2", "web:2"))
+	client := &k8s.Client{}
+	client.SetClientsetForTesting(clientset)
+	client.SetNamespace("default")
+	model := NewModelWithConfig(client, config.DefaultConfig())
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+	m := updated.(Model)
+	m.tabs.SetActiveTab(int(components.ResourceTypeDeployment))
+	m.resourceList.SetResourceType(components.ResourceTypeDeployment)
+	updated, _ = m.Update(m.loadResources()())
+	m = updated.(Model)
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = runCmd(updated.(Model), cmd)
+	if len(m.workloadRevisions) != 2 {
+		t.Fatalf("Expected 2 revisions, got %+v", m.workloadRevisions)
+	}
+	if view := m.View(); !strings.Contains(view, "web-2") || !strings.Contains(view, "web:1") {
+		t.Errorf("Expected the revision history in the detail view, got:\n%s", view)
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'H'}})
+	m = updated.(Model)
+	if m.viewMode != ViewModeRevisions {
+		t.Fatal("Expected H to open the revision viewer")
+	}
+	view := m.View()
+	for _, expected := range []string{"Revisions: Deployment default/web", "revision 2 → revision 1", "image: web:1"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected %q in the revision viewer, got:\n%s", expected, view)
+		}
+	}
+	if strings.Contains(view, appsv1.DefaultDeploymentUniqueLabelKey) {
+		t.Error("The diff should not include the pod-template-hash label")
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'u'}})
+	m = updated.(Model)
+	if !m.confirmDialog.IsVisible() {
+		t.Fatal("Expected a rollback confirmation")
+	}
+	updated, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	m = updated.(Model)
+	if m.viewMode != ViewModeDetail || !m.rolloutDialog.IsVisible() || cmd == nil {
+		t.Fatal("Expected the rollback to follow the rollout from the detail view")
+	}
+	m = runCmd(m, cmd)
+	rolledBack, err := clientset.AppsV1().Deployments("default").Get(t.Context(), "web", metav1.GetOptions{})
+	if err != nil || rolledBack.Spec.Template.Spec.Containers[0].Image != "web:1" {
+		t.Fatalf("Expected the revision 1 template to be restored, got %v (%v)", rolledBack, err)
+	}
+
+	// The controller moves the deployment to a new revision, reloading the history
+	rolledBack.Annotations[models.RevisionAnnotation] = "3"
+	updated, cmd = m.Update(watchEventMsg{event: k8s.WatchEvent{ResourceType: k8s.ResourceTypeDeployment, EventType: "MODIFIED", Object: rolledBack}})
+	m = updated.(Model)
+	if m.workloadRevisions != nil || cmd == nil {
+		t.Error("Expected the revision history to reload after the rollback")
+	}
+}
+
+// TestEditableGenericResource tests that the generic tab allows editing updatable
+// resources, except secrets and events
+func TestEditableGenericResource(t *testing.T) {
+	model := newTestModel()
+	model.tabs.SetActiveTab(int(components.ResourceTypeGeneric))
+	update := []string{"get", "list", "update"}
+
+	for _, tt := range []struct {
+		resource k8s.APIResource
+		editable bool
+	}{
+		{k8s.APIResource{Group: "example.com", Version: "v1", Resource: "widgets", Kind: "Widget", Verbs: update}, true},
+		{k8s.APIResource{Group: "example.com", Version: "v1", Resource: "gadgets", Kind: "Gadget", Verbs: []string{"get", "list"}}, false},
+		{k8s.APIResource{Version: "v1", Resource: "secrets", Kind: "Secret", Verbs: update}, false},
+		{k8s.APIResource{Version: "v1", Resource: "events", Kind: "Event", Verbs: update}, false},
+		{k8s.APIResource{Group: "events.k8s.io", Version: "v1", Resource: "events", Kind: "Event", Verbs: update}, false},
+	} {
+		model.genericResource = &tt.resource
+		if _, editable := model.editableResource(); editable != tt.editable {
+			t.Errorf("editableResource() for %s = %v, want %v", tt.resource.DisplayName(), editable, tt.editable)
+		}
+	}
+}
+
+func TestEditInEditor(t *testing.T) {
+	configMap := &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: "default", ResourceVersion: "5"},
+		Data:       map[string]string{"mode": "slow"},
+	}
+	clientset := fake.NewSimpleClientset(configMap)
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(scheme.Scheme, configMap)
+	// The fake client ignores dry-run, so answer dry-run updates without updating
+	dynamicClient.PrependReactor("update", "configmaps", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		update := action.(k8stesting.UpdateActionImpl)
+		return len(update.GetUpdateOptions().DryRun) > 0, update.GetObject(), nil
+	})
+	client := &k8s.Client{}
+	client.SetClientsetForTesting(clientset)
+	client.SetDynamicClientForTesting(dynamicClient)
+	client.SetNamespace("default")
+	model := NewModelWithConfig(client, config.DefaultConfig())
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+	m := updated.(Model)
+	m.tabs.SetActiveTab(int(components.ResourceTypeConfigMap))
+	m.resourceList.SetResourceType(components.ResourceTypeConfigMap)
+	updated, _ = m.Update(m.loadResources()())
+	m = updated.(Model)
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+	m = runCmd(updated.(Model), cmd)
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	m = updated.(Model)
+	if !strings.Contains(m.View(), "Edit in $EDITOR") {
+		t.Fatal("Expected the describe view to offer editing the YAML")
+	}
+
+	updated, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	m = updated.(Model)
+	if cmd == nil {
+		t.Fatal("Expected e to load the object for editing")
+	}
+	loaded, ok := cmd().(editLoadedMsg)
+	if !ok || loaded.err != nil {
+		t.Fatalf("Expected the object to load, got %+v", loaded)
+	}
+	if !strings.Contains(loaded.content, "# Please edit the object below") || !strings.Contains(loaded.content, "mode: slow") {
+		t.Errorf("Expected the header and the object, got:\n%s", loaded.content)
+	}
+	// The editor itself is run by Bubble Tea; only the file it leaves behind is used here
+	updated, _ = m.Update(loaded)
+	m = updated.(Model)
+
+	writeEdit := func(content string) string {
+		path := filepath.Join(t.TempDir(), "edit.yaml")
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	edited := strings.Replace(loaded.content, "mode: slow", "mode: fast", 1)
+	updated, cmd = m.Update(editorClosedMsg{path: writeEdit(edited)})
+	m = updated.(Model)
+	if m.viewMode != ViewModeEditReview || cmd == nil {
+		t.Fatal("Expected the edit to be reviewed and validated")
+	}
+	view := m.View()
+	for _, expected := range []string{"Edit ConfigMap default/settings", "-   mode: slow", "+   mode: fast", "dry run"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected %q in the edit review, got:\n%s", expected, view)
+		}
+	}
+	m = runCmd(m, cmd)
+	if !m.editReview.CanApply() {
+		t.Fatalf("Expected the dry run to pass, got:\n%s", m.View())
+	}
+	stored, _ := dynamicClient.Resource(corev1.SchemeGroupVersion.WithResource("configmaps")).Namespace("default").Get(t.Context(), "settings", metav1.GetOptions{})
+	if stored.Object["data"].(map[string]interface{})["mode"] != "slow" {
+		t.Fatal("Expected the dry run not to change the object")
+	}
+
+	// Another change lands before the edit is applied
+	dynamicClient.PrependReactor("update", "configmaps", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		update := action.(k8stesting.UpdateActionImpl)
+		if update.GetObject().(*unstructured.Unstructured).GetResourceVersion() == "5" {
+			return true, nil, apierrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, "settings", errors.New("the object has been modified"))
+		}
+		return false, nil, nil
+	})
+	stored.SetResourceVersion("6")
+	stored.SetLabels(map[string]string{"team": "ops"})
+	if _, err := dynamicClient.Resource(corev1.SchemeGroupVersion.WithResource("configmaps")).Namespace("default").Update(t.Context(), stored, metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	updated, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	m = updated.(Model)
+	applied, ok := cmd().(editAppliedMsg)
+	if !ok || !k8s.IsConflict(applied.err) {
+		t.Fatalf("Expected a conflict, got %+v", applied)
+	}
+	updated, cmd = m.Update(applied)
+	m = updated.(Model)
+	reloaded, ok := cmd().(editLoadedMsg)
+	if !ok || reloaded.err != nil {
+		t.Fatalf("Expected the edit to be reopened, got %+v", reloaded)
+	}
+	for _, expected := range []string{"# error: ", "the object has been modified", "mode: fast", "resourceVersion: \"6\""} {
+		if !strings.Contains(reloaded.content, expected) {
+			t.Errorf("Expected %q in the reopened edit, got:\n%s", expected, reloaded.content)
+		}
+	}
+	updated, _ = m.Update(reloaded)
+	m = updated.(Model)
+
+	updated, cmd = m.Update(editorClosedMsg{path: writeEdit(reloaded.content)})
+	m = runCmd(updated.(Model), cmd)
+	if view := m.View(); !strings.Contains(view, "-     team: ops") || !m.editReview.CanApply() {
+		t.Errorf("Expected the diff against the latest object, got:\n%s", view)
+	}
+	updated, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	m = runCmd(updated.(Model), cmd)
+	if m.viewMode != ViewModeDescribe || m.editTarget != nil {
+		t.Fatal("Expected to return to the describe view once applied")
+	}
+	stored, _ = dynamicClient.Resource(corev1.SchemeGroupVersion.WithResource("configmaps")).Namespace("default").Get(t.Context(), "settings", metav1.GetOptions{})
+	if stored.Object["data"].(map[string]interface{})["mode"] != "fast" {
+		t.Errorf("Expected the edit to be applied, got %v", stored.Object)
+	}
+}
+
+func TestEditorCommand(t *testing.T) {
+	t.Setenv("KUBE_EDITOR", "")
+	t.Setenv("EDITOR", "code --wait")
+	if cmd := editorCommand("/tmp/edit.yaml"); strings.Join(cmd.Args, " ") != "code --wait /tmp/edit.yaml" {
+		t.Errorf("Expected $EDITOR with its arguments, got %v", cmd.Args)
+	}
+
+	t.Setenv("KUBE_EDITOR", "nano")
+	if cmd := editorCommand("/tmp/edit.yaml"); strings.Join(cmd.Args, " ") != "nano /tmp/edit.yaml" {
+		t.Errorf("Expected $KUBE_EDITOR to take precedence, got %v", cmd.Args)
+	}
+
+	t.Setenv("KUBE_EDITOR", "")
+	t.Setenv("EDITOR", "")
+	if cmd := editorCommand("/tmp/edit.yaml"); strings.Join(cmd.Args, " ") != "vi /tmp/edit.yaml" {
+		t.Errorf("Expected vi as the fallback, got %v", cmd.Args)
+	}
+}
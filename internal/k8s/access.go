@@ -0,0 +1,100 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/williajm/k8s-tui/internal/models"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CanI asks the API server whether the current user may perform the query's verb
+// on its resource, like kubectl auth can-i. The query's subject is ignored.
+func (c *Client) CanI(ctx context.Context, query models.AccessQuery) (models.AccessAnswer, error) {
+	if c.IsReadOnly() {
+		return models.AccessAnswer{}, fmt.Errorf("access reviews need a live cluster, not a snapshot or replay")
+	}
+
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace:   query.Namespace,
+				Verb:        query.Verb,
+				Group:       query.Group,
+				Resource:    query.Resource,
+				Subresource: query.Subresource,
+			},
+		},
+	}
+	result, err := c.clientset.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
+	if err != nil {
+		return models.AccessAnswer{}, fmt.Errorf("failed to review access: %w", err)
+	}
+
+	query.Subject = models.Subject{}
+	question := strings.Replace(query.String(), "Who can", "Can I", 1)
+	answer := models.AccessAnswer{
+		Question: question,
+		Allowed:  result.Status.Allowed,
+		Reason:   result.Status.Reason,
+	}
+	if result.Status.EvaluationError != "" {
+		answer.Reason = strings.TrimSpace(answer.Reason + " " + result.Status.EvaluationError)
+	}
+	return answer, nil
+}
+
+// GetRBACPolicy retrieves the clusterroles, clusterrolebindings and the roles and
+// rolebindings of the given namespace, for evaluating access locally. An empty
+// namespace loads the cluster-wide objects only.
+func (c *Client) GetRBACPolicy(ctx context.Context, namespace string) (*models.RBACPolicy, error) {
+	clusterRoles, err := c.GetClusterRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	clusterBindings, err := c.GetClusterRoleBindings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	policy := &models.RBACPolicy{
+		ClusterRoles:        clusterRoles.Items,
+		ClusterRoleBindings: clusterBindings.Items,
+	}
+	if namespace == "" {
+		return policy, nil
+	}
+
+	roles, err := c.GetRoles(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	bindings, err := c.GetRoleBindings(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	policy.Roles = roles.Items
+	policy.RoleBindings = bindings.Items
+	return policy, nil
+}
+
+// FindAPIResource looks up a discovered resource by plural name, singular kind or
+// short name, like kubectl resolves resource arguments. A non-empty group must match.
+func FindAPIResource(resources []APIResource, group, name string) (APIResource, bool) {
+	name = strings.ToLower(name)
+	for _, resource := range resources {
+		if group != "" && resource.Group != group {
+			continue
+		}
+		if resource.Resource == name || strings.ToLower(resource.Kind) == name {
+			return resource, true
+		}
+		for _, shortName := range resource.ShortNames {
+			if shortName == name {
+				return resource, true
+			}
+		}
+	}
+	return APIResource{}, false
+}
@@ -0,0 +1,103 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/williajm/k8s-tui/internal/models"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestCanI(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	var reviewed *authorizationv1.ResourceAttributes
+	clientset.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		reviewed = review.Spec.ResourceAttributes
+		review.Status = authorizationv1.SubjectAccessReviewStatus{Allowed: true, Reason: `RBAC: allowed by RoleBinding "dev/shop"`}
+		return true, review, nil
+	})
+	client := &Client{clientset: clientset, namespace: "default"}
+
+	answer, err := client.CanI(context.Background(), models.AccessQuery{
+		Subject:     models.Subject{Kind: "User", Name: "ignored"},
+		Verb:        "update",
+		Group:       "apps",
+		Resource:    "deployments",
+		Subresource: "scale",
+		Namespace:   "shop",
+	})
+	if err != nil {
+		t.Fatalf("CanI failed: %v", err)
+	}
+	if reviewed == nil || reviewed.Group != "apps" || reviewed.Subresource != "scale" || reviewed.Namespace != "shop" {
+		t.Errorf("Unexpected review attributes %+v", reviewed)
+	}
+	if !answer.Allowed || answer.Local || answer.Reason == "" {
+		t.Errorf("Unexpected answer %+v", answer)
+	}
+	if answer.Question != "Can I update deployments.apps/scale in shop?" {
+		t.Errorf("Question = %q", answer.Question)
+	}
+}
+
+func TestCanI_Snapshot(t *testing.T) {
+	client := &Client{clientset: fake.NewSimpleClientset(), snapshotPath: "cluster.yaml"}
+	if _, err := client.CanI(context.Background(), models.AccessQuery{Verb: "get", Resource: "pods"}); err == nil {
+		t.Error("Expected access reviews to fail against a snapshot")
+	}
+}
+
+func TestGetRBACPolicy(t *testing.T) {
+	client := newDescribeTestClient(
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "view"}},
+		&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "viewers"}},
+		&rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: "reader", Namespace: "shop"}},
+		&rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: "reader", Namespace: "billing"}},
+		&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "readers", Namespace: "shop"}},
+	)
+
+	policy, err := client.GetRBACPolicy(context.Background(), "shop")
+	if err != nil {
+		t.Fatalf("GetRBACPolicy failed: %v", err)
+	}
+	if len(policy.ClusterRoles) != 1 || len(policy.ClusterRoleBindings) != 1 || len(policy.Roles) != 1 || len(policy.RoleBindings) != 1 {
+		t.Errorf("Unexpected policy %+v", policy)
+	}
+
+	policy, err = client.GetRBACPolicy(context.Background(), "")
+	if err != nil {
+		t.Fatalf("GetRBACPolicy failed: %v", err)
+	}
+	if len(policy.Roles) != 0 || len(policy.ClusterRoles) != 1 {
+		t.Errorf("Expected only cluster-wide objects, got %+v", policy)
+	}
+}
+
+func TestFindAPIResource(t *testing.T) {
+	resources := []APIResource{
+		{Group: "", Resource: "pods", Kind: "Pod", ShortNames: []string{"po"}},
+		{Group: "apps", Resource: "deployments", Kind: "Deployment", ShortNames: []string{"deploy"}},
+		{Group: "example.com", Resource: "deployments", Kind: "Deployment"},
+	}
+
+	for _, name := range []string{"deployments", "Deployment", "deploy"} {
+		if resource, ok := FindAPIResource(resources, "", name); !ok || resource.Group != "apps" {
+			t.Errorf("FindAPIResource(%q) = %+v, %t", name, resource, ok)
+		}
+	}
+	if resource, ok := FindAPIResource(resources, "example.com", "deployments"); !ok || resource.Group != "example.com" {
+		t.Errorf("Expected the group to pick the resource, got %+v, %t", resource, ok)
+	}
+	if _, ok := FindAPIResource(resources, "", "widgets"); ok {
+		t.Error("Expected unknown resources not to be found")
+	}
+	if _, ok := FindAPIResource(resources, "batch", "deployments"); ok {
+		t.Error("Expected resources of other groups not to be found")
+	}
+}
@@ -5,12 +5,21 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"sync"
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -20,7 +29,11 @@ import (
 // Client wraps the Kubernetes clientset with additional context
 type Client struct {
 	clientset      kubernetes.Interface
+	dynamicClient  dynamic.Interface
 	config         *rest.Config
+	kubeconfigPath string
+	snapshotPath   string // Set when backed by a snapshot or recording instead of a live cluster
+	replay         bool   // Set when snapshotPath is a recording being replayed
 	namespace      string
 	currentContext string
 	contexts       []string
@@ -29,12 +42,14 @@ type Client struct {
 
 // NewClient creates a new Kubernetes client
 // It attempts to load configuration in this order:
-// 1. In-cluster config
-// 2. KUBECONFIG environment variable
+// 1. In-cluster config (only when no context is requested)
+// 2. KUBECONFIG environment variable (a path list is merged like kubectl does)
 // 3. ~/.kube/config
-func NewClient(kubeconfigPath string, context string, namespace string) (*Client, error) {
+//
+// When contextName is set, the REST config, default namespace and context label
+// all come from that kubeconfig context rather than the file's current-context.
+func NewClient(kubeconfigPath string, contextName string, namespace string) (*Client, error) {
 	var config *rest.Config
-	var err error
 
 	// Try to load kubeconfig
 	if kubeconfigPath == "" {
@@ -47,11 +62,23 @@ func NewClient(kubeconfigPath string, context string, namespace string) (*Client
 		}
 	}
 
-	// Try in-cluster config first
-	config, err = rest.InClusterConfig()
-	if err != nil {
+	clientConfig := buildClientConfig(kubeconfigPath, contextName)
+
+	// An explicitly requested context must exist in the kubeconfig
+	if contextName != "" {
+		if err := validateContext(clientConfig, contextName); err != nil {
+			return nil, err
+		}
+	}
+
+	// Try in-cluster config first, unless a specific context was requested
+	if contextName == "" {
+		config, _ = rest.InClusterConfig()
+	}
+	if config == nil {
 		// Fall back to kubeconfig
-		config, err = clientcmd.BuildConfigFromFlags("", kubeconfigPath)
+		var err error
+		config, err = clientConfig.ClientConfig()
 		if err != nil {
 			return nil, fmt.Errorf("failed to load kubeconfig: %w", err)
 		}
@@ -63,8 +90,14 @@ func NewClient(kubeconfigPath string, context string, namespace string) (*Client
 		return nil, fmt.Errorf("failed to create kubernetes client: %w", err)
 	}
 
+	// Create the dynamic client used for discovered resource types
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dynamic client: %w", err)
+	}
+
 	// Load available contexts
-	contexts, currentContext, err := loadContexts(kubeconfigPath)
+	contexts, currentContext, err := loadContexts(clientConfig)
 	if err != nil {
 		// Non-fatal, just use empty contexts
 		contexts = []string{}
@@ -72,27 +105,62 @@ func NewClient(kubeconfigPath string, context string, namespace string) (*Client
 	}
 
 	// Use provided context or current context
-	if context != "" {
-		currentContext = context
+	if contextName != "" {
+		currentContext = contextName
 	}
 
-	// Default namespace if not specified
+	// Fall back to the context's namespace, then to "default"
+	if namespace == "" {
+		if contextNamespace, _, nsErr := clientConfig.Namespace(); nsErr == nil {
+			namespace = contextNamespace
+		}
+	}
 	if namespace == "" {
 		namespace = "default"
 	}
 
 	return &Client{
 		clientset:      clientset,
+		dynamicClient:  dynamicClient,
 		config:         config,
+		kubeconfigPath: kubeconfigPath,
 		namespace:      namespace,
 		currentContext: currentContext,
 		contexts:       contexts,
 	}, nil
 }
 
+// buildClientConfig creates a deferred kubeconfig loader for the given path,
+// overriding the current-context when contextName is set.
+// A path list (e.g. "a:b" as in $KUBECONFIG) is merged using clientcmd's
+// precedence rules: the first file to set a value wins.
+func buildClientConfig(kubeconfigPath, contextName string) clientcmd.ClientConfig {
+	loadingRules := &clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeconfigPath}
+	if paths := filepath.SplitList(kubeconfigPath); len(paths) > 1 {
+		loadingRules = &clientcmd.ClientConfigLoadingRules{Precedence: paths}
+	}
+	overrides := &clientcmd.ConfigOverrides{CurrentContext: contextName}
+
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, overrides)
+}
+
+// validateContext returns an error if contextName is not defined in the kubeconfig
+func validateContext(clientConfig clientcmd.ClientConfig, contextName string) error {
+	rawConfig, err := clientConfig.RawConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+
+	if _, ok := rawConfig.Contexts[contextName]; !ok {
+		return fmt.Errorf("context %q not found in kubeconfig", contextName)
+	}
+
+	return nil
+}
+
 // loadContexts reads available contexts from kubeconfig
-func loadContexts(kubeconfigPath string) ([]string, string, error) {
-	config, err := clientcmd.LoadFromFile(kubeconfigPath)
+func loadContexts(clientConfig clientcmd.ClientConfig) ([]string, string, error) {
+	config, err := clientConfig.RawConfig()
 	if err != nil {
 		return nil, "", err
 	}
@@ -101,6 +169,7 @@ func loadContexts(kubeconfigPath string) ([]string, string, error) {
 	for name := range config.Contexts {
 		contexts = append(contexts, name)
 	}
+	sort.Strings(contexts)
 
 	return contexts, config.CurrentContext, nil
 }
@@ -117,16 +186,6 @@ func (c *Client) GetPods(ctx context.Context, namespace string) (*corev1.PodList
 	return pods, nil
 }
 
-// GetAllPods retrieves pods from all namespaces
-func (c *Client) GetAllPods(ctx context.Context) (*corev1.PodList, error) {
-	pods, err := c.clientset.CoreV1().Pods("").List(ctx, metav1.ListOptions{})
-	if err != nil {
-		return nil, fmt.Errorf("failed to list all pods: %w", err)
-	}
-
-	return pods, nil
-}
-
 // GetNamespaces retrieves all namespaces
 func (c *Client) GetNamespaces(ctx context.Context) (*corev1.NamespaceList, error) {
 	namespaces, err := c.clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
@@ -137,6 +196,30 @@ func (c *Client) GetNamespaces(ctx context.Context) (*corev1.NamespaceList, erro
 	return namespaces, nil
 }
 
+// GetResourceQuotas retrieves the resourcequotas of a namespace
+func (c *Client) GetResourceQuotas(ctx context.Context, namespace string) (*corev1.ResourceQuotaList, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	quotas, err := c.clientset.CoreV1().ResourceQuotas(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resourcequotas: %w", err)
+	}
+
+	return quotas, nil
+}
+
+// GetLimitRanges retrieves the limitranges of a namespace
+func (c *Client) GetLimitRanges(ctx context.Context, namespace string) (*corev1.LimitRangeList, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	limitRanges, err := c.clientset.CoreV1().LimitRanges(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list limitranges: %w", err)
+	}
+
+	return limitRanges, nil
+}
+
 // GetPodLogs retrieves logs for a specific pod
 func (c *Client) GetPodLogs(ctx context.Context, namespace, podName, containerName string, tailLines int64) (string, error) {
 	namespace = c.resolveNamespace(namespace)
@@ -199,6 +282,18 @@ func (c *Client) GetContexts() []string {
 	return c.contexts
 }
 
+// ForContext creates a new client for another context from the same kubeconfig.
+// The namespace is taken from the target context, falling back to "default".
+func (c *Client) ForContext(contextName string) (*Client, error) {
+	if contextName == "" {
+		return nil, fmt.Errorf("context name is required")
+	}
+	if c.IsReadOnly() {
+		return nil, fmt.Errorf("context switching is not available in snapshot or replay mode")
+	}
+	return NewClient(c.kubeconfigPath, contextName, "")
+}
+
 // TestConnection verifies the connection to the Kubernetes cluster
 func (c *Client) TestConnection(parentCtx context.Context) error {
 	ctx, cancel := context.WithTimeout(parentCtx, 5*time.Second)
@@ -227,16 +322,6 @@ func (c *Client) GetServices(ctx context.Context, namespace string) (*corev1.Ser
 	return services, nil
 }
 
-// GetAllServices retrieves services from all namespaces
-func (c *Client) GetAllServices(ctx context.Context) (*corev1.ServiceList, error) {
-	services, err := c.clientset.CoreV1().Services("").List(ctx, metav1.ListOptions{})
-	if err != nil {
-		return nil, fmt.Errorf("failed to list all services: %w", err)
-	}
-
-	return services, nil
-}
-
 // GetService retrieves a specific service
 func (c *Client) GetService(ctx context.Context, namespace, name string) (*corev1.Service, error) {
 	namespace = c.resolveNamespace(namespace)
@@ -249,23 +334,27 @@ func (c *Client) GetService(ctx context.Context, namespace, name string) (*corev
 	return service, nil
 }
 
-// GetDeployments retrieves deployments from the specified namespace
-func (c *Client) GetDeployments(ctx context.Context, namespace string) (*appsv1.DeploymentList, error) {
+// GetServiceEndpointSlices retrieves the EndpointSlices that back a service
+func (c *Client) GetServiceEndpointSlices(ctx context.Context, namespace, name string) (*discoveryv1.EndpointSliceList, error) {
 	namespace = c.resolveNamespace(namespace)
 
-	deployments, err := c.clientset.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
+	slices, err := c.clientset.DiscoveryV1().EndpointSlices(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: discoveryv1.LabelServiceName + "=" + name,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to list deployments: %w", err)
+		return nil, fmt.Errorf("failed to list endpointslices: %w", err)
 	}
 
-	return deployments, nil
+	return slices, nil
 }
 
-// GetAllDeployments retrieves deployments from all namespaces
-func (c *Client) GetAllDeployments(ctx context.Context) (*appsv1.DeploymentList, error) {
-	deployments, err := c.clientset.AppsV1().Deployments("").List(ctx, metav1.ListOptions{})
+// GetDeployments retrieves deployments from the specified namespace
+func (c *Client) GetDeployments(ctx context.Context, namespace string) (*appsv1.DeploymentList, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	deployments, err := c.clientset.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
-		return nil, fmt.Errorf("failed to list all deployments: %w", err)
+		return nil, fmt.Errorf("failed to list deployments: %w", err)
 	}
 
 	return deployments, nil
@@ -295,16 +384,6 @@ func (c *Client) GetStatefulSets(ctx context.Context, namespace string) (*appsv1
 	return statefulSets, nil
 }
 
-// GetAllStatefulSets retrieves statefulsets from all namespaces
-func (c *Client) GetAllStatefulSets(ctx context.Context) (*appsv1.StatefulSetList, error) {
-	statefulSets, err := c.clientset.AppsV1().StatefulSets("").List(ctx, metav1.ListOptions{})
-	if err != nil {
-		return nil, fmt.Errorf("failed to list all statefulsets: %w", err)
-	}
-
-	return statefulSets, nil
-}
-
 // GetStatefulSet retrieves a specific statefulset
 func (c *Client) GetStatefulSet(ctx context.Context, namespace, name string) (*appsv1.StatefulSet, error) {
 	namespace = c.resolveNamespace(namespace)
@@ -317,8 +396,517 @@ func (c *Client) GetStatefulSet(ctx context.Context, namespace, name string) (*a
 	return statefulSet, nil
 }
 
+// GetConfigMaps retrieves configmaps from the specified namespace
+func (c *Client) GetConfigMaps(ctx context.Context, namespace string) (*corev1.ConfigMapList, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	configMaps, err := c.clientset.CoreV1().ConfigMaps(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list configmaps: %w", err)
+	}
+
+	return configMaps, nil
+}
+
+// GetConfigMap retrieves a specific configmap
+func (c *Client) GetConfigMap(ctx context.Context, namespace, name string) (*corev1.ConfigMap, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	configMap, err := c.clientset.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get configmap: %w", err)
+	}
+
+	return configMap, nil
+}
+
+// GetSecrets retrieves secrets from the specified namespace
+func (c *Client) GetSecrets(ctx context.Context, namespace string) (*corev1.SecretList, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	secrets, err := c.clientset.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list secrets: %w", err)
+	}
+
+	return secrets, nil
+}
+
+// GetSecret retrieves a specific secret
+func (c *Client) GetSecret(ctx context.Context, namespace, name string) (*corev1.Secret, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	secret, err := c.clientset.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret: %w", err)
+	}
+
+	return secret, nil
+}
+
+// GetNodes retrieves all nodes in the cluster
+func (c *Client) GetNodes(ctx context.Context) (*corev1.NodeList, error) {
+	nodes, err := c.clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	return nodes, nil
+}
+
+// GetNode retrieves a specific node
+func (c *Client) GetNode(ctx context.Context, name string) (*corev1.Node, error) {
+	node, err := c.clientset.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get node: %w", err)
+	}
+
+	return node, nil
+}
+
+// GetNodePods retrieves the pods scheduled on a node, across all namespaces
+func (c *Client) GetNodePods(ctx context.Context, nodeName string) ([]corev1.Pod, error) {
+	pods, err := c.clientset.CoreV1().Pods("").List(ctx, metav1.ListOptions{
+		FieldSelector: "spec.nodeName=" + nodeName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods on node: %w", err)
+	}
+
+	// Filter again in case the field selector was ignored (e.g. offline snapshots)
+	result := make([]corev1.Pod, 0, len(pods.Items))
+	for i := range pods.Items {
+		if pods.Items[i].Spec.NodeName == nodeName {
+			result = append(result, pods.Items[i])
+		}
+	}
+
+	return result, nil
+}
+
+// GetJobs retrieves jobs from the specified namespace
+func (c *Client) GetJobs(ctx context.Context, namespace string) (*batchv1.JobList, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	jobs, err := c.clientset.BatchV1().Jobs(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jobs: %w", err)
+	}
+
+	return jobs, nil
+}
+
+// GetJob retrieves a specific job
+func (c *Client) GetJob(ctx context.Context, namespace, name string) (*batchv1.Job, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	job, err := c.clientset.BatchV1().Jobs(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job: %w", err)
+	}
+
+	return job, nil
+}
+
+// GetCronJobs retrieves cronjobs from the specified namespace
+func (c *Client) GetCronJobs(ctx context.Context, namespace string) (*batchv1.CronJobList, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	cronJobs, err := c.clientset.BatchV1().CronJobs(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cronjobs: %w", err)
+	}
+
+	return cronJobs, nil
+}
+
+// GetCronJob retrieves a specific cronjob
+func (c *Client) GetCronJob(ctx context.Context, namespace, name string) (*batchv1.CronJob, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	cronJob, err := c.clientset.BatchV1().CronJobs(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cronjob: %w", err)
+	}
+
+	return cronJob, nil
+}
+
+// GetDaemonSets retrieves daemonsets from the specified namespace
+func (c *Client) GetDaemonSets(ctx context.Context, namespace string) (*appsv1.DaemonSetList, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	daemonSets, err := c.clientset.AppsV1().DaemonSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list daemonsets: %w", err)
+	}
+
+	return daemonSets, nil
+}
+
+// GetDaemonSet retrieves a specific daemonset
+func (c *Client) GetDaemonSet(ctx context.Context, namespace, name string) (*appsv1.DaemonSet, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	daemonSet, err := c.clientset.AppsV1().DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daemonset: %w", err)
+	}
+
+	return daemonSet, nil
+}
+
+// GetReplicaSets retrieves replicasets from the specified namespace
+func (c *Client) GetReplicaSets(ctx context.Context, namespace string) (*appsv1.ReplicaSetList, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	replicaSets, err := c.clientset.AppsV1().ReplicaSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list replicasets: %w", err)
+	}
+
+	return replicaSets, nil
+}
+
+// GetReplicaSet retrieves a specific replicaset
+func (c *Client) GetReplicaSet(ctx context.Context, namespace, name string) (*appsv1.ReplicaSet, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	replicaSet, err := c.clientset.AppsV1().ReplicaSets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get replicaset: %w", err)
+	}
+
+	return replicaSet, nil
+}
+
+// GetControllerRevisions retrieves the controller revisions, which hold the template
+// history of statefulsets and daemonsets, from the specified namespace
+func (c *Client) GetControllerRevisions(ctx context.Context, namespace string) (*appsv1.ControllerRevisionList, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	revisions, err := c.clientset.AppsV1().ControllerRevisions(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list controller revisions: %w", err)
+	}
+
+	return revisions, nil
+}
+
+// GetDaemonSetPods retrieves the pods owned by a daemonset
+func (c *Client) GetDaemonSetPods(ctx context.Context, daemonSet *appsv1.DaemonSet) ([]corev1.Pod, error) {
+	selector, err := metav1.LabelSelectorAsSelector(daemonSet.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse daemonset selector: %w", err)
+	}
+
+	pods, err := c.clientset.CoreV1().Pods(daemonSet.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list daemonset pods: %w", err)
+	}
+
+	// Other controllers' pods may share the labels, so keep only those the daemonset owns
+	result := make([]corev1.Pod, 0, len(pods.Items))
+	for i := range pods.Items {
+		for _, owner := range pods.Items[i].OwnerReferences {
+			if owner.UID == daemonSet.UID {
+				result = append(result, pods.Items[i])
+				break
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// GetIngresses retrieves ingresses from the specified namespace
+func (c *Client) GetIngresses(ctx context.Context, namespace string) (*networkingv1.IngressList, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	ingresses, err := c.clientset.NetworkingV1().Ingresses(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ingresses: %w", err)
+	}
+
+	return ingresses, nil
+}
+
+// GetIngress retrieves a specific ingress
+func (c *Client) GetIngress(ctx context.Context, namespace, name string) (*networkingv1.Ingress, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	ingress, err := c.clientset.NetworkingV1().Ingresses(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ingress: %w", err)
+	}
+
+	return ingress, nil
+}
+
+// GetPersistentVolumeClaims retrieves persistentvolumeclaims from the specified namespace
+func (c *Client) GetPersistentVolumeClaims(ctx context.Context, namespace string) (*corev1.PersistentVolumeClaimList, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	claims, err := c.clientset.CoreV1().PersistentVolumeClaims(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list persistentvolumeclaims: %w", err)
+	}
+
+	return claims, nil
+}
+
+// GetPersistentVolumeClaim retrieves a specific persistentvolumeclaim
+func (c *Client) GetPersistentVolumeClaim(ctx context.Context, namespace, name string) (*corev1.PersistentVolumeClaim, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	claim, err := c.clientset.CoreV1().PersistentVolumeClaims(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get persistentvolumeclaim: %w", err)
+	}
+
+	return claim, nil
+}
+
+// GetPersistentVolumes retrieves all persistentvolumes in the cluster
+func (c *Client) GetPersistentVolumes(ctx context.Context) (*corev1.PersistentVolumeList, error) {
+	volumes, err := c.clientset.CoreV1().PersistentVolumes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list persistentvolumes: %w", err)
+	}
+
+	return volumes, nil
+}
+
+// GetPersistentVolume retrieves a specific persistentvolume
+func (c *Client) GetPersistentVolume(ctx context.Context, name string) (*corev1.PersistentVolume, error) {
+	volume, err := c.clientset.CoreV1().PersistentVolumes().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get persistentvolume: %w", err)
+	}
+
+	return volume, nil
+}
+
+// GetStorageClasses retrieves all storageclasses in the cluster
+func (c *Client) GetStorageClasses(ctx context.Context) (*storagev1.StorageClassList, error) {
+	storageClasses, err := c.clientset.StorageV1().StorageClasses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list storageclasses: %w", err)
+	}
+
+	return storageClasses, nil
+}
+
+// GetStorageClass retrieves a specific storageclass
+func (c *Client) GetStorageClass(ctx context.Context, name string) (*storagev1.StorageClass, error) {
+	storageClass, err := c.clientset.StorageV1().StorageClasses().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get storageclass: %w", err)
+	}
+
+	return storageClass, nil
+}
+
+// GetHorizontalPodAutoscalers retrieves horizontalpodautoscalers from the specified namespace
+func (c *Client) GetHorizontalPodAutoscalers(ctx context.Context, namespace string) (*autoscalingv2.HorizontalPodAutoscalerList, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	hpas, err := c.clientset.AutoscalingV2().HorizontalPodAutoscalers(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list horizontalpodautoscalers: %w", err)
+	}
+
+	return hpas, nil
+}
+
+// GetHorizontalPodAutoscaler retrieves a specific horizontalpodautoscaler
+func (c *Client) GetHorizontalPodAutoscaler(ctx context.Context, namespace, name string) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	hpa, err := c.clientset.AutoscalingV2().HorizontalPodAutoscalers(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get horizontalpodautoscaler: %w", err)
+	}
+
+	return hpa, nil
+}
+
+// GetServiceAccounts retrieves serviceaccounts from the specified namespace
+func (c *Client) GetServiceAccounts(ctx context.Context, namespace string) (*corev1.ServiceAccountList, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	serviceAccounts, err := c.clientset.CoreV1().ServiceAccounts(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list serviceaccounts: %w", err)
+	}
+
+	return serviceAccounts, nil
+}
+
+// GetServiceAccount retrieves a specific serviceaccount
+func (c *Client) GetServiceAccount(ctx context.Context, namespace, name string) (*corev1.ServiceAccount, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	serviceAccount, err := c.clientset.CoreV1().ServiceAccounts(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get serviceaccount: %w", err)
+	}
+
+	return serviceAccount, nil
+}
+
+// GetRoles retrieves roles from the specified namespace
+func (c *Client) GetRoles(ctx context.Context, namespace string) (*rbacv1.RoleList, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	roles, err := c.clientset.RbacV1().Roles(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+
+	return roles, nil
+}
+
+// GetRole retrieves a specific role
+func (c *Client) GetRole(ctx context.Context, namespace, name string) (*rbacv1.Role, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	role, err := c.clientset.RbacV1().Roles(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role: %w", err)
+	}
+
+	return role, nil
+}
+
+// GetClusterRoles retrieves all clusterroles in the cluster
+func (c *Client) GetClusterRoles(ctx context.Context) (*rbacv1.ClusterRoleList, error) {
+	clusterRoles, err := c.clientset.RbacV1().ClusterRoles().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusterroles: %w", err)
+	}
+
+	return clusterRoles, nil
+}
+
+// GetClusterRole retrieves a specific clusterrole
+func (c *Client) GetClusterRole(ctx context.Context, name string) (*rbacv1.ClusterRole, error) {
+	clusterRole, err := c.clientset.RbacV1().ClusterRoles().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get clusterrole: %w", err)
+	}
+
+	return clusterRole, nil
+}
+
+// GetRoleBindings retrieves rolebindings from the specified namespace
+func (c *Client) GetRoleBindings(ctx context.Context, namespace string) (*rbacv1.RoleBindingList, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	bindings, err := c.clientset.RbacV1().RoleBindings(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rolebindings: %w", err)
+	}
+
+	return bindings, nil
+}
+
+// GetRoleBinding retrieves a specific rolebinding
+func (c *Client) GetRoleBinding(ctx context.Context, namespace, name string) (*rbacv1.RoleBinding, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	binding, err := c.clientset.RbacV1().RoleBindings(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rolebinding: %w", err)
+	}
+
+	return binding, nil
+}
+
+// GetClusterRoleBindings retrieves all clusterrolebindings in the cluster
+func (c *Client) GetClusterRoleBindings(ctx context.Context) (*rbacv1.ClusterRoleBindingList, error) {
+	clusterBindings, err := c.clientset.RbacV1().ClusterRoleBindings().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusterrolebindings: %w", err)
+	}
+
+	return clusterBindings, nil
+}
+
+// GetClusterRoleBinding retrieves a specific clusterrolebinding
+func (c *Client) GetClusterRoleBinding(ctx context.Context, name string) (*rbacv1.ClusterRoleBinding, error) {
+	clusterBinding, err := c.clientset.RbacV1().ClusterRoleBindings().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get clusterrolebinding: %w", err)
+	}
+
+	return clusterBinding, nil
+}
+
+// GetNetworkPolicies retrieves networkpolicies from the specified namespace
+func (c *Client) GetNetworkPolicies(ctx context.Context, namespace string) (*networkingv1.NetworkPolicyList, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	policies, err := c.clientset.NetworkingV1().NetworkPolicies(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list networkpolicies: %w", err)
+	}
+
+	return policies, nil
+}
+
+// GetNetworkPolicy retrieves a specific networkpolicy
+func (c *Client) GetNetworkPolicy(ctx context.Context, namespace, name string) (*networkingv1.NetworkPolicy, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	policy, err := c.clientset.NetworkingV1().NetworkPolicies(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get networkpolicy: %w", err)
+	}
+
+	return policy, nil
+}
+
+// GetPodDisruptionBudgets retrieves poddisruptionbudgets from the specified namespace
+func (c *Client) GetPodDisruptionBudgets(ctx context.Context, namespace string) (*policyv1.PodDisruptionBudgetList, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	budgets, err := c.clientset.PolicyV1().PodDisruptionBudgets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list poddisruptionbudgets: %w", err)
+	}
+
+	return budgets, nil
+}
+
+// GetPodDisruptionBudget retrieves a specific poddisruptionbudget
+func (c *Client) GetPodDisruptionBudget(ctx context.Context, namespace, name string) (*policyv1.PodDisruptionBudget, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	budget, err := c.clientset.PolicyV1().PodDisruptionBudgets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get poddisruptionbudget: %w", err)
+	}
+
+	return budget, nil
+}
+
 // SetClientsetForTesting allows setting the clientset for testing purposes
 // This should only be used in tests
 func (c *Client) SetClientsetForTesting(clientset kubernetes.Interface) {
 	c.clientset = clientset
 }
+
+// SetDynamicClientForTesting allows setting the dynamic client for testing purposes
+// This should only be used in tests
+func (c *Client) SetDynamicClientForTesting(dynamicClient dynamic.Interface) {
+	c.dynamicClient = dynamicClient
+}
+
+// SetCurrentContextForTesting allows setting the context name for testing purposes
+// This should only be used in tests
+func (c *Client) SetCurrentContextForTesting(contextName string) {
+	c.currentContext = contextName
+}
@@ -0,0 +1,199 @@
+package k8s
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+current-context: production
+clusters:
+- name: production
+  cluster:
+    server: https://production.example.com
+- name: staging
+  cluster:
+    server: https://staging.example.com
+users:
+- name: admin
+  user:
+    token: test-token
+contexts:
+- name: production
+  context:
+    cluster: production
+    user: admin
+- name: staging
+  context:
+    cluster: staging
+    user: admin
+    namespace: team-a
+`
+
+// writeTestKubeconfig writes the given kubeconfig content to a temp file and returns its path
+func writeTestKubeconfig(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write kubeconfig: %v", err)
+	}
+	return path
+}
+
+func TestNewClientUsesRequestedContext(t *testing.T) {
+	path := writeTestKubeconfig(t, testKubeconfig)
+
+	client, err := NewClient(path, "staging", "")
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	if client.config.Host != "https://staging.example.com" {
+		t.Errorf("Expected staging server, got %s", client.config.Host)
+	}
+	if client.GetCurrentContext() != "staging" {
+		t.Errorf("Expected current context staging, got %s", client.GetCurrentContext())
+	}
+	if client.GetNamespace() != "team-a" {
+		t.Errorf("Expected context namespace team-a, got %s", client.GetNamespace())
+	}
+}
+
+func TestNewClientNamespaceFlagOverridesContext(t *testing.T) {
+	path := writeTestKubeconfig(t, testKubeconfig)
+
+	client, err := NewClient(path, "staging", "kube-system")
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	if client.GetNamespace() != "kube-system" {
+		t.Errorf("Expected namespace kube-system, got %s", client.GetNamespace())
+	}
+}
+
+func TestNewClientDefaultsToCurrentContext(t *testing.T) {
+	path := writeTestKubeconfig(t, testKubeconfig)
+
+	client, err := NewClient(path, "", "")
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	if client.config.Host != "https://production.example.com" {
+		t.Errorf("Expected production server, got %s", client.config.Host)
+	}
+	if client.GetCurrentContext() != "production" {
+		t.Errorf("Expected current context production, got %s", client.GetCurrentContext())
+	}
+	if client.GetNamespace() != "default" {
+		t.Errorf("Expected namespace default, got %s", client.GetNamespace())
+	}
+
+	contexts := client.GetContexts()
+	if len(contexts) != 2 || contexts[0] != "production" || contexts[1] != "staging" {
+		t.Errorf("Expected sorted contexts [production staging], got %v", contexts)
+	}
+}
+
+func TestNewClientUnknownContext(t *testing.T) {
+	path := writeTestKubeconfig(t, testKubeconfig)
+
+	_, err := NewClient(path, "does-not-exist", "")
+	if err == nil {
+		t.Fatal("Expected error for unknown context")
+	}
+	if !strings.Contains(err.Error(), `context "does-not-exist" not found`) {
+		t.Errorf("Unexpected error message: %v", err)
+	}
+}
+
+func TestClientForContext(t *testing.T) {
+	path := writeTestKubeconfig(t, testKubeconfig)
+
+	client, err := NewClient(path, "production", "")
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	staging, err := client.ForContext("staging")
+	if err != nil {
+		t.Fatalf("ForContext failed: %v", err)
+	}
+
+	if staging.config.Host != "https://staging.example.com" {
+		t.Errorf("Expected staging server, got %s", staging.config.Host)
+	}
+	if staging.GetNamespace() != "team-a" {
+		t.Errorf("Expected namespace team-a, got %s", staging.GetNamespace())
+	}
+
+	if _, err := client.ForContext(""); err == nil {
+		t.Error("Expected error for empty context name")
+	}
+	if _, err := client.ForContext("missing"); err == nil {
+		t.Error("Expected error for unknown context")
+	}
+}
+
+const testKubeconfigDev = `apiVersion: v1
+kind: Config
+current-context: dev
+clusters:
+- name: dev
+  cluster:
+    server: https://dev.example.com
+- name: production
+  cluster:
+    server: https://shadowed.example.com
+users:
+- name: dev-user
+  user:
+    token: dev-token
+contexts:
+- name: dev
+  context:
+    cluster: dev
+    user: dev-user
+    namespace: sandbox
+`
+
+func TestNewClientMergesKubeconfigPathList(t *testing.T) {
+	first := writeTestKubeconfig(t, testKubeconfig)
+	second := writeTestKubeconfig(t, testKubeconfigDev)
+	t.Setenv("KUBECONFIG", first+string(os.PathListSeparator)+second)
+
+	client, err := NewClient("", "", "")
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	// The first file wins for current-context and for clusters defined in both
+	if client.GetCurrentContext() != "production" {
+		t.Errorf("Expected current context production, got %s", client.GetCurrentContext())
+	}
+	if client.config.Host != "https://production.example.com" {
+		t.Errorf("Expected production server from first file, got %s", client.config.Host)
+	}
+
+	contexts := client.GetContexts()
+	if len(contexts) != 3 || contexts[0] != "dev" || contexts[1] != "production" || contexts[2] != "staging" {
+		t.Errorf("Expected merged contexts [dev production staging], got %v", contexts)
+	}
+
+	// A context defined only in the second file resolves from that file
+	dev, err := client.ForContext("dev")
+	if err != nil {
+		t.Fatalf("ForContext failed: %v", err)
+	}
+	if dev.config.Host != "https://dev.example.com" {
+		t.Errorf("Expected dev server, got %s", dev.config.Host)
+	}
+	if dev.GetNamespace() != "sandbox" {
+		t.Errorf("Expected namespace sandbox, got %s", dev.GetNamespace())
+	}
+}
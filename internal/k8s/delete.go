@@ -0,0 +1,137 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var (
+	// ErrReadOnly is returned by write operations on clients backed by a snapshot
+	ErrReadOnly = errors.New("snapshots are read-only")
+	// ErrReplayReadOnly is returned by write operations on clients replaying a recording
+	ErrReplayReadOnly = errors.New("replays are read-only")
+)
+
+// DeleteOptions are the user-chosen options of a delete
+type DeleteOptions struct {
+	PropagationPolicy  string // Background, Foreground or Orphan; empty uses the server default
+	GracePeriodSeconds *int64 // nil uses the object's own grace period
+	DryRun             bool   // Only validate the delete on the server, without persisting it
+}
+
+// metaOptions converts the options to API delete options
+func (o DeleteOptions) metaOptions() metav1.DeleteOptions {
+	opts := metav1.DeleteOptions{GracePeriodSeconds: o.GracePeriodSeconds}
+	if o.PropagationPolicy != "" {
+		policy := metav1.DeletionPropagation(o.PropagationPolicy)
+		opts.PropagationPolicy = &policy
+	}
+	if o.DryRun {
+		opts.DryRun = []string{metav1.DryRunAll}
+	}
+	return opts
+}
+
+// resourceDeleter is implemented by the typed client of every supported resource type
+type resourceDeleter interface {
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+}
+
+// deleterFor returns the typed client that deletes objects of a resource type
+//
+//nolint:gocyclo // Acceptable complexity for resource type switching
+func (c *Client) deleterFor(resourceType, namespace string) (resourceDeleter, error) {
+	switch resourceType {
+	case "Pod":
+		return c.clientset.CoreV1().Pods(namespace), nil
+	case "Service":
+		return c.clientset.CoreV1().Services(namespace), nil
+	case "Deployment":
+		return c.clientset.AppsV1().Deployments(namespace), nil
+	case "StatefulSet":
+		return c.clientset.AppsV1().StatefulSets(namespace), nil
+	case "Event":
+		return c.clientset.CoreV1().Events(namespace), nil
+	case "ConfigMap":
+		return c.clientset.CoreV1().ConfigMaps(namespace), nil
+	case "Secret":
+		return c.clientset.CoreV1().Secrets(namespace), nil
+	case "Node":
+		return c.clientset.CoreV1().Nodes(), nil
+	case "Job":
+		return c.clientset.BatchV1().Jobs(namespace), nil
+	case "CronJob":
+		return c.clientset.BatchV1().CronJobs(namespace), nil
+	case "DaemonSet":
+		return c.clientset.AppsV1().DaemonSets(namespace), nil
+	case "ReplicaSet":
+		return c.clientset.AppsV1().ReplicaSets(namespace), nil
+	case "Ingress":
+		return c.clientset.NetworkingV1().Ingresses(namespace), nil
+	case "PersistentVolumeClaim":
+		return c.clientset.CoreV1().PersistentVolumeClaims(namespace), nil
+	case "PersistentVolume":
+		return c.clientset.CoreV1().PersistentVolumes(), nil
+	case "StorageClass":
+		return c.clientset.StorageV1().StorageClasses(), nil
+	case "HorizontalPodAutoscaler":
+		return c.clientset.AutoscalingV2().HorizontalPodAutoscalers(namespace), nil
+	case "ServiceAccount":
+		return c.clientset.CoreV1().ServiceAccounts(namespace), nil
+	case "Role":
+		return c.clientset.RbacV1().Roles(namespace), nil
+	case "ClusterRole":
+		return c.clientset.RbacV1().ClusterRoles(), nil
+	case "RoleBinding":
+		return c.clientset.RbacV1().RoleBindings(namespace), nil
+	case "ClusterRoleBinding":
+		return c.clientset.RbacV1().ClusterRoleBindings(), nil
+	case "NetworkPolicy":
+		return c.clientset.NetworkingV1().NetworkPolicies(namespace), nil
+	case "PodDisruptionBudget":
+		return c.clientset.PolicyV1().PodDisruptionBudgets(namespace), nil
+	default:
+		return nil, fmt.Errorf("unsupported resource type: %s", resourceType)
+	}
+}
+
+// DeleteResource deletes an object of a built-in resource type. With DryRun set the
+// API server runs validation and admission without deleting anything.
+func (c *Client) DeleteResource(ctx context.Context, resourceType, namespace, name string, opts DeleteOptions) error {
+	if c.IsReadOnly() {
+		return fmt.Errorf("failed to delete %s %s: %w", strings.ToLower(resourceType), name, c.readOnlyError())
+	}
+
+	deleter, err := c.deleterFor(resourceType, c.resolveNamespace(namespace))
+	if err != nil {
+		return err
+	}
+
+	if err := deleter.Delete(ctx, name, opts.metaOptions()); err != nil {
+		return fmt.Errorf("failed to delete %s %s: %w", strings.ToLower(resourceType), name, err)
+	}
+
+	return nil
+}
+
+// DeleteDynamic deletes an object of any discovered resource type
+func (c *Client) DeleteDynamic(ctx context.Context, resource APIResource, namespace, name string, opts DeleteOptions) error {
+	if c.IsReadOnly() {
+		return fmt.Errorf("failed to delete %s %s: %w", resource.Kind, name, c.readOnlyError())
+	}
+
+	client, err := c.dynamicResource(resource, namespace)
+	if err != nil {
+		return err
+	}
+
+	if err := client.Delete(ctx, name, opts.metaOptions()); err != nil {
+		return fmt.Errorf("failed to delete %s %s: %w", resource.Kind, name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestDeleteResource(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}})
+	// The fake clientset ignores dry-run, so answer dry-run deletes without deleting
+	var deletes []metav1.DeleteOptions
+	clientset.PrependReactor("delete", "deployments", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		opts := action.(k8stesting.DeleteAction).GetDeleteOptions()
+		deletes = append(deletes, opts)
+		return len(opts.DryRun) > 0, nil, nil
+	})
+	client := &Client{clientset: clientset, namespace: "default"}
+	ctx := context.Background()
+
+	grace := int64(0)
+	opts := DeleteOptions{PropagationPolicy: "Foreground", GracePeriodSeconds: &grace, DryRun: true}
+	if err := client.DeleteResource(ctx, "Deployment", "default", "web", opts); err != nil {
+		t.Fatalf("Dry-run DeleteResource failed: %v", err)
+	}
+	if _, err := clientset.AppsV1().Deployments("default").Get(ctx, "web", metav1.GetOptions{}); err != nil {
+		t.Fatalf("Expected the dry run to keep the deployment: %v", err)
+	}
+
+	opts.DryRun = false
+	if err := client.DeleteResource(ctx, "Deployment", "", "web", opts); err != nil {
+		t.Fatalf("DeleteResource failed: %v", err)
+	}
+	if _, err := clientset.AppsV1().Deployments("default").Get(ctx, "web", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("Expected the deployment to be deleted, got %v", err)
+	}
+
+	if len(deletes) != 2 {
+		t.Fatalf("Expected 2 deletes, got %d", len(deletes))
+	}
+	if len(deletes[0].DryRun) != 1 || deletes[0].DryRun[0] != metav1.DryRunAll || len(deletes[1].DryRun) != 0 {
+		t.Errorf("Expected only the first delete to be a dry run, got %v and %v", deletes[0].DryRun, deletes[1].DryRun)
+	}
+	if policy := deletes[1].PropagationPolicy; policy == nil || *policy != metav1.DeletePropagationForeground {
+		t.Errorf("Expected foreground propagation, got %v", policy)
+	}
+	if deletes[1].GracePeriodSeconds == nil || *deletes[1].GracePeriodSeconds != 0 {
+		t.Errorf("Expected a grace period of 0, got %v", deletes[1].GracePeriodSeconds)
+	}
+
+	if err := client.DeleteResource(ctx, "Deployment", "default", "web", DeleteOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+	if err := client.DeleteResource(ctx, "Widget", "default", "web", DeleteOptions{}); err == nil {
+		t.Error("Expected an error for an unsupported resource type")
+	}
+}
+
+func TestDeleteReadOnlySnapshot(t *testing.T) {
+	client := newTestDynamicClient(t)
+	ctx := context.Background()
+
+	if err := client.DeleteResource(ctx, "Pod", "shop", "web-1", DeleteOptions{DryRun: true}); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("Expected ErrReadOnly, got %v", err)
+	}
+	resource := APIResource{Group: "cert-manager.io", Version: "v1", Resource: "certificates", Kind: "Certificate", Namespaced: true}
+	if err := client.DeleteDynamic(ctx, resource, "shop", "web-tls", DeleteOptions{}); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("Expected ErrReadOnly, got %v", err)
+	}
+}
+
+func TestDeleteDynamic(t *testing.T) {
+	certificate := &unstructured.Unstructured{}
+	certificate.SetAPIVersion("cert-manager.io/v1")
+	certificate.SetKind("Certificate")
+	certificate.SetNamespace("shop")
+	certificate.SetName("web-tls")
+
+	gvr := schema.GroupVersionResource{Group: "cert-manager.io", Version: "v1", Resource: "certificates"}
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{gvr: "CertificateList"}, certificate)
+	client := &Client{clientset: fake.NewSimpleClientset(), dynamicClient: dynamicClient, namespace: "shop"}
+	resource := APIResource{Group: "cert-manager.io", Version: "v1", Resource: "certificates", Kind: "Certificate", Namespaced: true}
+	ctx := context.Background()
+
+	if err := client.DeleteDynamic(ctx, resource, "shop", "web-tls", DeleteOptions{PropagationPolicy: "Background"}); err != nil {
+		t.Fatalf("DeleteDynamic failed: %v", err)
+	}
+	if _, err := dynamicClient.Resource(gvr).Namespace("shop").Get(ctx, "web-tls", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("Expected the certificate to be deleted, got %v", err)
+	}
+}
@@ -2,12 +2,20 @@ package k8s
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/williajm/k8s-tui/internal/models"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/yaml"
 )
 
@@ -27,6 +35,44 @@ func (c *Client) GetResourceYAML(ctx context.Context, resourceType, namespace, n
 		obj, err = c.GetDeployment(ctx, namespace, name)
 	case "StatefulSet":
 		obj, err = c.GetStatefulSet(ctx, namespace, name)
+	case "ConfigMap":
+		obj, err = c.GetConfigMap(ctx, namespace, name)
+	case "Secret":
+		obj, err = c.getRedactedSecret(ctx, namespace, name)
+	case "Node":
+		obj, err = c.GetNode(ctx, name)
+	case "Job":
+		obj, err = c.GetJob(ctx, namespace, name)
+	case "CronJob":
+		obj, err = c.GetCronJob(ctx, namespace, name)
+	case "DaemonSet":
+		obj, err = c.GetDaemonSet(ctx, namespace, name)
+	case "ReplicaSet":
+		obj, err = c.GetReplicaSet(ctx, namespace, name)
+	case "Ingress":
+		obj, err = c.GetIngress(ctx, namespace, name)
+	case "PersistentVolumeClaim":
+		obj, err = c.GetPersistentVolumeClaim(ctx, namespace, name)
+	case "PersistentVolume":
+		obj, err = c.GetPersistentVolume(ctx, name)
+	case "StorageClass":
+		obj, err = c.GetStorageClass(ctx, name)
+	case "HorizontalPodAutoscaler":
+		obj, err = c.GetHorizontalPodAutoscaler(ctx, namespace, name)
+	case "ServiceAccount":
+		obj, err = c.GetServiceAccount(ctx, namespace, name)
+	case "Role":
+		obj, err = c.GetRole(ctx, namespace, name)
+	case "ClusterRole":
+		obj, err = c.GetClusterRole(ctx, name)
+	case "RoleBinding":
+		obj, err = c.GetRoleBinding(ctx, namespace, name)
+	case "ClusterRoleBinding":
+		obj, err = c.GetClusterRoleBinding(ctx, name)
+	case "NetworkPolicy":
+		obj, err = c.GetNetworkPolicy(ctx, namespace, name)
+	case "PodDisruptionBudget":
+		obj, err = c.GetPodDisruptionBudget(ctx, namespace, name)
 	default:
 		return "", fmt.Errorf("unsupported resource type: %s", resourceType)
 	}
@@ -59,6 +105,44 @@ func (c *Client) GetResourceJSON(ctx context.Context, resourceType, namespace, n
 		obj, err = c.GetDeployment(ctx, namespace, name)
 	case "StatefulSet":
 		obj, err = c.GetStatefulSet(ctx, namespace, name)
+	case "ConfigMap":
+		obj, err = c.GetConfigMap(ctx, namespace, name)
+	case "Secret":
+		obj, err = c.getRedactedSecret(ctx, namespace, name)
+	case "Node":
+		obj, err = c.GetNode(ctx, name)
+	case "Job":
+		obj, err = c.GetJob(ctx, namespace, name)
+	case "CronJob":
+		obj, err = c.GetCronJob(ctx, namespace, name)
+	case "DaemonSet":
+		obj, err = c.GetDaemonSet(ctx, namespace, name)
+	case "ReplicaSet":
+		obj, err = c.GetReplicaSet(ctx, namespace, name)
+	case "Ingress":
+		obj, err = c.GetIngress(ctx, namespace, name)
+	case "PersistentVolumeClaim":
+		obj, err = c.GetPersistentVolumeClaim(ctx, namespace, name)
+	case "PersistentVolume":
+		obj, err = c.GetPersistentVolume(ctx, name)
+	case "StorageClass":
+		obj, err = c.GetStorageClass(ctx, name)
+	case "HorizontalPodAutoscaler":
+		obj, err = c.GetHorizontalPodAutoscaler(ctx, namespace, name)
+	case "ServiceAccount":
+		obj, err = c.GetServiceAccount(ctx, namespace, name)
+	case "Role":
+		obj, err = c.GetRole(ctx, namespace, name)
+	case "ClusterRole":
+		obj, err = c.GetClusterRole(ctx, name)
+	case "RoleBinding":
+		obj, err = c.GetRoleBinding(ctx, namespace, name)
+	case "ClusterRoleBinding":
+		obj, err = c.GetClusterRoleBinding(ctx, name)
+	case "NetworkPolicy":
+		obj, err = c.GetNetworkPolicy(ctx, namespace, name)
+	case "PodDisruptionBudget":
+		obj, err = c.GetPodDisruptionBudget(ctx, namespace, name)
 	default:
 		return "", fmt.Errorf("unsupported resource type: %s", resourceType)
 	}
@@ -183,6 +267,24 @@ func (c *Client) DescribeService(ctx context.Context, namespace, name string) (*
 		}
 	}
 
+	// Endpoints section
+	if slices, err := c.GetServiceEndpointSlices(ctx, namespace, name); err == nil {
+		endpoints := desc.AddSection("Endpoints")
+		infos := models.NewEndpointInfos(slices.Items)
+		ready, terminating, notReady := models.EndpointCounts(infos)
+		endpoints.AddField("Summary", fmt.Sprintf("%d ready, %d terminating, %d not ready", ready, terminating, notReady), 0)
+		if len(infos) == 0 {
+			endpoints.AddField("Addresses", "<none>", 0)
+		}
+		for _, info := range infos {
+			target := info.Pod
+			if target == "" {
+				target = "<no pod>"
+			}
+			endpoints.AddField(info.Address, fmt.Sprintf("%s %s (%s)", target, info.Ports, info.State()), 1)
+		}
+	}
+
 	return desc, nil
 }
 
@@ -284,9 +386,1032 @@ func (c *Client) DescribeStatefulSet(ctx context.Context, namespace, name string
 	spec.AddField("Service Name", sts.Spec.ServiceName, 0)
 	spec.AddField("Pod Management Policy", string(sts.Spec.PodManagementPolicy), 0)
 
+	// Volume claim templates section
+	if templates := models.NewStatefulSetInfo(sts).VolumeClaimTemplates; len(templates) > 0 {
+		claims := desc.AddSection("Volume Claim Templates")
+		for _, template := range templates {
+			claims.AddField(template.Name, "", 0)
+			claims.AddField("Storage", template.Storage, 1)
+			claims.AddField("Access Modes", template.AccessModes, 1)
+			claims.AddField("Storage Class", template.StorageClass, 1)
+		}
+	}
+
+	return desc, nil
+}
+
+// DescribeDaemonSet generates a kubectl-style describe output for a daemonset, including
+// the daemon pod on each node so that nodes missing it stand out
+func (c *Client) DescribeDaemonSet(ctx context.Context, namespace, name string) (*models.DescribeData, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	ds, err := c.GetDaemonSet(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	desc := models.NewDescribeData("DaemonSet", name, namespace)
+	info := models.NewDaemonSetInfo(ds)
+
+	// Metadata section
+	metadata := desc.AddSection("Metadata")
+	metadata.AddField("Name", ds.Name, 0)
+	metadata.AddField("Namespace", ds.Namespace, 0)
+	metadata.AddField("Labels", formatMap(ds.Labels), 0)
+	metadata.AddField("Annotations", formatMap(ds.Annotations), 0)
+	if ds.Spec.Selector != nil {
+		metadata.AddField("Selector", formatMap(ds.Spec.Selector.MatchLabels), 0)
+	}
+	metadata.AddField("Node Selector", info.NodeSelector, 0)
+
+	// Strategy section
+	strategy := desc.AddSection("Update Strategy")
+	strategy.AddField("Type", info.Strategy, 0)
+	if rollingUpdate := ds.Spec.UpdateStrategy.RollingUpdate; rollingUpdate != nil {
+		if rollingUpdate.MaxUnavailable != nil {
+			strategy.AddField("Max Unavailable", rollingUpdate.MaxUnavailable.String(), 1)
+		}
+		if rollingUpdate.MaxSurge != nil {
+			strategy.AddField("Max Surge", rollingUpdate.MaxSurge.String(), 1)
+		}
+	}
+
+	// Status section
+	status := desc.AddSection("Status")
+	status.AddField("Desired Number of Nodes Scheduled", fmt.Sprintf("%d", info.Desired), 0)
+	status.AddField("Current Number of Nodes Scheduled", fmt.Sprintf("%d", info.Current), 0)
+	status.AddField("Number of Nodes Scheduled with Up-to-date Pods", fmt.Sprintf("%d", info.UpToDate), 0)
+	status.AddField("Number of Nodes Scheduled with Available Pods", fmt.Sprintf("%d", info.Available), 0)
+	status.AddField("Number of Nodes Misscheduled", fmt.Sprintf("%d", info.Misscheduled), 0)
+	status.AddField("Pods Ready", fmt.Sprintf("%d", info.Ready), 0)
+
+	// Nodes section; listing nodes needs cluster-wide access, so it is skipped if denied
+	nodes, err := c.GetNodes(ctx)
+	if err == nil {
+		pods, err := c.GetDaemonSetPods(ctx, ds)
+		if err != nil {
+			return nil, err
+		}
+		nodeSection := desc.AddSection("Nodes")
+		for _, node := range models.NewDaemonSetNodeStatuses(ds, nodes.Items, pods) {
+			value := node.Status
+			if node.Pod != "" {
+				value = fmt.Sprintf("%s (%s, %s ready)", node.Pod, node.Status, node.Ready)
+			}
+			if !node.Eligible {
+				value += ", misscheduled"
+			}
+			if len(node.OtherPods) > 0 {
+				value += ", also " + strings.Join(node.OtherPods, ", ")
+			}
+			nodeSection.AddField(node.Node, value, 0)
+		}
+	}
+
+	return desc, nil
+}
+
+// DescribeReplicaSet generates a kubectl-style describe output for a replicaset
+func (c *Client) DescribeReplicaSet(ctx context.Context, namespace, name string) (*models.DescribeData, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	rs, err := c.GetReplicaSet(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	desc := models.NewDescribeData("ReplicaSet", name, namespace)
+	info := models.NewReplicaSetInfo(rs)
+
+	// Metadata section
+	metadata := desc.AddSection("Metadata")
+	metadata.AddField("Name", rs.Name, 0)
+	metadata.AddField("Namespace", rs.Namespace, 0)
+	metadata.AddField("Labels", formatMap(rs.Labels), 0)
+	metadata.AddField("Annotations", formatMap(rs.Annotations), 0)
+	if rs.Spec.Selector != nil {
+		metadata.AddField("Selector", formatMap(rs.Spec.Selector.MatchLabels), 0)
+	}
+	if info.Owner != "" {
+		metadata.AddField("Controlled By", "Deployment/"+info.Owner, 0)
+	}
+
+	// Replicas section
+	replicas := desc.AddSection("Replicas")
+	replicas.AddField("Desired", fmt.Sprintf("%d", info.Desired), 0)
+	replicas.AddField("Current", fmt.Sprintf("%d", info.Current), 0)
+	replicas.AddField("Ready", fmt.Sprintf("%d", info.Ready), 0)
+	replicas.AddField("Available", fmt.Sprintf("%d", info.Available), 0)
+
+	// Containers section
+	containers := desc.AddSection("Containers")
+	for _, container := range rs.Spec.Template.Spec.Containers {
+		containers.AddField(container.Name, "", 0)
+		containers.AddField("Image", container.Image, 1)
+		containers.AddField("Ports", formatPorts(container.Ports), 1)
+	}
+
+	return desc, nil
+}
+
+// DescribeIngress generates a kubectl-style describe output for an ingress, flagging
+// backends whose service or port does not exist
+func (c *Client) DescribeIngress(ctx context.Context, namespace, name string) (*models.DescribeData, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	ingress, err := c.GetIngress(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	desc := models.NewDescribeData("Ingress", name, namespace)
+	info := models.NewIngressInfo(ingress)
+
+	problems := map[string]string{}
+	if services, err := c.GetServices(ctx, namespace); err == nil {
+		problems = models.IngressBackendProblems(&info, services.Items)
+	}
+	formatBackend := func(backend models.IngressBackend) string {
+		if problem, ok := problems[backend.String()]; ok {
+			return fmt.Sprintf("%s (%s)", backend, problem)
+		}
+		return backend.String()
+	}
+
+	// Metadata section
+	metadata := desc.AddSection("Metadata")
+	metadata.AddField("Name", ingress.Name, 0)
+	metadata.AddField("Namespace", ingress.Namespace, 0)
+	metadata.AddField("Labels", formatMap(ingress.Labels), 0)
+	metadata.AddField("Annotations", formatMap(ingress.Annotations), 0)
+	metadata.AddField("Ingress Class", info.Class, 0)
+	metadata.AddField("Address", info.Addresses, 0)
+
+	// Spec section
+	spec := desc.AddSection("Spec")
+	if info.DefaultBackend != nil {
+		spec.AddField("Default backend", formatBackend(*info.DefaultBackend), 0)
+	} else {
+		spec.AddField("Default backend", "<default>", 0)
+	}
+	if len(info.TLS) > 0 {
+		spec.AddField("TLS", "", 0)
+		for _, tls := range info.TLS {
+			spec.AddField(tls.SecretName, "terminates "+formatStringSlice(tls.Hosts), 1)
+		}
+	}
+
+	// Rules section
+	rules := desc.AddSection("Rules")
+	host := ""
+	for _, path := range info.Paths {
+		if path.Host != host {
+			host = path.Host
+			rules.AddField(host, "", 0)
+		}
+		rules.AddField(path.Path, formatBackend(path.Backend), 1)
+	}
+
+	return desc, nil
+}
+
+// DescribeNetworkPolicy generates a kubectl-style describe output for a networkpolicy
+func (c *Client) DescribeNetworkPolicy(ctx context.Context, namespace, name string) (*models.DescribeData, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	policy, err := c.GetNetworkPolicy(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	desc := models.NewDescribeData("NetworkPolicy", name, namespace)
+	info := models.NewNetworkPolicyInfo(policy)
+
+	// Metadata section
+	metadata := desc.AddSection("Metadata")
+	metadata.AddField("Name", policy.Name, 0)
+	metadata.AddField("Namespace", policy.Namespace, 0)
+	metadata.AddField("Labels", formatMap(policy.Labels), 0)
+	metadata.AddField("Annotations", formatMap(policy.Annotations), 0)
+
+	// Spec section
+	spec := desc.AddSection("Spec")
+	spec.AddField("Pod Selector", info.PodSelector, 0)
+	spec.AddField("Policy Types", info.PolicyTypesSummary(), 0)
+	restricts := func(policyType string) bool {
+		return slices.Contains(info.PolicyTypes, policyType)
+	}
+	addNetworkPolicyRules(spec, "Ingress", "From", restricts("Ingress"), info.IngressRules)
+	addNetworkPolicyRules(spec, "Egress", "To", restricts("Egress"), info.EgressRules)
+
 	return desc, nil
 }
 
+// addNetworkPolicyRules adds the ingress or egress rules of a networkpolicy to a section
+func addNetworkPolicyRules(section *models.DescribeSection, direction, peerLabel string, restricted bool, rules []models.NetworkPolicyRule) {
+	switch {
+	case !restricted:
+		section.AddField(direction, "<not affected>", 0)
+		return
+	case len(rules) == 0:
+		section.AddField(direction, "<none> (all "+strings.ToLower(direction)+" denied)", 0)
+		return
+	}
+	section.AddField(direction, "", 0)
+	for _, rule := range rules {
+		peers := "<any>"
+		if len(rule.Peers) > 0 {
+			peers = strings.Join(rule.Peers, "; ")
+		}
+		ports := "<any>"
+		if len(rule.Ports) > 0 {
+			ports = strings.Join(rule.Ports, ", ")
+		}
+		section.AddField(peerLabel, peers, 1)
+		section.AddField("Ports", ports, 1)
+	}
+}
+
+// DescribePodDisruptionBudget generates a kubectl-style describe output for a poddisruptionbudget
+func (c *Client) DescribePodDisruptionBudget(ctx context.Context, namespace, name string) (*models.DescribeData, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	budget, err := c.GetPodDisruptionBudget(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	desc := models.NewDescribeData("PodDisruptionBudget", name, namespace)
+	info := models.NewPodDisruptionBudgetInfo(budget)
+
+	// Metadata section
+	metadata := desc.AddSection("Metadata")
+	metadata.AddField("Name", budget.Name, 0)
+	metadata.AddField("Namespace", budget.Namespace, 0)
+	metadata.AddField("Labels", formatMap(budget.Labels), 0)
+	metadata.AddField("Annotations", formatMap(budget.Annotations), 0)
+
+	// Spec section
+	spec := desc.AddSection("Spec")
+	spec.AddField("Min Available", info.MinAvailable, 0)
+	spec.AddField("Max Unavailable", info.MaxUnavailable, 0)
+	spec.AddField("Selector", info.Selector, 0)
+	if budget.Spec.UnhealthyPodEvictionPolicy != nil {
+		spec.AddField("Unhealthy Pod Eviction Policy", string(*budget.Spec.UnhealthyPodEvictionPolicy), 0)
+	}
+
+	// Status section
+	status := desc.AddSection("Status")
+	status.AddField("Allowed Disruptions", fmt.Sprintf("%d", info.DisruptionsAllowed), 0)
+	status.AddField("Current Healthy", fmt.Sprintf("%d", info.CurrentHealthy), 0)
+	status.AddField("Desired Healthy", fmt.Sprintf("%d", info.DesiredHealthy), 0)
+	status.AddField("Expected Pods", fmt.Sprintf("%d", info.ExpectedPods), 0)
+	if len(budget.Status.DisruptedPods) > 0 {
+		status.AddField("Disrupted Pods", fmt.Sprintf("%d", len(budget.Status.DisruptedPods)), 0)
+	}
+
+	if len(budget.Status.Conditions) > 0 {
+		conditions := desc.AddSection("Conditions")
+		for _, condition := range budget.Status.Conditions {
+			conditions.AddField(condition.Type, string(condition.Status), 0)
+			if condition.Reason != "" {
+				conditions.AddField("Reason", condition.Reason, 1)
+			}
+			if condition.Message != "" {
+				conditions.AddField("Message", condition.Message, 1)
+			}
+		}
+	}
+
+	return desc, nil
+}
+
+// DescribeConfigMap generates a kubectl-style describe output for a configmap
+func (c *Client) DescribeConfigMap(ctx context.Context, namespace, name string) (*models.DescribeData, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	cm, err := c.GetConfigMap(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	desc := models.NewDescribeData("ConfigMap", name, namespace)
+
+	// Metadata section
+	metadata := desc.AddSection("Metadata")
+	metadata.AddField("Name", cm.Name, 0)
+	metadata.AddField("Namespace", cm.Namespace, 0)
+	metadata.AddField("Labels", formatMap(cm.Labels), 0)
+	metadata.AddField("Annotations", formatMap(cm.Annotations), 0)
+	if cm.Immutable != nil && *cm.Immutable {
+		metadata.AddField("Immutable", "true", 0)
+	}
+
+	// Data section lists keys with their sizes; values are shown in the key viewer
+	info := models.NewConfigMapInfo(cm)
+	data := desc.AddSection("Data")
+	if len(info.Keys) == 0 {
+		data.AddField("Keys", "<none>", 0)
+	}
+	for _, key := range info.Keys {
+		size := models.FormatBytes(key.Size)
+		if key.Binary {
+			size = "binary, " + size
+		}
+		data.AddField(key.Name, size, 0)
+	}
+
+	return desc, nil
+}
+
+// DescribeSecret generates a kubectl-style describe output for a secret.
+// Like kubectl, only key sizes are shown; certificates and registry logins are decoded.
+func (c *Client) DescribeSecret(ctx context.Context, namespace, name string) (*models.DescribeData, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	secret, err := c.GetSecret(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	desc := models.NewDescribeData("Secret", name, namespace)
+	info := models.NewSecretInfo(secret)
+
+	// Metadata section
+	metadata := desc.AddSection("Metadata")
+	metadata.AddField("Name", secret.Name, 0)
+	metadata.AddField("Namespace", secret.Namespace, 0)
+	metadata.AddField("Labels", formatMap(secret.Labels), 0)
+	metadata.AddField("Annotations", formatMap(redactAnnotations(secret.Annotations)), 0)
+	metadata.AddField("Type", string(secret.Type), 0)
+
+	// Data section
+	data := desc.AddSection("Data")
+	if len(info.Keys) == 0 {
+		data.AddField("Keys", "<none>", 0)
+	}
+	for _, key := range info.Keys {
+		data.AddField(key.Name, fmt.Sprintf("%d bytes", key.Size), 0)
+	}
+
+	if len(info.Certificates) > 0 {
+		certs := desc.AddSection("Certificates")
+		for _, cert := range info.Certificates {
+			certs.AddField("Subject", cert.Subject, 0)
+			certs.AddField("Issuer", cert.Issuer, 1)
+			certs.AddField("DNS Names", formatStringSlice(cert.DNSNames), 1)
+			certs.AddField("Not Before", cert.NotBefore.Format(time.RFC3339), 1)
+			certs.AddField("Not After", fmt.Sprintf("%s (%s)", cert.NotAfter.Format(time.RFC3339), cert.Expiry()), 1)
+		}
+	}
+
+	if len(info.Registries) > 0 {
+		registries := desc.AddSection("Registries")
+		for _, registry := range info.Registries {
+			username := registry.Username
+			if username == "" {
+				username = "<none>"
+			}
+			registries.AddField(registry.Host, username, 0)
+		}
+	}
+
+	if info.DecodeError != "" {
+		desc.AddSection("Decode Error").AddField("Error", info.DecodeError, 0)
+	}
+
+	return desc, nil
+}
+
+// DescribeNode generates a kubectl-style describe output for a node, including the
+// requests and limits allocated to the pods scheduled on it
+func (c *Client) DescribeNode(ctx context.Context, name string) (*models.DescribeData, error) {
+	node, err := c.GetNode(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := c.GetNodePods(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	desc := models.NewDescribeData("Node", name, "")
+	info := models.NewNodeInfo(node)
+
+	// Metadata section
+	metadata := desc.AddSection("Metadata")
+	metadata.AddField("Name", node.Name, 0)
+	metadata.AddField("Roles", info.Roles, 0)
+	metadata.AddField("Labels", formatMap(node.Labels), 0)
+	metadata.AddField("Annotations", formatMap(node.Annotations), 0)
+	metadata.AddField("Taints", formatStringSlice(info.Taints), 0)
+	metadata.AddField("Unschedulable", fmt.Sprintf("%v", node.Spec.Unschedulable), 0)
+
+	// Conditions section
+	if len(info.Conditions) > 0 {
+		conditions := desc.AddSection("Conditions")
+		for _, condition := range info.Conditions {
+			conditions.AddField(condition.Type, condition.Status, 0)
+			if condition.Reason != "" {
+				conditions.AddField("Reason", condition.Reason, 1)
+			}
+			if condition.Message != "" {
+				conditions.AddField("Message", condition.Message, 1)
+			}
+		}
+	}
+
+	// Addresses section
+	if len(node.Status.Addresses) > 0 {
+		addresses := desc.AddSection("Addresses")
+		for _, address := range node.Status.Addresses {
+			addresses.AddField(string(address.Type), address.Address, 0)
+		}
+	}
+
+	// Capacity and Allocatable sections
+	capacity := desc.AddSection("Capacity")
+	allocatable := desc.AddSection("Allocatable")
+	for _, resource := range info.Resources {
+		capacity.AddField(resource.Name, resource.Capacity, 0)
+		allocatable.AddField(resource.Name, resource.Allocatable, 0)
+	}
+
+	// System Info section
+	systemInfo := desc.AddSection("System Info")
+	systemInfo.AddField("OS Image", node.Status.NodeInfo.OSImage, 0)
+	systemInfo.AddField("Kernel Version", node.Status.NodeInfo.KernelVersion, 0)
+	systemInfo.AddField("Operating System", node.Status.NodeInfo.OperatingSystem, 0)
+	systemInfo.AddField("Architecture", node.Status.NodeInfo.Architecture, 0)
+	systemInfo.AddField("Container Runtime Version", node.Status.NodeInfo.ContainerRuntimeVersion, 0)
+	systemInfo.AddField("Kubelet Version", node.Status.NodeInfo.KubeletVersion, 0)
+
+	// Allocated resources section
+	allocation := models.NewNodeAllocation(node, pods)
+	allocated := desc.AddSection("Allocated Resources")
+	allocated.AddField("Non-terminated Pods", fmt.Sprintf("%d", allocation.PodCount), 0)
+	for _, resource := range allocation.Resources {
+		allocated.AddField(resource.Name, "", 0)
+		allocated.AddField("Requests", fmt.Sprintf("%s (%d%%)", resource.Requests, resource.RequestsPercent), 1)
+		allocated.AddField("Limits", fmt.Sprintf("%s (%d%%)", resource.Limits, resource.LimitsPercent), 1)
+	}
+
+	return desc, nil
+}
+
+// DescribeJob generates a kubectl-style describe output for a job
+func (c *Client) DescribeJob(ctx context.Context, namespace, name string) (*models.DescribeData, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	job, err := c.GetJob(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	desc := models.NewDescribeData("Job", name, namespace)
+	info := models.NewJobInfo(job)
+
+	// Metadata section
+	metadata := desc.AddSection("Metadata")
+	metadata.AddField("Name", job.Name, 0)
+	metadata.AddField("Namespace", job.Namespace, 0)
+	metadata.AddField("Labels", formatMap(job.Labels), 0)
+	metadata.AddField("Annotations", formatMap(job.Annotations), 0)
+	if info.Owner != "" {
+		metadata.AddField("Controlled By", "CronJob/"+info.Owner, 0)
+	}
+
+	// Spec section
+	spec := desc.AddSection("Spec")
+	spec.AddField("Parallelism", formatOptionalInt32(job.Spec.Parallelism), 0)
+	spec.AddField("Completions", formatOptionalInt32(job.Spec.Completions), 0)
+	if job.Spec.CompletionMode != nil {
+		spec.AddField("Completion Mode", string(*job.Spec.CompletionMode), 0)
+	}
+	spec.AddField("Backoff Limit", formatOptionalInt32(job.Spec.BackoffLimit), 0)
+	if job.Spec.ActiveDeadlineSeconds != nil {
+		spec.AddField("Active Deadline Seconds", fmt.Sprintf("%ds", *job.Spec.ActiveDeadlineSeconds), 0)
+	}
+	if job.Spec.Suspend != nil {
+		spec.AddField("Suspend", fmt.Sprintf("%v", *job.Spec.Suspend), 0)
+	}
+
+	// Status section
+	status := desc.AddSection("Status")
+	status.AddField("Status", info.Status, 0)
+	if job.Status.StartTime != nil {
+		status.AddField("Start Time", job.Status.StartTime.Format(time.RFC3339), 0)
+	}
+	if job.Status.CompletionTime != nil {
+		status.AddField("Completed At", job.Status.CompletionTime.Format(time.RFC3339), 0)
+	}
+	if info.Duration != "" {
+		status.AddField("Duration", info.Duration, 0)
+	}
+	status.AddField("Pods Statuses", fmt.Sprintf("%d Active / %d Succeeded / %d Failed", job.Status.Active, job.Status.Succeeded, job.Status.Failed), 0)
+
+	// Conditions section
+	if len(job.Status.Conditions) > 0 {
+		conditions := desc.AddSection("Conditions")
+		for _, condition := range job.Status.Conditions {
+			conditions.AddField(string(condition.Type), string(condition.Status), 0)
+			if condition.Reason != "" {
+				conditions.AddField("Reason", condition.Reason, 1)
+			}
+			if condition.Message != "" {
+				conditions.AddField("Message", condition.Message, 1)
+			}
+		}
+	}
+
+	return desc, nil
+}
+
+// DescribeCronJob generates a kubectl-style describe output for a cronjob,
+// including the jobs it has created
+func (c *Client) DescribeCronJob(ctx context.Context, namespace, name string) (*models.DescribeData, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	cronJob, err := c.GetCronJob(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs, err := c.GetJobs(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	desc := models.NewDescribeData("CronJob", name, namespace)
+	info := models.NewCronJobInfo(cronJob)
+
+	// Metadata section
+	metadata := desc.AddSection("Metadata")
+	metadata.AddField("Name", cronJob.Name, 0)
+	metadata.AddField("Namespace", cronJob.Namespace, 0)
+	metadata.AddField("Labels", formatMap(cronJob.Labels), 0)
+	metadata.AddField("Annotations", formatMap(cronJob.Annotations), 0)
+
+	// Spec section
+	spec := desc.AddSection("Spec")
+	spec.AddField("Schedule", cronJob.Spec.Schedule, 0)
+	if info.TimeZone != "" {
+		spec.AddField("Time Zone", info.TimeZone, 0)
+	}
+	spec.AddField("Concurrency Policy", string(cronJob.Spec.ConcurrencyPolicy), 0)
+	spec.AddField("Suspend", fmt.Sprintf("%v", info.Suspend), 0)
+	spec.AddField("Successful Job History Limit", formatOptionalInt32(cronJob.Spec.SuccessfulJobsHistoryLimit), 0)
+	spec.AddField("Failed Job History Limit", formatOptionalInt32(cronJob.Spec.FailedJobsHistoryLimit), 0)
+	if cronJob.Spec.StartingDeadlineSeconds != nil {
+		spec.AddField("Starting Deadline Seconds", fmt.Sprintf("%ds", *cronJob.Spec.StartingDeadlineSeconds), 0)
+	}
+
+	// Status section
+	status := desc.AddSection("Status")
+	status.AddField("Last Schedule Time", formatOptionalTime(cronJob.Status.LastScheduleTime), 0)
+	status.AddField("Last Successful Time", formatOptionalTime(cronJob.Status.LastSuccessfulTime), 0)
+	active := make([]string, 0, len(cronJob.Status.Active))
+	for _, ref := range cronJob.Status.Active {
+		active = append(active, ref.Name)
+	}
+	status.AddField("Active Jobs", formatStringSlice(active), 0)
+
+	// Jobs section
+	history := models.CronJobJobs(cronJob, jobs.Items)
+	jobSection := desc.AddSection("Jobs")
+	if len(history) == 0 {
+		jobSection.AddField("Jobs", "<none>", 0)
+	}
+	for _, job := range history {
+		jobSection.AddField(job.Name, fmt.Sprintf("%s, %s completed, %s old", job.Status, job.Completions, job.Age), 0)
+	}
+
+	return desc, nil
+}
+
+// DescribeHorizontalPodAutoscaler generates a kubectl-style describe output for a
+// horizontalpodautoscaler, including each metric's current and target value
+func (c *Client) DescribeHorizontalPodAutoscaler(ctx context.Context, namespace, name string) (*models.DescribeData, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	hpa, err := c.GetHorizontalPodAutoscaler(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	desc := models.NewDescribeData("HorizontalPodAutoscaler", name, namespace)
+	info := models.NewHorizontalPodAutoscalerInfo(hpa)
+
+	// Metadata section
+	metadata := desc.AddSection("Metadata")
+	metadata.AddField("Name", hpa.Name, 0)
+	metadata.AddField("Namespace", hpa.Namespace, 0)
+	metadata.AddField("Labels", formatMap(hpa.Labels), 0)
+	metadata.AddField("Annotations", formatMap(hpa.Annotations), 0)
+	metadata.AddField("Reference", info.Reference(), 0)
+
+	// Replicas section
+	replicas := desc.AddSection("Replicas")
+	replicas.AddField("Min", fmt.Sprintf("%d", info.MinReplicas), 0)
+	replicas.AddField("Max", fmt.Sprintf("%d", info.MaxReplicas), 0)
+	replicas.AddField("Current", fmt.Sprintf("%d", info.CurrentReplicas), 0)
+	replicas.AddField("Desired", fmt.Sprintf("%d", info.DesiredReplicas), 0)
+	replicas.AddField("Last Scale", formatOptionalTime(hpa.Status.LastScaleTime), 0)
+
+	// Metrics section
+	metrics := desc.AddSection("Metrics")
+	if len(info.Metrics) == 0 {
+		metrics.AddField("Metrics", "<none>", 0)
+	}
+	for _, metric := range info.Metrics {
+		metrics.AddField(metric.Name, fmt.Sprintf("%s / %s", metric.Current, metric.Target), 0)
+	}
+
+	// Conditions section
+	if len(info.Conditions) > 0 {
+		conditions := desc.AddSection("Conditions")
+		for _, condition := range info.Conditions {
+			conditions.AddField(condition.Type, condition.Status, 0)
+			if condition.Reason != "" {
+				conditions.AddField("Reason", condition.Reason, 1)
+			}
+			if condition.Message != "" {
+				conditions.AddField("Message", condition.Message, 1)
+			}
+		}
+	}
+
+	return desc, nil
+}
+
+// DescribePersistentVolumeClaim generates a kubectl-style describe output for a
+// persistentvolumeclaim, including the pods that mount it
+func (c *Client) DescribePersistentVolumeClaim(ctx context.Context, namespace, name string) (*models.DescribeData, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	claim, err := c.GetPersistentVolumeClaim(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := c.GetPods(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	desc := models.NewDescribeData("PersistentVolumeClaim", name, namespace)
+	info := models.NewPersistentVolumeClaimInfo(claim)
+
+	// Metadata section
+	metadata := desc.AddSection("Metadata")
+	metadata.AddField("Name", claim.Name, 0)
+	metadata.AddField("Namespace", claim.Namespace, 0)
+	metadata.AddField("Labels", formatMap(claim.Labels), 0)
+	metadata.AddField("Annotations", formatMap(claim.Annotations), 0)
+	metadata.AddField("Status", info.Status, 0)
+
+	// Spec section
+	spec := desc.AddSection("Spec")
+	spec.AddField("Storage Class", info.StorageClass, 0)
+	spec.AddField("Volume", info.Volume, 0)
+	spec.AddField("Requested", info.Requested, 0)
+	spec.AddField("Capacity", info.Capacity, 0)
+	spec.AddField("Access Modes", info.AccessModes, 0)
+	spec.AddField("Volume Mode", info.VolumeMode, 0)
+
+	// Conditions section
+	if len(claim.Status.Conditions) > 0 {
+		conditions := desc.AddSection("Conditions")
+		for _, condition := range claim.Status.Conditions {
+			conditions.AddField(string(condition.Type), string(condition.Status), 0)
+			if condition.Message != "" {
+				conditions.AddField("Message", condition.Message, 1)
+			}
+		}
+	}
+
+	// Used By section
+	usedBy := desc.AddSection("Used By")
+	claimPods := models.ClaimPods(claim, pods.Items)
+	if len(claimPods) == 0 {
+		usedBy.AddField("Pods", "<none>", 0)
+	}
+	for _, pod := range claimPods {
+		usedBy.AddField(pod.Name, pod.Status, 0)
+	}
+
+	return desc, nil
+}
+
+// DescribePersistentVolume generates a kubectl-style describe output for a persistentvolume
+func (c *Client) DescribePersistentVolume(ctx context.Context, name string) (*models.DescribeData, error) {
+	volume, err := c.GetPersistentVolume(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	desc := models.NewDescribeData("PersistentVolume", name, "")
+	info := models.NewPersistentVolumeInfo(volume)
+
+	// Metadata section
+	metadata := desc.AddSection("Metadata")
+	metadata.AddField("Name", volume.Name, 0)
+	metadata.AddField("Labels", formatMap(volume.Labels), 0)
+	metadata.AddField("Annotations", formatMap(volume.Annotations), 0)
+	metadata.AddField("Status", info.Status, 0)
+	if info.Reason != "" {
+		metadata.AddField("Reason", info.Reason, 0)
+	}
+	if volume.Status.Message != "" {
+		metadata.AddField("Message", volume.Status.Message, 0)
+	}
+
+	// Spec section
+	spec := desc.AddSection("Spec")
+	spec.AddField("Claim", info.Claim, 0)
+	spec.AddField("Storage Class", info.StorageClass, 0)
+	spec.AddField("Reclaim Policy", info.ReclaimPolicy, 0)
+	spec.AddField("Capacity", info.Capacity, 0)
+	spec.AddField("Access Modes", info.AccessModes, 0)
+	if volume.Spec.VolumeMode != nil {
+		spec.AddField("Volume Mode", string(*volume.Spec.VolumeMode), 0)
+	}
+	spec.AddField("Source", info.Source, 0)
+	if volume.Spec.CSI != nil {
+		spec.AddField("Volume Handle", volume.Spec.CSI.VolumeHandle, 1)
+	}
+
+	return desc, nil
+}
+
+// DescribeStorageClass generates a kubectl-style describe output for a storageclass
+func (c *Client) DescribeStorageClass(ctx context.Context, name string) (*models.DescribeData, error) {
+	storageClass, err := c.GetStorageClass(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	desc := models.NewDescribeData("StorageClass", name, "")
+	info := models.NewStorageClassInfo(storageClass)
+
+	// Metadata section
+	metadata := desc.AddSection("Metadata")
+	metadata.AddField("Name", storageClass.Name, 0)
+	metadata.AddField("Labels", formatMap(storageClass.Labels), 0)
+	metadata.AddField("Annotations", formatMap(storageClass.Annotations), 0)
+	metadata.AddField("Default", fmt.Sprintf("%v", info.IsDefault), 0)
+
+	// Spec section
+	spec := desc.AddSection("Spec")
+	spec.AddField("Provisioner", info.Provisioner, 0)
+	spec.AddField("Reclaim Policy", info.ReclaimPolicy, 0)
+	spec.AddField("Volume Binding Mode", info.VolumeBindingMode, 0)
+	spec.AddField("Allow Volume Expansion", fmt.Sprintf("%v", info.AllowVolumeExpansion), 0)
+	spec.AddField("Mount Options", formatStringSlice(storageClass.MountOptions), 0)
+
+	// Parameters section
+	if len(info.Parameters) > 0 {
+		parameters := desc.AddSection("Parameters")
+		for _, parameter := range info.Parameters {
+			key, value, _ := strings.Cut(parameter, "=")
+			parameters.AddField(key, value, 0)
+		}
+	}
+
+	return desc, nil
+}
+
+// DescribeServiceAccount generates a kubectl-style describe output for a
+// serviceaccount, including the bindings that grant it permissions
+func (c *Client) DescribeServiceAccount(ctx context.Context, namespace, name string) (*models.DescribeData, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	serviceAccount, err := c.GetServiceAccount(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := c.GetRBACPolicy(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	desc := models.NewDescribeData("ServiceAccount", name, namespace)
+	info := models.NewServiceAccountInfo(serviceAccount)
+
+	// Metadata section
+	metadata := desc.AddSection("Metadata")
+	metadata.AddField("Name", serviceAccount.Name, 0)
+	metadata.AddField("Namespace", serviceAccount.Namespace, 0)
+	metadata.AddField("Labels", formatMap(serviceAccount.Labels), 0)
+	metadata.AddField("Annotations", formatMap(serviceAccount.Annotations), 0)
+
+	// Tokens section
+	tokens := desc.AddSection("Tokens")
+	tokens.AddField("Automount Token", info.AutomountToken, 0)
+	tokens.AddField("Image Pull Secrets", formatStringSlice(info.ImagePullSecrets), 0)
+	mountable := make([]string, len(serviceAccount.Secrets))
+	for i, secret := range serviceAccount.Secrets {
+		mountable[i] = secret.Name
+	}
+	tokens.AddField("Mountable Secrets", formatStringSlice(mountable), 0)
+
+	// Bindings section
+	bindings := desc.AddSection("Bindings")
+	grants := policy.BindingsFor(info.Subject(), namespace)
+	if len(grants) == 0 {
+		bindings.AddField("Bindings", "<none>", 0)
+	}
+	for _, grant := range grants {
+		bindings.AddField(grant.Binding, grant.Role, 0)
+		if grant.Subject != info.Subject() {
+			bindings.AddField("Through", grant.Subject.String(), 1)
+		}
+	}
+
+	return desc, nil
+}
+
+// DescribeRole generates a kubectl-style describe output for a role
+func (c *Client) DescribeRole(ctx context.Context, namespace, name string) (*models.DescribeData, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	role, err := c.GetRole(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	desc := models.NewDescribeData("Role", name, namespace)
+
+	// Metadata section
+	metadata := desc.AddSection("Metadata")
+	metadata.AddField("Name", role.Name, 0)
+	metadata.AddField("Namespace", role.Namespace, 0)
+	metadata.AddField("Labels", formatMap(role.Labels), 0)
+	metadata.AddField("Annotations", formatMap(role.Annotations), 0)
+
+	addPolicyRulesSection(desc, role.Rules)
+
+	return desc, nil
+}
+
+// DescribeClusterRole generates a kubectl-style describe output for a clusterrole
+func (c *Client) DescribeClusterRole(ctx context.Context, name string) (*models.DescribeData, error) {
+	clusterRole, err := c.GetClusterRole(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	desc := models.NewDescribeData("ClusterRole", name, "")
+	info := models.NewClusterRoleInfo(clusterRole)
+
+	// Metadata section
+	metadata := desc.AddSection("Metadata")
+	metadata.AddField("Name", clusterRole.Name, 0)
+	metadata.AddField("Labels", formatMap(clusterRole.Labels), 0)
+	metadata.AddField("Annotations", formatMap(clusterRole.Annotations), 0)
+	if len(info.AggregateOf) > 0 {
+		metadata.AddField("Aggregates", formatStringSlice(info.AggregateOf), 0)
+	}
+
+	addPolicyRulesSection(desc, clusterRole.Rules)
+
+	return desc, nil
+}
+
+// DescribeRoleBinding generates a kubectl-style describe output for a rolebinding
+func (c *Client) DescribeRoleBinding(ctx context.Context, namespace, name string) (*models.DescribeData, error) {
+	namespace = c.resolveNamespace(namespace)
+
+	binding, err := c.GetRoleBinding(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	desc := models.NewDescribeData("RoleBinding", name, namespace)
+
+	// Metadata section
+	metadata := desc.AddSection("Metadata")
+	metadata.AddField("Name", binding.Name, 0)
+	metadata.AddField("Namespace", binding.Namespace, 0)
+	metadata.AddField("Labels", formatMap(binding.Labels), 0)
+	metadata.AddField("Annotations", formatMap(binding.Annotations), 0)
+	metadata.AddField("Role", binding.RoleRef.Kind+"/"+binding.RoleRef.Name, 0)
+
+	addSubjectsSection(desc, binding.Subjects)
+
+	return desc, nil
+}
+
+// DescribeClusterRoleBinding generates a kubectl-style describe output for a clusterrolebinding
+func (c *Client) DescribeClusterRoleBinding(ctx context.Context, name string) (*models.DescribeData, error) {
+	binding, err := c.GetClusterRoleBinding(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	desc := models.NewDescribeData("ClusterRoleBinding", name, "")
+
+	// Metadata section
+	metadata := desc.AddSection("Metadata")
+	metadata.AddField("Name", binding.Name, 0)
+	metadata.AddField("Labels", formatMap(binding.Labels), 0)
+	metadata.AddField("Annotations", formatMap(binding.Annotations), 0)
+	metadata.AddField("Role", binding.RoleRef.Kind+"/"+binding.RoleRef.Name, 0)
+
+	addSubjectsSection(desc, binding.Subjects)
+
+	return desc, nil
+}
+
+// addPolicyRulesSection adds the rules of a role, one field per rule like kubectl describe role
+func addPolicyRulesSection(desc *models.DescribeData, rules []rbacv1.PolicyRule) {
+	section := desc.AddSection("PolicyRule")
+	if len(rules) == 0 {
+		section.AddField("Rules", "<none>", 0)
+	}
+	for _, rule := range rules {
+		info := models.NewPolicyRuleInfo(rule)
+		if info.Resources != "" {
+			section.AddField("Resources", info.Resources, 0)
+		} else {
+			section.AddField("Non-Resource URLs", info.NonResourceURLs, 0)
+		}
+		if info.ResourceNames != "" {
+			section.AddField("Resource Names", info.ResourceNames, 1)
+		}
+		section.AddField("Verbs", info.Verbs, 1)
+	}
+}
+
+// addSubjectsSection adds the subjects of a binding
+func addSubjectsSection(desc *models.DescribeData, subjects []rbacv1.Subject) {
+	section := desc.AddSection("Subjects")
+	if len(subjects) == 0 {
+		section.AddField("Subjects", "<none>", 0)
+	}
+	for _, subject := range subjects {
+		section.AddField(subject.Kind, subject.Name, 0)
+		if subject.Namespace != "" {
+			section.AddField("Namespace", subject.Namespace, 1)
+		}
+	}
+}
+
+// getRedactedSecret retrieves a secret for YAML/JSON output with every value replaced
+// by its size, so that secret values are never shown without an explicit reveal
+func (c *Client) getRedactedSecret(ctx context.Context, namespace, name string) (map[string]interface{}, error) {
+	secret, err := c.GetSecret(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(secret.DeepCopy())
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert secret: %w", err)
+	}
+	redactSecretObject(obj)
+
+	return obj, nil
+}
+
+// redactSecretObject replaces every value of an unstructured secret by its size and
+// drops stringData and the last-applied-configuration annotation
+func redactSecretObject(obj map[string]interface{}) {
+	if data, ok := obj["data"].(map[string]interface{}); ok && len(data) > 0 {
+		redacted := make(map[string]interface{}, len(data))
+		for key, value := range data {
+			encoded, _ := value.(string)
+			size := len(encoded)
+			if decoded, err := base64.StdEncoding.DecodeString(encoded); err == nil {
+				size = len(decoded)
+			}
+			redacted[key] = fmt.Sprintf("REDACTED (%d bytes)", size)
+		}
+		obj["data"] = redacted
+	}
+	delete(obj, "stringData")
+
+	unstructured.RemoveNestedField(obj, "metadata", "annotations", corev1.LastAppliedConfigAnnotation)
+}
+
+// redactAnnotations drops the last-applied-configuration annotation, which can hold
+// a full copy of the secret data
+func redactAnnotations(annotations map[string]string) map[string]string {
+	if _, ok := annotations[corev1.LastAppliedConfigAnnotation]; !ok {
+		return annotations
+	}
+
+	result := make(map[string]string, len(annotations))
+	for k, v := range annotations {
+		if k != corev1.LastAppliedConfigAnnotation {
+			result[k] = v
+		}
+	}
+	return result
+}
+
 // Helper functions for formatting
 
 func formatMap(m map[string]string) string {
@@ -317,6 +1442,20 @@ func formatStringSlice(s []string) string {
 	return result
 }
 
+func formatOptionalInt32(value *int32) string {
+	if value == nil {
+		return "<unset>"
+	}
+	return fmt.Sprintf("%d", *value)
+}
+
+func formatOptionalTime(timestamp *metav1.Time) string {
+	if timestamp == nil {
+		return "<none>"
+	}
+	return timestamp.Format(time.RFC3339)
+}
+
 func formatPorts(ports []corev1.ContainerPort) string {
 	if len(ports) == 0 {
 		return "<none>"
@@ -0,0 +1,852 @@
+package k8s
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/williajm/k8s-tui/internal/models"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// newDescribeTestClient returns a client backed by a fake clientset holding objects
+func newDescribeTestClient(objects ...runtime.Object) *Client {
+	return &Client{
+		clientset: fake.NewSimpleClientset(objects...),
+		namespace: "default",
+	}
+}
+
+// findDescribeField returns the value of a field in a describe section
+func findDescribeField(desc *models.DescribeData, section, key string) (string, bool) {
+	for _, s := range desc.Sections {
+		if s.Title != section {
+			continue
+		}
+		for _, f := range s.Fields {
+			if f.Key == key {
+				return f.Value, true
+			}
+		}
+	}
+	return "", false
+}
+
+func TestDescribeConfigMap(t *testing.T) {
+	client := newDescribeTestClient(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-config", Namespace: "default"},
+		Data:       map[string]string{"LOG_LEVEL": "info"},
+		BinaryData: map[string][]byte{"logo.png": make([]byte, 2048)},
+	})
+
+	desc, err := client.DescribeConfigMap(context.Background(), "", "app-config")
+	if err != nil {
+		t.Fatalf("DescribeConfigMap failed: %v", err)
+	}
+
+	if desc.Kind != "ConfigMap" || desc.Name != "app-config" {
+		t.Errorf("Unexpected describe header %s/%s", desc.Kind, desc.Name)
+	}
+	if value, _ := findDescribeField(desc, "Data", "LOG_LEVEL"); value != "4 B" {
+		t.Errorf("Expected LOG_LEVEL size 4 B, got %q", value)
+	}
+	if value, _ := findDescribeField(desc, "Data", "logo.png"); value != "binary, 2.0 KiB" {
+		t.Errorf("Expected binary size label, got %q", value)
+	}
+}
+
+func TestDescribeConfigMapNotFound(t *testing.T) {
+	client := newDescribeTestClient()
+
+	if _, err := client.DescribeConfigMap(context.Background(), "default", "missing"); err == nil {
+		t.Error("Expected error for missing configmap")
+	}
+}
+
+func TestGetResourceYAMLConfigMap(t *testing.T) {
+	client := newDescribeTestClient(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-config", Namespace: "default"},
+		Data:       map[string]string{"LOG_LEVEL": "info"},
+	})
+
+	yamlOutput, err := client.GetResourceYAML(context.Background(), "ConfigMap", "default", "app-config")
+	if err != nil {
+		t.Fatalf("GetResourceYAML failed: %v", err)
+	}
+	if !strings.Contains(yamlOutput, "LOG_LEVEL: info") {
+		t.Errorf("Expected data in YAML output, got:\n%s", yamlOutput)
+	}
+
+	jsonOutput, err := client.GetResourceJSON(context.Background(), "ConfigMap", "default", "app-config")
+	if err != nil {
+		t.Fatalf("GetResourceJSON failed: %v", err)
+	}
+	if !strings.Contains(jsonOutput, `"LOG_LEVEL": "info"`) {
+		t.Errorf("Expected data in JSON output, got:\n%s", jsonOutput)
+	}
+}
+
+func TestDescribeSecret(t *testing.T) {
+	client := newDescribeTestClient(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "registry-login", Namespace: "default"},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{
+			corev1.DockerConfigJsonKey: []byte(`{"auths": {"ghcr.io": {"username": "bot", "password": "hunter2"}}}`),
+		},
+	})
+
+	desc, err := client.DescribeSecret(context.Background(), "", "registry-login")
+	if err != nil {
+		t.Fatalf("DescribeSecret failed: %v", err)
+	}
+
+	if value, _ := findDescribeField(desc, "Metadata", "Type"); value != string(corev1.SecretTypeDockerConfigJson) {
+		t.Errorf("Expected secret type, got %q", value)
+	}
+	if value, _ := findDescribeField(desc, "Data", corev1.DockerConfigJsonKey); !strings.HasSuffix(value, " bytes") {
+		t.Errorf("Expected key size, got %q", value)
+	}
+	if value, _ := findDescribeField(desc, "Registries", "ghcr.io"); value != "bot" {
+		t.Errorf("Expected registry username bot, got %q", value)
+	}
+	for _, section := range desc.Sections {
+		for _, field := range section.Fields {
+			if strings.Contains(field.Value, "hunter2") {
+				t.Errorf("Secret value leaked in %s/%s", section.Title, field.Key)
+			}
+		}
+	}
+}
+
+func TestGetResourceYAMLSecretRedacted(t *testing.T) {
+	client := newDescribeTestClient(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "db-credentials",
+			Namespace: "default",
+			Annotations: map[string]string{
+				corev1.LastAppliedConfigAnnotation: `{"data":{"password":"aHVudGVyMg=="}}`,
+				"team":                             "payments",
+			},
+		},
+		Data: map[string][]byte{"password": []byte("hunter2")},
+	})
+
+	for _, format := range []string{"yaml", "json"} {
+		var output string
+		var err error
+		if format == "yaml" {
+			output, err = client.GetResourceYAML(context.Background(), "Secret", "default", "db-credentials")
+		} else {
+			output, err = client.GetResourceJSON(context.Background(), "Secret", "default", "db-credentials")
+		}
+		if err != nil {
+			t.Fatalf("%s output failed: %v", format, err)
+		}
+		if strings.Contains(output, "aHVudGVyMg==") || strings.Contains(output, "hunter2") {
+			t.Errorf("Secret value leaked in %s output:\n%s", format, output)
+		}
+		if !strings.Contains(output, "REDACTED (7 bytes)") || !strings.Contains(output, "payments") {
+			t.Errorf("Expected redacted data and remaining annotations in %s output:\n%s", format, output)
+		}
+	}
+}
+
+func TestDescribeNode(t *testing.T) {
+	client := newDescribeTestClient(
+		&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "worker-1",
+				Labels: map[string]string{"node-role.kubernetes.io/worker": ""},
+			},
+			Spec: corev1.NodeSpec{Unschedulable: true},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue, Reason: "KubeletReady"}},
+				Capacity:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("2"),
+					corev1.ResourceMemory: resource.MustParse("4Gi"),
+				},
+				NodeInfo: corev1.NodeSystemInfo{KubeletVersion: "v1.31.2"},
+			},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "shop"},
+			Spec: corev1.PodSpec{
+				NodeName: "worker-1",
+				Containers: []corev1.Container{{
+					Name: "web",
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+						Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+					},
+				}},
+			},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"},
+			Spec: corev1.PodSpec{
+				NodeName:   "worker-2",
+				Containers: []corev1.Container{{Name: "other"}},
+			},
+		},
+	)
+
+	desc, err := client.DescribeNode(context.Background(), "worker-1")
+	if err != nil {
+		t.Fatalf("DescribeNode failed: %v", err)
+	}
+
+	tests := []struct {
+		section, key, want string
+	}{
+		{"Metadata", "Roles", "worker"},
+		{"Metadata", "Unschedulable", "true"},
+		{"Conditions", "Ready", "True"},
+		{"Capacity", "cpu", "2"},
+		{"System Info", "Kubelet Version", "v1.31.2"},
+		{"Allocated Resources", "Non-terminated Pods", "1"},
+		{"Allocated Resources", "Requests", "500m (25%)"},
+	}
+	for _, tt := range tests {
+		if value, _ := findDescribeField(desc, tt.section, tt.key); value != tt.want {
+			t.Errorf("%s/%s = %q, want %q", tt.section, tt.key, value, tt.want)
+		}
+	}
+
+	yamlOutput, err := client.GetResourceYAML(context.Background(), "Node", "", "worker-1")
+	if err != nil || !strings.Contains(yamlOutput, "unschedulable: true") {
+		t.Errorf("Expected node YAML, got %q (err %v)", yamlOutput, err)
+	}
+}
+
+func TestDescribeJob(t *testing.T) {
+	completions := int32(2)
+	client := newDescribeTestClient(&batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "nightly-report-1",
+			Namespace:       "default",
+			OwnerReferences: []metav1.OwnerReference{{Kind: "CronJob", Name: "nightly-report"}},
+		},
+		Spec: batchv1.JobSpec{Completions: &completions},
+		Status: batchv1.JobStatus{
+			Active:    1,
+			Succeeded: 1,
+			Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobSuspended, Status: corev1.ConditionFalse, Reason: "JobResumed"},
+			},
+		},
+	})
+
+	desc, err := client.DescribeJob(context.Background(), "default", "nightly-report-1")
+	if err != nil {
+		t.Fatalf("DescribeJob failed: %v", err)
+	}
+
+	tests := []struct {
+		section, key, want string
+	}{
+		{"Metadata", "Controlled By", "CronJob/nightly-report"},
+		{"Spec", "Completions", "2"},
+		{"Spec", "Backoff Limit", "<unset>"},
+		{"Status", "Status", "Running"},
+		{"Status", "Pods Statuses", "1 Active / 1 Succeeded / 0 Failed"},
+		{"Conditions", "Suspended", "False"},
+	}
+	for _, tt := range tests {
+		if value, _ := findDescribeField(desc, tt.section, tt.key); value != tt.want {
+			t.Errorf("%s/%s = %q, want %q", tt.section, tt.key, value, tt.want)
+		}
+	}
+
+	jsonOutput, err := client.GetResourceJSON(context.Background(), "Job", "default", "nightly-report-1")
+	if err != nil || !strings.Contains(jsonOutput, `"completions": 2`) {
+		t.Errorf("Expected job JSON, got %q (err %v)", jsonOutput, err)
+	}
+}
+
+func TestDescribeCronJob(t *testing.T) {
+	cronJob := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly-report", Namespace: "default", UID: "cron-uid"},
+		Spec: batchv1.CronJobSpec{
+			Schedule:          "0 2 * * *",
+			ConcurrencyPolicy: batchv1.ForbidConcurrent,
+		},
+		Status: batchv1.CronJobStatus{
+			Active: []corev1.ObjectReference{{Name: "nightly-report-2"}},
+		},
+	}
+	ownedBy := []metav1.OwnerReference{{Kind: "CronJob", Name: "nightly-report", UID: "cron-uid"}}
+	client := newDescribeTestClient(
+		cronJob,
+		&batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: "nightly-report-2", Namespace: "default", OwnerReferences: ownedBy},
+			Status:     batchv1.JobStatus{Active: 1},
+		},
+		&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "manual-run", Namespace: "default"}},
+	)
+
+	desc, err := client.DescribeCronJob(context.Background(), "default", "nightly-report")
+	if err != nil {
+		t.Fatalf("DescribeCronJob failed: %v", err)
+	}
+
+	tests := []struct {
+		section, key, want string
+	}{
+		{"Spec", "Schedule", "0 2 * * *"},
+		{"Spec", "Concurrency Policy", "Forbid"},
+		{"Spec", "Suspend", "false"},
+		{"Status", "Last Schedule Time", "<none>"},
+		{"Status", "Active Jobs", "nightly-report-2"},
+	}
+	for _, tt := range tests {
+		if value, _ := findDescribeField(desc, tt.section, tt.key); value != tt.want {
+			t.Errorf("%s/%s = %q, want %q", tt.section, tt.key, value, tt.want)
+		}
+	}
+
+	if value, ok := findDescribeField(desc, "Jobs", "nightly-report-2"); !ok || !strings.HasPrefix(value, "Running, 0/1 completed") {
+		t.Errorf("Expected nightly-report-2 in the job history, got %q", value)
+	}
+	if _, ok := findDescribeField(desc, "Jobs", "manual-run"); ok {
+		t.Error("Expected jobs not owned by the cronjob to be excluded")
+	}
+}
+
+func TestDescribeDaemonSet(t *testing.T) {
+	ds := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "fluent-bit", Namespace: "default", UID: "ds-uid"},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "fluent-bit"}},
+		},
+		Status: appsv1.DaemonSetStatus{DesiredNumberScheduled: 2, CurrentNumberScheduled: 1, NumberReady: 1},
+	}
+	client := newDescribeTestClient(
+		ds,
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker-1"}},
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker-2"}},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "fluent-bit-abcde",
+				Namespace:       "default",
+				Labels:          map[string]string{"app": "fluent-bit"},
+				OwnerReferences: []metav1.OwnerReference{{Kind: "DaemonSet", Name: "fluent-bit", UID: "ds-uid"}},
+			},
+			Spec:   corev1.PodSpec{NodeName: "worker-1", Containers: []corev1.Container{{Name: "fluent-bit"}}},
+			Status: corev1.PodStatus{Phase: corev1.PodPending},
+		},
+		&corev1.Pod{
+			// Same labels but not owned by the daemonset
+			ObjectMeta: metav1.ObjectMeta{Name: "debug", Namespace: "default", Labels: map[string]string{"app": "fluent-bit"}},
+			Spec:       corev1.PodSpec{NodeName: "worker-2"},
+		},
+	)
+
+	desc, err := client.DescribeDaemonSet(context.Background(), "default", "fluent-bit")
+	if err != nil {
+		t.Fatalf("DescribeDaemonSet failed: %v", err)
+	}
+
+	tests := []struct {
+		section, key, want string
+	}{
+		{"Metadata", "Node Selector", "<none>"},
+		{"Status", "Desired Number of Nodes Scheduled", "2"},
+		{"Status", "Current Number of Nodes Scheduled", "1"},
+		{"Nodes", "worker-1", "fluent-bit-abcde (Pending, 0/1 ready)"},
+		{"Nodes", "worker-2", "Missing"},
+	}
+	for _, tt := range tests {
+		if value, _ := findDescribeField(desc, tt.section, tt.key); value != tt.want {
+			t.Errorf("%s/%s = %q, want %q", tt.section, tt.key, value, tt.want)
+		}
+	}
+
+	yamlOutput, err := client.GetResourceYAML(context.Background(), "DaemonSet", "default", "fluent-bit")
+	if err != nil || !strings.Contains(yamlOutput, "desiredNumberScheduled: 2") {
+		t.Errorf("Expected daemonset YAML, got %q (err %v)", yamlOutput, err)
+	}
+}
+
+func TestDescribeReplicaSet(t *testing.T) {
+	replicas := int32(3)
+	client := newDescribeTestClient(&appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "web-7d4b9c8f5",
+			Namespace:       "default",
+			OwnerReferences: []metav1.OwnerReference{{Kind: "Deployment", Name: "web"}},
+		},
+		Spec: appsv1.ReplicaSetSpec{
+			Replicas: &replicas,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "web", Image: "shop/web:1.4.2"}}},
+			},
+		},
+		Status: appsv1.ReplicaSetStatus{Replicas: 3, ReadyReplicas: 2},
+	})
+
+	desc, err := client.DescribeReplicaSet(context.Background(), "default", "web-7d4b9c8f5")
+	if err != nil {
+		t.Fatalf("DescribeReplicaSet failed: %v", err)
+	}
+
+	tests := []struct {
+		section, key, want string
+	}{
+		{"Metadata", "Controlled By", "Deployment/web"},
+		{"Replicas", "Desired", "3"},
+		{"Replicas", "Ready", "2"},
+		{"Containers", "Image", "shop/web:1.4.2"},
+	}
+	for _, tt := range tests {
+		if value, _ := findDescribeField(desc, tt.section, tt.key); value != tt.want {
+			t.Errorf("%s/%s = %q, want %q", tt.section, tt.key, value, tt.want)
+		}
+	}
+
+	jsonOutput, err := client.GetResourceJSON(context.Background(), "ReplicaSet", "default", "web-7d4b9c8f5")
+	if err != nil || !strings.Contains(jsonOutput, `"readyReplicas": 2`) {
+		t.Errorf("Expected replicaset JSON, got %q (err %v)", jsonOutput, err)
+	}
+}
+
+func TestDescribeServiceEndpoints(t *testing.T) {
+	ready, notReady := true, false
+	client := newDescribeTestClient(
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Spec:       corev1.ServiceSpec{Selector: map[string]string{"app": "web"}},
+		},
+		&discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-abc", Namespace: "default", Labels: map[string]string{discoveryv1.LabelServiceName: "web"}},
+			Endpoints: []discoveryv1.Endpoint{
+				{Addresses: []string{"10.0.0.1"}, Conditions: discoveryv1.EndpointConditions{Ready: &ready}, TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "web-1"}},
+				{Addresses: []string{"10.0.0.2"}, Conditions: discoveryv1.EndpointConditions{Ready: &notReady}, TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "web-2"}},
+			},
+		},
+		&discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{Name: "api-abc", Namespace: "default", Labels: map[string]string{discoveryv1.LabelServiceName: "api"}},
+			Endpoints:  []discoveryv1.Endpoint{{Addresses: []string{"10.0.0.9"}}},
+		},
+	)
+
+	desc, err := client.DescribeService(context.Background(), "default", "web")
+	if err != nil {
+		t.Fatalf("DescribeService failed: %v", err)
+	}
+	if value, _ := findDescribeField(desc, "Endpoints", "Summary"); value != "1 ready, 0 terminating, 1 not ready" {
+		t.Errorf("Unexpected endpoint summary %q", value)
+	}
+	if value, _ := findDescribeField(desc, "Endpoints", "10.0.0.2"); !strings.Contains(value, "web-2") || !strings.Contains(value, "NotReady") {
+		t.Errorf("Expected 10.0.0.2 to be web-2 and not ready, got %q", value)
+	}
+	if _, found := findDescribeField(desc, "Endpoints", "10.0.0.9"); found {
+		t.Error("Expected endpoints of other services to be excluded")
+	}
+}
+
+func TestDescribeIngress(t *testing.T) {
+	className := "nginx"
+	backend := func(service string, port int32) networkingv1.IngressBackend {
+		return networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{
+			Name: service,
+			Port: networkingv1.ServiceBackendPort{Number: port},
+		}}
+	}
+	client := newDescribeTestClient(
+		&networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{Name: "shop", Namespace: "default"},
+			Spec: networkingv1.IngressSpec{
+				IngressClassName: &className,
+				TLS:              []networkingv1.IngressTLS{{Hosts: []string{"shop.example.com"}, SecretName: "shop-tls"}},
+				Rules: []networkingv1.IngressRule{{
+					Host: "shop.example.com",
+					IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{
+							{Path: "/api", Backend: backend("api", 8080)},
+							{Path: "/static", Backend: backend("assets", 80)},
+						},
+					}},
+				}},
+			},
+		},
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "default"},
+			Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: 8080}}},
+		},
+	)
+
+	desc, err := client.DescribeIngress(context.Background(), "default", "shop")
+	if err != nil {
+		t.Fatalf("DescribeIngress failed: %v", err)
+	}
+
+	tests := []struct {
+		section, key, want string
+	}{
+		{"Metadata", "Ingress Class", "nginx"},
+		{"Metadata", "Address", "<none>"},
+		{"Spec", "Default backend", "<default>"},
+		{"Spec", "shop-tls", "terminates shop.example.com"},
+		{"Rules", "/api", "api:8080"},
+		{"Rules", "/static", `assets:80 (service "assets" not found)`},
+	}
+	for _, tt := range tests {
+		if value, _ := findDescribeField(desc, tt.section, tt.key); value != tt.want {
+			t.Errorf("%s/%s = %q, want %q", tt.section, tt.key, value, tt.want)
+		}
+	}
+
+	yamlOutput, err := client.GetResourceYAML(context.Background(), "Ingress", "default", "shop")
+	if err != nil || !strings.Contains(yamlOutput, "ingressClassName: nginx") {
+		t.Errorf("Expected ingress YAML, got %q (err %v)", yamlOutput, err)
+	}
+}
+
+func TestDescribePersistentVolumeClaim(t *testing.T) {
+	className := "gp3"
+	client := newDescribeTestClient(
+		&corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "data", Namespace: "default"},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+				StorageClassName: &className,
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("5Gi")},
+				},
+			},
+			Status: corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimPending},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"},
+			Spec: corev1.PodSpec{Volumes: []corev1.Volume{{
+				Name: "data",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "data"},
+				},
+			}}},
+			Status: corev1.PodStatus{Phase: corev1.PodPending},
+		},
+	)
+
+	desc, err := client.DescribePersistentVolumeClaim(context.Background(), "default", "data")
+	if err != nil {
+		t.Fatalf("DescribePersistentVolumeClaim failed: %v", err)
+	}
+
+	tests := []struct {
+		section, key, want string
+	}{
+		{"Metadata", "Status", "Pending"},
+		{"Spec", "Storage Class", "gp3"},
+		{"Spec", "Requested", "5Gi"},
+		{"Spec", "Access Modes", "RWO"},
+		{"Used By", "db", "Pending"},
+	}
+	for _, tt := range tests {
+		if value, _ := findDescribeField(desc, tt.section, tt.key); value != tt.want {
+			t.Errorf("%s/%s = %q, want %q", tt.section, tt.key, value, tt.want)
+		}
+	}
+
+	yamlOutput, err := client.GetResourceYAML(context.Background(), "PersistentVolumeClaim", "default", "data")
+	if err != nil || !strings.Contains(yamlOutput, "storageClassName: gp3") {
+		t.Errorf("Expected claim YAML, got %q (err %v)", yamlOutput, err)
+	}
+}
+
+func TestDescribePersistentVolume(t *testing.T) {
+	client := newDescribeTestClient(&corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "pv-data"},
+		Spec: corev1.PersistentVolumeSpec{
+			Capacity:                      corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("5Gi")},
+			AccessModes:                   []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimRetain,
+			ClaimRef:                      &corev1.ObjectReference{Namespace: "default", Name: "data"},
+			PersistentVolumeSource: corev1.PersistentVolumeSource{
+				CSI: &corev1.CSIPersistentVolumeSource{Driver: "ebs.csi.aws.com", VolumeHandle: "vol-0abc"},
+			},
+		},
+		Status: corev1.PersistentVolumeStatus{Phase: corev1.VolumeBound},
+	})
+
+	desc, err := client.DescribePersistentVolume(context.Background(), "pv-data")
+	if err != nil {
+		t.Fatalf("DescribePersistentVolume failed: %v", err)
+	}
+
+	tests := []struct {
+		section, key, want string
+	}{
+		{"Metadata", "Status", "Bound"},
+		{"Spec", "Claim", "default/data"},
+		{"Spec", "Reclaim Policy", "Retain"},
+		{"Spec", "Source", "CSI (ebs.csi.aws.com)"},
+		{"Spec", "Volume Handle", "vol-0abc"},
+	}
+	for _, tt := range tests {
+		if value, _ := findDescribeField(desc, tt.section, tt.key); value != tt.want {
+			t.Errorf("%s/%s = %q, want %q", tt.section, tt.key, value, tt.want)
+		}
+	}
+}
+
+func TestDescribeStorageClass(t *testing.T) {
+	client := newDescribeTestClient(&storagev1.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "gp3",
+			Annotations: map[string]string{"storageclass.kubernetes.io/is-default-class": "true"},
+		},
+		Provisioner: "ebs.csi.aws.com",
+		Parameters:  map[string]string{"type": "gp3"},
+	})
+
+	desc, err := client.DescribeStorageClass(context.Background(), "gp3")
+	if err != nil {
+		t.Fatalf("DescribeStorageClass failed: %v", err)
+	}
+
+	tests := []struct {
+		section, key, want string
+	}{
+		{"Metadata", "Default", "true"},
+		{"Spec", "Provisioner", "ebs.csi.aws.com"},
+		{"Spec", "Reclaim Policy", "Delete"},
+		{"Parameters", "type", "gp3"},
+	}
+	for _, tt := range tests {
+		if value, _ := findDescribeField(desc, tt.section, tt.key); value != tt.want {
+			t.Errorf("%s/%s = %q, want %q", tt.section, tt.key, value, tt.want)
+		}
+	}
+}
+
+func TestDescribeStatefulSetVolumeClaimTemplates(t *testing.T) {
+	client := newDescribeTestClient(&appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "postgres", Namespace: "default"},
+		Spec: appsv1.StatefulSetSpec{
+			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{{
+				ObjectMeta: metav1.ObjectMeta{Name: "data"},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+					Resources: corev1.VolumeResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+					},
+				},
+			}},
+		},
+	})
+
+	desc, err := client.DescribeStatefulSet(context.Background(), "default", "postgres")
+	if err != nil {
+		t.Fatalf("DescribeStatefulSet failed: %v", err)
+	}
+	if value, _ := findDescribeField(desc, "Volume Claim Templates", "Storage"); value != "10Gi" {
+		t.Errorf("Volume Claim Templates/Storage = %q, want 10Gi", value)
+	}
+	if value, _ := findDescribeField(desc, "Volume Claim Templates", "Storage Class"); value != "<default>" {
+		t.Errorf("Volume Claim Templates/Storage Class = %q, want <default>", value)
+	}
+}
+
+func TestDescribeHorizontalPodAutoscaler(t *testing.T) {
+	target := int32(80)
+	current := int32(95)
+	client := newDescribeTestClient(&autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{Kind: "Deployment", Name: "web"},
+			MaxReplicas:    4,
+			Metrics: []autoscalingv2.MetricSpec{{
+				Type: autoscalingv2.ResourceMetricSourceType,
+				Resource: &autoscalingv2.ResourceMetricSource{
+					Name:   corev1.ResourceCPU,
+					Target: autoscalingv2.MetricTarget{Type: autoscalingv2.UtilizationMetricType, AverageUtilization: &target},
+				},
+			}},
+		},
+		Status: autoscalingv2.HorizontalPodAutoscalerStatus{
+			CurrentReplicas: 4,
+			DesiredReplicas: 4,
+			CurrentMetrics: []autoscalingv2.MetricStatus{{
+				Type: autoscalingv2.ResourceMetricSourceType,
+				Resource: &autoscalingv2.ResourceMetricStatus{
+					Name:    corev1.ResourceCPU,
+					Current: autoscalingv2.MetricValueStatus{AverageUtilization: &current},
+				},
+			}},
+			Conditions: []autoscalingv2.HorizontalPodAutoscalerCondition{{
+				Type:    autoscalingv2.ScalingLimited,
+				Status:  corev1.ConditionTrue,
+				Reason:  "TooManyReplicas",
+				Message: "the desired replica count is more than the maximum replica count",
+			}},
+		},
+	})
+
+	desc, err := client.DescribeHorizontalPodAutoscaler(context.Background(), "default", "web")
+	if err != nil {
+		t.Fatalf("DescribeHorizontalPodAutoscaler failed: %v", err)
+	}
+
+	tests := []struct {
+		section, key, want string
+	}{
+		{"Metadata", "Reference", "Deployment/web"},
+		{"Replicas", "Min", "1"},
+		{"Replicas", "Max", "4"},
+		{"Metrics", "cpu", "95% / 80%"},
+		{"Conditions", "ScalingLimited", "True"},
+		{"Conditions", "Reason", "TooManyReplicas"},
+	}
+	for _, tt := range tests {
+		if value, _ := findDescribeField(desc, tt.section, tt.key); value != tt.want {
+			t.Errorf("%s/%s = %q, want %q", tt.section, tt.key, value, tt.want)
+		}
+	}
+
+	yamlOutput, err := client.GetResourceYAML(context.Background(), "HorizontalPodAutoscaler", "default", "web")
+	if err != nil || !strings.Contains(yamlOutput, "maxReplicas: 4") {
+		t.Errorf("Expected autoscaler YAML, got %q (err %v)", yamlOutput, err)
+	}
+}
+
+func TestDescribeRBAC(t *testing.T) {
+	client := newDescribeTestClient(
+		&corev1.ServiceAccount{
+			ObjectMeta:       metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			ImagePullSecrets: []corev1.LocalObjectReference{{Name: "registry"}},
+		},
+		&rbacv1.Role{
+			ObjectMeta: metav1.ObjectMeta{Name: "pod-reader", Namespace: "default"},
+			Rules: []rbacv1.PolicyRule{
+				{APIGroups: []string{"", "apps"}, Resources: []string{"pods", "deployments"}, Verbs: []string{"get", "list"}},
+				{APIGroups: []string{""}, Resources: []string{"configmaps"}, ResourceNames: []string{"settings"}, Verbs: []string{"get"}},
+			},
+		},
+		&rbacv1.ClusterRole{
+			ObjectMeta: metav1.ObjectMeta{Name: "metrics"},
+			Rules:      []rbacv1.PolicyRule{{NonResourceURLs: []string{"/metrics"}, Verbs: []string{"get"}}},
+		},
+		&rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-read", Namespace: "default"},
+			RoleRef:    rbacv1.RoleRef{Kind: "Role", Name: "pod-reader"},
+			Subjects:   []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: "web", Namespace: "default"}},
+		},
+		&rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "scrapers"},
+			RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: "metrics"},
+			Subjects:   []rbacv1.Subject{{Kind: rbacv1.GroupKind, Name: "system:serviceaccounts"}},
+		},
+	)
+	ctx := context.Background()
+
+	serviceAccount, err := client.DescribeServiceAccount(ctx, "default", "web")
+	if err != nil {
+		t.Fatalf("DescribeServiceAccount failed: %v", err)
+	}
+	role, err := client.DescribeRole(ctx, "default", "pod-reader")
+	if err != nil {
+		t.Fatalf("DescribeRole failed: %v", err)
+	}
+	clusterRole, err := client.DescribeClusterRole(ctx, "metrics")
+	if err != nil {
+		t.Fatalf("DescribeClusterRole failed: %v", err)
+	}
+	binding, err := client.DescribeRoleBinding(ctx, "default", "web-read")
+	if err != nil {
+		t.Fatalf("DescribeRoleBinding failed: %v", err)
+	}
+	clusterBinding, err := client.DescribeClusterRoleBinding(ctx, "scrapers")
+	if err != nil {
+		t.Fatalf("DescribeClusterRoleBinding failed: %v", err)
+	}
+
+	tests := []struct {
+		desc               *models.DescribeData
+		section, key, want string
+	}{
+		{serviceAccount, "Tokens", "Image Pull Secrets", "registry"},
+		{serviceAccount, "Tokens", "Automount Token", "<default>"},
+		{serviceAccount, "Bindings", "RoleBinding default/web-read", "Role/pod-reader"},
+		{serviceAccount, "Bindings", "ClusterRoleBinding scrapers", "ClusterRole/metrics"},
+		{serviceAccount, "Bindings", "Through", "Group system:serviceaccounts"},
+		{role, "PolicyRule", "Resources", "pods, deployments, pods.apps, deployments.apps"},
+		{role, "PolicyRule", "Resource Names", "settings"},
+		{clusterRole, "PolicyRule", "Non-Resource URLs", "/metrics"},
+		{binding, "Metadata", "Role", "Role/pod-reader"},
+		{binding, "Subjects", "ServiceAccount", "web"},
+		{clusterBinding, "Subjects", "Group", "system:serviceaccounts"},
+	}
+	for _, tt := range tests {
+		if value, _ := findDescribeField(tt.desc, tt.section, tt.key); value != tt.want {
+			t.Errorf("%s %s/%s = %q, want %q", tt.desc.Kind, tt.section, tt.key, value, tt.want)
+		}
+	}
+
+	yamlOutput, err := client.GetResourceYAML(ctx, "ClusterRoleBinding", "", "scrapers")
+	if err != nil || !strings.Contains(yamlOutput, "name: metrics") {
+		t.Errorf("Expected clusterrolebinding YAML, got %q (err %v)", yamlOutput, err)
+	}
+}
+
+func TestDescribePodDisruptionBudget(t *testing.T) {
+	minAvailable := intstr.FromInt32(2)
+	client := newDescribeTestClient(&policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MinAvailable: &minAvailable,
+			Selector:     &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+		},
+		Status: policyv1.PodDisruptionBudgetStatus{
+			CurrentHealthy: 2,
+			DesiredHealthy: 2,
+			ExpectedPods:   2,
+			Conditions: []metav1.Condition{{
+				Type:   policyv1.DisruptionAllowedCondition,
+				Status: metav1.ConditionFalse,
+				Reason: policyv1.InsufficientPodsReason,
+			}},
+		},
+	})
+
+	desc, err := client.DescribePodDisruptionBudget(context.Background(), "default", "web")
+	if err != nil {
+		t.Fatalf("DescribePodDisruptionBudget failed: %v", err)
+	}
+
+	tests := []struct {
+		section, key, want string
+	}{
+		{"Spec", "Min Available", "2"},
+		{"Spec", "Max Unavailable", "N/A"},
+		{"Spec", "Selector", "app=web"},
+		{"Status", "Allowed Disruptions", "0"},
+		{"Status", "Current Healthy", "2"},
+		{"Conditions", "DisruptionAllowed", "False"},
+		{"Conditions", "Reason", "InsufficientPods"},
+	}
+	for _, tt := range tests {
+		if value, _ := findDescribeField(desc, tt.section, tt.key); value != tt.want {
+			t.Errorf("%s/%s = %q, want %q", tt.section, tt.key, value, tt.want)
+		}
+	}
+
+	yamlOutput, err := client.GetResourceYAML(context.Background(), "PodDisruptionBudget", "default", "web")
+	if err != nil || !strings.Contains(yamlOutput, "minAvailable: 2") {
+		t.Errorf("Expected poddisruptionbudget YAML, got %q (err %v)", yamlOutput, err)
+	}
+}
@@ -0,0 +1,368 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/williajm/k8s-tui/internal/models"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/yaml"
+)
+
+// APIResource describes a resource type advertised by the API server
+type APIResource struct {
+	Group      string
+	Version    string
+	Resource   string // Plural resource name, e.g. "certificates"
+	Kind       string
+	Namespaced bool
+	Verbs      []string
+	ShortNames []string
+}
+
+// GroupVersionResource returns the resource's GVR for use with the dynamic client
+func (r APIResource) GroupVersionResource() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: r.Group, Version: r.Version, Resource: r.Resource}
+}
+
+// GroupVersion returns the apiVersion string, e.g. "v1" or "cert-manager.io/v1"
+func (r APIResource) GroupVersion() string {
+	return schema.GroupVersion{Group: r.Group, Version: r.Version}.String()
+}
+
+// DisplayName returns the resource name qualified by its group, like kubectl api-resources
+func (r APIResource) DisplayName() string {
+	if r.Group == "" {
+		return r.Resource
+	}
+	return r.Resource + "." + r.Group
+}
+
+// HasVerb returns true if the resource supports the given verb
+func (r APIResource) HasVerb(verb string) bool {
+	for _, v := range r.Verbs {
+		if v == verb {
+			return true
+		}
+	}
+	return false
+}
+
+// DiscoverResources returns every listable resource the API server advertises,
+// using the preferred version of each group. Groups that fail discovery
+// (e.g. an unavailable aggregated API) are skipped.
+func (c *Client) DiscoverResources(_ context.Context) ([]APIResource, error) {
+	lists, err := discovery.ServerPreferredResources(c.clientset.Discovery())
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, fmt.Errorf("failed to discover resources: %w", err)
+	}
+
+	var resources []APIResource
+	for _, list := range lists {
+		gv, parseErr := schema.ParseGroupVersion(list.GroupVersion)
+		if parseErr != nil {
+			continue
+		}
+
+		for _, r := range list.APIResources {
+			// Skip subresources such as pods/log
+			if strings.Contains(r.Name, "/") {
+				continue
+			}
+
+			resource := APIResource{
+				Group:      gv.Group,
+				Version:    gv.Version,
+				Resource:   r.Name,
+				Kind:       r.Kind,
+				Namespaced: r.Namespaced,
+				Verbs:      r.Verbs,
+				ShortNames: r.ShortNames,
+			}
+			if !resource.HasVerb("list") {
+				continue
+			}
+			resources = append(resources, resource)
+		}
+	}
+
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].DisplayName() < resources[j].DisplayName()
+	})
+
+	return resources, nil
+}
+
+// IsSecret reports whether a resource is core/v1 secrets, whose values are
+// redacted when read through the dynamic client
+func (r APIResource) IsSecret() bool {
+	return r.Group == "" && r.Resource == "secrets"
+}
+
+// redactDynamic redacts the values of an object read through the dynamic client
+// if it is a secret. Other objects are left untouched.
+func redactDynamic(resource APIResource, obj *unstructured.Unstructured) {
+	if resource.IsSecret() {
+		redactSecretObject(obj.Object)
+	}
+}
+
+// dynamicResource returns the dynamic client interface for a resource,
+// scoped to the namespace for namespaced resources
+func (c *Client) dynamicResource(resource APIResource, namespace string) (dynamic.ResourceInterface, error) {
+	if c.dynamicClient == nil {
+		return nil, fmt.Errorf("dynamic client not available")
+	}
+
+	client := c.dynamicClient.Resource(resource.GroupVersionResource())
+	if resource.Namespaced {
+		return client.Namespace(c.resolveNamespace(namespace)), nil
+	}
+	return client, nil
+}
+
+// ListDynamic lists objects of any discovered resource type
+func (c *Client) ListDynamic(ctx context.Context, resource APIResource, namespace string) (*unstructured.UnstructuredList, error) {
+	client, err := c.dynamicResource(resource, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := client.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", resource.DisplayName(), err)
+	}
+	for i := range list.Items {
+		redactDynamic(resource, &list.Items[i])
+	}
+
+	return list, nil
+}
+
+// GetDynamic retrieves a single object of any discovered resource type
+func (c *Client) GetDynamic(ctx context.Context, resource APIResource, namespace, name string) (*unstructured.Unstructured, error) {
+	client, err := c.dynamicResource(resource, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := client.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s %s: %w", resource.Kind, name, err)
+	}
+	redactDynamic(resource, obj)
+
+	return obj, nil
+}
+
+// WatchDynamic creates a watch for any discovered resource type.
+// If resourceVersion is empty, the watch starts from the current state.
+func (c *Client) WatchDynamic(ctx context.Context, resource APIResource, namespace string, resourceVersion string) (watch.Interface, error) {
+	client, err := c.dynamicResource(resource, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := metav1.ListOptions{
+		ResourceVersion: resourceVersion,
+		TimeoutSeconds:  int64ptr(int64(DefaultWatchTimeout.Seconds())),
+		Watch:           true,
+	}
+
+	watcher, err := client.Watch(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch %s: %w", resource.DisplayName(), err)
+	}
+	if resource.IsSecret() {
+		return watch.Filter(watcher, func(event watch.Event) (watch.Event, bool) {
+			if obj, ok := event.Object.(*unstructured.Unstructured); ok {
+				redactDynamic(resource, obj)
+			}
+			return event, true
+		}), nil
+	}
+
+	return watcher, nil
+}
+
+// GetDynamicYAML retrieves an object of any discovered resource type as YAML
+func (c *Client) GetDynamicYAML(ctx context.Context, resource APIResource, namespace, name string) (string, error) {
+	obj, err := c.GetDynamic(ctx, resource, namespace, name)
+	if err != nil {
+		return "", err
+	}
+
+	yamlBytes, err := yaml.Marshal(obj.Object)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal to YAML: %w", err)
+	}
+
+	return string(yamlBytes), nil
+}
+
+// GetDynamicJSON retrieves an object of any discovered resource type as JSON
+func (c *Client) GetDynamicJSON(ctx context.Context, resource APIResource, namespace, name string) (string, error) {
+	obj, err := c.GetDynamic(ctx, resource, namespace, name)
+	if err != nil {
+		return "", err
+	}
+
+	jsonBytes, err := json.MarshalIndent(obj.Object, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal to JSON: %w", err)
+	}
+
+	return string(jsonBytes), nil
+}
+
+// DescribeDynamic generates a describe output for any discovered resource type.
+// Metadata and conditions get their own sections; spec and status are flattened.
+func (c *Client) DescribeDynamic(ctx context.Context, resource APIResource, namespace, name string) (*models.DescribeData, error) {
+	obj, err := c.GetDynamic(ctx, resource, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	desc := models.NewDescribeData(resource.Kind, obj.GetName(), obj.GetNamespace())
+
+	// Metadata section
+	metadata := desc.AddSection("Metadata")
+	metadata.AddField("Name", obj.GetName(), 0)
+	if resource.Namespaced {
+		metadata.AddField("Namespace", obj.GetNamespace(), 0)
+	}
+	metadata.AddField("API Version", obj.GetAPIVersion(), 0)
+	metadata.AddField("Kind", obj.GetKind(), 0)
+	metadata.AddField("Labels", formatMap(obj.GetLabels()), 0)
+	metadata.AddField("Annotations", formatMap(obj.GetAnnotations()), 0)
+	metadata.AddField("Created", obj.GetCreationTimestamp().String(), 0)
+	for _, owner := range obj.GetOwnerReferences() {
+		metadata.AddField("Controlled By", fmt.Sprintf("%s/%s", owner.Kind, owner.Name), 0)
+	}
+
+	// Everything other than metadata and status, e.g. spec or data
+	var topLevel []string
+	for key := range obj.Object {
+		switch key {
+		case "apiVersion", "kind", "metadata", "status":
+		default:
+			topLevel = append(topLevel, key)
+		}
+	}
+	sort.Strings(topLevel)
+	for _, key := range topLevel {
+		section := desc.AddSection(sectionTitle(key))
+		addUnstructuredFields(section, "", obj.Object[key], 0)
+	}
+
+	status, hasStatus := obj.Object["status"].(map[string]interface{})
+	if hasStatus {
+		conditions, _, _ := unstructured.NestedSlice(status, "conditions")
+		if len(conditions) > 0 {
+			conditionSection := desc.AddSection("Conditions")
+			for _, item := range conditions {
+				condition, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				conditionType, _, _ := unstructured.NestedString(condition, "type")
+				conditionStatus, _, _ := unstructured.NestedString(condition, "status")
+				conditionSection.AddField(conditionType, conditionStatus, 0)
+				if reason, _, _ := unstructured.NestedString(condition, "reason"); reason != "" {
+					conditionSection.AddField("Reason", reason, 1)
+				}
+				if message, _, _ := unstructured.NestedString(condition, "message"); message != "" {
+					conditionSection.AddField("Message", message, 1)
+				}
+			}
+		}
+
+		rest := make(map[string]interface{}, len(status))
+		for key, value := range status {
+			if key != "conditions" {
+				rest[key] = value
+			}
+		}
+		if len(rest) > 0 {
+			statusSection := desc.AddSection("Status")
+			addUnstructuredFields(statusSection, "", rest, 0)
+		}
+	}
+
+	return desc, nil
+}
+
+// sectionTitle capitalizes a top-level field name for use as a section title
+func sectionTitle(key string) string {
+	if key == "" {
+		return key
+	}
+	return strings.ToUpper(key[:1]) + key[1:]
+}
+
+// addUnstructuredFields flattens an arbitrary JSON value into indented describe fields
+func addUnstructuredFields(section *models.DescribeSection, key string, value interface{}, indent int) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if len(v) == 0 {
+			if key != "" {
+				section.AddField(key, "<none>", indent)
+			}
+			return
+		}
+		childIndent := indent
+		if key != "" {
+			section.AddField(key, "", indent)
+			childIndent = indent + 1
+		}
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			addUnstructuredFields(section, k, v[k], childIndent)
+		}
+
+	case []interface{}:
+		if len(v) == 0 {
+			section.AddField(key, "<none>", indent)
+			return
+		}
+		if scalars, ok := joinScalars(v); ok {
+			section.AddField(key, scalars, indent)
+			return
+		}
+		section.AddField(key, "", indent)
+		for i, item := range v {
+			addUnstructuredFields(section, fmt.Sprintf("[%d]", i), item, indent+1)
+		}
+
+	case nil:
+		section.AddField(key, "<none>", indent)
+
+	default:
+		section.AddField(key, fmt.Sprintf("%v", v), indent)
+	}
+}
+
+// joinScalars joins a list of scalar values, returning false if any item is an object or list
+func joinScalars(items []interface{}) (string, bool) {
+	parts := make([]string, 0, len(items))
+	for _, item := range items {
+		switch item.(type) {
+		case map[string]interface{}, []interface{}:
+			return "", false
+		}
+		parts = append(parts, fmt.Sprintf("%v", item))
+	}
+	return strings.Join(parts, ", "), true
+}
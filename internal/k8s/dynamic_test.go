@@ -0,0 +1,335 @@
+package k8s
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/watch"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+// newTestDynamicClient returns a snapshot client holding a pod and a custom resource
+func newTestDynamicClient(t *testing.T) *Client {
+	t.Helper()
+
+	dir := writeSnapshotDir(t, map[string]string{"manifests.yaml": snapshotManifests})
+	client, err := NewSnapshotClient(dir, "shop")
+	if err != nil {
+		t.Fatalf("NewSnapshotClient failed: %v", err)
+	}
+	return client
+}
+
+// findAPIResource looks up a discovered resource by display name
+func findAPIResource(t *testing.T, resources []APIResource, name string) APIResource {
+	t.Helper()
+
+	for _, r := range resources {
+		if r.DisplayName() == name {
+			return r
+		}
+	}
+	t.Fatalf("resource %s not discovered in %v", name, resources)
+	return APIResource{}
+}
+
+func TestAPIResourceNames(t *testing.T) {
+	core := APIResource{Version: "v1", Resource: "pods", Verbs: []string{"get", "list"}}
+	if core.DisplayName() != "pods" || core.GroupVersion() != "v1" {
+		t.Errorf("Unexpected core names %s %s", core.DisplayName(), core.GroupVersion())
+	}
+	if !core.HasVerb("list") || core.HasVerb("watch") {
+		t.Error("HasVerb returned unexpected result")
+	}
+
+	crd := APIResource{Group: "cert-manager.io", Version: "v1", Resource: "certificates"}
+	if crd.DisplayName() != "certificates.cert-manager.io" {
+		t.Errorf("Unexpected display name %s", crd.DisplayName())
+	}
+	if crd.GroupVersion() != "cert-manager.io/v1" {
+		t.Errorf("Unexpected group version %s", crd.GroupVersion())
+	}
+}
+
+func TestDiscoverResources(t *testing.T) {
+	client := newTestDynamicClient(t)
+
+	resources, err := client.DiscoverResources(context.Background())
+	if err != nil {
+		t.Fatalf("DiscoverResources failed: %v", err)
+	}
+
+	for i := 1; i < len(resources); i++ {
+		if resources[i-1].DisplayName() > resources[i].DisplayName() {
+			t.Errorf("Resources not sorted: %s before %s", resources[i-1].DisplayName(), resources[i].DisplayName())
+		}
+	}
+
+	widgets := findAPIResource(t, resources, "widgets.example.com")
+	if widgets.Kind != "Widget" || !widgets.Namespaced {
+		t.Errorf("Unexpected widget resource %+v", widgets)
+	}
+	namespaces := findAPIResource(t, resources, "namespaces")
+	if namespaces.Namespaced {
+		t.Error("Expected namespaces to be cluster-scoped")
+	}
+}
+
+func TestListDynamic(t *testing.T) {
+	client := newTestDynamicClient(t)
+	ctx := context.Background()
+
+	resources, err := client.DiscoverResources(ctx)
+	if err != nil {
+		t.Fatalf("DiscoverResources failed: %v", err)
+	}
+
+	widgets, err := client.ListDynamic(ctx, findAPIResource(t, resources, "widgets.example.com"), "shop")
+	if err != nil {
+		t.Fatalf("ListDynamic failed: %v", err)
+	}
+	if len(widgets.Items) != 1 || widgets.Items[0].GetName() != "gizmo" {
+		t.Errorf("Expected widget gizmo, got %v", widgets.Items)
+	}
+
+	// Typed objects are served through the dynamic client too
+	pods, err := client.ListDynamic(ctx, findAPIResource(t, resources, "pods"), "shop")
+	if err != nil {
+		t.Fatalf("ListDynamic failed: %v", err)
+	}
+	if len(pods.Items) != 1 || pods.Items[0].GetName() != "web-0" {
+		t.Errorf("Expected pod web-0, got %v", pods.Items)
+	}
+
+	// Cluster-scoped resources ignore the namespace
+	namespaces, err := client.ListDynamic(ctx, findAPIResource(t, resources, "namespaces"), "other")
+	if err != nil {
+		t.Fatalf("ListDynamic failed: %v", err)
+	}
+	if len(namespaces.Items) != 1 {
+		t.Errorf("Expected 1 namespace, got %d", len(namespaces.Items))
+	}
+}
+
+func TestDescribeDynamic(t *testing.T) {
+	client := newTestDynamicClient(t)
+	ctx := context.Background()
+
+	widgets := APIResource{Group: "example.com", Version: "v1", Resource: "widgets", Kind: "Widget", Namespaced: true}
+
+	data, err := client.DescribeDynamic(ctx, widgets, "shop", "gizmo")
+	if err != nil {
+		t.Fatalf("DescribeDynamic failed: %v", err)
+	}
+
+	var titles []string
+	for _, section := range data.Sections {
+		titles = append(titles, section.Title)
+	}
+	if strings.Join(titles, ",") != "Metadata,Spec,Conditions" {
+		t.Errorf("Unexpected sections %v", titles)
+	}
+	if data.Sections[1].Fields[0].Key != "size" || data.Sections[1].Fields[0].Value != "3" {
+		t.Errorf("Unexpected spec fields %+v", data.Sections[1].Fields)
+	}
+	if data.Sections[2].Fields[0].Key != "Ready" || data.Sections[2].Fields[0].Value != "True" {
+		t.Errorf("Unexpected condition fields %+v", data.Sections[2].Fields)
+	}
+
+	yamlOutput, err := client.GetDynamicYAML(ctx, widgets, "shop", "gizmo")
+	if err != nil {
+		t.Fatalf("GetDynamicYAML failed: %v", err)
+	}
+	if !strings.Contains(yamlOutput, "kind: Widget") {
+		t.Errorf("Expected YAML to contain kind, got %s", yamlOutput)
+	}
+
+	if _, err := client.GetDynamicJSON(ctx, widgets, "shop", "missing"); err == nil {
+		t.Error("Expected error for missing object")
+	}
+}
+
+func TestDynamicClientUnavailable(t *testing.T) {
+	client := &Client{namespace: "default"}
+	if _, err := client.ListDynamic(context.Background(), APIResource{Version: "v1", Resource: "pods"}, ""); err == nil {
+		t.Error("Expected error without a dynamic client")
+	}
+}
+
+func TestWatchManagerWatchDynamic(t *testing.T) {
+	client := newTestDynamicClient(t)
+	wm := NewWatchManager(client)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := wm.Start(ctx, []ResourceType{ResourceTypePod}); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	defer wm.Stop()
+
+	widgets := APIResource{Group: "example.com", Version: "v1", Resource: "widgets", Kind: "Widget", Namespaced: true}
+	if err := wm.WatchDynamic(widgets); err != nil {
+		t.Fatalf("WatchDynamic failed: %v", err)
+	}
+
+	deadline := time.After(2 * time.Second)
+	for {
+		select {
+		case event := <-wm.GetEventChannel():
+			if event.ResourceType != ResourceTypeGeneric {
+				continue
+			}
+			obj, ok := event.Object.(*unstructured.Unstructured)
+			if !ok {
+				t.Fatalf("Expected *unstructured.Unstructured, got %T", event.Object)
+			}
+			if event.EventType != watch.Added || obj.GetName() != "gizmo" {
+				t.Errorf("Unexpected event %s %s", event.EventType, obj.GetName())
+			}
+			return
+		case <-deadline:
+			t.Fatal("Timeout waiting for widget event")
+		}
+	}
+}
+
+func TestDynamicSecretsRedacted(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "db",
+			Namespace:   "shop",
+			Annotations: map[string]string{corev1.LastAppliedConfigAnnotation: `{"data":{"password":"aHVudGVyMg=="}}`},
+		},
+		Data:       map[string][]byte{"password": []byte("hunter2")},
+		StringData: map[string]string{"token": "s3cr3t"},
+	}
+	client := &Client{
+		dynamicClient: dynamicfake.NewSimpleDynamicClient(scheme.Scheme, secret),
+		namespace:     "shop",
+	}
+	resource := APIResource{Version: "v1", Resource: "secrets", Kind: "Secret", Namespaced: true}
+	ctx := t.Context()
+
+	assertRedacted := func(what, output string) {
+		t.Helper()
+		for _, value := range []string{"hunter2", "aHVudGVyMg==", "s3cr3t", corev1.LastAppliedConfigAnnotation} {
+			if strings.Contains(output, value) {
+				t.Errorf("%s leaks %q:\n%s", what, value, output)
+			}
+		}
+		if !strings.Contains(output, "REDACTED (7 bytes)") {
+			t.Errorf("%s is missing the redacted value:\n%s", what, output)
+		}
+	}
+
+	list, err := client.ListDynamic(ctx, resource, "shop")
+	if err != nil {
+		t.Fatalf("ListDynamic failed: %v", err)
+	}
+	if len(list.Items) != 1 {
+		t.Fatalf("Expected 1 secret, got %d", len(list.Items))
+	}
+	listed, err := list.Items[0].MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON failed: %v", err)
+	}
+	assertRedacted("ListDynamic", string(listed))
+
+	yamlOutput, err := client.GetDynamicYAML(ctx, resource, "shop", "db")
+	if err != nil {
+		t.Fatalf("GetDynamicYAML failed: %v", err)
+	}
+	assertRedacted("GetDynamicYAML", yamlOutput)
+
+	jsonOutput, err := client.GetDynamicJSON(ctx, resource, "shop", "db")
+	if err != nil {
+		t.Fatalf("GetDynamicJSON failed: %v", err)
+	}
+	assertRedacted("GetDynamicJSON", jsonOutput)
+
+	desc, err := client.DescribeDynamic(ctx, resource, "shop", "db")
+	if err != nil {
+		t.Fatalf("DescribeDynamic failed: %v", err)
+	}
+	var described strings.Builder
+	for _, section := range desc.Sections {
+		for _, field := range section.Fields {
+			described.WriteString(field.Key + ": " + field.Value + "\n")
+		}
+	}
+	assertRedacted("DescribeDynamic", described.String())
+
+	// Secrets created while watching are redacted too
+	watcher, err := client.WatchDynamic(ctx, resource, "shop", "")
+	if err != nil {
+		t.Fatalf("WatchDynamic failed: %v", err)
+	}
+	defer watcher.Stop()
+
+	created := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   map[string]interface{}{"name": "api", "namespace": "shop"},
+		"data":       map[string]interface{}{"password": "aHVudGVyMg=="},
+	}}
+	dynamicSecrets := client.dynamicClient.Resource(resource.GroupVersionResource()).Namespace("shop")
+	if _, err := dynamicSecrets.Create(ctx, created, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	select {
+	case event := <-watcher.ResultChan():
+		obj, ok := event.Object.(*unstructured.Unstructured)
+		if !ok {
+			t.Fatalf("Expected an unstructured object, got %T", event.Object)
+		}
+		watched, err := obj.MarshalJSON()
+		if err != nil {
+			t.Fatalf("MarshalJSON failed: %v", err)
+		}
+		assertRedacted("WatchDynamic", string(watched))
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timeout waiting for the watched secret")
+	}
+}
+
+func TestWatchManagerClearDynamic(t *testing.T) {
+	client := newTestDynamicClient(t)
+	wm := NewWatchManager(client)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := wm.Start(ctx, []ResourceType{ResourceTypePod}); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	defer wm.Stop()
+
+	widgets := APIResource{Group: "example.com", Version: "v1", Resource: "widgets", Kind: "Widget", Namespaced: true}
+	if err := wm.WatchDynamic(widgets); err != nil {
+		t.Fatalf("WatchDynamic failed: %v", err)
+	}
+	if wm.GetWatcherCount() != 2 {
+		t.Fatalf("Expected pod and generic watchers, got %d", wm.GetWatcherCount())
+	}
+
+	wm.ClearDynamic()
+	if wm.GetWatcherCount() != 1 {
+		t.Errorf("Expected only the pod watcher after ClearDynamic, got %d", wm.GetWatcherCount())
+	}
+
+	// Switching clients no longer restarts the generic watcher
+	if err := wm.SwitchClient(newTestDynamicClient(t)); err != nil {
+		t.Fatalf("SwitchClient failed: %v", err)
+	}
+	if wm.GetWatcherCount() != 1 {
+		t.Errorf("Expected only the pod watcher after SwitchClient, got %d", wm.GetWatcherCount())
+	}
+}
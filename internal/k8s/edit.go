@@ -0,0 +1,138 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// builtinResources maps the kinds of the built-in tabs to their API resources, so
+// that they can be edited through the dynamic client like any discovered resource.
+// Secrets are left out: their values are redacted everywhere else.
+var builtinResources = map[string]APIResource{
+	"Pod":                     {Version: "v1", Resource: "pods", Kind: "Pod", Namespaced: true},
+	"Service":                 {Version: "v1", Resource: "services", Kind: "Service", Namespaced: true},
+	"Deployment":              {Group: "apps", Version: "v1", Resource: "deployments", Kind: "Deployment", Namespaced: true},
+	"StatefulSet":             {Group: "apps", Version: "v1", Resource: "statefulsets", Kind: "StatefulSet", Namespaced: true},
+	"ConfigMap":               {Version: "v1", Resource: "configmaps", Kind: "ConfigMap", Namespaced: true},
+	"Node":                    {Version: "v1", Resource: "nodes", Kind: "Node"},
+	"Job":                     {Group: "batch", Version: "v1", Resource: "jobs", Kind: "Job", Namespaced: true},
+	"CronJob":                 {Group: "batch", Version: "v1", Resource: "cronjobs", Kind: "CronJob", Namespaced: true},
+	"DaemonSet":               {Group: "apps", Version: "v1", Resource: "daemonsets", Kind: "DaemonSet", Namespaced: true},
+	"ReplicaSet":              {Group: "apps", Version: "v1", Resource: "replicasets", Kind: "ReplicaSet", Namespaced: true},
+	"Ingress":                 {Group: "networking.k8s.io", Version: "v1", Resource: "ingresses", Kind: "Ingress", Namespaced: true},
+	"PersistentVolumeClaim":   {Version: "v1", Resource: "persistentvolumeclaims", Kind: "PersistentVolumeClaim", Namespaced: true},
+	"PersistentVolume":        {Version: "v1", Resource: "persistentvolumes", Kind: "PersistentVolume"},
+	"StorageClass":            {Group: "storage.k8s.io", Version: "v1", Resource: "storageclasses", Kind: "StorageClass"},
+	"HorizontalPodAutoscaler": {Group: "autoscaling", Version: "v2", Resource: "horizontalpodautoscalers", Kind: "HorizontalPodAutoscaler", Namespaced: true},
+	"ServiceAccount":          {Version: "v1", Resource: "serviceaccounts", Kind: "ServiceAccount", Namespaced: true},
+	"Role":                    {Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "roles", Kind: "Role", Namespaced: true},
+	"ClusterRole":             {Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterroles", Kind: "ClusterRole"},
+	"RoleBinding":             {Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "rolebindings", Kind: "RoleBinding", Namespaced: true},
+	"ClusterRoleBinding":      {Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterrolebindings", Kind: "ClusterRoleBinding"},
+	"NetworkPolicy":           {Group: "networking.k8s.io", Version: "v1", Resource: "networkpolicies", Kind: "NetworkPolicy", Namespaced: true},
+	"PodDisruptionBudget":     {Group: "policy", Version: "v1", Resource: "poddisruptionbudgets", Kind: "PodDisruptionBudget", Namespaced: true},
+}
+
+// EditableResource returns the API resource used to edit objects of a built-in kind
+func EditableResource(kind string) (APIResource, bool) {
+	resource, ok := builtinResources[kind]
+	return resource, ok
+}
+
+// IsEditable reports whether objects of a discovered resource can be edited: the
+// resource must support update, and, as for the built-in tabs, secrets and events
+// are left out
+func (r APIResource) IsEditable() bool {
+	if r.IsSecret() || (r.Resource == "events" && (r.Group == "" || r.Group == "events.k8s.io")) {
+		return false
+	}
+	return r.HasVerb("update")
+}
+
+// IsConflict reports whether an update failed because the object changed since it was read
+func IsConflict(err error) bool {
+	return apierrors.IsConflict(err)
+}
+
+// GetEditableYAML retrieves an object as YAML for editing, without its managed fields
+// and status. The resourceVersion is kept so that an update of a stale edit conflicts.
+func (c *Client) GetEditableYAML(ctx context.Context, resource APIResource, namespace, name string) (string, error) {
+	obj, err := c.GetDynamic(ctx, resource, namespace, name)
+	if err != nil {
+		return "", err
+	}
+	return EditableYAML(obj)
+}
+
+// EditableYAML renders an object as YAML without its managed fields and status
+func EditableYAML(obj *unstructured.Unstructured) (string, error) {
+	editable := obj.DeepCopy()
+	unstructured.RemoveNestedField(editable.Object, "metadata", "managedFields")
+	unstructured.RemoveNestedField(editable.Object, "status")
+
+	yamlBytes, err := yaml.Marshal(editable.Object)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal to YAML: %w", err)
+	}
+
+	return string(yamlBytes), nil
+}
+
+// ParseEditedYAML parses an edited object, checking that it is still the object
+// being edited
+func ParseEditedYAML(resource APIResource, name, edited string) (*unstructured.Unstructured, error) {
+	obj := &unstructured.Unstructured{}
+	if err := yaml.Unmarshal([]byte(edited), &obj.Object); err != nil {
+		return nil, fmt.Errorf("failed to parse edited YAML: %w", err)
+	}
+	if obj.Object == nil {
+		return nil, fmt.Errorf("edited YAML is empty")
+	}
+	if obj.GetKind() != resource.Kind || obj.GetName() != name {
+		return nil, fmt.Errorf("edited YAML must still be %s %s, got %s %s", resource.Kind, name, obj.GetKind(), obj.GetName())
+	}
+	return obj, nil
+}
+
+// UpdateEdited replaces an object with its edited version. With dryRun set the API
+// server validates the update and runs admission without persisting it.
+// The edited YAML has no status, so the live status is kept: resources without a
+// status subresource would otherwise lose theirs.
+func (c *Client) UpdateEdited(ctx context.Context, resource APIResource, namespace string,
+	obj *unstructured.Unstructured, dryRun bool,
+) error {
+	if c.IsReadOnly() {
+		return fmt.Errorf("failed to update %s %s: %w", resource.Kind, obj.GetName(), c.readOnlyError())
+	}
+
+	client, err := c.dynamicResource(resource, namespace)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := obj.Object["status"]; !ok {
+		live, err := client.Get(ctx, obj.GetName(), metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get %s %s: %w", resource.Kind, obj.GetName(), err)
+		}
+		if status, ok := live.Object["status"]; ok {
+			obj = obj.DeepCopy()
+			obj.Object["status"] = status
+		}
+	}
+
+	opts := metav1.UpdateOptions{}
+	if dryRun {
+		opts.DryRun = []string{metav1.DryRunAll}
+	}
+	if _, err := client.Update(ctx, obj, opts); err != nil {
+		return fmt.Errorf("failed to update %s %s: %w", resource.Kind, obj.GetName(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestGetEditableYAML(t *testing.T) {
+	deployment := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "web",
+			Namespace:       "default",
+			ResourceVersion: "7",
+			ManagedFields:   []metav1.ManagedFieldsEntry{{Manager: "kubectl"}},
+		},
+		Status: appsv1.DeploymentStatus{ReadyReplicas: 2},
+	}
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(scheme.Scheme, deployment)
+	client := &Client{clientset: fake.NewSimpleClientset(), dynamicClient: dynamicClient, namespace: "default"}
+	resource, ok := EditableResource("Deployment")
+	if !ok {
+		t.Fatal("Expected deployments to be editable")
+	}
+
+	yaml, err := client.GetEditableYAML(context.Background(), resource, "default", "web")
+	if err != nil {
+		t.Fatalf("GetEditableYAML failed: %v", err)
+	}
+	if strings.Contains(yaml, "managedFields") || strings.Contains(yaml, "status:") || strings.Contains(yaml, "readyReplicas") {
+		t.Errorf("Expected managed fields and status to be stripped, got:\n%s", yaml)
+	}
+	if !strings.Contains(yaml, "resourceVersion:") || !strings.Contains(yaml, "name: web") {
+		t.Errorf("Expected the metadata to be kept, got:\n%s", yaml)
+	}
+
+	if _, ok := EditableResource("Secret"); ok {
+		t.Error("Expected secrets not to be editable")
+	}
+}
+
+func TestParseEditedYAML(t *testing.T) {
+	resource, _ := EditableResource("ConfigMap")
+
+	obj, err := ParseEditedYAML(resource, "settings", "# edited\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: settings\ndata:\n  mode: fast\n")
+	if err != nil {
+		t.Fatalf("ParseEditedYAML failed: %v", err)
+	}
+	if obj.GetName() != "settings" || obj.Object["data"].(map[string]interface{})["mode"] != "fast" {
+		t.Errorf("Unexpected object %v", obj.Object)
+	}
+
+	for _, edited := range []string{"", "kind: ConfigMap\nmetadata:\n  name: other\n", "kind: Secret\nmetadata:\n  name: settings\n", "data: [unclosed"} {
+		if _, err := ParseEditedYAML(resource, "settings", edited); err == nil {
+			t.Errorf("Expected an error for %q", edited)
+		}
+	}
+}
+
+func TestUpdateEdited(t *testing.T) {
+	deployment := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", ResourceVersion: "7"},
+		Status:     appsv1.DeploymentStatus{ReadyReplicas: 2},
+	}
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(scheme.Scheme, deployment)
+	// The fake client ignores dry-run, so answer dry-run updates without updating
+	var dryRuns int
+	dynamicClient.PrependReactor("update", "deployments", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		update := action.(k8stesting.UpdateActionImpl)
+		if len(update.GetUpdateOptions().DryRun) == 0 {
+			return false, nil, nil
+		}
+		dryRuns++
+		return true, update.GetObject(), nil
+	})
+	client := &Client{clientset: fake.NewSimpleClientset(), dynamicClient: dynamicClient, namespace: "default"}
+	resource, _ := EditableResource("Deployment")
+	ctx := context.Background()
+
+	edited := "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: web\n  namespace: default\n  resourceVersion: \"7\"\n  labels:\n    tier: web\n"
+	obj, err := ParseEditedYAML(resource, "web", edited)
+	if err != nil {
+		t.Fatalf("ParseEditedYAML failed: %v", err)
+	}
+
+	if err := client.UpdateEdited(ctx, resource, "default", obj, true); err != nil || dryRuns != 1 {
+		t.Fatalf("Expected a dry-run update, got %v (%d dry runs)", err, dryRuns)
+	}
+	if err := client.UpdateEdited(ctx, resource, "default", obj, false); err != nil {
+		t.Fatalf("UpdateEdited failed: %v", err)
+	}
+	updated, err := dynamicClient.Resource(resource.GroupVersionResource()).Namespace("default").Get(ctx, "web", metav1.GetOptions{})
+	if err != nil || updated.GetLabels()["tier"] != "web" {
+		t.Fatalf("Expected the label to be applied, got %v (%v)", updated, err)
+	}
+	// Like a custom resource without a status subresource, the fake replaces the whole object
+	if ready, _, _ := unstructured.NestedInt64(updated.Object, "status", "readyReplicas"); ready != 2 {
+		t.Errorf("Expected the live status to be kept, got %v", updated.Object["status"])
+	}
+	if _, ok := obj.Object["status"]; ok {
+		t.Error("Expected the edited object not to be modified")
+	}
+
+	// The fake client does not check resource versions, so answer like the API server
+	// once the object has changed since the edit was read
+	dynamicClient.PrependReactor("update", "deployments", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewConflict(resource.GroupVersionResource().GroupResource(), "web",
+			errors.New("the object has been modified; please apply your changes to the latest version and try again"))
+	})
+	if err := client.UpdateEdited(ctx, resource, "default", obj, false); !IsConflict(err) {
+		t.Errorf("Expected a conflict, got %v", err)
+	}
+}
+
+func TestUpdateEditedReadOnlySnapshot(t *testing.T) {
+	client := newTestDynamicClient(t)
+	resource, _ := EditableResource("Pod")
+	obj, err := ParseEditedYAML(resource, "web-1", "apiVersion: v1\nkind: Pod\nmetadata:\n  name: web-1\n")
+	if err != nil {
+		t.Fatalf("ParseEditedYAML failed: %v", err)
+	}
+	if err := client.UpdateEdited(context.Background(), resource, "shop", obj, true); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("Expected ErrReadOnly, got %v", err)
+	}
+}
@@ -20,16 +20,6 @@ func (c *Client) GetEvents(ctx context.Context, namespace string) (*corev1.Event
 	return events, nil
 }
 
-// GetAllEvents retrieves events from all namespaces
-func (c *Client) GetAllEvents(ctx context.Context) (*corev1.EventList, error) {
-	events, err := c.clientset.CoreV1().Events("").List(ctx, metav1.ListOptions{})
-	if err != nil {
-		return nil, fmt.Errorf("failed to list all events: %w", err)
-	}
-
-	return events, nil
-}
-
 // GetEventsForResource retrieves events for a specific resource
 func (c *Client) GetEventsForResource(ctx context.Context, namespace, resourceKind, resourceName string) (*corev1.EventList, error) {
 	namespace = c.resolveNamespace(namespace)
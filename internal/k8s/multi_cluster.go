@@ -0,0 +1,191 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// clusterWatch pairs a client with the watch manager for a single kube context
+type clusterWatch struct {
+	name    string
+	client  *Client
+	manager *WatchManager
+}
+
+// MultiClusterWatcher runs one WatchManager per kube context and merges their
+// events into a single stream, tagging each event with its source cluster
+type MultiClusterWatcher struct {
+	clusters  []clusterWatch
+	eventChan chan WatchEvent
+	errorChan chan WatchError
+	mu        sync.RWMutex
+	cancelAll context.CancelFunc
+}
+
+// NewMultiClusterWatcher creates a watcher for the given clients.
+// Each client is identified by its current context name.
+func NewMultiClusterWatcher(clients []*Client) *MultiClusterWatcher {
+	clusters := make([]clusterWatch, 0, len(clients))
+	for _, client := range clients {
+		clusters = append(clusters, clusterWatch{
+			name:    client.GetCurrentContext(),
+			client:  client,
+			manager: NewWatchManager(client),
+		})
+	}
+
+	return &MultiClusterWatcher{
+		clusters:  clusters,
+		eventChan: make(chan WatchEvent, 100*len(clients)),
+		errorChan: make(chan WatchError, 100*len(clients)),
+	}
+}
+
+// Start begins watching the specified resource types in every cluster
+func (mc *MultiClusterWatcher) Start(ctx context.Context, resourceTypes []ResourceType) error {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	forwardCtx, cancel := context.WithCancel(ctx)
+	mc.cancelAll = cancel
+
+	for _, cluster := range mc.clusters {
+		if err := cluster.manager.Start(ctx, resourceTypes); err != nil {
+			mc.stopAllLocked()
+			return fmt.Errorf("failed to start watchers for context %s: %w", cluster.name, err)
+		}
+		go mc.forward(forwardCtx, cluster)
+	}
+
+	return nil
+}
+
+// forward relays events and errors from one cluster's watch manager into the merged channels
+func (mc *MultiClusterWatcher) forward(ctx context.Context, cluster clusterWatch) {
+	events := cluster.manager.GetEventChannel()
+	errs := cluster.manager.GetErrorChannel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-events:
+			event.Cluster = cluster.name
+			select {
+			case mc.eventChan <- event:
+			case <-ctx.Done():
+				return
+			}
+		case watchErr := <-errs:
+			watchErr.Cluster = cluster.name
+			watchErr.Err = fmt.Errorf("%s: %w", cluster.name, watchErr.Err)
+			select {
+			case mc.errorChan <- watchErr:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// Stop stops all watchers in every cluster
+func (mc *MultiClusterWatcher) Stop() {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	mc.stopAllLocked()
+}
+
+// stopAllLocked stops forwarding and all cluster watchers (must be called with lock held)
+func (mc *MultiClusterWatcher) stopAllLocked() {
+	if mc.cancelAll != nil {
+		mc.cancelAll()
+		mc.cancelAll = nil
+	}
+
+	for _, cluster := range mc.clusters {
+		cluster.manager.Stop()
+	}
+}
+
+// UpdateNamespace switches every cluster to the given namespace and restarts its watchers
+func (mc *MultiClusterWatcher) UpdateNamespace(namespace string) error {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+
+	for _, cluster := range mc.clusters {
+		if err := cluster.manager.UpdateNamespace(namespace); err != nil {
+			return fmt.Errorf("context %s: %w", cluster.name, err)
+		}
+	}
+
+	return nil
+}
+
+// WatchDynamic points every cluster's generic watcher at a discovered resource
+func (mc *MultiClusterWatcher) WatchDynamic(resource APIResource) error {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+
+	for _, cluster := range mc.clusters {
+		if err := cluster.manager.WatchDynamic(resource); err != nil {
+			return fmt.Errorf("context %s: %w", cluster.name, err)
+		}
+	}
+
+	return nil
+}
+
+// GetEventChannel returns the merged channel of watch events from all clusters
+func (mc *MultiClusterWatcher) GetEventChannel() <-chan WatchEvent {
+	return mc.eventChan
+}
+
+// GetErrorChannel returns the merged channel of watch errors from all clusters
+func (mc *MultiClusterWatcher) GetErrorChannel() <-chan WatchError {
+	return mc.errorChan
+}
+
+// GetOverallConnectionState returns an aggregate connection state across all clusters
+func (mc *MultiClusterWatcher) GetOverallConnectionState() ConnectionState {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+
+	var states []ConnectionState
+	for _, cluster := range mc.clusters {
+		for _, state := range cluster.manager.GetConnectionStates() {
+			states = append(states, state)
+		}
+	}
+
+	return aggregateConnectionStates(states)
+}
+
+// GetContexts returns the context names being watched, in the order they were given
+func (mc *MultiClusterWatcher) GetContexts() []string {
+	contexts := make([]string, 0, len(mc.clusters))
+	for _, cluster := range mc.clusters {
+		contexts = append(contexts, cluster.name)
+	}
+	return contexts
+}
+
+// GetClients returns the clients being watched, in the order they were given
+func (mc *MultiClusterWatcher) GetClients() []*Client {
+	clients := make([]*Client, 0, len(mc.clusters))
+	for _, cluster := range mc.clusters {
+		clients = append(clients, cluster.client)
+	}
+	return clients
+}
+
+// GetClient returns the client for the named context, or nil if it is not watched
+func (mc *MultiClusterWatcher) GetClient(contextName string) *Client {
+	for _, cluster := range mc.clusters {
+		if cluster.name == contextName {
+			return cluster.client
+		}
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// newTestClusterClient creates a client for the given context backed by a fake clientset
+func newTestClusterClient(contextName string, podNames ...string) *Client {
+	fakeClientset := fake.NewSimpleClientset()
+	for _, name := range podNames {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", ResourceVersion: "1"},
+		}
+		_ = fakeClientset.Tracker().Add(pod)
+	}
+
+	return &Client{
+		clientset:      fakeClientset,
+		namespace:      "default",
+		currentContext: contextName,
+	}
+}
+
+func TestNewMultiClusterWatcher(t *testing.T) {
+	east := newTestClusterClient("east")
+	west := newTestClusterClient("west")
+
+	mc := NewMultiClusterWatcher([]*Client{east, west})
+
+	contexts := mc.GetContexts()
+	if len(contexts) != 2 || contexts[0] != "east" || contexts[1] != "west" {
+		t.Errorf("Expected contexts [east west], got %v", contexts)
+	}
+	if mc.GetClient("west") != west {
+		t.Error("Expected GetClient(west) to return the west client")
+	}
+	if mc.GetClient("missing") != nil {
+		t.Error("Expected GetClient for unknown context to return nil")
+	}
+	if len(mc.GetClients()) != 2 {
+		t.Errorf("Expected 2 clients, got %d", len(mc.GetClients()))
+	}
+	if state := mc.GetOverallConnectionState(); state != StateDisconnected {
+		t.Errorf("Expected StateDisconnected before start, got %s", state)
+	}
+}
+
+func TestMultiClusterWatcherTagsEvents(t *testing.T) {
+	east := newTestClusterClient("east", "east-pod")
+	west := newTestClusterClient("west", "west-pod")
+
+	mc := NewMultiClusterWatcher([]*Client{east, west})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := mc.Start(ctx, []ResourceType{ResourceTypePod}); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	defer mc.Stop()
+
+	seen := make(map[string]string)
+	for len(seen) < 2 {
+		select {
+		case event := <-mc.GetEventChannel():
+			pod, ok := event.Object.(*corev1.Pod)
+			if !ok {
+				t.Fatalf("Expected *corev1.Pod, got %T", event.Object)
+			}
+			seen[pod.Name] = event.Cluster
+		case <-time.After(2 * time.Second):
+			t.Fatalf("Timeout waiting for events, got %v", seen)
+		}
+	}
+
+	if seen["east-pod"] != "east" {
+		t.Errorf("Expected east-pod tagged with east, got %q", seen["east-pod"])
+	}
+	if seen["west-pod"] != "west" {
+		t.Errorf("Expected west-pod tagged with west, got %q", seen["west-pod"])
+	}
+}
+
+func TestMultiClusterWatcherUpdateNamespace(t *testing.T) {
+	east := newTestClusterClient("east")
+	west := newTestClusterClient("west")
+
+	mc := NewMultiClusterWatcher([]*Client{east, west})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := mc.Start(ctx, []ResourceType{ResourceTypePod}); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	defer mc.Stop()
+
+	if err := mc.UpdateNamespace("production"); err != nil {
+		t.Fatalf("UpdateNamespace failed: %v", err)
+	}
+
+	if east.GetNamespace() != "production" || west.GetNamespace() != "production" {
+		t.Errorf("Expected both clients in production, got %s and %s",
+			east.GetNamespace(), west.GetNamespace())
+	}
+}
@@ -0,0 +1,353 @@
+package k8s
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// recordedEvent is the on-disk form of a WatchEvent: one JSON object per line
+type recordedEvent struct {
+	Time         time.Time       `json:"time"`
+	ResourceType string          `json:"resourceType"`
+	EventType    watch.EventType `json:"eventType"`
+	Cluster      string          `json:"cluster,omitempty"`
+	Object       json.RawMessage `json:"object"`
+}
+
+// EventRecorder writes watch events to a file with the time they were emitted
+type EventRecorder struct {
+	file    *os.File
+	encoder *json.Encoder
+	now     func() time.Time
+	mu      sync.Mutex
+}
+
+// NewEventRecorder creates (or truncates) the recording file at path
+func NewEventRecorder(path string) (*EventRecorder, error) {
+	// Recordings can hold anything the watchers see, so keep them private to the user
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create recording: %w", err)
+	}
+
+	return &EventRecorder{
+		file:    file,
+		encoder: json.NewEncoder(file),
+		now:     time.Now,
+	}, nil
+}
+
+// Record appends an event to the recording
+func (r *EventRecorder) Record(event WatchEvent) error {
+	object, err := json.Marshal(redactRecordedObject(event.Object))
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", event.ResourceType, err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	record := recordedEvent{
+		Time:         r.now(),
+		ResourceType: event.ResourceType.String(),
+		EventType:    event.EventType,
+		Cluster:      event.Cluster,
+		Object:       object,
+	}
+	if err := r.encoder.Encode(record); err != nil {
+		return fmt.Errorf("failed to write recording: %w", err)
+	}
+
+	return nil
+}
+
+// redactRecordedObject keeps secret values out of recordings. The keys stay so
+// replays still show how many entries each secret holds.
+func redactRecordedObject(obj interface{}) interface{} {
+	secret, ok := obj.(*corev1.Secret)
+	if !ok {
+		return obj
+	}
+
+	redacted := secret.DeepCopy()
+	for key := range redacted.Data {
+		redacted.Data[key] = nil
+	}
+	redacted.StringData = nil
+	redacted.Annotations = redactAnnotations(redacted.Annotations)
+
+	return redacted
+}
+
+// Close flushes and closes the recording file
+func (r *EventRecorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.file.Close()
+}
+
+// replayedEvent is a decoded recording entry
+type replayedEvent struct {
+	time  time.Time
+	event WatchEvent
+}
+
+// EventReplayer feeds a recording back as watch events.
+// A speed of 1 replays in real time, 10 replays ten times faster,
+// and 0 replays as fast as the consumer reads.
+type EventReplayer struct {
+	events []replayedEvent
+	speed  float64
+}
+
+// NewEventReplayer loads a recording written by EventRecorder
+func NewEventReplayer(path string, speed float64) (*EventReplayer, error) {
+	if speed < 0 {
+		return nil, fmt.Errorf("replay speed must not be negative, got %v", speed)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open recording: %w", err)
+	}
+	defer file.Close()
+
+	var events []replayedEvent
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024) // Objects can be large
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		event, err := decodeRecordedEvent(scanner.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode recording line %d: %w", line, err)
+		}
+		events = append(events, event)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read recording: %w", err)
+	}
+
+	return &EventReplayer{events: events, speed: speed}, nil
+}
+
+// decodeRecordedEvent parses one recording line into a typed WatchEvent
+func decodeRecordedEvent(data []byte) (replayedEvent, error) {
+	var record recordedEvent
+	if err := json.Unmarshal(data, &record); err != nil {
+		return replayedEvent{}, err
+	}
+
+	resourceType, err := parseResourceType(record.ResourceType)
+	if err != nil {
+		return replayedEvent{}, err
+	}
+
+	obj := newObjectForResourceType(resourceType)
+	if err := json.Unmarshal(record.Object, obj); err != nil {
+		return replayedEvent{}, fmt.Errorf("failed to decode %s: %w", record.ResourceType, err)
+	}
+
+	return replayedEvent{
+		time: record.Time,
+		event: WatchEvent{
+			ResourceType: resourceType,
+			EventType:    record.EventType,
+			Object:       obj,
+			Cluster:      record.Cluster,
+		},
+	}, nil
+}
+
+// parseResourceType is the inverse of ResourceType.String
+func parseResourceType(name string) (ResourceType, error) {
+	for _, rt := range []ResourceType{
+		ResourceTypePod,
+		ResourceTypeService,
+		ResourceTypeDeployment,
+		ResourceTypeStatefulSet,
+		ResourceTypeEvent,
+		ResourceTypeConfigMap,
+		ResourceTypeSecret,
+		ResourceTypeNode,
+		ResourceTypeJob,
+		ResourceTypeCronJob,
+		ResourceTypeDaemonSet,
+		ResourceTypeReplicaSet,
+		ResourceTypeIngress,
+		ResourceTypePersistentVolumeClaim,
+		ResourceTypePersistentVolume,
+		ResourceTypeStorageClass,
+		ResourceTypeHorizontalPodAutoscaler,
+		ResourceTypeServiceAccount,
+		ResourceTypeRole,
+		ResourceTypeClusterRole,
+		ResourceTypeRoleBinding,
+		ResourceTypeClusterRoleBinding,
+		ResourceTypeNetworkPolicy,
+		ResourceTypePodDisruptionBudget,
+		ResourceTypeGeneric,
+	} {
+		if rt.String() == name {
+			return rt, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown resource type %q", name)
+}
+
+// newObjectForResourceType returns an empty typed object for decoding
+func newObjectForResourceType(rt ResourceType) runtime.Object {
+	switch rt {
+	case ResourceTypeService:
+		return &corev1.Service{}
+	case ResourceTypeDeployment:
+		return &appsv1.Deployment{}
+	case ResourceTypeStatefulSet:
+		return &appsv1.StatefulSet{}
+	case ResourceTypeEvent:
+		return &corev1.Event{}
+	case ResourceTypeConfigMap:
+		return &corev1.ConfigMap{}
+	case ResourceTypeSecret:
+		return &corev1.Secret{}
+	case ResourceTypeNode:
+		return &corev1.Node{}
+	case ResourceTypeJob:
+		return &batchv1.Job{}
+	case ResourceTypeCronJob:
+		return &batchv1.CronJob{}
+	case ResourceTypeDaemonSet:
+		return &appsv1.DaemonSet{}
+	case ResourceTypeReplicaSet:
+		return &appsv1.ReplicaSet{}
+	case ResourceTypeIngress:
+		return &networkingv1.Ingress{}
+	case ResourceTypePersistentVolumeClaim:
+		return &corev1.PersistentVolumeClaim{}
+	case ResourceTypePersistentVolume:
+		return &corev1.PersistentVolume{}
+	case ResourceTypeStorageClass:
+		return &storagev1.StorageClass{}
+	case ResourceTypeHorizontalPodAutoscaler:
+		return &autoscalingv2.HorizontalPodAutoscaler{}
+	case ResourceTypeServiceAccount:
+		return &corev1.ServiceAccount{}
+	case ResourceTypeRole:
+		return &rbacv1.Role{}
+	case ResourceTypeClusterRole:
+		return &rbacv1.ClusterRole{}
+	case ResourceTypeRoleBinding:
+		return &rbacv1.RoleBinding{}
+	case ResourceTypeClusterRoleBinding:
+		return &rbacv1.ClusterRoleBinding{}
+	case ResourceTypeNetworkPolicy:
+		return &networkingv1.NetworkPolicy{}
+	case ResourceTypePodDisruptionBudget:
+		return &policyv1.PodDisruptionBudget{}
+	case ResourceTypeGeneric:
+		return &unstructured.Unstructured{}
+	default:
+		return &corev1.Pod{}
+	}
+}
+
+// Events returns the recorded events in order, without timing.
+// Useful for feeding a recording straight into event handlers in tests.
+func (r *EventReplayer) Events() []WatchEvent {
+	events := make([]WatchEvent, len(r.events))
+	for i, e := range r.events {
+		events[i] = e.event
+	}
+	return events
+}
+
+// Objects returns the last recorded state of every object that was not deleted,
+// so a client can be seeded with what the UI saw at the end of the recording
+func (r *EventReplayer) Objects() []runtime.Object {
+	type objectKey struct {
+		resourceType ResourceType
+		cluster      string
+		namespace    string
+		name         string
+	}
+
+	latest := make(map[objectKey]runtime.Object)
+	var order []objectKey
+	for _, e := range r.events {
+		accessor, err := metaAccessor(e.event.Object)
+		if err != nil {
+			continue
+		}
+		key := objectKey{e.event.ResourceType, e.event.Cluster, accessor.GetNamespace(), accessor.GetName()}
+
+		if e.event.EventType == watch.Deleted {
+			delete(latest, key)
+			continue
+		}
+		if _, seen := latest[key]; !seen {
+			order = append(order, key)
+		}
+		latest[key] = e.event.Object
+	}
+
+	objects := make([]runtime.Object, 0, len(latest))
+	for _, key := range order {
+		if obj, ok := latest[key]; ok {
+			objects = append(objects, obj)
+			delete(latest, key) // A re-added key appears twice in order
+		}
+	}
+	return objects
+}
+
+// run sends every recorded event accepted by include (all events if nil) to
+// eventChan, sleeping between events to reproduce the recorded timing scaled by
+// the replay speed
+func (r *EventReplayer) run(ctx context.Context, eventChan chan<- WatchEvent, include func(WatchEvent) bool) {
+	for i, e := range r.events {
+		if i > 0 && r.speed > 0 {
+			delay := time.Duration(float64(e.time.Sub(r.events[i-1].time)) / r.speed)
+			if delay > 0 {
+				timer := time.NewTimer(delay)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return
+				case <-timer.C:
+				}
+			}
+		}
+
+		if include != nil && !include(e.event) {
+			continue
+		}
+
+		select {
+		case eventChan <- e.event:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
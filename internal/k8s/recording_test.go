@@ -0,0 +1,385 @@
+package k8s
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// writeTestRecording records the given events one second apart and returns the file path
+func writeTestRecording(t *testing.T, events []WatchEvent) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	recorder, err := NewEventRecorder(path)
+	if err != nil {
+		t.Fatalf("NewEventRecorder failed: %v", err)
+	}
+
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	for i, event := range events {
+		recorder.now = func() time.Time { return start.Add(time.Duration(i) * time.Second) }
+		if err := recorder.Record(event); err != nil {
+			t.Fatalf("Record failed: %v", err)
+		}
+	}
+	if err := recorder.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+
+	return path
+}
+
+func testRecordingPod(name, phase string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Status:     corev1.PodStatus{Phase: corev1.PodPhase(phase)},
+	}
+}
+
+func TestEventRecorderRoundTrip(t *testing.T) {
+	path := writeTestRecording(t, []WatchEvent{
+		{ResourceType: ResourceTypePod, EventType: watch.Added, Object: testRecordingPod("web", "Pending")},
+		{ResourceType: ResourceTypeService, EventType: watch.Added, Object: &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		}},
+		{ResourceType: ResourceTypePod, EventType: watch.Modified, Object: testRecordingPod("web", "Running"), Cluster: "east"},
+	})
+
+	replayer, err := NewEventReplayer(path, 0)
+	if err != nil {
+		t.Fatalf("NewEventReplayer failed: %v", err)
+	}
+
+	events := replayer.Events()
+	if len(events) != 3 {
+		t.Fatalf("Expected 3 events, got %d", len(events))
+	}
+
+	if _, ok := events[1].Object.(*corev1.Service); !ok {
+		t.Errorf("Expected *corev1.Service, got %T", events[1].Object)
+	}
+
+	pod, ok := events[2].Object.(*corev1.Pod)
+	if !ok {
+		t.Fatalf("Expected *corev1.Pod, got %T", events[2].Object)
+	}
+	if pod.Status.Phase != corev1.PodRunning {
+		t.Errorf("Expected phase Running, got %s", pod.Status.Phase)
+	}
+	if events[2].EventType != watch.Modified || events[2].Cluster != "east" {
+		t.Errorf("Unexpected event metadata: %s %q", events[2].EventType, events[2].Cluster)
+	}
+}
+
+func TestEventRecorderRedactsSecrets(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "db",
+			Namespace: "default",
+			Annotations: map[string]string{
+				corev1.LastAppliedConfigAnnotation: `{"stringData":{"password":"applied-s3cret"}}`,
+				"team":                             "payments",
+			},
+		},
+		Data:       map[string][]byte{"password": []byte("data-s3cret")},
+		StringData: map[string]string{"token": "string-s3cret"},
+	}
+	path := writeTestRecording(t, []WatchEvent{
+		{ResourceType: ResourceTypeSecret, EventType: watch.Added, Object: secret},
+	})
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Stat failed: %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("Expected recording mode 0600, got %o", perm)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile failed: %v", err)
+	}
+	for _, leaked := range []string{"data-s3cret", "string-s3cret", "applied-s3cret",
+		base64.StdEncoding.EncodeToString([]byte("data-s3cret"))} {
+		if strings.Contains(string(content), leaked) {
+			t.Errorf("Recording contains secret value %q", leaked)
+		}
+	}
+	if len(secret.Data["password"]) == 0 {
+		t.Error("Record should not modify the watched secret")
+	}
+
+	replayer, err := NewEventReplayer(path, 0)
+	if err != nil {
+		t.Fatalf("NewEventReplayer failed: %v", err)
+	}
+	replayed, ok := replayer.Events()[0].Object.(*corev1.Secret)
+	if !ok {
+		t.Fatalf("Expected *corev1.Secret, got %T", replayer.Events()[0].Object)
+	}
+	if _, ok := replayed.Data["password"]; !ok || len(replayed.Data) != 1 {
+		t.Errorf("Expected the password key to survive, got %v", replayed.Data)
+	}
+	if replayed.Annotations["team"] != "payments" {
+		t.Errorf("Expected other annotations to survive, got %v", replayed.Annotations)
+	}
+}
+
+func TestEventReplayerObjects(t *testing.T) {
+	path := writeTestRecording(t, []WatchEvent{
+		{ResourceType: ResourceTypePod, EventType: watch.Added, Object: testRecordingPod("web", "Pending")},
+		{ResourceType: ResourceTypePod, EventType: watch.Added, Object: testRecordingPod("job", "Pending")},
+		{ResourceType: ResourceTypePod, EventType: watch.Modified, Object: testRecordingPod("web", "Running")},
+		{ResourceType: ResourceTypePod, EventType: watch.Deleted, Object: testRecordingPod("job", "Succeeded")},
+	})
+
+	replayer, err := NewEventReplayer(path, 0)
+	if err != nil {
+		t.Fatalf("NewEventReplayer failed: %v", err)
+	}
+
+	objects := replayer.Objects()
+	if len(objects) != 1 {
+		t.Fatalf("Expected 1 remaining object, got %d", len(objects))
+	}
+	pod := objects[0].(*corev1.Pod)
+	if pod.Name != "web" || pod.Status.Phase != corev1.PodRunning {
+		t.Errorf("Expected latest state of web, got %s %s", pod.Name, pod.Status.Phase)
+	}
+}
+
+func TestEventReplayerTiming(t *testing.T) {
+	path := writeTestRecording(t, []WatchEvent{
+		{ResourceType: ResourceTypePod, EventType: watch.Added, Object: testRecordingPod("a", "Running")},
+		{ResourceType: ResourceTypePod, EventType: watch.Added, Object: testRecordingPod("b", "Running")},
+	})
+
+	// Events were recorded one second apart; at 20x the gap should be ~50ms
+	replayer, err := NewEventReplayer(path, 20)
+	if err != nil {
+		t.Fatalf("NewEventReplayer failed: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	eventChan := make(chan WatchEvent, 2)
+	go replayer.run(ctx, eventChan, nil)
+
+	<-eventChan
+	start := time.Now()
+	select {
+	case <-eventChan:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timeout waiting for second event")
+	}
+
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("Expected replay to wait ~50ms between events, waited %s", elapsed)
+	}
+}
+
+func TestNewEventReplayerErrors(t *testing.T) {
+	if _, err := NewEventReplayer(filepath.Join(t.TempDir(), "missing"), 1); err == nil {
+		t.Error("Expected error for missing recording")
+	}
+
+	path := writeTestRecording(t, nil)
+	if _, err := NewEventReplayer(path, -1); err == nil {
+		t.Error("Expected error for negative speed")
+	}
+}
+
+func TestWatchManagerRecordsEvents(t *testing.T) {
+	client := newTestClusterClient("test", "web")
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+
+	recorder, err := NewEventRecorder(path)
+	if err != nil {
+		t.Fatalf("NewEventRecorder failed: %v", err)
+	}
+
+	wm := NewWatchManager(client)
+	wm.SetRecorder(recorder)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := wm.Start(ctx, []ResourceType{ResourceTypePod}); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+
+	select {
+	case event := <-wm.GetEventChannel():
+		if event.EventType != watch.Added {
+			t.Errorf("Expected ADDED event, got %s", event.EventType)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timeout waiting for event")
+	}
+
+	wm.Stop()
+	if err := recorder.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+
+	replayer, err := NewEventReplayer(path, 0)
+	if err != nil {
+		t.Fatalf("NewEventReplayer failed: %v", err)
+	}
+	events := replayer.Events()
+	if len(events) != 1 {
+		t.Fatalf("Expected 1 recorded event, got %d", len(events))
+	}
+	if pod := events[0].Object.(*corev1.Pod); pod.Name != "web" {
+		t.Errorf("Expected recorded pod web, got %s", pod.Name)
+	}
+}
+
+func TestWatchManagerReplay(t *testing.T) {
+	path := writeTestRecording(t, []WatchEvent{
+		{ResourceType: ResourceTypePod, EventType: watch.Added, Object: testRecordingPod("web", "Running")},
+		{ResourceType: ResourceTypePod, EventType: watch.Deleted, Object: testRecordingPod("web", "Running")},
+	})
+
+	replayer, err := NewEventReplayer(path, 0)
+	if err != nil {
+		t.Fatalf("NewEventReplayer failed: %v", err)
+	}
+	client, err := NewReplayClient(path, replayer, "")
+	if err != nil {
+		t.Fatalf("NewReplayClient failed: %v", err)
+	}
+	if client.GetCurrentContext() != "replay:events.jsonl" {
+		t.Errorf("Unexpected context label %s", client.GetCurrentContext())
+	}
+
+	wm := NewWatchManager(client)
+	wm.SetReplayer(replayer)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := wm.Start(ctx, []ResourceType{ResourceTypePod}); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	defer wm.Stop()
+
+	if state := wm.GetOverallConnectionState(); state != StateConnected {
+		t.Errorf("Expected StateConnected while replaying, got %s", state)
+	}
+	if wm.GetWatcherCount() != 0 {
+		t.Errorf("Expected no live watchers during replay, got %d", wm.GetWatcherCount())
+	}
+
+	for _, want := range []watch.EventType{watch.Added, watch.Deleted} {
+		select {
+		case event := <-wm.GetEventChannel():
+			if event.EventType != want {
+				t.Errorf("Expected %s, got %s", want, event.EventType)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("Timeout waiting for %s", want)
+		}
+	}
+}
+
+func TestWatchManagerReplayAcrossRestarts(t *testing.T) {
+	other := testRecordingPod("api", "Running")
+	other.Namespace = "other"
+	path := writeTestRecording(t, []WatchEvent{
+		{ResourceType: ResourceTypePod, EventType: watch.Added, Object: testRecordingPod("web", "Running")},
+		{ResourceType: ResourceTypePod, EventType: watch.Added, Object: other},
+		{ResourceType: ResourceTypeNode, EventType: watch.Added, Object: &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+		}},
+	})
+
+	replayer, err := NewEventReplayer(path, 0)
+	if err != nil {
+		t.Fatalf("NewEventReplayer failed: %v", err)
+	}
+	client, err := NewReplayClient(path, replayer, "default")
+	if err != nil {
+		t.Fatalf("NewReplayClient failed: %v", err)
+	}
+
+	wm := NewWatchManager(client)
+	wm.SetReplayer(replayer)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := wm.Start(ctx, []ResourceType{ResourceTypePod}); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	defer wm.Stop()
+
+	// Events of other namespaces are skipped; cluster-scoped objects are not
+	for _, want := range []string{"web", "node-1"} {
+		select {
+		case event := <-wm.GetEventChannel():
+			accessor, err := metaAccessor(event.Object)
+			if err != nil {
+				t.Fatalf("metaAccessor failed: %v", err)
+			}
+			if accessor.GetName() != want {
+				t.Errorf("Expected %s, got %s", want, accessor.GetName())
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("Timeout waiting for %s", want)
+		}
+	}
+
+	// A restart, as on a namespace change, carries on instead of replaying again
+	if err := wm.RestartAll(); err != nil {
+		t.Fatalf("RestartAll failed: %v", err)
+	}
+	select {
+	case event := <-wm.GetEventChannel():
+		t.Errorf("Expected no events after the restart, got %s %T", event.EventType, event.Object)
+	case <-time.After(100 * time.Millisecond):
+	}
+	if !wm.IsReplaying() {
+		t.Error("Expected the manager to still be replaying after the restart")
+	}
+}
+
+func TestReplayClientReadOnly(t *testing.T) {
+	path := writeTestRecording(t, []WatchEvent{
+		{ResourceType: ResourceTypePod, EventType: watch.Added, Object: testRecordingPod("web", "Running")},
+	})
+
+	replayer, err := NewEventReplayer(path, 0)
+	if err != nil {
+		t.Fatalf("NewEventReplayer failed: %v", err)
+	}
+	client, err := NewReplayClient(path, replayer, "default")
+	if err != nil {
+		t.Fatalf("NewReplayClient failed: %v", err)
+	}
+
+	if !client.IsReplay() || client.IsSnapshot() || !client.IsReadOnly() {
+		t.Errorf("Expected a read-only replay client, got replay=%v snapshot=%v read-only=%v",
+			client.IsReplay(), client.IsSnapshot(), client.IsReadOnly())
+	}
+
+	err = client.DeleteResource(context.Background(), "Pod", "default", "web", DeleteOptions{})
+	if !errors.Is(err, ErrReplayReadOnly) {
+		t.Fatalf("Expected ErrReplayReadOnly, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "replays are read-only") {
+		t.Errorf("Unexpected error message %q", err.Error())
+	}
+}
@@ -8,9 +8,16 @@ import (
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/watch"
 )
@@ -24,6 +31,26 @@ const (
 	ResourceTypeDeployment
 	ResourceTypeStatefulSet
 	ResourceTypeEvent
+	ResourceTypeConfigMap
+	ResourceTypeSecret
+	ResourceTypeNode
+	ResourceTypeJob
+	ResourceTypeCronJob
+	ResourceTypeDaemonSet
+	ResourceTypeReplicaSet
+	ResourceTypeIngress
+	ResourceTypePersistentVolumeClaim
+	ResourceTypePersistentVolume
+	ResourceTypeStorageClass
+	ResourceTypeHorizontalPodAutoscaler
+	ResourceTypeServiceAccount
+	ResourceTypeRole
+	ResourceTypeClusterRole
+	ResourceTypeRoleBinding
+	ResourceTypeClusterRoleBinding
+	ResourceTypeNetworkPolicy
+	ResourceTypePodDisruptionBudget
+	ResourceTypeGeneric // Any discovered resource, served by the dynamic client
 )
 
 // String returns the string representation of the resource type
@@ -39,6 +66,46 @@ func (rt ResourceType) String() string {
 		return "StatefulSet"
 	case ResourceTypeEvent:
 		return "Event"
+	case ResourceTypeConfigMap:
+		return "ConfigMap"
+	case ResourceTypeSecret:
+		return "Secret"
+	case ResourceTypeNode:
+		return "Node"
+	case ResourceTypeJob:
+		return "Job"
+	case ResourceTypeCronJob:
+		return "CronJob"
+	case ResourceTypeDaemonSet:
+		return "DaemonSet"
+	case ResourceTypeReplicaSet:
+		return "ReplicaSet"
+	case ResourceTypeIngress:
+		return "Ingress"
+	case ResourceTypePersistentVolumeClaim:
+		return "PersistentVolumeClaim"
+	case ResourceTypePersistentVolume:
+		return "PersistentVolume"
+	case ResourceTypeStorageClass:
+		return "StorageClass"
+	case ResourceTypeHorizontalPodAutoscaler:
+		return "HorizontalPodAutoscaler"
+	case ResourceTypeServiceAccount:
+		return "ServiceAccount"
+	case ResourceTypeRole:
+		return "Role"
+	case ResourceTypeClusterRole:
+		return "ClusterRole"
+	case ResourceTypeRoleBinding:
+		return "RoleBinding"
+	case ResourceTypeClusterRoleBinding:
+		return "ClusterRoleBinding"
+	case ResourceTypeNetworkPolicy:
+		return "NetworkPolicy"
+	case ResourceTypePodDisruptionBudget:
+		return "PodDisruptionBudget"
+	case ResourceTypeGeneric:
+		return "Generic"
 	default:
 		return "Unknown"
 	}
@@ -53,6 +120,9 @@ const (
 	StateConnected
 	StateReconnecting
 	StateError
+	// StateForbidden marks a watcher stopped because RBAC denies it; the rest of
+	// the cluster can still be healthy
+	StateForbidden
 )
 
 // String returns the string representation of the connection state
@@ -68,6 +138,8 @@ func (cs ConnectionState) String() string {
 		return "Reconnecting"
 	case StateError:
 		return "Error"
+	case StateForbidden:
+		return "Forbidden"
 	default:
 		return "Unknown"
 	}
@@ -78,6 +150,7 @@ type WatchEvent struct {
 	ResourceType ResourceType
 	EventType    watch.EventType
 	Object       runtime.Object
+	Cluster      string // Source kube context, set in multi-cluster mode
 }
 
 // WatchError represents an error from the watch stream
@@ -85,6 +158,7 @@ type WatchError struct {
 	ResourceType ResourceType
 	Err          error
 	Fatal        bool
+	Cluster      string // Source kube context, set in multi-cluster mode
 }
 
 // ResourceWatcher handles watching a single resource type
@@ -96,6 +170,7 @@ type ResourceWatcher struct {
 	state           ConnectionState
 	backoff         *ExponentialBackoff
 	debugMode       bool
+	apiResource     APIResource // Resource to watch when resourceType is ResourceTypeGeneric
 	mu              sync.RWMutex
 	cancelFunc      context.CancelFunc
 }
@@ -119,6 +194,13 @@ func (rw *ResourceWatcher) SetDebugMode(enabled bool) {
 	rw.debugMode = enabled
 }
 
+// SetAPIResource sets the discovered resource watched by a ResourceTypeGeneric watcher
+func (rw *ResourceWatcher) SetAPIResource(resource APIResource) {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	rw.apiResource = resource
+}
+
 // Start begins watching the resource type and sends events to the provided channels
 func (rw *ResourceWatcher) Start(ctx context.Context, eventChan chan<- WatchEvent, errorChan chan<- WatchError) {
 	watchCtx, cancel := context.WithCancel(ctx)
@@ -208,7 +290,11 @@ func (rw *ResourceWatcher) watchLoop(ctx context.Context, eventChan chan<- Watch
 				}
 
 				if fatal {
-					rw.setState(StateError)
+					if apierrors.IsForbidden(err) {
+						rw.setState(StateForbidden)
+					} else {
+						rw.setState(StateError)
+					}
 					rw.debugLogf("Fatal error, stopping watch: %v", err)
 					return
 				}
@@ -282,89 +368,133 @@ func (rw *ResourceWatcher) performInitialList(ctx context.Context, eventChan cha
 
 	switch rw.resourceType {
 	case ResourceTypePod:
-		list, err := rw.client.GetPods(ctx, rw.namespace)
-		if err != nil {
-			return err
-		}
-		rw.setResourceVersion(list.ResourceVersion)
-		rw.debugLogf("Initial list returned %d items, resourceVersion=%s", len(list.Items), list.ResourceVersion)
-
-		// Send ADDED events for existing items
-		for i := range list.Items {
-			eventChan <- WatchEvent{
-				ResourceType: rw.resourceType,
-				EventType:    watch.Added,
-				Object:       &list.Items[i],
-			}
-		}
-
+		return listAndEmit(rw, eventChan, func() (*corev1.PodList, error) {
+			return rw.client.GetPods(ctx, rw.namespace)
+		}, func(list *corev1.PodList) []corev1.Pod { return list.Items })
 	case ResourceTypeService:
-		list, err := rw.client.GetServices(ctx, rw.namespace)
-		if err != nil {
-			return err
-		}
-		rw.setResourceVersion(list.ResourceVersion)
-		rw.debugLogf("Initial list returned %d items, resourceVersion=%s", len(list.Items), list.ResourceVersion)
-
-		for i := range list.Items {
-			eventChan <- WatchEvent{
-				ResourceType: rw.resourceType,
-				EventType:    watch.Added,
-				Object:       &list.Items[i],
-			}
-		}
-
+		return listAndEmit(rw, eventChan, func() (*corev1.ServiceList, error) {
+			return rw.client.GetServices(ctx, rw.namespace)
+		}, func(list *corev1.ServiceList) []corev1.Service { return list.Items })
 	case ResourceTypeDeployment:
-		list, err := rw.client.GetDeployments(ctx, rw.namespace)
-		if err != nil {
-			return err
-		}
-		rw.setResourceVersion(list.ResourceVersion)
-		rw.debugLogf("Initial list returned %d items, resourceVersion=%s", len(list.Items), list.ResourceVersion)
-
-		for i := range list.Items {
-			eventChan <- WatchEvent{
-				ResourceType: rw.resourceType,
-				EventType:    watch.Added,
-				Object:       &list.Items[i],
-			}
-		}
-
+		return listAndEmit(rw, eventChan, func() (*appsv1.DeploymentList, error) {
+			return rw.client.GetDeployments(ctx, rw.namespace)
+		}, func(list *appsv1.DeploymentList) []appsv1.Deployment { return list.Items })
 	case ResourceTypeStatefulSet:
-		list, err := rw.client.GetStatefulSets(ctx, rw.namespace)
-		if err != nil {
-			return err
-		}
-		rw.setResourceVersion(list.ResourceVersion)
-		rw.debugLogf("Initial list returned %d items, resourceVersion=%s", len(list.Items), list.ResourceVersion)
-
-		for i := range list.Items {
-			eventChan <- WatchEvent{
-				ResourceType: rw.resourceType,
-				EventType:    watch.Added,
-				Object:       &list.Items[i],
-			}
-		}
-
+		return listAndEmit(rw, eventChan, func() (*appsv1.StatefulSetList, error) {
+			return rw.client.GetStatefulSets(ctx, rw.namespace)
+		}, func(list *appsv1.StatefulSetList) []appsv1.StatefulSet { return list.Items })
 	case ResourceTypeEvent:
-		list, err := rw.client.clientset.CoreV1().Events(rw.namespace).List(ctx, metav1.ListOptions{})
-		if err != nil {
-			return err
-		}
-		rw.setResourceVersion(list.ResourceVersion)
-		rw.debugLogf("Initial list returned %d items, resourceVersion=%s", len(list.Items), list.ResourceVersion)
-
-		for i := range list.Items {
-			eventChan <- WatchEvent{
-				ResourceType: rw.resourceType,
-				EventType:    watch.Added,
-				Object:       &list.Items[i],
-			}
-		}
-
+		return listAndEmit(rw, eventChan, func() (*corev1.EventList, error) {
+			return rw.client.clientset.CoreV1().Events(rw.namespace).List(ctx, metav1.ListOptions{})
+		}, func(list *corev1.EventList) []corev1.Event { return list.Items })
+	case ResourceTypeConfigMap:
+		return listAndEmit(rw, eventChan, func() (*corev1.ConfigMapList, error) {
+			return rw.client.GetConfigMaps(ctx, rw.namespace)
+		}, func(list *corev1.ConfigMapList) []corev1.ConfigMap { return list.Items })
+	case ResourceTypeSecret:
+		return listAndEmit(rw, eventChan, func() (*corev1.SecretList, error) {
+			return rw.client.GetSecrets(ctx, rw.namespace)
+		}, func(list *corev1.SecretList) []corev1.Secret { return list.Items })
+	case ResourceTypeNode:
+		return listAndEmit(rw, eventChan, func() (*corev1.NodeList, error) {
+			return rw.client.GetNodes(ctx)
+		}, func(list *corev1.NodeList) []corev1.Node { return list.Items })
+	case ResourceTypeJob:
+		return listAndEmit(rw, eventChan, func() (*batchv1.JobList, error) {
+			return rw.client.GetJobs(ctx, rw.namespace)
+		}, func(list *batchv1.JobList) []batchv1.Job { return list.Items })
+	case ResourceTypeCronJob:
+		return listAndEmit(rw, eventChan, func() (*batchv1.CronJobList, error) {
+			return rw.client.GetCronJobs(ctx, rw.namespace)
+		}, func(list *batchv1.CronJobList) []batchv1.CronJob { return list.Items })
+	case ResourceTypeDaemonSet:
+		return listAndEmit(rw, eventChan, func() (*appsv1.DaemonSetList, error) {
+			return rw.client.GetDaemonSets(ctx, rw.namespace)
+		}, func(list *appsv1.DaemonSetList) []appsv1.DaemonSet { return list.Items })
+	case ResourceTypeReplicaSet:
+		return listAndEmit(rw, eventChan, func() (*appsv1.ReplicaSetList, error) {
+			return rw.client.GetReplicaSets(ctx, rw.namespace)
+		}, func(list *appsv1.ReplicaSetList) []appsv1.ReplicaSet { return list.Items })
+	case ResourceTypeIngress:
+		return listAndEmit(rw, eventChan, func() (*networkingv1.IngressList, error) {
+			return rw.client.GetIngresses(ctx, rw.namespace)
+		}, func(list *networkingv1.IngressList) []networkingv1.Ingress { return list.Items })
+	case ResourceTypePersistentVolumeClaim:
+		return listAndEmit(rw, eventChan, func() (*corev1.PersistentVolumeClaimList, error) {
+			return rw.client.GetPersistentVolumeClaims(ctx, rw.namespace)
+		}, func(list *corev1.PersistentVolumeClaimList) []corev1.PersistentVolumeClaim { return list.Items })
+	case ResourceTypePersistentVolume:
+		return listAndEmit(rw, eventChan, func() (*corev1.PersistentVolumeList, error) {
+			return rw.client.GetPersistentVolumes(ctx)
+		}, func(list *corev1.PersistentVolumeList) []corev1.PersistentVolume { return list.Items })
+	case ResourceTypeStorageClass:
+		return listAndEmit(rw, eventChan, func() (*storagev1.StorageClassList, error) {
+			return rw.client.GetStorageClasses(ctx)
+		}, func(list *storagev1.StorageClassList) []storagev1.StorageClass { return list.Items })
+	case ResourceTypeHorizontalPodAutoscaler:
+		return listAndEmit(rw, eventChan, func() (*autoscalingv2.HorizontalPodAutoscalerList, error) {
+			return rw.client.GetHorizontalPodAutoscalers(ctx, rw.namespace)
+		}, func(list *autoscalingv2.HorizontalPodAutoscalerList) []autoscalingv2.HorizontalPodAutoscaler {
+			return list.Items
+		})
+	case ResourceTypeServiceAccount:
+		return listAndEmit(rw, eventChan, func() (*corev1.ServiceAccountList, error) {
+			return rw.client.GetServiceAccounts(ctx, rw.namespace)
+		}, func(list *corev1.ServiceAccountList) []corev1.ServiceAccount { return list.Items })
+	case ResourceTypeRole:
+		return listAndEmit(rw, eventChan, func() (*rbacv1.RoleList, error) {
+			return rw.client.GetRoles(ctx, rw.namespace)
+		}, func(list *rbacv1.RoleList) []rbacv1.Role { return list.Items })
+	case ResourceTypeClusterRole:
+		return listAndEmit(rw, eventChan, func() (*rbacv1.ClusterRoleList, error) {
+			return rw.client.GetClusterRoles(ctx)
+		}, func(list *rbacv1.ClusterRoleList) []rbacv1.ClusterRole { return list.Items })
+	case ResourceTypeRoleBinding:
+		return listAndEmit(rw, eventChan, func() (*rbacv1.RoleBindingList, error) {
+			return rw.client.GetRoleBindings(ctx, rw.namespace)
+		}, func(list *rbacv1.RoleBindingList) []rbacv1.RoleBinding { return list.Items })
+	case ResourceTypeClusterRoleBinding:
+		return listAndEmit(rw, eventChan, func() (*rbacv1.ClusterRoleBindingList, error) {
+			return rw.client.GetClusterRoleBindings(ctx)
+		}, func(list *rbacv1.ClusterRoleBindingList) []rbacv1.ClusterRoleBinding { return list.Items })
+	case ResourceTypeNetworkPolicy:
+		return listAndEmit(rw, eventChan, func() (*networkingv1.NetworkPolicyList, error) {
+			return rw.client.GetNetworkPolicies(ctx, rw.namespace)
+		}, func(list *networkingv1.NetworkPolicyList) []networkingv1.NetworkPolicy { return list.Items })
+	case ResourceTypePodDisruptionBudget:
+		return listAndEmit(rw, eventChan, func() (*policyv1.PodDisruptionBudgetList, error) {
+			return rw.client.GetPodDisruptionBudgets(ctx, rw.namespace)
+		}, func(list *policyv1.PodDisruptionBudgetList) []policyv1.PodDisruptionBudget { return list.Items })
+	case ResourceTypeGeneric:
+		return listAndEmit(rw, eventChan, func() (*unstructured.UnstructuredList, error) {
+			return rw.client.ListDynamic(ctx, rw.apiResource, rw.namespace)
+		}, func(list *unstructured.UnstructuredList) []unstructured.Unstructured { return list.Items })
 	default:
 		return fmt.Errorf("unsupported resource type: %v", rw.resourceType)
 	}
+}
+
+// listAndEmit runs the initial list, records its resourceVersion and sends an
+// ADDED event for each existing item
+func listAndEmit[L metav1.ListInterface, T any, PT interface {
+	*T
+	runtime.Object
+}](rw *ResourceWatcher, eventChan chan<- WatchEvent, list func() (L, error), items func(L) []T) error {
+	result, err := list()
+	if err != nil {
+		return err
+	}
+	objects := items(result)
+	rw.setResourceVersion(result.GetResourceVersion())
+	rw.debugLogf("Initial list returned %d items, resourceVersion=%s", len(objects), result.GetResourceVersion())
+
+	for i := range objects {
+		eventChan <- WatchEvent{
+			ResourceType: rw.resourceType,
+			EventType:    watch.Added,
+			Object:       PT(&objects[i]),
+		}
+	}
 
 	return nil
 }
@@ -384,6 +514,46 @@ func (rw *ResourceWatcher) createWatcher(ctx context.Context) (watch.Interface,
 		return rw.client.WatchStatefulSets(ctx, rw.namespace, rv)
 	case ResourceTypeEvent:
 		return rw.client.WatchEvents(ctx, rw.namespace, rv)
+	case ResourceTypeConfigMap:
+		return rw.client.WatchConfigMaps(ctx, rw.namespace, rv)
+	case ResourceTypeSecret:
+		return rw.client.WatchSecrets(ctx, rw.namespace, rv)
+	case ResourceTypeNode:
+		return rw.client.WatchNodes(ctx, rv)
+	case ResourceTypeJob:
+		return rw.client.WatchJobs(ctx, rw.namespace, rv)
+	case ResourceTypeCronJob:
+		return rw.client.WatchCronJobs(ctx, rw.namespace, rv)
+	case ResourceTypeDaemonSet:
+		return rw.client.WatchDaemonSets(ctx, rw.namespace, rv)
+	case ResourceTypeReplicaSet:
+		return rw.client.WatchReplicaSets(ctx, rw.namespace, rv)
+	case ResourceTypeIngress:
+		return rw.client.WatchIngresses(ctx, rw.namespace, rv)
+	case ResourceTypePersistentVolumeClaim:
+		return rw.client.WatchPersistentVolumeClaims(ctx, rw.namespace, rv)
+	case ResourceTypePersistentVolume:
+		return rw.client.WatchPersistentVolumes(ctx, rv)
+	case ResourceTypeStorageClass:
+		return rw.client.WatchStorageClasses(ctx, rv)
+	case ResourceTypeHorizontalPodAutoscaler:
+		return rw.client.WatchHorizontalPodAutoscalers(ctx, rw.namespace, rv)
+	case ResourceTypeServiceAccount:
+		return rw.client.WatchServiceAccounts(ctx, rw.namespace, rv)
+	case ResourceTypeRole:
+		return rw.client.WatchRoles(ctx, rw.namespace, rv)
+	case ResourceTypeClusterRole:
+		return rw.client.WatchClusterRoles(ctx, rv)
+	case ResourceTypeRoleBinding:
+		return rw.client.WatchRoleBindings(ctx, rw.namespace, rv)
+	case ResourceTypeClusterRoleBinding:
+		return rw.client.WatchClusterRoleBindings(ctx, rv)
+	case ResourceTypeNetworkPolicy:
+		return rw.client.WatchNetworkPolicies(ctx, rw.namespace, rv)
+	case ResourceTypePodDisruptionBudget:
+		return rw.client.WatchPodDisruptionBudgets(ctx, rw.namespace, rv)
+	case ResourceTypeGeneric:
+		return rw.client.WatchDynamic(ctx, rw.apiResource, rw.namespace, rv)
 	default:
 		return nil, fmt.Errorf("unsupported resource type: %v", rw.resourceType)
 	}
@@ -450,6 +620,46 @@ func (rw *ResourceWatcher) updateResourceVersionFromObject(obj runtime.Object) e
 		rv = o.ResourceVersion
 	case *corev1.Event:
 		rv = o.ResourceVersion
+	case *corev1.ConfigMap:
+		rv = o.ResourceVersion
+	case *corev1.Secret:
+		rv = o.ResourceVersion
+	case *corev1.Node:
+		rv = o.ResourceVersion
+	case *batchv1.Job:
+		rv = o.ResourceVersion
+	case *batchv1.CronJob:
+		rv = o.ResourceVersion
+	case *appsv1.DaemonSet:
+		rv = o.ResourceVersion
+	case *appsv1.ReplicaSet:
+		rv = o.ResourceVersion
+	case *networkingv1.Ingress:
+		rv = o.ResourceVersion
+	case *corev1.PersistentVolumeClaim:
+		rv = o.ResourceVersion
+	case *corev1.PersistentVolume:
+		rv = o.ResourceVersion
+	case *storagev1.StorageClass:
+		rv = o.ResourceVersion
+	case *autoscalingv2.HorizontalPodAutoscaler:
+		rv = o.ResourceVersion
+	case *corev1.ServiceAccount:
+		rv = o.ResourceVersion
+	case *rbacv1.Role:
+		rv = o.ResourceVersion
+	case *rbacv1.ClusterRole:
+		rv = o.ResourceVersion
+	case *rbacv1.RoleBinding:
+		rv = o.ResourceVersion
+	case *rbacv1.ClusterRoleBinding:
+		rv = o.ResourceVersion
+	case *networkingv1.NetworkPolicy:
+		rv = o.ResourceVersion
+	case *policyv1.PodDisruptionBudget:
+		rv = o.ResourceVersion
+	case *unstructured.Unstructured:
+		rv = o.GetResourceVersion()
 	default:
 		return fmt.Errorf("unsupported object type: %T", obj)
 	}
@@ -474,6 +684,46 @@ func getObjectName(obj runtime.Object) string {
 		return fmt.Sprintf("%s/%s", o.Namespace, o.Name)
 	case *corev1.Event:
 		return fmt.Sprintf("%s/%s", o.Namespace, o.Name)
+	case *corev1.ConfigMap:
+		return fmt.Sprintf("%s/%s", o.Namespace, o.Name)
+	case *corev1.Secret:
+		return fmt.Sprintf("%s/%s", o.Namespace, o.Name)
+	case *corev1.Node:
+		return o.Name
+	case *batchv1.Job:
+		return fmt.Sprintf("%s/%s", o.Namespace, o.Name)
+	case *batchv1.CronJob:
+		return fmt.Sprintf("%s/%s", o.Namespace, o.Name)
+	case *appsv1.DaemonSet:
+		return fmt.Sprintf("%s/%s", o.Namespace, o.Name)
+	case *appsv1.ReplicaSet:
+		return fmt.Sprintf("%s/%s", o.Namespace, o.Name)
+	case *networkingv1.Ingress:
+		return fmt.Sprintf("%s/%s", o.Namespace, o.Name)
+	case *corev1.PersistentVolumeClaim:
+		return fmt.Sprintf("%s/%s", o.Namespace, o.Name)
+	case *corev1.PersistentVolume:
+		return o.Name
+	case *storagev1.StorageClass:
+		return o.Name
+	case *autoscalingv2.HorizontalPodAutoscaler:
+		return fmt.Sprintf("%s/%s", o.Namespace, o.Name)
+	case *corev1.ServiceAccount:
+		return fmt.Sprintf("%s/%s", o.Namespace, o.Name)
+	case *rbacv1.Role:
+		return fmt.Sprintf("%s/%s", o.Namespace, o.Name)
+	case *rbacv1.ClusterRole:
+		return o.Name
+	case *rbacv1.RoleBinding:
+		return fmt.Sprintf("%s/%s", o.Namespace, o.Name)
+	case *rbacv1.ClusterRoleBinding:
+		return o.Name
+	case *networkingv1.NetworkPolicy:
+		return fmt.Sprintf("%s/%s", o.Namespace, o.Name)
+	case *policyv1.PodDisruptionBudget:
+		return fmt.Sprintf("%s/%s", o.Namespace, o.Name)
+	case *unstructured.Unstructured:
+		return fmt.Sprintf("%s/%s", o.GetNamespace(), o.GetName())
 	default:
 		return fmt.Sprintf("unknown(%T)", obj)
 	}
@@ -196,6 +196,25 @@ func TestResourceTypeString(t *testing.T) {
 		{ResourceTypeDeployment, "Deployment"},
 		{ResourceTypeStatefulSet, "StatefulSet"},
 		{ResourceTypeEvent, "Event"},
+		{ResourceTypeConfigMap, "ConfigMap"},
+		{ResourceTypeSecret, "Secret"},
+		{ResourceTypeNode, "Node"},
+		{ResourceTypeJob, "Job"},
+		{ResourceTypeCronJob, "CronJob"},
+		{ResourceTypeDaemonSet, "DaemonSet"},
+		{ResourceTypeReplicaSet, "ReplicaSet"},
+		{ResourceTypeIngress, "Ingress"},
+		{ResourceTypePersistentVolumeClaim, "PersistentVolumeClaim"},
+		{ResourceTypePersistentVolume, "PersistentVolume"},
+		{ResourceTypeStorageClass, "StorageClass"},
+		{ResourceTypeHorizontalPodAutoscaler, "HorizontalPodAutoscaler"},
+		{ResourceTypeServiceAccount, "ServiceAccount"},
+		{ResourceTypeRole, "Role"},
+		{ResourceTypeClusterRole, "ClusterRole"},
+		{ResourceTypeRoleBinding, "RoleBinding"},
+		{ResourceTypeClusterRoleBinding, "ClusterRoleBinding"},
+		{ResourceTypeNetworkPolicy, "NetworkPolicy"},
+		{ResourceTypePodDisruptionBudget, "PodDisruptionBudget"},
 		{ResourceType(999), "Unknown"},
 	}
 
@@ -219,6 +238,7 @@ func TestConnectionStateString(t *testing.T) {
 		{StateConnected, "Connected"},
 		{StateReconnecting, "Reconnecting"},
 		{StateError, "Error"},
+		{StateForbidden, "Forbidden"},
 		{ConnectionState(999), "Unknown"},
 	}
 
@@ -505,6 +525,25 @@ func TestCreateWatcherForDifferentResourceTypes(t *testing.T) {
 		{"Deployment", ResourceTypeDeployment},
 		{"StatefulSet", ResourceTypeStatefulSet},
 		{"Event", ResourceTypeEvent},
+		{"ConfigMap", ResourceTypeConfigMap},
+		{"Secret", ResourceTypeSecret},
+		{"Node", ResourceTypeNode},
+		{"Job", ResourceTypeJob},
+		{"CronJob", ResourceTypeCronJob},
+		{"DaemonSet", ResourceTypeDaemonSet},
+		{"ReplicaSet", ResourceTypeReplicaSet},
+		{"Ingress", ResourceTypeIngress},
+		{"PersistentVolumeClaim", ResourceTypePersistentVolumeClaim},
+		{"PersistentVolume", ResourceTypePersistentVolume},
+		{"StorageClass", ResourceTypeStorageClass},
+		{"HorizontalPodAutoscaler", ResourceTypeHorizontalPodAutoscaler},
+		{"ServiceAccount", ResourceTypeServiceAccount},
+		{"Role", ResourceTypeRole},
+		{"ClusterRole", ResourceTypeClusterRole},
+		{"RoleBinding", ResourceTypeRoleBinding},
+		{"ClusterRoleBinding", ResourceTypeClusterRoleBinding},
+		{"NetworkPolicy", ResourceTypeNetworkPolicy},
+		{"PodDisruptionBudget", ResourceTypePodDisruptionBudget},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,104 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/williajm/k8s-tui/internal/models"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// patchWorkload applies a patch to a deployment, statefulset or daemonset
+func (c *Client) patchWorkload(ctx context.Context, resourceType, namespace, name string, patchType types.PatchType, patch []byte) error {
+	namespace = c.resolveNamespace(namespace)
+	var err error
+	switch resourceType {
+	case "Deployment":
+		_, err = c.clientset.AppsV1().Deployments(namespace).Patch(ctx, name, patchType, patch, metav1.PatchOptions{})
+	case "StatefulSet":
+		_, err = c.clientset.AppsV1().StatefulSets(namespace).Patch(ctx, name, patchType, patch, metav1.PatchOptions{})
+	case "DaemonSet":
+		_, err = c.clientset.AppsV1().DaemonSets(namespace).Patch(ctx, name, patchType, patch, metav1.PatchOptions{})
+	default:
+		return fmt.Errorf("unsupported resource type: %s", resourceType)
+	}
+	return err
+}
+
+// RestartRollout rolls out new pods for a deployment, statefulset or daemonset by
+// stamping the restartedAt annotation on its pod template, like kubectl rollout restart
+func (c *Client) RestartRollout(ctx context.Context, resourceType, namespace, name string) error {
+	if c.IsReadOnly() {
+		return fmt.Errorf("failed to restart %s %s: %w", strings.ToLower(resourceType), name, c.readOnlyError())
+	}
+
+	patch, err := json.Marshal(map[string]any{
+		"spec": map[string]any{
+			"template": map[string]any{
+				"metadata": map[string]any{
+					"annotations": map[string]string{models.RestartedAtAnnotation: time.Now().Format(time.RFC3339)},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to build restart patch: %w", err)
+	}
+
+	if err := c.patchWorkload(ctx, resourceType, namespace, name, types.StrategicMergePatchType, patch); err != nil {
+		return fmt.Errorf("failed to restart %s %s: %w", strings.ToLower(resourceType), name, err)
+	}
+
+	return nil
+}
+
+// SetRolloutPaused pauses or resumes the rollout of a deployment. Only deployments
+// can be paused.
+func (c *Client) SetRolloutPaused(ctx context.Context, resourceType, namespace, name string, paused bool) error {
+	action := "resume"
+	if paused {
+		action = "pause"
+	}
+	if c.IsReadOnly() {
+		return fmt.Errorf("failed to %s %s %s: %w", action, strings.ToLower(resourceType), name, c.readOnlyError())
+	}
+	if resourceType != "Deployment" {
+		return fmt.Errorf("failed to %s %s %s: only deployments can be paused", action, strings.ToLower(resourceType), name)
+	}
+
+	patch := fmt.Sprintf(`{"spec":{"paused":%t}}`, paused)
+	if err := c.patchWorkload(ctx, resourceType, namespace, name, types.StrategicMergePatchType, []byte(patch)); err != nil {
+		return fmt.Errorf("failed to %s %s %s: %w", action, strings.ToLower(resourceType), name, err)
+	}
+
+	return nil
+}
+
+// RollbackWorkload replaces the pod template of a deployment or statefulset with the
+// template of an earlier revision, like kubectl rollout undo
+func (c *Client) RollbackWorkload(ctx context.Context, resourceType, namespace, name string, template *corev1.PodTemplateSpec) error {
+	if c.IsReadOnly() {
+		return fmt.Errorf("failed to roll back %s %s: %w", strings.ToLower(resourceType), name, c.readOnlyError())
+	}
+	if resourceType != "Deployment" && resourceType != "StatefulSet" {
+		return fmt.Errorf("unsupported resource type: %s", resourceType)
+	}
+
+	patch, err := json.Marshal([]map[string]any{
+		{"op": "replace", "path": "/spec/template", "value": template},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to build rollback patch: %w", err)
+	}
+
+	if err := c.patchWorkload(ctx, resourceType, namespace, name, types.JSONPatchType, patch); err != nil {
+		return fmt.Errorf("failed to roll back %s %s: %w", strings.ToLower(resourceType), name, err)
+	}
+
+	return nil
+}
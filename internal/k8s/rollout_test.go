@@ -0,0 +1,125 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/williajm/k8s-tui/internal/models"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRestartRollout(t *testing.T) {
+	clientset := fake.NewSimpleClientset(
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}},
+		&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"}},
+		&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "default"}},
+	)
+	client := &Client{clientset: clientset, namespace: "default"}
+	ctx := context.Background()
+
+	for _, kind := range []string{"Deployment", "StatefulSet", "DaemonSet"} {
+		name := map[string]string{"Deployment": "web", "StatefulSet": "db", "DaemonSet": "agent"}[kind]
+		if err := client.RestartRollout(ctx, kind, "", name); err != nil {
+			t.Fatalf("RestartRollout(%s) failed: %v", kind, err)
+		}
+	}
+
+	deployment, _ := clientset.AppsV1().Deployments("default").Get(ctx, "web", metav1.GetOptions{})
+	restartedAt, err := time.Parse(time.RFC3339, deployment.Spec.Template.Annotations[models.RestartedAtAnnotation])
+	if err != nil || time.Since(restartedAt) > time.Minute {
+		t.Errorf("Expected a recent restartedAt annotation, got %v (%v)", deployment.Spec.Template.Annotations, err)
+	}
+	statefulSet, _ := clientset.AppsV1().StatefulSets("default").Get(ctx, "db", metav1.GetOptions{})
+	daemonSet, _ := clientset.AppsV1().DaemonSets("default").Get(ctx, "agent", metav1.GetOptions{})
+	if statefulSet.Spec.Template.Annotations[models.RestartedAtAnnotation] == "" || daemonSet.Spec.Template.Annotations[models.RestartedAtAnnotation] == "" {
+		t.Error("Expected the statefulset and daemonset to be restarted")
+	}
+
+	if err := client.RestartRollout(ctx, "Job", "default", "migrate"); err == nil {
+		t.Error("Expected an error for a job")
+	}
+	if err := client.RestartRollout(ctx, "Deployment", "default", "missing"); err == nil {
+		t.Error("Expected an error for a missing deployment")
+	}
+}
+
+func TestSetRolloutPaused(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}})
+	client := &Client{clientset: clientset, namespace: "default"}
+	ctx := context.Background()
+
+	if err := client.SetRolloutPaused(ctx, "Deployment", "default", "web", true); err != nil {
+		t.Fatalf("SetRolloutPaused failed: %v", err)
+	}
+	deployment, _ := clientset.AppsV1().Deployments("default").Get(ctx, "web", metav1.GetOptions{})
+	if !deployment.Spec.Paused {
+		t.Error("Expected the deployment to be paused")
+	}
+
+	if err := client.SetRolloutPaused(ctx, "Deployment", "default", "web", false); err != nil {
+		t.Fatalf("SetRolloutPaused failed: %v", err)
+	}
+	deployment, _ = clientset.AppsV1().Deployments("default").Get(ctx, "web", metav1.GetOptions{})
+	if deployment.Spec.Paused {
+		t.Error("Expected the deployment to be resumed")
+	}
+
+	if err := client.SetRolloutPaused(ctx, "StatefulSet", "default", "db", true); err == nil {
+		t.Error("Expected an error for a statefulset")
+	}
+
+	snapshot := newTestDynamicClient(t)
+	if err := snapshot.SetRolloutPaused(ctx, "Deployment", "shop", "web", true); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("Expected ErrReadOnly, got %v", err)
+	}
+	if err := snapshot.RestartRollout(ctx, "Deployment", "shop", "web"); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("Expected ErrReadOnly, got %v", err)
+	}
+}
+
+func TestRollbackWorkload(t *testing.T) {
+	clientset := fake.NewSimpleClientset(
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web"}, Annotations: map[string]string{"note": "new"}},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "web", Image: "web:1.2"}}},
+			}},
+		},
+		&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"}},
+	)
+	client := &Client{clientset: clientset, namespace: "default"}
+	ctx := context.Background()
+
+	template := &corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web"}},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "web", Image: "web:1.0"}}},
+	}
+	if err := client.RollbackWorkload(ctx, "Deployment", "", "web", template); err != nil {
+		t.Fatalf("RollbackWorkload failed: %v", err)
+	}
+	deployment, _ := clientset.AppsV1().Deployments("default").Get(ctx, "web", metav1.GetOptions{})
+	if image := deployment.Spec.Template.Spec.Containers[0].Image; image != "web:1.0" {
+		t.Errorf("Expected the web:1.0 template, got %s", image)
+	}
+	if len(deployment.Spec.Template.Annotations) != 0 {
+		t.Errorf("Expected the template to be replaced, got annotations %v", deployment.Spec.Template.Annotations)
+	}
+
+	if err := client.RollbackWorkload(ctx, "StatefulSet", "default", "db", template); err != nil {
+		t.Fatalf("RollbackWorkload failed: %v", err)
+	}
+	if err := client.RollbackWorkload(ctx, "DaemonSet", "default", "agent", template); err == nil {
+		t.Error("Expected an error for a daemonset")
+	}
+
+	snapshot := newTestDynamicClient(t)
+	if err := snapshot.RollbackWorkload(ctx, "Deployment", "shop", "web", template); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("Expected ErrReadOnly, got %v", err)
+	}
+}
@@ -0,0 +1,47 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// resourceScaler is implemented by the typed client of every scalable workload type
+type resourceScaler interface {
+	UpdateScale(ctx context.Context, name string, scale *autoscalingv1.Scale, opts metav1.UpdateOptions) (*autoscalingv1.Scale, error)
+}
+
+// ScaleWorkload sets the desired replica count of a deployment or statefulset through
+// its scale subresource, like kubectl scale
+func (c *Client) ScaleWorkload(ctx context.Context, resourceType, namespace, name string, replicas int32) error {
+	if c.IsReadOnly() {
+		return fmt.Errorf("failed to scale %s %s: %w", strings.ToLower(resourceType), name, c.readOnlyError())
+	}
+	if replicas < 0 {
+		return fmt.Errorf("invalid replica count: %d", replicas)
+	}
+
+	namespace = c.resolveNamespace(namespace)
+	var scaler resourceScaler
+	switch resourceType {
+	case "Deployment":
+		scaler = c.clientset.AppsV1().Deployments(namespace)
+	case "StatefulSet":
+		scaler = c.clientset.AppsV1().StatefulSets(namespace)
+	default:
+		return fmt.Errorf("unsupported resource type: %s", resourceType)
+	}
+
+	scale := &autoscalingv1.Scale{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec:       autoscalingv1.ScaleSpec{Replicas: replicas},
+	}
+	if _, err := scaler.UpdateScale(ctx, name, scale, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to scale %s %s: %w", strings.ToLower(resourceType), name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestScaleWorkload(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	// The fake clientset has no scale subresource, so record the scale updates
+	var scales []string
+	clientset.PrependReactor("update", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "scale" {
+			return false, nil, nil
+		}
+		scale := action.(k8stesting.UpdateAction).GetObject().(*autoscalingv1.Scale)
+		scales = append(scales, action.GetResource().Resource+" "+scale.Namespace+"/"+scale.Name)
+		if scale.Spec.Replicas != 5 {
+			t.Errorf("Expected 5 replicas, got %d", scale.Spec.Replicas)
+		}
+		return true, scale, nil
+	})
+	client := &Client{clientset: clientset, namespace: "default"}
+	ctx := context.Background()
+
+	if err := client.ScaleWorkload(ctx, "Deployment", "", "web", 5); err != nil {
+		t.Fatalf("ScaleWorkload failed: %v", err)
+	}
+	if err := client.ScaleWorkload(ctx, "StatefulSet", "shop", "db", 5); err != nil {
+		t.Fatalf("ScaleWorkload failed: %v", err)
+	}
+	if len(scales) != 2 || scales[0] != "deployments default/web" || scales[1] != "statefulsets shop/db" {
+		t.Errorf("Unexpected scale updates: %v", scales)
+	}
+
+	if err := client.ScaleWorkload(ctx, "DaemonSet", "default", "agent", 1); err == nil {
+		t.Error("Expected an error for a daemonset")
+	}
+	if err := client.ScaleWorkload(ctx, "Deployment", "default", "web", -1); err == nil {
+		t.Error("Expected an error for a negative replica count")
+	}
+
+	snapshot := newTestDynamicClient(t)
+	if err := snapshot.ScaleWorkload(ctx, "Deployment", "shop", "web", 1); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("Expected ErrReadOnly, got %v", err)
+	}
+}
@@ -0,0 +1,469 @@
+package k8s
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// Context label prefixes for clients that are not backed by a live cluster
+const (
+	snapshotContextPrefix = "snapshot:"
+	replayContextPrefix   = "replay:"
+)
+
+// clusterScopedKinds lists the built-in kinds that are not namespaced. Offline clients
+// have no API server to ask, so every other kind known to the client-go scheme is
+// treated as namespaced.
+var clusterScopedKinds = map[schema.GroupKind]bool{
+	{Group: "", Kind: "Namespace"}:                                                    true,
+	{Group: "", Kind: "Node"}:                                                         true,
+	{Group: "", Kind: "PersistentVolume"}:                                             true,
+	{Group: "", Kind: "ComponentStatus"}:                                              true,
+	{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}:                         true,
+	{Group: "rbac.authorization.k8s.io", Kind: "ClusterRoleBinding"}:                  true,
+	{Group: "storage.k8s.io", Kind: "StorageClass"}:                                   true,
+	{Group: "storage.k8s.io", Kind: "VolumeAttachment"}:                               true,
+	{Group: "storage.k8s.io", Kind: "CSIDriver"}:                                      true,
+	{Group: "storage.k8s.io", Kind: "CSINode"}:                                        true,
+	{Group: "storage.k8s.io", Kind: "VolumeAttributesClass"}:                          true,
+	{Group: "networking.k8s.io", Kind: "IngressClass"}:                                true,
+	{Group: "networking.k8s.io", Kind: "IPAddress"}:                                   true,
+	{Group: "networking.k8s.io", Kind: "ServiceCIDR"}:                                 true,
+	{Group: "node.k8s.io", Kind: "RuntimeClass"}:                                      true,
+	{Group: "scheduling.k8s.io", Kind: "PriorityClass"}:                               true,
+	{Group: "certificates.k8s.io", Kind: "CertificateSigningRequest"}:                 true,
+	{Group: "certificates.k8s.io", Kind: "ClusterTrustBundle"}:                        true,
+	{Group: "admissionregistration.k8s.io", Kind: "MutatingWebhookConfiguration"}:     true,
+	{Group: "admissionregistration.k8s.io", Kind: "ValidatingWebhookConfiguration"}:   true,
+	{Group: "admissionregistration.k8s.io", Kind: "ValidatingAdmissionPolicy"}:        true,
+	{Group: "admissionregistration.k8s.io", Kind: "ValidatingAdmissionPolicyBinding"}: true,
+	{Group: "admissionregistration.k8s.io", Kind: "MutatingAdmissionPolicy"}:          true,
+	{Group: "admissionregistration.k8s.io", Kind: "MutatingAdmissionPolicyBinding"}:   true,
+	{Group: "flowcontrol.apiserver.k8s.io", Kind: "FlowSchema"}:                       true,
+	{Group: "flowcontrol.apiserver.k8s.io", Kind: "PriorityLevelConfiguration"}:       true,
+	{Group: "resource.k8s.io", Kind: "DeviceClass"}:                                   true,
+	{Group: "resource.k8s.io", Kind: "ResourceSlice"}:                                 true,
+	{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}:                 true,
+	{Group: "apiregistration.k8s.io", Kind: "APIService"}:                             true,
+}
+
+// NewSnapshotClient creates a client backed by an in-memory fake clientset seeded
+// from a snapshot instead of a live API server. The path can be a single file or a
+// directory of .yaml/.yml/.json files; each file may hold multiple YAML documents
+// or a List (e.g. the output of `kubectl get -o json`).
+// Objects of kinds without a Go type (such as custom resources) are only served
+// through the dynamic client, for the generic resource browser.
+func NewSnapshotClient(path string, namespace string) (*Client, error) {
+	objects, err := loadSnapshotObjects(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return newOfflineClient(objects, path, snapshotContextPrefix+filepath.Base(path), namespace)
+}
+
+// NewReplayClient creates an offline client for replay mode, seeded with the
+// objects that still existed at the end of the recording so that describe
+// and YAML views work without the original cluster
+func NewReplayClient(recordingPath string, replayer *EventReplayer, namespace string) (*Client, error) {
+	client, err := newOfflineClient(replayer.Objects(), recordingPath, replayContextPrefix+filepath.Base(recordingPath), namespace)
+	if err != nil {
+		return nil, err
+	}
+	client.replay = true
+	return client, nil
+}
+
+// newOfflineClient creates a client backed by a fake clientset holding objects
+func newOfflineClient(objects []runtime.Object, source, contextLabel, namespace string) (*Client, error) {
+	clientset := fake.NewSimpleClientset()
+	addEventFieldSelectorReactor(clientset)
+
+	namespaced := namespacedKinds(objects)
+	namespaces := make(map[string]bool)
+	for _, obj := range objects {
+		// Like kubectl, namespaced objects without a namespace go to the default namespace
+		if accessor, err := metaAccessor(obj); err == nil && accessor.GetNamespace() == "" {
+			if gk, ok := objectGroupKind(obj); ok && namespaced[gk] {
+				accessor.SetNamespace(metav1.NamespaceDefault)
+			}
+		}
+
+		// Untyped objects are only served by the dynamic client
+		if _, ok := obj.(*unstructured.Unstructured); !ok {
+			if err := clientset.Tracker().Add(obj); err != nil {
+				return nil, fmt.Errorf("failed to load snapshot object: %w", err)
+			}
+		}
+		if accessor, err := metaAccessor(obj); err == nil && accessor.GetNamespace() != "" {
+			namespaces[accessor.GetNamespace()] = true
+		}
+		if ns, ok := obj.(*corev1.Namespace); ok {
+			delete(namespaces, ns.Name)
+		}
+	}
+
+	// Dumps often omit Namespace objects; synthesize them so the namespace selector works
+	for name := range namespaces {
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if err := clientset.Tracker().Add(ns); err != nil {
+			return nil, fmt.Errorf("failed to load snapshot namespace: %w", err)
+		}
+		objects = append(objects, ns)
+	}
+
+	// Advertise every loaded kind so the generic resource browser can find it
+	clientset.Resources = offlineAPIResources(objects, namespaced)
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(scheme.Scheme, objects...)
+
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	return &Client{
+		clientset:      clientset,
+		dynamicClient:  dynamicClient,
+		snapshotPath:   source,
+		namespace:      namespace,
+		currentContext: contextLabel,
+		contexts:       []string{},
+	}, nil
+}
+
+// IsSnapshot reports whether the client is backed by a snapshot rather than a live cluster
+func (c *Client) IsSnapshot() bool {
+	return c.snapshotPath != "" && !c.replay
+}
+
+// IsReplay reports whether the client serves the objects of a recording being replayed
+func (c *Client) IsReplay() bool {
+	return c.snapshotPath != "" && c.replay
+}
+
+// IsReadOnly reports whether the client is backed by a snapshot or a replay, which
+// cannot be written to
+func (c *Client) IsReadOnly() bool {
+	return c.snapshotPath != ""
+}
+
+// readOnlyError returns the error for a write to a read-only client
+func (c *Client) readOnlyError() error {
+	if c.replay {
+		return ErrReplayReadOnly
+	}
+	return ErrReadOnly
+}
+
+// loadSnapshotObjects reads every supported object from a snapshot file or directory
+func loadSnapshotObjects(path string) ([]runtime.Object, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open snapshot: %w", err)
+	}
+
+	var files []string
+	if info.IsDir() {
+		err = filepath.WalkDir(path, func(p string, d fs.DirEntry, walkErr error) error {
+			if walkErr != nil {
+				return walkErr
+			}
+			if !d.IsDir() && isManifestFile(p) {
+				files = append(files, p)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read snapshot directory: %w", err)
+		}
+		sort.Strings(files)
+	} else {
+		files = []string{path}
+	}
+
+	var objects []runtime.Object
+	for _, file := range files {
+		fileObjects, err := decodeManifestFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", file, err)
+		}
+		objects = append(objects, fileObjects...)
+	}
+
+	return objects, nil
+}
+
+// isManifestFile reports whether the file extension is one we decode
+func isManifestFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	default:
+		return false
+	}
+}
+
+// decodeManifestFile decodes all typed objects in a YAML or JSON file, expanding Lists
+func decodeManifestFile(path string) ([]runtime.Object, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []runtime.Object
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+	for {
+		var doc map[string]interface{}
+		if err := decoder.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		if len(doc) == 0 {
+			continue
+		}
+
+		docObjects, err := decodeSnapshotDocument(&unstructured.Unstructured{Object: doc})
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, docObjects...)
+	}
+
+	return objects, nil
+}
+
+// decodeSnapshotDocument converts one document into typed objects.
+// Lists are expanded; items without apiVersion/kind inherit them from the list kind.
+func decodeSnapshotDocument(doc *unstructured.Unstructured) ([]runtime.Object, error) {
+	if !doc.IsList() {
+		obj, ok, err := toTypedObject(doc)
+		if err != nil || !ok {
+			return nil, err
+		}
+		return []runtime.Object{obj}, nil
+	}
+
+	itemKind := strings.TrimSuffix(doc.GetKind(), "List")
+	var objects []runtime.Object
+	err := doc.EachListItem(func(item runtime.Object) error {
+		u, ok := item.(*unstructured.Unstructured)
+		if !ok {
+			return nil
+		}
+		if u.GetKind() == "" && itemKind != "" {
+			u.SetAPIVersion(doc.GetAPIVersion())
+			u.SetKind(itemKind)
+		}
+		obj, ok, err := toTypedObject(u)
+		if err != nil {
+			return err
+		}
+		if ok {
+			objects = append(objects, obj)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return objects, nil
+}
+
+// toTypedObject converts an unstructured object into its registered Go type.
+// Kinds that are not registered in the client-go scheme are returned unchanged;
+// documents without a kind are skipped.
+func toTypedObject(u *unstructured.Unstructured) (runtime.Object, bool, error) {
+	gvk := u.GroupVersionKind()
+	if gvk.Kind == "" || gvk.Version == "" {
+		return nil, false, nil
+	}
+	if !scheme.Scheme.Recognizes(gvk) {
+		return u, true, nil
+	}
+
+	obj, err := scheme.Scheme.New(gvk)
+	if err != nil {
+		return nil, false, err
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, obj); err != nil {
+		return nil, false, fmt.Errorf("failed to convert %s %s: %w", gvk.Kind, u.GetName(), err)
+	}
+
+	return obj, true, nil
+}
+
+// namespacedKinds decides the scope of every kind in objects. Built-in kinds have a
+// known scope; custom resources take theirs from a CustomResourceDefinition in the
+// snapshot, and otherwise count as namespaced if any of their objects has a namespace.
+func namespacedKinds(objects []runtime.Object) map[schema.GroupKind]bool {
+	namespaced := make(map[schema.GroupKind]bool)
+	defined := make(map[schema.GroupKind]bool)
+
+	for _, obj := range objects {
+		u, ok := obj.(*unstructured.Unstructured)
+		if !ok || u.GroupVersionKind().GroupKind() != (schema.GroupKind{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}) {
+			continue
+		}
+		group, _, _ := unstructured.NestedString(u.Object, "spec", "group")
+		kind, _, _ := unstructured.NestedString(u.Object, "spec", "names", "kind")
+		scope, _, _ := unstructured.NestedString(u.Object, "spec", "scope")
+		gk := schema.GroupKind{Group: group, Kind: kind}
+		namespaced[gk] = scope == "Namespaced"
+		defined[gk] = true
+	}
+
+	for _, obj := range objects {
+		gk, ok := objectGroupKind(obj)
+		if !ok || defined[gk] {
+			continue
+		}
+		if _, custom := obj.(*unstructured.Unstructured); !custom {
+			namespaced[gk] = !clusterScopedKinds[gk]
+			continue
+		}
+		if accessor, err := metaAccessor(obj); err == nil && accessor.GetNamespace() != "" {
+			namespaced[gk] = true
+		}
+	}
+
+	return namespaced
+}
+
+// objectGroupKind returns the group and kind of a typed or unstructured object
+func objectGroupKind(obj runtime.Object) (schema.GroupKind, bool) {
+	gvks, _, err := scheme.Scheme.ObjectKinds(obj)
+	if err != nil || len(gvks) == 0 {
+		return schema.GroupKind{}, false
+	}
+	return gvks[0].GroupKind(), true
+}
+
+// offlineAPIResources builds discovery data describing the kinds present in objects
+func offlineAPIResources(objects []runtime.Object, namespaced map[schema.GroupKind]bool) []*metav1.APIResourceList {
+	type resourceKey struct {
+		gv       schema.GroupVersion
+		resource string
+	}
+
+	lists := make(map[schema.GroupVersion]*metav1.APIResourceList)
+	seen := make(map[resourceKey]bool)
+	for _, obj := range objects {
+		gvks, _, err := scheme.Scheme.ObjectKinds(obj)
+		if err != nil || len(gvks) == 0 {
+			continue
+		}
+		gvk := gvks[0]
+
+		plural, _ := meta.UnsafeGuessKindToResource(gvk)
+		key := resourceKey{gvk.GroupVersion(), plural.Resource}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		list, ok := lists[gvk.GroupVersion()]
+		if !ok {
+			list = &metav1.APIResourceList{GroupVersion: gvk.GroupVersion().String()}
+			lists[gvk.GroupVersion()] = list
+		}
+		list.APIResources = append(list.APIResources, metav1.APIResource{
+			Name:       plural.Resource,
+			Kind:       gvk.Kind,
+			Namespaced: namespaced[gvk.GroupKind()],
+			Verbs:      metav1.Verbs{"get", "list", "watch"},
+		})
+	}
+
+	result := make([]*metav1.APIResourceList, 0, len(lists))
+	for _, list := range lists {
+		result = append(result, list)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].GroupVersion < result[j].GroupVersion
+	})
+	return result
+}
+
+// metaAccessor returns the object metadata of a typed object
+func metaAccessor(obj runtime.Object) (metav1.Object, error) {
+	accessor, ok := obj.(metav1.Object)
+	if !ok {
+		return nil, fmt.Errorf("object %T has no metadata", obj)
+	}
+	return accessor, nil
+}
+
+// addEventFieldSelectorReactor makes event lists honour field selectors, which the
+// fake clientset otherwise ignores. Describe views rely on this to show only the
+// events for the selected resource.
+func addEventFieldSelectorReactor(clientset *fake.Clientset) {
+	eventsResource := corev1.SchemeGroupVersion.WithResource("events")
+	eventKind := corev1.SchemeGroupVersion.WithKind("Event")
+
+	clientset.PrependReactor("list", "events", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		listAction, ok := action.(k8stesting.ListAction)
+		if !ok {
+			return false, nil, nil
+		}
+		selector := listAction.GetListRestrictions().Fields
+		if selector == nil || selector.Empty() {
+			return false, nil, nil
+		}
+
+		obj, err := clientset.Tracker().List(eventsResource, eventKind, action.GetNamespace())
+		if err != nil {
+			return true, nil, err
+		}
+
+		list := obj.(*corev1.EventList)
+		filtered := &corev1.EventList{ListMeta: list.ListMeta}
+		for _, event := range list.Items {
+			if selector.Matches(eventFields(&event)) {
+				filtered.Items = append(filtered.Items, event)
+			}
+		}
+
+		return true, filtered, nil
+	})
+}
+
+// eventFields returns the field set the API server supports for event field selectors
+func eventFields(event *corev1.Event) fields.Set {
+	return fields.Set{
+		"metadata.name":                  event.Name,
+		"metadata.namespace":             event.Namespace,
+		"involvedObject.kind":            event.InvolvedObject.Kind,
+		"involvedObject.namespace":       event.InvolvedObject.Namespace,
+		"involvedObject.name":            event.InvolvedObject.Name,
+		"involvedObject.uid":             string(event.InvolvedObject.UID),
+		"involvedObject.apiVersion":      event.InvolvedObject.APIVersion,
+		"involvedObject.resourceVersion": event.InvolvedObject.ResourceVersion,
+		"involvedObject.fieldPath":       event.InvolvedObject.FieldPath,
+		"reason":                         event.Reason,
+		"reportingComponent":             event.ReportingController,
+		"source":                         event.Source.Component,
+		"type":                           event.Type,
+	}
+}
@@ -0,0 +1,351 @@
+package k8s
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const snapshotManifests = `apiVersion: v1
+kind: Pod
+metadata:
+  name: web-0
+  namespace: shop
+  resourceVersion: "42"
+spec:
+  containers:
+  - name: web
+    image: nginx
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: shop
+spec:
+  selector:
+    matchLabels:
+      app: web
+  template:
+    metadata:
+      labels:
+        app: web
+    spec:
+      containers:
+      - name: web
+        image: nginx
+---
+apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: gizmo
+  namespace: shop
+spec:
+  size: 3
+status:
+  conditions:
+  - type: Ready
+    status: "True"
+`
+
+const snapshotListDump = `{
+  "apiVersion": "v1",
+  "kind": "List",
+  "items": [
+    {
+      "apiVersion": "v1",
+      "kind": "Service",
+      "metadata": {"name": "web", "namespace": "shop"},
+      "spec": {"ports": [{"port": 80}]}
+    },
+    {
+      "apiVersion": "v1",
+      "kind": "Event",
+      "metadata": {"name": "web-0.1", "namespace": "shop"},
+      "involvedObject": {"kind": "Pod", "name": "web-0", "namespace": "shop"},
+      "reason": "Started",
+      "type": "Normal"
+    },
+    {
+      "apiVersion": "v1",
+      "kind": "Event",
+      "metadata": {"name": "other.1", "namespace": "shop"},
+      "involvedObject": {"kind": "Pod", "name": "other", "namespace": "shop"},
+      "reason": "BackOff",
+      "type": "Warning"
+    }
+  ]
+}`
+
+const snapshotTypedList = `{
+  "apiVersion": "v1",
+  "kind": "PodList",
+  "items": [
+    {"metadata": {"name": "api-0", "namespace": "backend"}, "spec": {"containers": [{"name": "api", "image": "api"}]}}
+  ]
+}`
+
+// writeSnapshotDir writes the given files into a temp directory and returns its path
+func writeSnapshotDir(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+	return dir
+}
+
+func TestNewSnapshotClientFromDirectory(t *testing.T) {
+	dir := writeSnapshotDir(t, map[string]string{
+		"manifests.yaml": snapshotManifests,
+		"dump.json":      snapshotListDump,
+		"pods.json":      snapshotTypedList,
+		"README.md":      "not a manifest",
+	})
+
+	client, err := NewSnapshotClient(dir, "shop")
+	if err != nil {
+		t.Fatalf("NewSnapshotClient failed: %v", err)
+	}
+
+	ctx := context.Background()
+
+	if !client.IsSnapshot() {
+		t.Error("Expected IsSnapshot to be true")
+	}
+	if client.GetCurrentContext() != "snapshot:"+filepath.Base(dir) {
+		t.Errorf("Unexpected context label %s", client.GetCurrentContext())
+	}
+	if err := client.TestConnection(ctx); err != nil {
+		t.Errorf("TestConnection failed: %v", err)
+	}
+
+	pods, err := client.GetPods(ctx, "")
+	if err != nil {
+		t.Fatalf("GetPods failed: %v", err)
+	}
+	if len(pods.Items) != 1 || pods.Items[0].Name != "web-0" {
+		t.Errorf("Expected pod web-0, got %v", pods.Items)
+	}
+
+	deployments, err := client.GetDeployments(ctx, "")
+	if err != nil {
+		t.Fatalf("GetDeployments failed: %v", err)
+	}
+	if len(deployments.Items) != 1 {
+		t.Errorf("Expected 1 deployment, got %d", len(deployments.Items))
+	}
+
+	services, err := client.GetServices(ctx, "")
+	if err != nil {
+		t.Fatalf("GetServices failed: %v", err)
+	}
+	if len(services.Items) != 1 {
+		t.Errorf("Expected 1 service from List dump, got %d", len(services.Items))
+	}
+
+	// Items in a typed list inherit their kind from the list
+	backendPods, err := client.GetPods(ctx, "backend")
+	if err != nil {
+		t.Fatalf("GetPods failed: %v", err)
+	}
+	if len(backendPods.Items) != 1 || backendPods.Items[0].Name != "api-0" {
+		t.Errorf("Expected pod api-0 from PodList, got %v", backendPods.Items)
+	}
+
+	// Namespaces are synthesized from the objects
+	namespaces, err := client.GetNamespaces(ctx)
+	if err != nil {
+		t.Fatalf("GetNamespaces failed: %v", err)
+	}
+	if len(namespaces.Items) != 2 {
+		t.Errorf("Expected namespaces backend and shop, got %d", len(namespaces.Items))
+	}
+
+	if _, err := client.ForContext("other"); err == nil {
+		t.Error("Expected ForContext to fail in snapshot mode")
+	}
+}
+
+func TestSnapshotClientDescribeFiltersEvents(t *testing.T) {
+	dir := writeSnapshotDir(t, map[string]string{
+		"manifests.yaml": snapshotManifests,
+		"dump.json":      snapshotListDump,
+	})
+
+	client, err := NewSnapshotClient(dir, "shop")
+	if err != nil {
+		t.Fatalf("NewSnapshotClient failed: %v", err)
+	}
+
+	ctx := context.Background()
+
+	events, err := client.GetEventsForResource(ctx, "shop", "Pod", "web-0")
+	if err != nil {
+		t.Fatalf("GetEventsForResource failed: %v", err)
+	}
+	if len(events.Items) != 1 || events.Items[0].Reason != "Started" {
+		t.Errorf("Expected only the web-0 event, got %v", events.Items)
+	}
+
+	allEvents, err := client.GetEvents(ctx, "shop")
+	if err != nil {
+		t.Fatalf("GetEvents failed: %v", err)
+	}
+	if len(allEvents.Items) != 2 {
+		t.Errorf("Expected 2 events without a selector, got %d", len(allEvents.Items))
+	}
+
+	data, err := client.DescribePod(ctx, "shop", "web-0")
+	if err != nil {
+		t.Fatalf("DescribePod failed: %v", err)
+	}
+	if data.Name != "web-0" {
+		t.Errorf("Expected describe data for web-0, got %s", data.Name)
+	}
+}
+
+func TestSnapshotClientWatch(t *testing.T) {
+	dir := writeSnapshotDir(t, map[string]string{"manifests.yaml": snapshotManifests})
+
+	client, err := NewSnapshotClient(dir, "shop")
+	if err != nil {
+		t.Fatalf("NewSnapshotClient failed: %v", err)
+	}
+
+	wm := NewWatchManager(client)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := wm.Start(ctx, []ResourceType{ResourceTypePod}); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	defer wm.Stop()
+
+	select {
+	case event := <-wm.GetEventChannel():
+		if event.EventType != "ADDED" {
+			t.Errorf("Expected ADDED event, got %s", event.EventType)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timeout waiting for snapshot pod event")
+	}
+}
+
+func TestNewSnapshotClientMissingPath(t *testing.T) {
+	if _, err := NewSnapshotClient(filepath.Join(t.TempDir(), "missing"), ""); err == nil {
+		t.Error("Expected error for missing snapshot path")
+	}
+}
+
+func TestNewSnapshotClientSingleFile(t *testing.T) {
+	dir := writeSnapshotDir(t, map[string]string{"dump.json": snapshotListDump})
+
+	client, err := NewSnapshotClient(filepath.Join(dir, "dump.json"), "")
+	if err != nil {
+		t.Fatalf("NewSnapshotClient failed: %v", err)
+	}
+	if client.GetNamespace() != "default" {
+		t.Errorf("Expected default namespace, got %s", client.GetNamespace())
+	}
+	if client.GetCurrentContext() != "snapshot:dump.json" {
+		t.Errorf("Unexpected context label %s", client.GetCurrentContext())
+	}
+}
+
+const snapshotNamespacelessManifests = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: api
+spec:
+  selector:
+    matchLabels:
+      app: api
+  template:
+    metadata:
+      labels:
+        app: api
+    spec:
+      containers:
+      - name: api
+        image: api
+---
+apiVersion: storage.k8s.io/v1
+kind: StorageClass
+metadata:
+  name: fast
+provisioner: example.com/fast
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: gadgets.example.com
+spec:
+  group: example.com
+  scope: Namespaced
+  names:
+    kind: Gadget
+    plural: gadgets
+---
+apiVersion: example.com/v1
+kind: Gadget
+metadata:
+  name: sprocket
+`
+
+func TestSnapshotClientNamespacelessManifests(t *testing.T) {
+	dir := writeSnapshotDir(t, map[string]string{"manifests.yaml": snapshotNamespacelessManifests})
+
+	client, err := NewSnapshotClient(dir, "")
+	if err != nil {
+		t.Fatalf("NewSnapshotClient failed: %v", err)
+	}
+
+	ctx := context.Background()
+
+	// Namespaced kinds without a namespace land in the default namespace, like kubectl apply
+	deployments, err := client.GetDeployments(ctx, "default")
+	if err != nil {
+		t.Fatalf("GetDeployments failed: %v", err)
+	}
+	if len(deployments.Items) != 1 || deployments.Items[0].Name != "api" {
+		t.Errorf("Expected deployment api in default, got %v", deployments.Items)
+	}
+
+	namespaces, err := client.GetNamespaces(ctx)
+	if err != nil {
+		t.Fatalf("GetNamespaces failed: %v", err)
+	}
+	if len(namespaces.Items) != 1 || namespaces.Items[0].Name != "default" {
+		t.Errorf("Expected the default namespace to be synthesized, got %v", namespaces.Items)
+	}
+
+	resources, err := client.DiscoverResources(ctx)
+	if err != nil {
+		t.Fatalf("DiscoverResources failed: %v", err)
+	}
+	scopes := make(map[string]bool)
+	for _, resource := range resources {
+		scopes[resource.Resource] = resource.Namespaced
+	}
+	expected := map[string]bool{"deployments": true, "storageclasses": false, "gadgets": true, "customresourcedefinitions": false}
+	for resource, namespaced := range expected {
+		if got, ok := scopes[resource]; !ok || got != namespaced {
+			t.Errorf("Expected %s to be advertised with Namespaced=%v, got %v (found %v)", resource, namespaced, got, ok)
+		}
+	}
+
+	gadget, err := client.GetDynamic(ctx, APIResource{Group: "example.com", Version: "v1", Resource: "gadgets", Kind: "Gadget", Namespaced: true},
+		"default", "sprocket")
+	if err != nil {
+		t.Fatalf("GetDynamic failed: %v", err)
+	}
+	if gadget.GetNamespace() != "default" {
+		t.Errorf("Expected gadget in default, got %q", gadget.GetNamespace())
+	}
+}
@@ -0,0 +1,121 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+)
+
+// tableAcceptHeader asks the API server to render objects as a Table, the same
+// way kubectl get does. The JSON fallback lets old servers answer with a plain list.
+const tableAcceptHeader = "application/json;as=Table;v=v1;g=meta.k8s.io,application/json"
+
+// tableClient returns a REST client that is not bound to a group version,
+// or nil if the client is not backed by a live API server
+func (c *Client) tableClient() rest.Interface {
+	if c.clientset == nil {
+		return nil
+	}
+	return c.clientset.Discovery().RESTClient()
+}
+
+// SupportsTable reports whether server-side Table output can be requested.
+// Snapshot and replay clients have no API server to render tables.
+func (c *Client) SupportsTable() bool {
+	return c.tableClient() != nil
+}
+
+// ListTable lists objects of any discovered resource type as a server-side Table.
+// Columns include CRD additionalPrinterColumns, and each row carries the full object.
+func (c *Client) ListTable(ctx context.Context, resource APIResource, namespace string) (*metav1.Table, error) {
+	table, err := c.getTable(ctx, resource, namespace, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", resource.DisplayName(), err)
+	}
+	return table, nil
+}
+
+// GetTable retrieves a single object as a one-row server-side Table
+func (c *Client) GetTable(ctx context.Context, resource APIResource, namespace, name string) (*metav1.Table, error) {
+	table, err := c.getTable(ctx, resource, namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s %s: %w", resource.Kind, name, err)
+	}
+	return table, nil
+}
+
+// getTable requests a Table for a resource collection, or for one object if name is set
+func (c *Client) getTable(ctx context.Context, resource APIResource, namespace, name string) (*metav1.Table, error) {
+	client := c.tableClient()
+	if client == nil {
+		return nil, fmt.Errorf("server-side table output not available")
+	}
+
+	data, err := client.Get().
+		AbsPath(resourcePath(resource, c.resolveNamespace(namespace), name)...).
+		Param("includeObject", "Object").
+		SetHeader("Accept", tableAcceptHeader).
+		DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var table metav1.Table
+	if err := json.Unmarshal(data, &table); err != nil {
+		return nil, fmt.Errorf("failed to decode table: %w", err)
+	}
+	if table.Kind != "Table" {
+		return nil, fmt.Errorf("server returned %s instead of a Table", table.Kind)
+	}
+	if resource.IsSecret() {
+		if err := redactTableRows(&table); err != nil {
+			return nil, err
+		}
+	}
+
+	return &table, nil
+}
+
+// redactTableRows redacts the secret carried by each row of a Table of secrets
+func redactTableRows(table *metav1.Table) error {
+	for i := range table.Rows {
+		raw := table.Rows[i].Object.Raw
+		if len(raw) == 0 {
+			continue
+		}
+
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(raw); err != nil {
+			return fmt.Errorf("failed to decode table row: %w", err)
+		}
+		redactSecretObject(obj.Object)
+		redacted, err := obj.MarshalJSON()
+		if err != nil {
+			return fmt.Errorf("failed to encode table row: %w", err)
+		}
+		table.Rows[i].Object.Raw = redacted
+	}
+	return nil
+}
+
+// resourcePath builds the REST path segments for a resource collection or object
+func resourcePath(resource APIResource, namespace, name string) []string {
+	var segments []string
+	if resource.Group == "" {
+		segments = []string{"/api", resource.Version}
+	} else {
+		segments = []string{"/apis", resource.Group, resource.Version}
+	}
+	if resource.Namespaced {
+		segments = append(segments, "namespaces", namespace)
+	}
+	segments = append(segments, resource.Resource)
+	if name != "" {
+		segments = append(segments, name)
+	}
+	return segments
+}
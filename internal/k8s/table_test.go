@@ -0,0 +1,150 @@
+package k8s
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+const testCertificateTable = `{
+  "kind": "Table",
+  "apiVersion": "meta.k8s.io/v1",
+  "columnDefinitions": [
+    {"name": "Name", "type": "string", "format": "name", "priority": 0},
+    {"name": "Ready", "type": "string", "priority": 0},
+    {"name": "Secret", "type": "string", "priority": 0},
+    {"name": "Issuer", "type": "string", "priority": 1},
+    {"name": "Age", "type": "date", "priority": 0}
+  ],
+  "rows": [
+    {
+      "cells": ["web-tls", "True", "web-tls", "letsencrypt", "3d"],
+      "object": {
+        "apiVersion": "cert-manager.io/v1",
+        "kind": "Certificate",
+        "metadata": {"name": "web-tls", "namespace": "shop"}
+      }
+    }
+  ]
+}`
+
+// newTableTestClient returns a client whose API server answers every request with body,
+// recording the last request it received
+func newTableTestClient(t *testing.T, body string, lastRequest **http.Request) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*lastRequest = r
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create clientset: %v", err)
+	}
+
+	client := &Client{namespace: "shop"}
+	client.SetClientsetForTesting(clientset)
+	return client
+}
+
+func TestListTable(t *testing.T) {
+	var request *http.Request
+	client := newTableTestClient(t, testCertificateTable, &request)
+
+	if !client.SupportsTable() {
+		t.Fatal("Expected a live client to support tables")
+	}
+
+	certificates := APIResource{Group: "cert-manager.io", Version: "v1", Resource: "certificates", Kind: "Certificate", Namespaced: true}
+	table, err := client.ListTable(context.Background(), certificates, "")
+	if err != nil {
+		t.Fatalf("ListTable failed: %v", err)
+	}
+
+	if request.URL.Path != "/apis/cert-manager.io/v1/namespaces/shop/certificates" {
+		t.Errorf("Unexpected request path %s", request.URL.Path)
+	}
+	if !strings.Contains(request.Header.Get("Accept"), "as=Table") {
+		t.Errorf("Expected Table Accept header, got %s", request.Header.Get("Accept"))
+	}
+	if request.URL.Query().Get("includeObject") != "Object" {
+		t.Errorf("Expected includeObject=Object, got %s", request.URL.RawQuery)
+	}
+
+	if len(table.ColumnDefinitions) != 5 || table.ColumnDefinitions[1].Name != "Ready" {
+		t.Errorf("Unexpected columns %v", table.ColumnDefinitions)
+	}
+	if len(table.Rows) != 1 || table.Rows[0].Cells[0] != "web-tls" {
+		t.Errorf("Unexpected rows %v", table.Rows)
+	}
+	if len(table.Rows[0].Object.Raw) == 0 {
+		t.Error("Expected rows to carry the full object")
+	}
+}
+
+func TestGetTableClusterScoped(t *testing.T) {
+	var request *http.Request
+	client := newTableTestClient(t, testCertificateTable, &request)
+
+	nodes := APIResource{Version: "v1", Resource: "nodes", Kind: "Node"}
+	if _, err := client.GetTable(context.Background(), nodes, "", "node-1"); err != nil {
+		t.Fatalf("GetTable failed: %v", err)
+	}
+	if request.URL.Path != "/api/v1/nodes/node-1" {
+		t.Errorf("Unexpected request path %s", request.URL.Path)
+	}
+}
+
+func TestListTableRedactsSecrets(t *testing.T) {
+	var request *http.Request
+	client := newTableTestClient(t, `{
+  "kind": "Table",
+  "apiVersion": "meta.k8s.io/v1",
+  "columnDefinitions": [{"name": "Name", "type": "string", "format": "name", "priority": 0}],
+  "rows": [{
+    "cells": ["db"],
+    "object": {"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "db", "namespace": "shop"},
+      "data": {"password": "aHVudGVyMg=="}}
+  }]
+}`, &request)
+
+	secrets := APIResource{Version: "v1", Resource: "secrets", Kind: "Secret", Namespaced: true}
+	table, err := client.ListTable(context.Background(), secrets, "")
+	if err != nil {
+		t.Fatalf("ListTable failed: %v", err)
+	}
+	row := string(table.Rows[0].Object.Raw)
+	if strings.Contains(row, "aHVudGVyMg==") || !strings.Contains(row, "REDACTED (7 bytes)") {
+		t.Errorf("Expected the secret in the row to be redacted, got %s", row)
+	}
+}
+
+func TestListTableRejectsPlainList(t *testing.T) {
+	var request *http.Request
+	client := newTableTestClient(t, `{"kind": "CertificateList", "apiVersion": "cert-manager.io/v1", "items": []}`, &request)
+
+	certificates := APIResource{Group: "cert-manager.io", Version: "v1", Resource: "certificates", Namespaced: true}
+	if _, err := client.ListTable(context.Background(), certificates, ""); err == nil {
+		t.Error("Expected error when the server does not return a Table")
+	}
+}
+
+func TestSnapshotClientDoesNotSupportTable(t *testing.T) {
+	client := newTestDynamicClient(t)
+	if client.SupportsTable() {
+		t.Error("Expected snapshot client not to support tables")
+	}
+
+	pods := APIResource{Version: "v1", Resource: "pods", Namespaced: true}
+	if _, err := client.ListTable(context.Background(), pods, ""); err == nil {
+		t.Error("Expected ListTable to fail without an API server")
+	}
+}
@@ -97,6 +97,366 @@ func (c *Client) WatchStatefulSets(ctx context.Context, namespace string, resour
 	return watcher, nil
 }
 
+// WatchConfigMaps creates a watch for configmaps in the specified namespace.
+func (c *Client) WatchConfigMaps(ctx context.Context, namespace string, resourceVersion string) (watch.Interface, error) {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	opts := metav1.ListOptions{
+		ResourceVersion: resourceVersion,
+		TimeoutSeconds:  int64ptr(int64(DefaultWatchTimeout.Seconds())),
+		Watch:           true,
+	}
+
+	watcher, err := c.clientset.CoreV1().ConfigMaps(namespace).Watch(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch configmaps: %w", err)
+	}
+
+	return watcher, nil
+}
+
+// WatchSecrets creates a watch for secrets in the specified namespace.
+func (c *Client) WatchSecrets(ctx context.Context, namespace string, resourceVersion string) (watch.Interface, error) {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	opts := metav1.ListOptions{
+		ResourceVersion: resourceVersion,
+		TimeoutSeconds:  int64ptr(int64(DefaultWatchTimeout.Seconds())),
+		Watch:           true,
+	}
+
+	watcher, err := c.clientset.CoreV1().Secrets(namespace).Watch(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch secrets: %w", err)
+	}
+
+	return watcher, nil
+}
+
+// WatchJobs creates a watch for jobs in the specified namespace.
+func (c *Client) WatchJobs(ctx context.Context, namespace string, resourceVersion string) (watch.Interface, error) {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	opts := metav1.ListOptions{
+		ResourceVersion: resourceVersion,
+		TimeoutSeconds:  int64ptr(int64(DefaultWatchTimeout.Seconds())),
+		Watch:           true,
+	}
+
+	watcher, err := c.clientset.BatchV1().Jobs(namespace).Watch(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch jobs: %w", err)
+	}
+
+	return watcher, nil
+}
+
+// WatchCronJobs creates a watch for cronjobs in the specified namespace.
+func (c *Client) WatchCronJobs(ctx context.Context, namespace string, resourceVersion string) (watch.Interface, error) {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	opts := metav1.ListOptions{
+		ResourceVersion: resourceVersion,
+		TimeoutSeconds:  int64ptr(int64(DefaultWatchTimeout.Seconds())),
+		Watch:           true,
+	}
+
+	watcher, err := c.clientset.BatchV1().CronJobs(namespace).Watch(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch cronjobs: %w", err)
+	}
+
+	return watcher, nil
+}
+
+// WatchDaemonSets creates a watch for daemonsets in the specified namespace.
+func (c *Client) WatchDaemonSets(ctx context.Context, namespace string, resourceVersion string) (watch.Interface, error) {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	opts := metav1.ListOptions{
+		ResourceVersion: resourceVersion,
+		TimeoutSeconds:  int64ptr(int64(DefaultWatchTimeout.Seconds())),
+		Watch:           true,
+	}
+
+	watcher, err := c.clientset.AppsV1().DaemonSets(namespace).Watch(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch daemonsets: %w", err)
+	}
+
+	return watcher, nil
+}
+
+// WatchReplicaSets creates a watch for replicasets in the specified namespace.
+func (c *Client) WatchReplicaSets(ctx context.Context, namespace string, resourceVersion string) (watch.Interface, error) {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	opts := metav1.ListOptions{
+		ResourceVersion: resourceVersion,
+		TimeoutSeconds:  int64ptr(int64(DefaultWatchTimeout.Seconds())),
+		Watch:           true,
+	}
+
+	watcher, err := c.clientset.AppsV1().ReplicaSets(namespace).Watch(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch replicasets: %w", err)
+	}
+
+	return watcher, nil
+}
+
+// WatchIngresses creates a watch for ingresses in the specified namespace.
+func (c *Client) WatchIngresses(ctx context.Context, namespace string, resourceVersion string) (watch.Interface, error) {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	opts := metav1.ListOptions{
+		ResourceVersion: resourceVersion,
+		TimeoutSeconds:  int64ptr(int64(DefaultWatchTimeout.Seconds())),
+		Watch:           true,
+	}
+
+	watcher, err := c.clientset.NetworkingV1().Ingresses(namespace).Watch(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch ingresses: %w", err)
+	}
+
+	return watcher, nil
+}
+
+// WatchPersistentVolumeClaims creates a watch for persistentvolumeclaims in the specified namespace.
+func (c *Client) WatchPersistentVolumeClaims(ctx context.Context, namespace string, resourceVersion string) (watch.Interface, error) {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	opts := metav1.ListOptions{
+		ResourceVersion: resourceVersion,
+		TimeoutSeconds:  int64ptr(int64(DefaultWatchTimeout.Seconds())),
+		Watch:           true,
+	}
+
+	watcher, err := c.clientset.CoreV1().PersistentVolumeClaims(namespace).Watch(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch persistentvolumeclaims: %w", err)
+	}
+
+	return watcher, nil
+}
+
+// WatchHorizontalPodAutoscalers creates a watch for horizontalpodautoscalers in the specified namespace.
+func (c *Client) WatchHorizontalPodAutoscalers(ctx context.Context, namespace string, resourceVersion string) (watch.Interface, error) {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	opts := metav1.ListOptions{
+		ResourceVersion: resourceVersion,
+		TimeoutSeconds:  int64ptr(int64(DefaultWatchTimeout.Seconds())),
+		Watch:           true,
+	}
+
+	watcher, err := c.clientset.AutoscalingV2().HorizontalPodAutoscalers(namespace).Watch(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch horizontalpodautoscalers: %w", err)
+	}
+
+	return watcher, nil
+}
+
+// WatchServiceAccounts creates a watch for serviceaccounts in the specified namespace.
+func (c *Client) WatchServiceAccounts(ctx context.Context, namespace string, resourceVersion string) (watch.Interface, error) {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	opts := metav1.ListOptions{
+		ResourceVersion: resourceVersion,
+		TimeoutSeconds:  int64ptr(int64(DefaultWatchTimeout.Seconds())),
+		Watch:           true,
+	}
+
+	watcher, err := c.clientset.CoreV1().ServiceAccounts(namespace).Watch(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch serviceaccounts: %w", err)
+	}
+
+	return watcher, nil
+}
+
+// WatchRoles creates a watch for roles in the specified namespace.
+func (c *Client) WatchRoles(ctx context.Context, namespace string, resourceVersion string) (watch.Interface, error) {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	opts := metav1.ListOptions{
+		ResourceVersion: resourceVersion,
+		TimeoutSeconds:  int64ptr(int64(DefaultWatchTimeout.Seconds())),
+		Watch:           true,
+	}
+
+	watcher, err := c.clientset.RbacV1().Roles(namespace).Watch(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch roles: %w", err)
+	}
+
+	return watcher, nil
+}
+
+// WatchRoleBindings creates a watch for rolebindings in the specified namespace.
+func (c *Client) WatchRoleBindings(ctx context.Context, namespace string, resourceVersion string) (watch.Interface, error) {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	opts := metav1.ListOptions{
+		ResourceVersion: resourceVersion,
+		TimeoutSeconds:  int64ptr(int64(DefaultWatchTimeout.Seconds())),
+		Watch:           true,
+	}
+
+	watcher, err := c.clientset.RbacV1().RoleBindings(namespace).Watch(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch rolebindings: %w", err)
+	}
+
+	return watcher, nil
+}
+
+// WatchNetworkPolicies creates a watch for networkpolicies in the specified namespace.
+func (c *Client) WatchNetworkPolicies(ctx context.Context, namespace string, resourceVersion string) (watch.Interface, error) {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	opts := metav1.ListOptions{
+		ResourceVersion: resourceVersion,
+		TimeoutSeconds:  int64ptr(int64(DefaultWatchTimeout.Seconds())),
+		Watch:           true,
+	}
+
+	watcher, err := c.clientset.NetworkingV1().NetworkPolicies(namespace).Watch(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch networkpolicies: %w", err)
+	}
+
+	return watcher, nil
+}
+
+// WatchPodDisruptionBudgets creates a watch for poddisruptionbudgets in the specified namespace.
+func (c *Client) WatchPodDisruptionBudgets(ctx context.Context, namespace string, resourceVersion string) (watch.Interface, error) {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	opts := metav1.ListOptions{
+		ResourceVersion: resourceVersion,
+		TimeoutSeconds:  int64ptr(int64(DefaultWatchTimeout.Seconds())),
+		Watch:           true,
+	}
+
+	watcher, err := c.clientset.PolicyV1().PodDisruptionBudgets(namespace).Watch(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch poddisruptionbudgets: %w", err)
+	}
+
+	return watcher, nil
+}
+
+// WatchNodes creates a watch for nodes. Nodes are cluster-scoped, so no namespace is needed.
+func (c *Client) WatchNodes(ctx context.Context, resourceVersion string) (watch.Interface, error) {
+	opts := metav1.ListOptions{
+		ResourceVersion: resourceVersion,
+		TimeoutSeconds:  int64ptr(int64(DefaultWatchTimeout.Seconds())),
+		Watch:           true,
+	}
+
+	watcher, err := c.clientset.CoreV1().Nodes().Watch(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch nodes: %w", err)
+	}
+
+	return watcher, nil
+}
+
+// WatchPersistentVolumes creates a watch for persistentvolumes. PersistentVolumes are cluster-scoped, so no namespace is needed.
+func (c *Client) WatchPersistentVolumes(ctx context.Context, resourceVersion string) (watch.Interface, error) {
+	opts := metav1.ListOptions{
+		ResourceVersion: resourceVersion,
+		TimeoutSeconds:  int64ptr(int64(DefaultWatchTimeout.Seconds())),
+		Watch:           true,
+	}
+
+	watcher, err := c.clientset.CoreV1().PersistentVolumes().Watch(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch persistentvolumes: %w", err)
+	}
+
+	return watcher, nil
+}
+
+// WatchStorageClasses creates a watch for storageclasses. StorageClasses are cluster-scoped, so no namespace is needed.
+func (c *Client) WatchStorageClasses(ctx context.Context, resourceVersion string) (watch.Interface, error) {
+	opts := metav1.ListOptions{
+		ResourceVersion: resourceVersion,
+		TimeoutSeconds:  int64ptr(int64(DefaultWatchTimeout.Seconds())),
+		Watch:           true,
+	}
+
+	watcher, err := c.clientset.StorageV1().StorageClasses().Watch(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch storageclasses: %w", err)
+	}
+
+	return watcher, nil
+}
+
+// WatchClusterRoles creates a watch for clusterroles. ClusterRoles are cluster-scoped, so no namespace is needed.
+func (c *Client) WatchClusterRoles(ctx context.Context, resourceVersion string) (watch.Interface, error) {
+	opts := metav1.ListOptions{
+		ResourceVersion: resourceVersion,
+		TimeoutSeconds:  int64ptr(int64(DefaultWatchTimeout.Seconds())),
+		Watch:           true,
+	}
+
+	watcher, err := c.clientset.RbacV1().ClusterRoles().Watch(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch clusterroles: %w", err)
+	}
+
+	return watcher, nil
+}
+
+// WatchClusterRoleBindings creates a watch for clusterrolebindings. ClusterRoleBindings are cluster-scoped, so no namespace is needed.
+func (c *Client) WatchClusterRoleBindings(ctx context.Context, resourceVersion string) (watch.Interface, error) {
+	opts := metav1.ListOptions{
+		ResourceVersion: resourceVersion,
+		TimeoutSeconds:  int64ptr(int64(DefaultWatchTimeout.Seconds())),
+		Watch:           true,
+	}
+
+	watcher, err := c.clientset.RbacV1().ClusterRoleBindings().Watch(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch clusterrolebindings: %w", err)
+	}
+
+	return watcher, nil
+}
+
 // WatchEvents creates a watch for events in the specified namespace.
 func (c *Client) WatchEvents(ctx context.Context, namespace string, resourceVersion string) (watch.Interface, error) {
 	if namespace == "" {
@@ -14,9 +14,15 @@ type WatchManager struct {
 	eventChan   chan WatchEvent
 	errorChan   chan WatchError
 	mu          sync.RWMutex
+	parentCtx   context.Context // Context passed to Start, reused on restart
 	ctx         context.Context
 	cancelAll   context.CancelFunc
 	debugMode   bool
+	recorder    *EventRecorder     // Optional, records every emitted event
+	recordChan  chan WatchEvent    // Watchers write here while recording
+	replayer    *EventReplayer     // Optional, replaces the watchers with a recording
+	replayStop  context.CancelFunc // Stops the replay; set once it has started
+	dynamicRes  *APIResource       // Resource served by the ResourceTypeGeneric watcher
 }
 
 // NewWatchManager creates a new watch manager
@@ -44,6 +50,26 @@ func (wm *WatchManager) SetDebugMode(enabled bool) {
 	}
 }
 
+// SetRecorder records every event the manager emits. Must be called before Start.
+func (wm *WatchManager) SetRecorder(recorder *EventRecorder) {
+	wm.mu.Lock()
+	defer wm.mu.Unlock()
+
+	wm.recorder = recorder
+	if wm.recordChan == nil {
+		wm.recordChan = make(chan WatchEvent, 100)
+	}
+}
+
+// SetReplayer makes Start replay a recording through the event channel
+// instead of watching the cluster. Must be called before Start.
+func (wm *WatchManager) SetReplayer(replayer *EventReplayer) {
+	wm.mu.Lock()
+	defer wm.mu.Unlock()
+
+	wm.replayer = replayer
+}
+
 // Start begins watching the specified resource types
 // This spawns a goroutine for each resource type
 func (wm *WatchManager) Start(ctx context.Context, resourceTypes []ResourceType) error {
@@ -51,8 +77,24 @@ func (wm *WatchManager) Start(ctx context.Context, resourceTypes []ResourceType)
 	defer wm.mu.Unlock()
 
 	// Create cancellable context for all watchers
+	wm.parentCtx = ctx
 	wm.ctx, wm.cancelAll = context.WithCancel(ctx)
 
+	// Replay the recording instead of watching. The replay runs once, across
+	// restarts, so a namespace change carries on from the current position.
+	if wm.replayer != nil {
+		if wm.replayStop == nil {
+			var replayCtx context.Context
+			replayCtx, wm.replayStop = context.WithCancel(ctx)
+			go wm.replayer.run(replayCtx, wm.eventChan, wm.inSelectedNamespace)
+		}
+		return nil
+	}
+
+	if wm.recorder != nil {
+		go wm.recordLoop(wm.ctx, wm.recorder)
+	}
+
 	// Start a watcher for each resource type
 	for _, rt := range resourceTypes {
 		if err := wm.startWatcherLocked(rt); err != nil {
@@ -75,6 +117,12 @@ func (wm *WatchManager) startWatcherLocked(rt ResourceType) error {
 	// Create watcher
 	watcher := NewResourceWatcher(wm.client, rt, wm.client.GetNamespace())
 	watcher.SetDebugMode(wm.debugMode)
+	if rt == ResourceTypeGeneric {
+		if wm.dynamicRes == nil {
+			return fmt.Errorf("no resource selected for %s watcher", rt)
+		}
+		watcher.SetAPIResource(*wm.dynamicRes)
+	}
 
 	// Create context for this specific watcher
 	watcherCtx, watcherCancel := context.WithCancel(wm.ctx)
@@ -83,12 +131,40 @@ func (wm *WatchManager) startWatcherLocked(rt ResourceType) error {
 	wm.watchers[rt] = watcher
 	wm.cancelFuncs[rt] = watcherCancel
 
-	// Start the watcher
-	watcher.Start(watcherCtx, wm.eventChan, wm.errorChan)
+	// Start the watcher, routing events through the recorder if one is set
+	eventChan := wm.eventChan
+	if wm.recorder != nil {
+		eventChan = wm.recordChan
+	}
+	watcher.Start(watcherCtx, eventChan, wm.errorChan)
 
 	return nil
 }
 
+// recordLoop records each watcher event and forwards it to the event channel
+func (wm *WatchManager) recordLoop(ctx context.Context, recorder *EventRecorder) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-wm.recordChan:
+			if err := recorder.Record(event); err != nil {
+				// Report but keep the UI updating
+				select {
+				case wm.errorChan <- WatchError{ResourceType: event.ResourceType, Err: err}:
+				default:
+				}
+			}
+
+			select {
+			case wm.eventChan <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
 // Stop gracefully stops all watchers
 // Note: Channels are not closed to avoid send-on-closed-channel panics from
 // goroutines that may still be running. The channels will be garbage collected
@@ -98,6 +174,26 @@ func (wm *WatchManager) Stop() {
 	defer wm.mu.Unlock()
 
 	wm.stopAllLocked()
+	if wm.replayStop != nil {
+		wm.replayStop()
+	}
+}
+
+// inSelectedNamespace reports whether a replayed event belongs to the namespace the
+// client is scoped to. Cluster-scoped objects and an all-namespaces client match.
+func (wm *WatchManager) inSelectedNamespace(event WatchEvent) bool {
+	wm.mu.RLock()
+	namespace := wm.client.GetNamespace()
+	wm.mu.RUnlock()
+
+	if namespace == "" {
+		return true
+	}
+	accessor, err := metaAccessor(event.Object)
+	if err != nil {
+		return true
+	}
+	return accessor.GetNamespace() == "" || accessor.GetNamespace() == namespace
 }
 
 // stopAllLocked stops all watchers (must be called with lock held)
@@ -138,6 +234,47 @@ func (wm *WatchManager) RestartWatcher(resourceType ResourceType) error {
 	return wm.startWatcherLocked(resourceType)
 }
 
+// WatchDynamic points the ResourceTypeGeneric watcher at a discovered resource,
+// replacing whatever it was watching before. If the manager has not been
+// started yet, the resource is remembered and watched once it starts.
+func (wm *WatchManager) WatchDynamic(resource APIResource) error {
+	wm.mu.Lock()
+	defer wm.mu.Unlock()
+
+	wm.dynamicRes = &resource
+	if wm.ctx == nil || wm.ctx.Err() != nil || wm.replayer != nil {
+		return nil
+	}
+
+	if watcher, exists := wm.watchers[ResourceTypeGeneric]; exists {
+		watcher.Stop()
+		if cancel, ok := wm.cancelFuncs[ResourceTypeGeneric]; ok {
+			cancel()
+		}
+		delete(wm.watchers, ResourceTypeGeneric)
+		delete(wm.cancelFuncs, ResourceTypeGeneric)
+	}
+
+	return wm.startWatcherLocked(ResourceTypeGeneric)
+}
+
+// ClearDynamic stops the ResourceTypeGeneric watcher and forgets its resource, so
+// that it is not restarted, e.g. against a cluster that may not serve it
+func (wm *WatchManager) ClearDynamic() {
+	wm.mu.Lock()
+	defer wm.mu.Unlock()
+
+	wm.dynamicRes = nil
+	if watcher, exists := wm.watchers[ResourceTypeGeneric]; exists {
+		watcher.Stop()
+		if cancel, ok := wm.cancelFuncs[ResourceTypeGeneric]; ok {
+			cancel()
+		}
+		delete(wm.watchers, ResourceTypeGeneric)
+		delete(wm.cancelFuncs, ResourceTypeGeneric)
+	}
+}
+
 // RestartAll restarts all watchers (e.g., after namespace change)
 func (wm *WatchManager) RestartAll() error {
 	wm.mu.Lock()
@@ -148,8 +285,9 @@ func (wm *WatchManager) RestartAll() error {
 		resourceTypes = append(resourceTypes, rt)
 	}
 
-	// Capture ctx while holding lock to avoid TOCTOU race
-	ctx := wm.ctx
+	// Capture the parent ctx while holding lock to avoid TOCTOU race.
+	// wm.ctx itself is canceled by stopAllLocked and cannot be reused.
+	ctx := wm.parentCtx
 
 	// Stop all watchers
 	wm.stopAllLocked()
@@ -163,6 +301,55 @@ func (wm *WatchManager) RestartAll() error {
 	return fmt.Errorf("watch manager not started")
 }
 
+// SwitchClient stops all watchers, replaces the client they use and restarts
+// them against the new cluster (e.g., after a kube context change).
+// If the manager has not been started yet, only the client is replaced.
+func (wm *WatchManager) SwitchClient(client *Client) error {
+	wm.mu.Lock()
+
+	// Get current resource types
+	resourceTypes := make([]ResourceType, 0, len(wm.watchers))
+	for rt := range wm.watchers {
+		resourceTypes = append(resourceTypes, rt)
+	}
+
+	// Capture the parent ctx while holding lock to avoid TOCTOU race
+	ctx := wm.parentCtx
+
+	wm.stopAllLocked()
+	wm.client = client
+
+	// Discard anything still buffered from the previous cluster
+	wm.drainChannelsLocked()
+	wm.mu.Unlock()
+
+	if ctx == nil {
+		return nil
+	}
+
+	return wm.Start(ctx, resourceTypes)
+}
+
+// drainChannelsLocked discards buffered events and errors (must be called with lock held)
+func (wm *WatchManager) drainChannelsLocked() {
+	for {
+		select {
+		case <-wm.eventChan:
+		case <-wm.errorChan:
+		case <-wm.recordChan:
+		default:
+			return
+		}
+	}
+}
+
+// GetClient returns the client currently used by the watchers
+func (wm *WatchManager) GetClient() *Client {
+	wm.mu.RLock()
+	defer wm.mu.RUnlock()
+	return wm.client
+}
+
 // GetEventChannel returns the channel for receiving watch events
 func (wm *WatchManager) GetEventChannel() <-chan WatchEvent {
 	return wm.eventChan
@@ -186,17 +373,44 @@ func (wm *WatchManager) GetConnectionStates() map[ResourceType]ConnectionState {
 }
 
 // GetOverallConnectionState returns an aggregate connection state
+// Forbidden watchers are ignored unless every watcher is forbidden
 // If any watcher is in error state, returns StateError
 // If any watcher is reconnecting, returns StateReconnecting
 // If all watchers are connected, returns StateConnected
 // Otherwise returns StateDisconnected
 func (wm *WatchManager) GetOverallConnectionState() ConnectionState {
+	if wm.IsReplaying() {
+		return StateConnected
+	}
+
 	states := wm.GetConnectionStates()
 
+	stateList := make([]ConnectionState, 0, len(states))
+	for _, state := range states {
+		stateList = append(stateList, state)
+	}
+
+	return aggregateConnectionStates(stateList)
+}
+
+// aggregateConnectionStates reduces individual watcher states to a single state
+func aggregateConnectionStates(states []ConnectionState) ConnectionState {
 	if len(states) == 0 {
 		return StateDisconnected
 	}
 
+	// Resource types the user may not watch say nothing about the connection
+	available := make([]ConnectionState, 0, len(states))
+	for _, state := range states {
+		if state != StateForbidden {
+			available = append(available, state)
+		}
+	}
+	if len(available) == 0 {
+		return StateError
+	}
+	states = available
+
 	hasError := false
 	hasReconnecting := false
 	hasConnecting := false
@@ -232,6 +446,13 @@ func (wm *WatchManager) GetOverallConnectionState() ConnectionState {
 	return StateDisconnected
 }
 
+// IsReplaying returns true if the manager is replaying a recording
+func (wm *WatchManager) IsReplaying() bool {
+	wm.mu.RLock()
+	defer wm.mu.RUnlock()
+	return wm.replayer != nil && wm.ctx != nil && wm.ctx.Err() == nil
+}
+
 // GetWatcherCount returns the number of active watchers
 func (wm *WatchManager) GetWatcherCount() int {
 	wm.mu.RLock()
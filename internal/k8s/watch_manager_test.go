@@ -2,12 +2,17 @@ package k8s
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 )
 
 func TestNewWatchManager(t *testing.T) {
@@ -803,3 +808,128 @@ func TestWatchManagerDebugModePropagation(t *testing.T) {
 		t.Error("Expected debugMode to be false after disabling")
 	}
 }
+
+func TestWatchManagerSwitchClient(t *testing.T) {
+	oldPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "old-pod", Namespace: "default", ResourceVersion: "1"},
+	}
+	newPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "new-pod", Namespace: "default", ResourceVersion: "1"},
+	}
+
+	oldClient := &Client{clientset: fake.NewSimpleClientset(oldPod), namespace: "default"}
+	newClient := &Client{clientset: fake.NewSimpleClientset(newPod), namespace: "default"}
+
+	wm := NewWatchManager(oldClient)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := wm.Start(ctx, []ResourceType{ResourceTypePod}); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+
+	// Let the old watcher emit its initial list without consuming it
+	time.Sleep(100 * time.Millisecond)
+
+	if err := wm.SwitchClient(newClient); err != nil {
+		t.Fatalf("SwitchClient failed: %v", err)
+	}
+	defer wm.Stop()
+
+	if wm.GetClient() != newClient {
+		t.Error("Expected watch manager to use the new client")
+	}
+	if !wm.IsWatching(ResourceTypePod) {
+		t.Error("Expected pod watcher to be restarted")
+	}
+
+	select {
+	case event := <-wm.GetEventChannel():
+		pod, ok := event.Object.(*corev1.Pod)
+		if !ok {
+			t.Fatalf("Expected *corev1.Pod, got %T", event.Object)
+		}
+		if pod.Name != "new-pod" {
+			t.Errorf("Expected event from new cluster, got pod %s", pod.Name)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timeout waiting for event from new client")
+	}
+}
+
+func TestWatchManagerSwitchClientNotStarted(t *testing.T) {
+	oldClient := &Client{clientset: fake.NewSimpleClientset(), namespace: "default"}
+	newClient := &Client{clientset: fake.NewSimpleClientset(), namespace: "default"}
+
+	wm := NewWatchManager(oldClient)
+
+	if err := wm.SwitchClient(newClient); err != nil {
+		t.Fatalf("SwitchClient on unstarted manager failed: %v", err)
+	}
+	if wm.GetClient() != newClient {
+		t.Error("Expected client to be replaced")
+	}
+	if wm.GetWatcherCount() != 0 {
+		t.Errorf("Expected no watchers, got %d", wm.GetWatcherCount())
+	}
+}
+
+func TestWatchManagerForbiddenWatcherExcludedFromState(t *testing.T) {
+	fakeClientset := fake.NewSimpleClientset()
+	fakeClientset.PrependReactor("list", "nodes", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewForbidden(schema.GroupResource{Resource: "nodes"}, "", errors.New("namespace-scoped role"))
+	})
+	client := &Client{clientset: fakeClientset, namespace: "default"}
+
+	wm := NewWatchManager(client)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := wm.Start(ctx, []ResourceType{ResourceTypePod, ResourceTypeNode}); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	defer wm.Stop()
+
+	select {
+	case watchErr := <-wm.GetErrorChannel():
+		if watchErr.ResourceType != ResourceTypeNode || !watchErr.Fatal {
+			t.Fatalf("Expected a fatal node error, got %+v", watchErr)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timeout waiting for the forbidden error")
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for wm.GetConnectionStates()[ResourceTypePod] != StateConnected && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if state := wm.GetConnectionStates()[ResourceTypeNode]; state != StateForbidden {
+		t.Errorf("Expected node watcher to be Forbidden, got %v", state)
+	}
+	if state := wm.GetOverallConnectionState(); state != StateConnected {
+		t.Errorf("Expected overall state Connected, got %v", state)
+	}
+}
+
+func TestAggregateConnectionStatesForbidden(t *testing.T) {
+	tests := []struct {
+		name     string
+		states   []ConnectionState
+		expected ConnectionState
+	}{
+		{"forbidden ignored", []ConnectionState{StateConnected, StateForbidden}, StateConnected},
+		{"error still wins", []ConnectionState{StateError, StateForbidden, StateConnected}, StateError},
+		{"all forbidden", []ConnectionState{StateForbidden, StateForbidden}, StateError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := aggregateConnectionStates(tt.states); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
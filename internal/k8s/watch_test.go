@@ -7,6 +7,7 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes/fake"
@@ -386,6 +387,76 @@ func TestWatchWithDifferentResourceTypes(t *testing.T) {
 			watchFunc:    (*Client).WatchEvents,
 			resourceType: "Event",
 		},
+		{
+			name:         "ConfigMaps",
+			watchFunc:    (*Client).WatchConfigMaps,
+			resourceType: "ConfigMap",
+		},
+		{
+			name:         "Secrets",
+			watchFunc:    (*Client).WatchSecrets,
+			resourceType: "Secret",
+		},
+		{
+			name:         "Jobs",
+			watchFunc:    (*Client).WatchJobs,
+			resourceType: "Job",
+		},
+		{
+			name:         "CronJobs",
+			watchFunc:    (*Client).WatchCronJobs,
+			resourceType: "CronJob",
+		},
+		{
+			name:         "DaemonSets",
+			watchFunc:    (*Client).WatchDaemonSets,
+			resourceType: "DaemonSet",
+		},
+		{
+			name:         "ReplicaSets",
+			watchFunc:    (*Client).WatchReplicaSets,
+			resourceType: "ReplicaSet",
+		},
+		{
+			name:         "Ingresses",
+			watchFunc:    (*Client).WatchIngresses,
+			resourceType: "Ingress",
+		},
+		{
+			name:         "PersistentVolumeClaims",
+			watchFunc:    (*Client).WatchPersistentVolumeClaims,
+			resourceType: "PersistentVolumeClaim",
+		},
+		{
+			name:         "HorizontalPodAutoscalers",
+			watchFunc:    (*Client).WatchHorizontalPodAutoscalers,
+			resourceType: "HorizontalPodAutoscaler",
+		},
+		{
+			name:         "ServiceAccounts",
+			watchFunc:    (*Client).WatchServiceAccounts,
+			resourceType: "ServiceAccount",
+		},
+		{
+			name:         "Roles",
+			watchFunc:    (*Client).WatchRoles,
+			resourceType: "Role",
+		},
+		{
+			name:         "RoleBindings",
+			watchFunc:    (*Client).WatchRoleBindings,
+			resourceType: "RoleBinding",
+		},
+		{
+			name:         "NetworkPolicies",
+			watchFunc:    (*Client).WatchNetworkPolicies,
+			resourceType: "NetworkPolicy",
+		},
+		{
+			name:         "PodDisruptionBudgets",
+			watchFunc:    (*Client).WatchPodDisruptionBudgets,
+			resourceType: "PodDisruptionBudget",
+		},
 	}
 
 	for _, tt := range tests {
@@ -412,6 +483,38 @@ func TestWatchWithDifferentResourceTypes(t *testing.T) {
 	}
 }
 
+// TestWatchNodes tests watching the cluster-scoped node resource
+func TestWatchNodes(t *testing.T) {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker-1"}}
+	fakeClientset := fake.NewSimpleClientset()
+	client := &Client{
+		clientset: fakeClientset,
+		namespace: "default",
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	watcher, err := client.WatchNodes(ctx, "")
+	if err != nil {
+		t.Fatalf("WatchNodes failed: %v", err)
+	}
+	defer watcher.Stop()
+
+	if _, err := fakeClientset.CoreV1().Nodes().Create(ctx, node, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create node: %v", err)
+	}
+
+	select {
+	case event := <-watcher.ResultChan():
+		if got, ok := event.Object.(*corev1.Node); !ok || got.Name != "worker-1" {
+			t.Errorf("Expected worker-1 node event, got %v", event.Object)
+		}
+	case <-ctx.Done():
+		t.Fatal("Timeout waiting for node event")
+	}
+}
+
 // TestWatchReceivesDeletedEvent tests that deleted events are received
 func TestWatchReceivesDeletedEvent(t *testing.T) {
 	// Create a deployment for testing
@@ -477,3 +580,34 @@ func TestWatchReceivesDeletedEvent(t *testing.T) {
 func int32ptr(i int32) *int32 {
 	return &i
 }
+
+func TestWatchStorageClasses(t *testing.T) {
+	storageClass := &storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: "gp3"}, Provisioner: "ebs.csi.aws.com"}
+	fakeClientset := fake.NewSimpleClientset()
+	client := &Client{
+		clientset: fakeClientset,
+		namespace: "default",
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	watcher, err := client.WatchStorageClasses(ctx, "")
+	if err != nil {
+		t.Fatalf("WatchStorageClasses failed: %v", err)
+	}
+	defer watcher.Stop()
+
+	if _, err := fakeClientset.StorageV1().StorageClasses().Create(ctx, storageClass, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create storage class: %v", err)
+	}
+
+	select {
+	case event := <-watcher.ResultChan():
+		if got, ok := event.Object.(*storagev1.StorageClass); !ok || got.Name != "gp3" {
+			t.Errorf("Expected gp3 storage class event, got %v", event.Object)
+		}
+	case <-ctx.Done():
+		t.Fatal("Timeout waiting for storage class event")
+	}
+}
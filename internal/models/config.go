@@ -0,0 +1,81 @@
+package models
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ConfigMapInfo represents simplified configmap information for display
+type ConfigMapInfo struct {
+	Name      string
+	Namespace string
+	DataCount int // Keys in data and binaryData
+	Age       string
+	Keys      []ConfigKey
+	Cluster   string            // Source kube context (multi-cluster mode only)
+	ConfigMap *corev1.ConfigMap // Keep reference to full configmap
+}
+
+// ConfigKey represents a single key of a configmap
+type ConfigKey struct {
+	Name   string
+	Value  string // Empty for binary keys
+	Binary bool
+	Size   int // Value size in bytes
+}
+
+// NewConfigMapInfo creates a ConfigMapInfo from a Kubernetes ConfigMap
+func NewConfigMapInfo(configMap *corev1.ConfigMap) ConfigMapInfo {
+	keys := make([]ConfigKey, 0, len(configMap.Data)+len(configMap.BinaryData))
+	for name, value := range configMap.Data {
+		keys = append(keys, ConfigKey{Name: name, Value: value, Size: len(value)})
+	}
+	for name, value := range configMap.BinaryData {
+		keys = append(keys, ConfigKey{Name: name, Binary: true, Size: len(value)})
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Name < keys[j].Name
+	})
+
+	return ConfigMapInfo{
+		Name:      configMap.Name,
+		Namespace: configMap.Namespace,
+		DataCount: len(keys),
+		Age:       formatAge(configMap.CreationTimestamp),
+		Keys:      keys,
+		ConfigMap: configMap,
+	}
+}
+
+// GetStatusSymbol returns a visual indicator for configmap status.
+// ConfigMaps have no status, so every configmap is shown as healthy.
+func (c *ConfigMapInfo) GetStatusSymbol() string {
+	return "●"
+}
+
+// KeyValue returns the full value of a key for display.
+// Binary values are rendered as a hex dump.
+func (c *ConfigMapInfo) KeyValue(key ConfigKey) string {
+	if key.Binary && c.ConfigMap != nil {
+		return hex.Dump(c.ConfigMap.BinaryData[key.Name])
+	}
+	return key.Value
+}
+
+// FormatBytes formats a byte count using binary units, e.g. "1.5 KiB"
+func FormatBytes(size int) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	div, exp := unit, 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,102 @@
+package models
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewConfigMapInfo(t *testing.T) {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app-config",
+			Namespace: "default",
+			CreationTimestamp: metav1.Time{
+				Time: time.Now().Add(-3 * time.Hour),
+			},
+		},
+		Data: map[string]string{
+			"settings.yaml": "debug: true\n",
+			"LOG_LEVEL":     "info",
+		},
+		BinaryData: map[string][]byte{
+			"logo.png": make([]byte, 2048),
+		},
+	}
+
+	got := NewConfigMapInfo(configMap)
+
+	if got.Name != "app-config" || got.Namespace != "default" {
+		t.Errorf("Unexpected name/namespace %s/%s", got.Namespace, got.Name)
+	}
+	if got.DataCount != 3 {
+		t.Errorf("DataCount = %d, want 3", got.DataCount)
+	}
+	if got.Age != "3h" {
+		t.Errorf("Age = %s, want 3h", got.Age)
+	}
+
+	wantKeys := []ConfigKey{
+		{Name: "LOG_LEVEL", Value: "info", Size: 4},
+		{Name: "logo.png", Binary: true, Size: 2048},
+		{Name: "settings.yaml", Value: "debug: true\n", Size: 12},
+	}
+	if len(got.Keys) != len(wantKeys) {
+		t.Fatalf("Expected %d keys, got %d", len(wantKeys), len(got.Keys))
+	}
+	for i, want := range wantKeys {
+		if got.Keys[i] != want {
+			t.Errorf("Keys[%d] = %+v, want %+v", i, got.Keys[i], want)
+		}
+	}
+
+	if got.GetStatusSymbol() != "●" {
+		t.Errorf("GetStatusSymbol() = %s, want ●", got.GetStatusSymbol())
+	}
+}
+
+func TestConfigMapInfo_KeyValue(t *testing.T) {
+	info := NewConfigMapInfo(&corev1.ConfigMap{
+		Data:       map[string]string{"greeting": "hello"},
+		BinaryData: map[string][]byte{"magic": {0x89, 'P', 'N', 'G'}},
+	})
+
+	if got := info.KeyValue(info.Keys[0]); got != "hello" {
+		t.Errorf("KeyValue(greeting) = %q, want hello", got)
+	}
+	if got := info.KeyValue(info.Keys[1]); !strings.Contains(got, "89 50 4e 47") || !strings.Contains(got, ".PNG") {
+		t.Errorf("Expected hex dump for binary key, got %q", got)
+	}
+}
+
+func TestNewConfigMapInfo_Empty(t *testing.T) {
+	got := NewConfigMapInfo(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "empty"}})
+
+	if got.DataCount != 0 || len(got.Keys) != 0 {
+		t.Errorf("Expected no keys, got %d", got.DataCount)
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		size int
+		want string
+	}{
+		{0, "0 B"},
+		{512, "512 B"},
+		{1024, "1.0 KiB"},
+		{1536, "1.5 KiB"},
+		{5 * 1024 * 1024, "5.0 MiB"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := FormatBytes(tt.size); got != tt.want {
+				t.Errorf("FormatBytes(%d) = %s, want %s", tt.size, got, tt.want)
+			}
+		})
+	}
+}
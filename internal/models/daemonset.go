@@ -0,0 +1,220 @@
+package models
+
+import (
+	"slices"
+	"sort"
+	"strconv"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// daemonSetTemplateGenerationLabel is set by the daemonset controller on each daemon pod
+// to the template generation it was created from, which the daemonset records in its
+// deprecated.daemonset.template.generation annotation
+const daemonSetTemplateGenerationLabel = "pod-template-generation"
+
+// daemonSetTolerations are added to every daemon pod by the daemonset controller,
+// so these taints never keep a daemon pod off a node
+var daemonSetTolerations = []corev1.Toleration{
+	{Key: corev1.TaintNodeNotReady, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute},
+	{Key: corev1.TaintNodeUnreachable, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute},
+	{Key: corev1.TaintNodeDiskPressure, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+	{Key: corev1.TaintNodeMemoryPressure, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+	{Key: corev1.TaintNodePIDPressure, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+	{Key: corev1.TaintNodeUnschedulable, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+}
+
+// DaemonSetNodeStatus describes the daemon pod on one node
+type DaemonSetNodeStatus struct {
+	Node      string
+	Pod       string // Empty if the daemon pod is missing
+	Status    string // Pod status, or "Missing"
+	Ready     string
+	Eligible  bool // False if the node should not run the daemon pod (misscheduled)
+	Symbol    string
+	OtherPods []string // Other daemon pods on the node, e.g. the old pod during a rolling update
+}
+
+// NewDaemonSetNodeStatuses lists, per node, the daemon pod of a daemonset. Every node the
+// daemon pod should run on is included, so nodes where it is missing show up as "Missing".
+// When a node runs several daemon pods, as during a rolling update, the pod of the current
+// template is shown and the others are listed with it.
+// Pods must already be filtered to those owned by the daemonset.
+func NewDaemonSetNodeStatuses(daemonSet *appsv1.DaemonSet, nodes []corev1.Node, pods []corev1.Pod) []DaemonSetNodeStatus {
+	podsByNode := make(map[string][]*corev1.Pod, len(pods))
+	for i := range pods {
+		if pods[i].Spec.NodeName != "" {
+			podsByNode[pods[i].Spec.NodeName] = append(podsByNode[pods[i].Spec.NodeName], &pods[i])
+		}
+	}
+	generation := daemonSet.Annotations[appsv1.DeprecatedTemplateGeneration]
+	for _, nodePods := range podsByNode {
+		sortDaemonPods(nodePods, generation)
+	}
+
+	statuses := make([]DaemonSetNodeStatus, 0, len(nodes))
+	for i := range nodes {
+		node := &nodes[i]
+		eligible := DaemonSetShouldRunOnNode(&daemonSet.Spec.Template.Spec, node)
+		nodePods, ok := podsByNode[node.Name]
+		if !ok {
+			if eligible {
+				statuses = append(statuses, DaemonSetNodeStatus{Node: node.Name, Status: "Missing", Eligible: true, Symbol: "✖"})
+			}
+			continue
+		}
+
+		pod := nodePods[0]
+		info := NewPodInfo(pod)
+		status := DaemonSetNodeStatus{
+			Node:     node.Name,
+			Pod:      pod.Name,
+			Status:   info.Status,
+			Ready:    info.Ready,
+			Eligible: eligible,
+			Symbol:   info.GetStatusSymbol(),
+		}
+		for _, other := range nodePods[1:] {
+			status.OtherPods = append(status.OtherPods, other.Name)
+		}
+		statuses = append(statuses, status)
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Node < statuses[j].Node
+	})
+	return statuses
+}
+
+// sortDaemonPods orders the daemon pods of one node by preference: pods of the current
+// template generation first, then pods that are not being deleted, then the newest
+func sortDaemonPods(pods []*corev1.Pod, generation string) {
+	current := func(pod *corev1.Pod) bool {
+		return generation != "" && pod.Labels[daemonSetTemplateGenerationLabel] == generation
+	}
+	sort.SliceStable(pods, func(i, j int) bool {
+		if current(pods[i]) != current(pods[j]) {
+			return current(pods[i])
+		}
+		if (pods[i].DeletionTimestamp == nil) != (pods[j].DeletionTimestamp == nil) {
+			return pods[i].DeletionTimestamp == nil
+		}
+		return pods[j].CreationTimestamp.Before(&pods[i].CreationTimestamp)
+	})
+}
+
+// DaemonSetShouldRunOnNode reports whether a daemon pod with the given spec belongs on node:
+// the node must match its node selector and required node affinity, and every
+// NoSchedule/NoExecute taint must be tolerated
+func DaemonSetShouldRunOnNode(spec *corev1.PodSpec, node *corev1.Node) bool {
+	for key, value := range spec.NodeSelector {
+		if node.Labels[key] != value {
+			return false
+		}
+	}
+
+	if affinity := spec.Affinity; affinity != nil && affinity.NodeAffinity != nil {
+		if required := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution; required != nil {
+			if !matchesNodeSelectorTerms(node, required.NodeSelectorTerms) {
+				return false
+			}
+		}
+	}
+
+	for i := range node.Spec.Taints {
+		taint := &node.Spec.Taints[i]
+		if taint.Effect == corev1.TaintEffectPreferNoSchedule {
+			continue
+		}
+		if !toleratesTaint(spec.Tolerations, taint) && !toleratesTaint(daemonSetTolerations, taint) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// toleratesTaint reports whether any of the tolerations tolerates taint
+func toleratesTaint(tolerations []corev1.Toleration, taint *corev1.Taint) bool {
+	for i := range tolerations {
+		toleration := &tolerations[i]
+		if toleration.Effect != "" && toleration.Effect != taint.Effect {
+			continue
+		}
+		if toleration.Key != "" && toleration.Key != taint.Key {
+			continue
+		}
+		switch toleration.Operator {
+		case corev1.TolerationOpExists:
+			return true
+		case corev1.TolerationOpEqual, "":
+			if toleration.Key != "" && toleration.Value == taint.Value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchesNodeSelectorTerms reports whether node matches any of the terms (terms are ORed)
+func matchesNodeSelectorTerms(node *corev1.Node, terms []corev1.NodeSelectorTerm) bool {
+	for _, term := range terms {
+		if matchesNodeSelectorTerm(node, term) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesNodeSelectorTerm reports whether node satisfies every requirement of a term
+func matchesNodeSelectorTerm(node *corev1.Node, term corev1.NodeSelectorTerm) bool {
+	if len(term.MatchExpressions) == 0 && len(term.MatchFields) == 0 {
+		return false // An empty term matches no objects
+	}
+	for _, requirement := range term.MatchExpressions {
+		value, ok := node.Labels[requirement.Key]
+		if !matchesNodeSelectorRequirement(requirement, value, ok) {
+			return false
+		}
+	}
+	for _, requirement := range term.MatchFields {
+		// metadata.name is the only supported field
+		if requirement.Key != "metadata.name" || !matchesNodeSelectorRequirement(requirement, node.Name, true) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesNodeSelectorRequirement evaluates one requirement against a label value
+func matchesNodeSelectorRequirement(requirement corev1.NodeSelectorRequirement, value string, present bool) bool {
+	switch requirement.Operator {
+	case corev1.NodeSelectorOpIn:
+		return present && slices.Contains(requirement.Values, value)
+	case corev1.NodeSelectorOpNotIn:
+		return !present || !slices.Contains(requirement.Values, value)
+	case corev1.NodeSelectorOpExists:
+		return present
+	case corev1.NodeSelectorOpDoesNotExist:
+		return !present
+	case corev1.NodeSelectorOpGt, corev1.NodeSelectorOpLt:
+		if !present || len(requirement.Values) != 1 {
+			return false
+		}
+		actual, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return false
+		}
+		limit, err := strconv.ParseInt(requirement.Values[0], 10, 64)
+		if err != nil {
+			return false
+		}
+		if requirement.Operator == corev1.NodeSelectorOpGt {
+			return actual > limit
+		}
+		return actual < limit
+	default:
+		return false
+	}
+}
@@ -0,0 +1,158 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// newLabeledNode returns a node with the given labels and taints
+func newLabeledNode(name string, labels map[string]string, taints ...corev1.Taint) corev1.Node {
+	return corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		Spec:       corev1.NodeSpec{Taints: taints},
+	}
+}
+
+func TestNewDaemonSetNodeStatuses(t *testing.T) {
+	daemonSet := &appsv1.DaemonSet{
+		Spec: appsv1.DaemonSetSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{NodeSelector: map[string]string{"kubernetes.io/os": "linux"}},
+			},
+		},
+	}
+	linux := map[string]string{"kubernetes.io/os": "linux"}
+	nodes := []corev1.Node{
+		newLabeledNode("worker-2", linux),
+		newLabeledNode("worker-1", linux),
+		newLabeledNode("windows-1", map[string]string{"kubernetes.io/os": "windows"}),
+		newLabeledNode("gpu-1", linux, corev1.Taint{Key: "nvidia.com/gpu", Effect: corev1.TaintEffectNoSchedule}),
+		newLabeledNode("draining-1", linux, corev1.Taint{Key: corev1.TaintNodeUnschedulable, Effect: corev1.TaintEffectNoSchedule}),
+	}
+	pods := []corev1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "agent-abcde"},
+			Spec:       corev1.PodSpec{NodeName: "worker-1", Containers: []corev1.Container{{Name: "agent"}}},
+			Status: corev1.PodStatus{
+				Phase:             corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{{Ready: true, State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}}},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "agent-fghij"},
+			Spec:       corev1.PodSpec{NodeName: "windows-1", Containers: []corev1.Container{{Name: "agent"}}},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		},
+	}
+
+	got := NewDaemonSetNodeStatuses(daemonSet, nodes, pods)
+
+	want := []DaemonSetNodeStatus{
+		{Node: "draining-1", Status: "Missing", Eligible: true, Symbol: "✖"}, // Cordoned nodes are tolerated
+		{Node: "windows-1", Pod: "agent-fghij", Status: "NotReady", Ready: "0/1", Eligible: false, Symbol: "◑"},
+		{Node: "worker-1", Pod: "agent-abcde", Status: "Running", Ready: "1/1", Eligible: true, Symbol: "●"},
+		{Node: "worker-2", Status: "Missing", Eligible: true, Symbol: "✖"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Expected %d nodes, got %+v", len(want), got)
+	}
+	for i := range want {
+		if !reflect.DeepEqual(got[i], want[i]) {
+			t.Errorf("statuses[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestNewDaemonSetNodeStatusesRollingUpdate(t *testing.T) {
+	daemonSet := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{appsv1.DeprecatedTemplateGeneration: "2"}},
+	}
+	nodes := []corev1.Node{newLabeledNode("worker-1", nil)}
+	now := metav1.Now()
+	daemonPod := func(name, generation string, deleting bool) corev1.Pod {
+		pod := corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"pod-template-generation": generation}},
+			Spec:       corev1.PodSpec{NodeName: "worker-1", Containers: []corev1.Container{{Name: "agent"}}},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+		if deleting {
+			pod.DeletionTimestamp = &now
+		}
+		return pod
+	}
+
+	// The old pod is listed first and is still terminating while the new one starts
+	pods := []corev1.Pod{daemonPod("agent-old", "1", true), daemonPod("agent-new", "2", false)}
+	got := NewDaemonSetNodeStatuses(daemonSet, nodes, pods)
+	if len(got) != 1 {
+		t.Fatalf("Expected 1 node, got %+v", got)
+	}
+	if got[0].Pod != "agent-new" {
+		t.Errorf("Expected the pod of the current template, got %s", got[0].Pod)
+	}
+	if !reflect.DeepEqual(got[0].OtherPods, []string{"agent-old"}) {
+		t.Errorf("Expected the old pod to be kept, got %v", got[0].OtherPods)
+	}
+}
+
+func TestDaemonSetShouldRunOnNode(t *testing.T) {
+	gpuTaint := corev1.Taint{Key: "nvidia.com/gpu", Value: "true", Effect: corev1.TaintEffectNoSchedule}
+	zoneAffinity := &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{
+		RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+			NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+				MatchExpressions: []corev1.NodeSelectorRequirement{
+					{Key: "topology.kubernetes.io/zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"eu-west-1a", "eu-west-1b"}},
+					{Key: "node.kubernetes.io/exclude-agents", Operator: corev1.NodeSelectorOpDoesNotExist},
+				},
+			}},
+		},
+	}}
+
+	tests := []struct {
+		name string
+		spec corev1.PodSpec
+		node corev1.Node
+		want bool
+	}{
+		{"no constraints", corev1.PodSpec{}, newLabeledNode("n1", nil), true},
+		{"untolerated taint", corev1.PodSpec{}, newLabeledNode("n1", nil, gpuTaint), false},
+		{
+			"tolerated by key and value",
+			corev1.PodSpec{Tolerations: []corev1.Toleration{{Key: "nvidia.com/gpu", Operator: corev1.TolerationOpEqual, Value: "true"}}},
+			newLabeledNode("n1", nil, gpuTaint),
+			true,
+		},
+		{
+			"tolerate everything",
+			corev1.PodSpec{Tolerations: []corev1.Toleration{{Operator: corev1.TolerationOpExists}}},
+			newLabeledNode("n1", nil, gpuTaint),
+			true,
+		},
+		{
+			"prefer no schedule is ignored",
+			corev1.PodSpec{},
+			newLabeledNode("n1", nil, corev1.Taint{Key: "spot", Effect: corev1.TaintEffectPreferNoSchedule}),
+			true,
+		},
+		{"affinity matches", corev1.PodSpec{Affinity: zoneAffinity}, newLabeledNode("n1", map[string]string{"topology.kubernetes.io/zone": "eu-west-1b"}), true},
+		{"affinity other zone", corev1.PodSpec{Affinity: zoneAffinity}, newLabeledNode("n1", map[string]string{"topology.kubernetes.io/zone": "eu-west-1c"}), false},
+		{
+			"affinity excluded",
+			corev1.PodSpec{Affinity: zoneAffinity},
+			newLabeledNode("n1", map[string]string{"topology.kubernetes.io/zone": "eu-west-1a", "node.kubernetes.io/exclude-agents": ""}),
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DaemonSetShouldRunOnNode(&tt.spec, &tt.node); got != tt.want {
+				t.Errorf("DaemonSetShouldRunOnNode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
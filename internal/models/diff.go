@@ -0,0 +1,146 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DiffOp is how a line of a diff changed
+type DiffOp int
+
+const (
+	// DiffEqual marks a line present on both sides
+	DiffEqual DiffOp = iota
+	// DiffInsert marks a line only on the new side
+	DiffInsert
+	// DiffDelete marks a line only on the old side
+	DiffDelete
+	// DiffSkip marks a run of unchanged lines left out of a compact diff
+	DiffSkip
+)
+
+// DiffLine is one line of a line-based diff
+type DiffLine struct {
+	Op   DiffOp
+	Text string
+}
+
+// String renders the line with a unified diff prefix
+func (l DiffLine) String() string {
+	switch l.Op {
+	case DiffInsert:
+		return "+ " + l.Text
+	case DiffDelete:
+		return "- " + l.Text
+	case DiffSkip:
+		return l.Text
+	default:
+		return "  " + l.Text
+	}
+}
+
+// DiffLines returns the line-based diff turning from into to, using the longest
+// common subsequence of their lines
+func DiffLines(from, to string) []DiffLine {
+	a := splitLines(from)
+	b := splitLines(to)
+
+	// Lines shared at the start and end are kept out of the quadratic table
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	middleA := a[prefix : len(a)-suffix]
+	middleB := b[prefix : len(b)-suffix]
+
+	// lcs[i][j] is the length of the longest common subsequence of middleA[i:] and middleB[j:]
+	lcs := make([][]int, len(middleA)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(middleB)+1)
+	}
+	for i := len(middleA) - 1; i >= 0; i-- {
+		for j := len(middleB) - 1; j >= 0; j-- {
+			if middleA[i] == middleB[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	lines := make([]DiffLine, 0, len(a)+len(b))
+	for _, line := range a[:prefix] {
+		lines = append(lines, DiffLine{Op: DiffEqual, Text: line})
+	}
+	i, j := 0, 0
+	for i < len(middleA) || j < len(middleB) {
+		switch {
+		case i < len(middleA) && j < len(middleB) && middleA[i] == middleB[j]:
+			lines = append(lines, DiffLine{Op: DiffEqual, Text: middleA[i]})
+			i++
+			j++
+		case i < len(middleA) && (j == len(middleB) || lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, DiffLine{Op: DiffDelete, Text: middleA[i]})
+			i++
+		default:
+			lines = append(lines, DiffLine{Op: DiffInsert, Text: middleB[j]})
+			j++
+		}
+	}
+	for _, line := range a[len(a)-suffix:] {
+		lines = append(lines, DiffLine{Op: DiffEqual, Text: line})
+	}
+	return lines
+}
+
+// HasChanges reports whether a diff inserts or deletes any line
+func HasChanges(lines []DiffLine) bool {
+	for _, line := range lines {
+		if line.Op == DiffInsert || line.Op == DiffDelete {
+			return true
+		}
+	}
+	return false
+}
+
+// CompactDiff keeps the changed lines of a diff with up to context unchanged lines
+// around them, replacing each longer run of unchanged lines with a DiffSkip line
+func CompactDiff(lines []DiffLine, context int) []DiffLine {
+	keep := make([]bool, len(lines))
+	for i, line := range lines {
+		if line.Op == DiffEqual {
+			continue
+		}
+		for k := max(0, i-context); k <= min(len(lines)-1, i+context); k++ {
+			keep[k] = true
+		}
+	}
+
+	result := make([]DiffLine, 0, len(lines))
+	for i := 0; i < len(lines); {
+		if keep[i] {
+			result = append(result, lines[i])
+			i++
+			continue
+		}
+		start := i
+		for i < len(lines) && !keep[i] {
+			i++
+		}
+		result = append(result, DiffLine{Op: DiffSkip, Text: fmt.Sprintf("@@ %d unchanged lines @@", i-start)})
+	}
+	return result
+}
+
+// splitLines splits text into lines, without a trailing empty line
+func splitLines(text string) []string {
+	text = strings.TrimSuffix(text, "\n")
+	if text == "" {
+		return nil
+	}
+	return strings.Split(text, "\n")
+}
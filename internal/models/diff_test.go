@@ -0,0 +1,53 @@
+package models
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDiffLines(t *testing.T) {
+	from := "a\nb\nc\nd\n"
+	to := "a\nc\nx\nd\ne\n"
+
+	lines := DiffLines(from, to)
+	var rendered []string
+	for _, line := range lines {
+		rendered = append(rendered, line.String())
+	}
+	want := []string{"  a", "- b", "  c", "+ x", "  d", "+ e"}
+	if strings.Join(rendered, "|") != strings.Join(want, "|") {
+		t.Errorf("DiffLines() = %q, want %q", rendered, want)
+	}
+	if !HasChanges(lines) {
+		t.Error("HasChanges() should report the changes")
+	}
+
+	if lines := DiffLines(from, from); HasChanges(lines) || len(lines) != 4 {
+		t.Errorf("Expected an unchanged diff of 4 lines, got %v", lines)
+	}
+	if lines := DiffLines("", "a\n"); len(lines) != 1 || lines[0].Op != DiffInsert {
+		t.Errorf("Expected a single insert, got %v", lines)
+	}
+	if lines := DiffLines("a\nb", "b\n"); len(lines) != 2 || lines[0].Op != DiffDelete || lines[1].Op != DiffEqual {
+		t.Errorf("Expected a delete then an unchanged line, got %v", lines)
+	}
+}
+
+func TestCompactDiff(t *testing.T) {
+	from := "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n"
+	to := "1\n2\n3\n4\n5\nsix\n7\n8\n9\n10\n"
+
+	compact := CompactDiff(DiffLines(from, to), 1)
+	var rendered []string
+	for _, line := range compact {
+		rendered = append(rendered, line.String())
+	}
+	want := []string{"@@ 4 unchanged lines @@", "  5", "- 6", "+ six", "  7", "@@ 3 unchanged lines @@"}
+	if strings.Join(rendered, "|") != strings.Join(want, "|") {
+		t.Errorf("CompactDiff() = %q, want %q", rendered, want)
+	}
+
+	if compact := CompactDiff(DiffLines(from, from), 3); len(compact) != 1 || compact[0].Op != DiffSkip {
+		t.Errorf("Expected an unchanged diff to compact to one skip line, got %v", compact)
+	}
+}
@@ -0,0 +1,131 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	discoveryv1 "k8s.io/api/discovery/v1"
+)
+
+// EndpointInfo describes one address backing a service, taken from its EndpointSlices
+type EndpointInfo struct {
+	Address     string
+	Pod         string // Target pod, empty if the endpoint is not a pod
+	Namespace   string // Namespace of the target pod
+	Node        string
+	Ports       string
+	Ready       bool
+	Serving     bool
+	Terminating bool
+}
+
+// NewEndpointInfos flattens the endpoints of a service's EndpointSlices, sorted by pod
+// and address. A nil ready condition counts as ready and a nil serving condition
+// follows ready, as the API specifies.
+func NewEndpointInfos(slices []discoveryv1.EndpointSlice) []EndpointInfo {
+	endpoints := make([]EndpointInfo, 0)
+	for i := range slices {
+		slice := &slices[i]
+		ports := endpointSlicePorts(slice.Ports)
+		for _, endpoint := range slice.Endpoints {
+			info := EndpointInfo{
+				Address:     strings.Join(endpoint.Addresses, ","),
+				Ports:       ports,
+				Ready:       endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready,
+				Terminating: endpoint.Conditions.Terminating != nil && *endpoint.Conditions.Terminating,
+			}
+			info.Serving = info.Ready
+			if endpoint.Conditions.Serving != nil {
+				info.Serving = *endpoint.Conditions.Serving
+			}
+			if endpoint.NodeName != nil {
+				info.Node = *endpoint.NodeName
+			}
+			if ref := endpoint.TargetRef; ref != nil && ref.Kind == "Pod" {
+				info.Pod = ref.Name
+				info.Namespace = ref.Namespace
+				if info.Namespace == "" {
+					info.Namespace = slice.Namespace
+				}
+			}
+			endpoints = append(endpoints, info)
+		}
+	}
+
+	sort.SliceStable(endpoints, func(i, j int) bool {
+		if endpoints[i].Pod != endpoints[j].Pod {
+			return endpoints[i].Pod < endpoints[j].Pod
+		}
+		return endpoints[i].Address < endpoints[j].Address
+	})
+	return endpoints
+}
+
+// endpointSlicePorts formats the ports of an EndpointSlice, e.g. "http:8080/TCP"
+func endpointSlicePorts(ports []discoveryv1.EndpointPort) string {
+	formatted := make([]string, 0, len(ports))
+	for _, port := range ports {
+		text := ""
+		if port.Port != nil {
+			text = fmt.Sprintf("%d", *port.Port)
+		}
+		if port.Protocol != nil {
+			text += "/" + string(*port.Protocol)
+		}
+		if port.Name != nil && *port.Name != "" {
+			text = *port.Name + ":" + text
+		}
+		formatted = append(formatted, text)
+	}
+	if len(formatted) == 0 {
+		return "<none>"
+	}
+	return strings.Join(formatted, ",")
+}
+
+// State summarizes the endpoint conditions. A terminating endpoint that is still
+// serving receives traffic only while no other endpoint is ready.
+func (e EndpointInfo) State() string {
+	switch {
+	case e.Terminating && e.Serving:
+		return "Terminating (serving)"
+	case e.Terminating:
+		return "Terminating"
+	case e.Ready:
+		return "Ready"
+	case e.Serving:
+		return "Serving"
+	default:
+		return "NotReady"
+	}
+}
+
+// GetStatusSymbol returns a visual indicator for the endpoint state
+func (e EndpointInfo) GetStatusSymbol() string {
+	switch {
+	case e.Terminating:
+		return "⊗"
+	case e.Ready:
+		return "✓"
+	case e.Serving:
+		return "◐"
+	default:
+		return "✗"
+	}
+}
+
+// EndpointCounts counts the ready, terminating and not ready endpoints of a service
+func EndpointCounts(endpoints []EndpointInfo) (ready, terminating, notReady int) {
+	for _, endpoint := range endpoints {
+		switch {
+		case endpoint.Terminating:
+			terminating++
+		case endpoint.Ready:
+			ready++
+		default:
+			notReady++
+		}
+	}
+	return ready, terminating, notReady
+}
@@ -0,0 +1,70 @@
+package models
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewEndpointInfos(t *testing.T) {
+	yes, no := true, false
+	port, protocol, name := int32(8080), corev1.ProtocolTCP, "http"
+	node := "node-1"
+	slices := []discoveryv1.EndpointSlice{{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-abc", Namespace: "shop"},
+		Ports:      []discoveryv1.EndpointPort{{Name: &name, Port: &port, Protocol: &protocol}},
+		Endpoints: []discoveryv1.Endpoint{
+			{
+				Addresses:  []string{"10.0.0.2"},
+				Conditions: discoveryv1.EndpointConditions{Ready: &no, Serving: &yes, Terminating: &yes},
+				TargetRef:  &corev1.ObjectReference{Kind: "Pod", Name: "web-2"},
+			},
+			{
+				Addresses: []string{"10.0.0.1"},
+				NodeName:  &node,
+				TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "web-1", Namespace: "shop"},
+			},
+			{
+				Addresses:  []string{"10.0.0.3"},
+				Conditions: discoveryv1.EndpointConditions{Ready: &no},
+			},
+		},
+	}}
+
+	endpoints := NewEndpointInfos(slices)
+	if len(endpoints) != 3 {
+		t.Fatalf("Expected 3 endpoints, got %d", len(endpoints))
+	}
+
+	// Sorted by pod, so the endpoint without a pod comes first
+	manual, ready, terminating := endpoints[0], endpoints[1], endpoints[2]
+	if manual.Pod != "" || manual.State() != "NotReady" || manual.GetStatusSymbol() != "✗" {
+		t.Errorf("Unexpected manual endpoint %+v", manual)
+	}
+	if ready.Pod != "web-1" || ready.Namespace != "shop" || ready.Node != "node-1" || ready.State() != "Ready" {
+		t.Errorf("Unexpected ready endpoint %+v", ready)
+	}
+	if ready.Ports != "http:8080/TCP" {
+		t.Errorf("Expected ports http:8080/TCP, got %q", ready.Ports)
+	}
+	if terminating.Namespace != "shop" || terminating.State() != "Terminating (serving)" || terminating.GetStatusSymbol() != "⊗" {
+		t.Errorf("Unexpected terminating endpoint %+v", terminating)
+	}
+
+	readyCount, terminatingCount, notReadyCount := EndpointCounts(endpoints)
+	if readyCount != 1 || terminatingCount != 1 || notReadyCount != 1 {
+		t.Errorf("Expected 1 ready, 1 terminating and 1 not ready, got %d, %d, %d", readyCount, terminatingCount, notReadyCount)
+	}
+}
+
+func TestNewEndpointInfos_Empty(t *testing.T) {
+	endpoints := NewEndpointInfos(nil)
+	if endpoints == nil || len(endpoints) != 0 {
+		t.Errorf("Expected an empty, non-nil slice, got %v", endpoints)
+	}
+	if ports := endpointSlicePorts(nil); ports != "<none>" {
+		t.Errorf("Expected <none>, got %q", ports)
+	}
+}
@@ -19,6 +19,7 @@ type EventInfo struct {
 	FirstTimestamp time.Time
 	LastTimestamp  time.Time
 	Count          int32
+	Cluster        string        // Source kube context (multi-cluster mode only)
 	Event          *corev1.Event // Keep reference to full event
 }
 
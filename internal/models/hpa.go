@@ -0,0 +1,241 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// HorizontalPodAutoscalerInfo represents simplified horizontalpodautoscaler information for display
+type HorizontalPodAutoscalerInfo struct {
+	Name            string
+	Namespace       string
+	TargetKind      string // Kind of the scaled workload, e.g. Deployment
+	TargetName      string
+	MinReplicas     int32
+	MaxReplicas     int32
+	CurrentReplicas int32
+	DesiredReplicas int32
+	Metrics         []HPAMetric
+	Targets         string // Metrics summarised like kubectl get hpa, e.g. "cpu: 45%/80%"
+	Conditions      []HPACondition
+	LastScale       string // Age of the last scaling event, empty if it never scaled
+	Age             string
+	Cluster         string                                 // Source kube context (multi-cluster mode only)
+	HPA             *autoscalingv2.HorizontalPodAutoscaler // Keep reference to full autoscaler
+}
+
+// HPAMetric is one metric an autoscaler scales on, with its current and target values
+type HPAMetric struct {
+	Name    string // e.g. "cpu" or "requests_per_second (on Ingress/shop)"
+	Current string // "<unknown>" until the metric has been read
+	Target  string
+}
+
+// String formats the metric like kubectl get hpa, e.g. "cpu: 45%/80%"
+func (m HPAMetric) String() string {
+	return fmt.Sprintf("%s: %s/%s", m.Name, m.Current, m.Target)
+}
+
+// HPACondition is a status condition of an autoscaler, such as ScalingLimited
+type HPACondition struct {
+	Type    string
+	Status  string
+	Reason  string
+	Message string
+}
+
+// NewHorizontalPodAutoscalerInfo creates a HorizontalPodAutoscalerInfo from a Kubernetes HorizontalPodAutoscaler
+func NewHorizontalPodAutoscalerInfo(hpa *autoscalingv2.HorizontalPodAutoscaler) HorizontalPodAutoscalerInfo {
+	info := HorizontalPodAutoscalerInfo{
+		Name:            hpa.Name,
+		Namespace:       hpa.Namespace,
+		TargetKind:      hpa.Spec.ScaleTargetRef.Kind,
+		TargetName:      hpa.Spec.ScaleTargetRef.Name,
+		MinReplicas:     1,
+		MaxReplicas:     hpa.Spec.MaxReplicas,
+		CurrentReplicas: hpa.Status.CurrentReplicas,
+		DesiredReplicas: hpa.Status.DesiredReplicas,
+		Age:             formatAge(hpa.CreationTimestamp),
+		HPA:             hpa,
+	}
+	if hpa.Spec.MinReplicas != nil {
+		info.MinReplicas = *hpa.Spec.MinReplicas
+	}
+	if hpa.Status.LastScaleTime != nil {
+		info.LastScale = formatAge(*hpa.Status.LastScaleTime)
+	}
+
+	// Current values are matched to their spec by metric identity, not position
+	current := make(map[string]autoscalingv2.MetricValueStatus, len(hpa.Status.CurrentMetrics))
+	for _, status := range hpa.Status.CurrentMetrics {
+		if name, value, ok := metricStatusValue(status); ok {
+			current[name] = value
+		}
+	}
+
+	var targets []string
+	for _, spec := range hpa.Spec.Metrics {
+		name, target, ok := metricSpecTarget(spec)
+		if !ok {
+			continue
+		}
+		metric := HPAMetric{Name: name, Current: "<unknown>", Target: formatMetricTarget(target)}
+		if value, ok := current[name]; ok {
+			metric.Current = formatMetricValue(target.Type, value)
+		}
+		info.Metrics = append(info.Metrics, metric)
+		targets = append(targets, metric.String())
+	}
+	info.Targets = strings.Join(targets, ", ")
+	if info.Targets == "" {
+		info.Targets = "<none>"
+	}
+
+	for _, condition := range hpa.Status.Conditions {
+		info.Conditions = append(info.Conditions, HPACondition{
+			Type:    string(condition.Type),
+			Status:  string(condition.Status),
+			Reason:  condition.Reason,
+			Message: condition.Message,
+		})
+	}
+
+	return info
+}
+
+// metricSpecTarget returns the display name and target of a metric spec
+func metricSpecTarget(spec autoscalingv2.MetricSpec) (string, autoscalingv2.MetricTarget, bool) {
+	switch {
+	case spec.Resource != nil:
+		return string(spec.Resource.Name), spec.Resource.Target, true
+	case spec.ContainerResource != nil:
+		return containerResourceMetricName(spec.ContainerResource.Name, spec.ContainerResource.Container), spec.ContainerResource.Target, true
+	case spec.Pods != nil:
+		return spec.Pods.Metric.Name, spec.Pods.Target, true
+	case spec.Object != nil:
+		return objectMetricName(spec.Object.Metric.Name, spec.Object.DescribedObject), spec.Object.Target, true
+	case spec.External != nil:
+		return spec.External.Metric.Name, spec.External.Target, true
+	default:
+		return "", autoscalingv2.MetricTarget{}, false
+	}
+}
+
+// metricStatusValue returns the display name and current value of a metric status
+func metricStatusValue(status autoscalingv2.MetricStatus) (string, autoscalingv2.MetricValueStatus, bool) {
+	switch {
+	case status.Resource != nil:
+		return string(status.Resource.Name), status.Resource.Current, true
+	case status.ContainerResource != nil:
+		return containerResourceMetricName(status.ContainerResource.Name, status.ContainerResource.Container), status.ContainerResource.Current, true
+	case status.Pods != nil:
+		return status.Pods.Metric.Name, status.Pods.Current, true
+	case status.Object != nil:
+		return objectMetricName(status.Object.Metric.Name, status.Object.DescribedObject), status.Object.Current, true
+	case status.External != nil:
+		return status.External.Metric.Name, status.External.Current, true
+	default:
+		return "", autoscalingv2.MetricValueStatus{}, false
+	}
+}
+
+// containerResourceMetricName names a per-container resource metric, e.g. "cpu (container app)"
+func containerResourceMetricName(resource corev1.ResourceName, container string) string {
+	return fmt.Sprintf("%s (container %s)", resource, container)
+}
+
+// objectMetricName names a metric read from another object, e.g. "hits (on Ingress/shop)"
+func objectMetricName(metric string, object autoscalingv2.CrossVersionObjectReference) string {
+	return fmt.Sprintf("%s (on %s/%s)", metric, object.Kind, object.Name)
+}
+
+// formatMetricTarget formats a metric target as a utilization percentage or quantity
+func formatMetricTarget(target autoscalingv2.MetricTarget) string {
+	switch {
+	case target.Type == autoscalingv2.UtilizationMetricType && target.AverageUtilization != nil:
+		return fmt.Sprintf("%d%%", *target.AverageUtilization)
+	case target.Type == autoscalingv2.AverageValueMetricType && target.AverageValue != nil:
+		return target.AverageValue.String()
+	case target.Value != nil:
+		return target.Value.String()
+	default:
+		return "<unknown>"
+	}
+}
+
+// formatMetricValue formats a current metric value in the same form as its target
+func formatMetricValue(targetType autoscalingv2.MetricTargetType, value autoscalingv2.MetricValueStatus) string {
+	switch {
+	case targetType == autoscalingv2.UtilizationMetricType && value.AverageUtilization != nil:
+		return fmt.Sprintf("%d%%", *value.AverageUtilization)
+	case targetType == autoscalingv2.AverageValueMetricType && value.AverageValue != nil:
+		return value.AverageValue.String()
+	case targetType == autoscalingv2.ValueMetricType && value.Value != nil:
+		return value.Value.String()
+	default:
+		return "<unknown>"
+	}
+}
+
+// Reference returns the scaled workload as Kind/name, like kubectl get hpa
+func (h *HorizontalPodAutoscalerInfo) Reference() string {
+	return h.TargetKind + "/" + h.TargetName
+}
+
+// Scales reports whether the autoscaler scales the workload of the given kind and name
+func (h *HorizontalPodAutoscalerInfo) Scales(kind, name string) bool {
+	return h.TargetKind == kind && h.TargetName == name
+}
+
+// Condition returns the autoscaler condition of the given type, if present
+func (h *HorizontalPodAutoscalerInfo) Condition(conditionType autoscalingv2.HorizontalPodAutoscalerConditionType) (HPACondition, bool) {
+	for _, condition := range h.Conditions {
+		if condition.Type == string(conditionType) {
+			return condition, true
+		}
+	}
+	return HPACondition{}, false
+}
+
+// LimitedMessage explains why the autoscaler is pinned at its min or max replicas,
+// or returns an empty string when it is free to scale
+func (h *HorizontalPodAutoscalerInfo) LimitedMessage() string {
+	condition, ok := h.Condition(autoscalingv2.ScalingLimited)
+	if !ok || condition.Status != string(corev1.ConditionTrue) {
+		return ""
+	}
+	if condition.Message == "" {
+		return condition.Reason
+	}
+	return condition.Message
+}
+
+// GetStatusSymbol returns a visual indicator for autoscaler status
+func (h *HorizontalPodAutoscalerInfo) GetStatusSymbol() string {
+	if len(h.Conditions) == 0 {
+		return "◐" // Not yet reconciled by the controller
+	}
+	for _, conditionType := range []autoscalingv2.HorizontalPodAutoscalerConditionType{autoscalingv2.AbleToScale, autoscalingv2.ScalingActive} {
+		if condition, ok := h.Condition(conditionType); ok && condition.Status == string(corev1.ConditionFalse) {
+			return "✖" // Cannot scale, e.g. metrics are unavailable
+		}
+	}
+	if condition, ok := h.Condition(autoscalingv2.ScalingLimited); ok && condition.Status == string(corev1.ConditionTrue) {
+		return "◐" // Pinned at min or max replicas
+	}
+	return "●"
+}
+
+// AutoscalersFor returns the autoscalers that scale the workload of the given kind and name
+func AutoscalersFor(kind, name string, hpas []HorizontalPodAutoscalerInfo) []HorizontalPodAutoscalerInfo {
+	result := make([]HorizontalPodAutoscalerInfo, 0)
+	for i := range hpas {
+		if hpas[i].Scales(kind, name) {
+			result = append(result, hpas[i])
+		}
+	}
+	return result
+}
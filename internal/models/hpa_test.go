@@ -0,0 +1,211 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newTestHPA() *autoscalingv2.HorizontalPodAutoscaler {
+	minReplicas := int32(2)
+	cpuTarget := int32(80)
+	cpuCurrent := int32(45)
+	requestsTarget := resource.MustParse("100")
+	requestsCurrent := resource.MustParse("250")
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "web",
+			Namespace:         "shop",
+			CreationTimestamp: metav1.Time{Time: time.Now().Add(-24 * time.Hour)},
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{Kind: "Deployment", Name: "web", APIVersion: "apps/v1"},
+			MinReplicas:    &minReplicas,
+			MaxReplicas:    10,
+			Metrics: []autoscalingv2.MetricSpec{
+				{
+					Type: autoscalingv2.ResourceMetricSourceType,
+					Resource: &autoscalingv2.ResourceMetricSource{
+						Name:   corev1.ResourceCPU,
+						Target: autoscalingv2.MetricTarget{Type: autoscalingv2.UtilizationMetricType, AverageUtilization: &cpuTarget},
+					},
+				},
+				{
+					Type: autoscalingv2.PodsMetricSourceType,
+					Pods: &autoscalingv2.PodsMetricSource{
+						Metric: autoscalingv2.MetricIdentifier{Name: "requests_per_second"},
+						Target: autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: &requestsTarget},
+					},
+				},
+				{
+					Type: autoscalingv2.ExternalMetricSourceType,
+					External: &autoscalingv2.ExternalMetricSource{
+						Metric: autoscalingv2.MetricIdentifier{Name: "queue_depth"},
+						Target: autoscalingv2.MetricTarget{Type: autoscalingv2.ValueMetricType, Value: &requestsTarget},
+					},
+				},
+			},
+		},
+		Status: autoscalingv2.HorizontalPodAutoscalerStatus{
+			CurrentReplicas: 10,
+			DesiredReplicas: 10,
+			LastScaleTime:   &metav1.Time{Time: time.Now().Add(-5 * time.Minute)},
+			// Status order differs from spec order
+			CurrentMetrics: []autoscalingv2.MetricStatus{
+				{
+					Type: autoscalingv2.PodsMetricSourceType,
+					Pods: &autoscalingv2.PodsMetricStatus{
+						Metric:  autoscalingv2.MetricIdentifier{Name: "requests_per_second"},
+						Current: autoscalingv2.MetricValueStatus{AverageValue: &requestsCurrent},
+					},
+				},
+				{
+					Type: autoscalingv2.ResourceMetricSourceType,
+					Resource: &autoscalingv2.ResourceMetricStatus{
+						Name:    corev1.ResourceCPU,
+						Current: autoscalingv2.MetricValueStatus{AverageUtilization: &cpuCurrent},
+					},
+				},
+			},
+			Conditions: []autoscalingv2.HorizontalPodAutoscalerCondition{
+				{Type: autoscalingv2.AbleToScale, Status: corev1.ConditionTrue, Reason: "ReadyForNewScale"},
+				{Type: autoscalingv2.ScalingActive, Status: corev1.ConditionTrue, Reason: "ValidMetricFound"},
+				{Type: autoscalingv2.ScalingLimited, Status: corev1.ConditionTrue, Reason: "TooManyReplicas", Message: "the desired replica count is more than the maximum replica count"},
+			},
+		},
+	}
+}
+
+func TestNewHorizontalPodAutoscalerInfo(t *testing.T) {
+	got := NewHorizontalPodAutoscalerInfo(newTestHPA())
+
+	if got.Reference() != "Deployment/web" || got.MinReplicas != 2 || got.MaxReplicas != 10 || got.CurrentReplicas != 10 {
+		t.Errorf("Unexpected autoscaler info %+v", got)
+	}
+	if got.LastScale != "5m" || got.Age != "1d" {
+		t.Errorf("LastScale = %q, Age = %q", got.LastScale, got.Age)
+	}
+
+	want := "cpu: 45%/80%, requests_per_second: 250/100, queue_depth: <unknown>/100"
+	if got.Targets != want {
+		t.Errorf("Targets = %q, want %q", got.Targets, want)
+	}
+	if len(got.Conditions) != 3 || got.Conditions[2].Reason != "TooManyReplicas" {
+		t.Errorf("Unexpected conditions %+v", got.Conditions)
+	}
+	if got.LimitedMessage() != "the desired replica count is more than the maximum replica count" {
+		t.Errorf("LimitedMessage() = %q", got.LimitedMessage())
+	}
+}
+
+func TestNewHorizontalPodAutoscalerInfo_Defaults(t *testing.T) {
+	got := NewHorizontalPodAutoscalerInfo(&autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: "worker"},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{Kind: "StatefulSet", Name: "worker"},
+			MaxReplicas:    3,
+		},
+	})
+
+	if got.MinReplicas != 1 || got.Targets != "<none>" || got.LastScale != "" {
+		t.Errorf("Unexpected defaulted autoscaler info %+v", got)
+	}
+	if got.GetStatusSymbol() != "◐" {
+		t.Errorf("GetStatusSymbol() = %q, want ◐ before the controller reconciles", got.GetStatusSymbol())
+	}
+}
+
+func TestHorizontalPodAutoscalerInfo_GetStatusSymbol(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions []autoscalingv2.HorizontalPodAutoscalerCondition
+		want       string
+	}{
+		{
+			name: "scaling normally",
+			conditions: []autoscalingv2.HorizontalPodAutoscalerCondition{
+				{Type: autoscalingv2.AbleToScale, Status: corev1.ConditionTrue},
+				{Type: autoscalingv2.ScalingActive, Status: corev1.ConditionTrue},
+				{Type: autoscalingv2.ScalingLimited, Status: corev1.ConditionFalse},
+			},
+			want: "●",
+		},
+		{
+			name: "limited by max replicas",
+			conditions: []autoscalingv2.HorizontalPodAutoscalerCondition{
+				{Type: autoscalingv2.AbleToScale, Status: corev1.ConditionTrue},
+				{Type: autoscalingv2.ScalingLimited, Status: corev1.ConditionTrue},
+			},
+			want: "◐",
+		},
+		{
+			name: "metrics unavailable",
+			conditions: []autoscalingv2.HorizontalPodAutoscalerCondition{
+				{Type: autoscalingv2.AbleToScale, Status: corev1.ConditionTrue},
+				{Type: autoscalingv2.ScalingActive, Status: corev1.ConditionFalse, Reason: "FailedGetResourceMetric"},
+			},
+			want: "✖",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hpa := newTestHPA()
+			hpa.Status.Conditions = tt.conditions
+			info := NewHorizontalPodAutoscalerInfo(hpa)
+			if got := info.GetStatusSymbol(); got != tt.want {
+				t.Errorf("GetStatusSymbol() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAutoscalersFor(t *testing.T) {
+	hpas := []HorizontalPodAutoscalerInfo{
+		{Name: "web", TargetKind: "Deployment", TargetName: "web"},
+		{Name: "web-sts", TargetKind: "StatefulSet", TargetName: "web"},
+		{Name: "api", TargetKind: "Deployment", TargetName: "api"},
+	}
+
+	got := AutoscalersFor("Deployment", "web", hpas)
+	if len(got) != 1 || got[0].Name != "web" {
+		t.Errorf("AutoscalersFor() = %+v, want web", got)
+	}
+	if got := AutoscalersFor("Deployment", "worker", hpas); got == nil || len(got) != 0 {
+		t.Errorf("AutoscalersFor() = %#v, want empty non-nil slice", got)
+	}
+}
+
+func TestObjectAndContainerMetricNames(t *testing.T) {
+	target := int32(60)
+	hpa := newTestHPA()
+	hpa.Spec.Metrics = []autoscalingv2.MetricSpec{
+		{
+			Type: autoscalingv2.ContainerResourceMetricSourceType,
+			ContainerResource: &autoscalingv2.ContainerResourceMetricSource{
+				Name:      corev1.ResourceMemory,
+				Container: "app",
+				Target:    autoscalingv2.MetricTarget{Type: autoscalingv2.UtilizationMetricType, AverageUtilization: &target},
+			},
+		},
+		{
+			Type: autoscalingv2.ObjectMetricSourceType,
+			Object: &autoscalingv2.ObjectMetricSource{
+				DescribedObject: autoscalingv2.CrossVersionObjectReference{Kind: "Ingress", Name: "shop"},
+				Metric:          autoscalingv2.MetricIdentifier{Name: "hits"},
+				Target:          autoscalingv2.MetricTarget{Type: autoscalingv2.ValueMetricType, Value: resource.NewQuantity(2000, resource.DecimalSI)},
+			},
+		},
+	}
+	hpa.Status.CurrentMetrics = nil
+
+	got := NewHorizontalPodAutoscalerInfo(hpa)
+	want := "memory (container app): <unknown>/60%, hits (on Ingress/shop): <unknown>/2k"
+	if got.Targets != want {
+		t.Errorf("Targets = %q, want %q", got.Targets, want)
+	}
+}
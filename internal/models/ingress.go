@@ -0,0 +1,217 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+// ingressClassAnnotation is the deprecated annotation used before spec.ingressClassName
+const ingressClassAnnotation = "kubernetes.io/ingress.class"
+
+// IngressInfo represents simplified ingress information for display
+type IngressInfo struct {
+	Name           string
+	Namespace      string
+	Class          string
+	Hosts          string // Comma separated, "*" when a rule matches every host
+	Addresses      string
+	Ports          string // 80, plus 443 when TLS is configured
+	Age            string
+	Paths          []IngressPath // Every host/path of every rule, in spec order
+	DefaultBackend *IngressBackend
+	TLS            []IngressTLS
+	Cluster        string                // Source kube context (multi-cluster mode only)
+	Ingress        *networkingv1.Ingress // Keep reference to full ingress
+}
+
+// IngressPath is one host/path of an ingress rule and the backend it routes to
+type IngressPath struct {
+	Host     string // "*" when the rule matches every host
+	Path     string
+	PathType string
+	Backend  IngressBackend
+}
+
+// IngressBackend is the service port or resource an ingress routes traffic to
+type IngressBackend struct {
+	Service  string
+	Port     string // Port number or name
+	Resource string // Kind/name of a resource backend
+}
+
+// String formats the backend as service:port, like kubectl describe ingress
+func (b IngressBackend) String() string {
+	if b.Resource != "" {
+		return b.Resource
+	}
+	return fmt.Sprintf("%s:%s", b.Service, b.Port)
+}
+
+// IngressTLS is a TLS secret and the hosts it terminates
+type IngressTLS struct {
+	SecretName string
+	Hosts      []string
+}
+
+// NewIngressInfo creates an IngressInfo from a Kubernetes Ingress
+func NewIngressInfo(ingress *networkingv1.Ingress) IngressInfo {
+	info := IngressInfo{
+		Name:      ingress.Name,
+		Namespace: ingress.Namespace,
+		Class:     "<none>",
+		Age:       formatAge(ingress.CreationTimestamp),
+		Ingress:   ingress,
+	}
+
+	if ingress.Spec.IngressClassName != nil {
+		info.Class = *ingress.Spec.IngressClassName
+	} else if class, ok := ingress.Annotations[ingressClassAnnotation]; ok {
+		info.Class = class
+	}
+
+	var hosts []string
+	for _, rule := range ingress.Spec.Rules {
+		host := rule.Host
+		if host == "" {
+			host = "*"
+		}
+		hosts = append(hosts, host)
+
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			ingressPath := IngressPath{
+				Host:    host,
+				Path:    path.Path,
+				Backend: newIngressBackend(path.Backend),
+			}
+			if ingressPath.Path == "" {
+				ingressPath.Path = "/"
+			}
+			if path.PathType != nil {
+				ingressPath.PathType = string(*path.PathType)
+			}
+			info.Paths = append(info.Paths, ingressPath)
+		}
+	}
+	info.Hosts = strings.Join(hosts, ",")
+	if info.Hosts == "" {
+		info.Hosts = "*"
+	}
+
+	if ingress.Spec.DefaultBackend != nil {
+		backend := newIngressBackend(*ingress.Spec.DefaultBackend)
+		info.DefaultBackend = &backend
+	}
+
+	for _, tls := range ingress.Spec.TLS {
+		info.TLS = append(info.TLS, IngressTLS{SecretName: tls.SecretName, Hosts: tls.Hosts})
+	}
+
+	info.Ports = "80"
+	if len(info.TLS) > 0 {
+		info.Ports = "80, 443"
+	}
+
+	var addresses []string
+	for _, lb := range ingress.Status.LoadBalancer.Ingress {
+		if lb.IP != "" {
+			addresses = append(addresses, lb.IP)
+		} else if lb.Hostname != "" {
+			addresses = append(addresses, lb.Hostname)
+		}
+	}
+	info.Addresses = strings.Join(addresses, ",")
+	if info.Addresses == "" {
+		info.Addresses = "<none>"
+	}
+
+	return info
+}
+
+// newIngressBackend converts an API backend to its display form
+func newIngressBackend(backend networkingv1.IngressBackend) IngressBackend {
+	if backend.Resource != nil {
+		return IngressBackend{Resource: fmt.Sprintf("%s/%s", backend.Resource.Kind, backend.Resource.Name)}
+	}
+	if backend.Service == nil {
+		return IngressBackend{}
+	}
+	result := IngressBackend{Service: backend.Service.Name, Port: backend.Service.Port.Name}
+	if backend.Service.Port.Number != 0 {
+		result.Port = fmt.Sprintf("%d", backend.Service.Port.Number)
+	}
+	return result
+}
+
+// GetStatusSymbol returns a visual indicator for ingress status
+func (i *IngressInfo) GetStatusSymbol() string {
+	if i.Addresses == "<none>" {
+		return "◐" // Waiting for the controller to assign an address
+	}
+	return "●"
+}
+
+// Backends returns the distinct backends of the ingress, default backend first
+func (i *IngressInfo) Backends() []IngressBackend {
+	var backends []IngressBackend
+	seen := make(map[IngressBackend]bool)
+	add := func(backend IngressBackend) {
+		if !seen[backend] {
+			seen[backend] = true
+			backends = append(backends, backend)
+		}
+	}
+	if i.DefaultBackend != nil {
+		add(*i.DefaultBackend)
+	}
+	for _, path := range i.Paths {
+		add(path.Backend)
+	}
+	return backends
+}
+
+// IngressBackendProblems cross-checks the service backends of an ingress against the
+// services in its namespace. It returns a problem for every backend whose service or
+// port is missing, keyed by the backend's String; healthy backends have no entry.
+// Resource backends are not checked.
+func IngressBackendProblems(ingress *IngressInfo, services []corev1.Service) map[string]string {
+	byName := make(map[string]*corev1.Service, len(services))
+	for i := range services {
+		byName[services[i].Name] = &services[i]
+	}
+
+	problems := make(map[string]string)
+	for _, backend := range ingress.Backends() {
+		if backend.Resource != "" {
+			continue
+		}
+		service, ok := byName[backend.Service]
+		if !ok {
+			problems[backend.String()] = fmt.Sprintf("service %q not found", backend.Service)
+			continue
+		}
+		// ExternalName services have no ports of their own to check
+		if service.Spec.Type == corev1.ServiceTypeExternalName {
+			continue
+		}
+		if !serviceHasPort(service, backend.Port) {
+			problems[backend.String()] = fmt.Sprintf("service %q has no port %s", backend.Service, backend.Port)
+		}
+	}
+	return problems
+}
+
+// serviceHasPort reports whether a service exposes port, given as a number or name
+func serviceHasPort(service *corev1.Service, port string) bool {
+	for _, servicePort := range service.Spec.Ports {
+		if (servicePort.Name != "" && servicePort.Name == port) || fmt.Sprintf("%d", servicePort.Port) == port {
+			return true
+		}
+	}
+	return false
+}
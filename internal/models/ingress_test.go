@@ -0,0 +1,146 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// serviceBackend is a helper for tests
+func serviceBackend(name string, port networkingv1.ServiceBackendPort) networkingv1.IngressBackend {
+	return networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{Name: name, Port: port}}
+}
+
+func newTestIngress() *networkingv1.Ingress {
+	className := "nginx"
+	prefix := networkingv1.PathTypePrefix
+	defaultBackend := serviceBackend("default-http-backend", networkingv1.ServiceBackendPort{Number: 80})
+	return &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "shop",
+			Namespace:         "shop",
+			CreationTimestamp: metav1.Time{Time: time.Now().Add(-2 * time.Hour)},
+		},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: &className,
+			DefaultBackend:   &defaultBackend,
+			TLS:              []networkingv1.IngressTLS{{Hosts: []string{"shop.example.com"}, SecretName: "shop-tls"}},
+			Rules: []networkingv1.IngressRule{
+				{
+					Host: "shop.example.com",
+					IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{
+							{Path: "/api", PathType: &prefix, Backend: serviceBackend("api", networkingv1.ServiceBackendPort{Number: 8080})},
+							{Path: "/", PathType: &prefix, Backend: serviceBackend("web", networkingv1.ServiceBackendPort{Name: "http"})},
+						},
+					}},
+				},
+				{
+					IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{
+							{Backend: serviceBackend("web", networkingv1.ServiceBackendPort{Name: "http"})},
+						},
+					}},
+				},
+			},
+		},
+		Status: networkingv1.IngressStatus{LoadBalancer: networkingv1.IngressLoadBalancerStatus{
+			Ingress: []networkingv1.IngressLoadBalancerIngress{{IP: "203.0.113.10"}},
+		}},
+	}
+}
+
+func TestNewIngressInfo(t *testing.T) {
+	got := NewIngressInfo(newTestIngress())
+
+	if got.Class != "nginx" || got.Hosts != "shop.example.com,*" || got.Addresses != "203.0.113.10" || got.Ports != "80, 443" || got.Age != "2h" {
+		t.Errorf("Unexpected ingress info %+v", got)
+	}
+	if len(got.Paths) != 3 {
+		t.Fatalf("Expected 3 paths, got %v", got.Paths)
+	}
+	if got.Paths[0].Host != "shop.example.com" || got.Paths[0].Path != "/api" || got.Paths[0].PathType != "Prefix" || got.Paths[0].Backend.String() != "api:8080" {
+		t.Errorf("Unexpected first path %+v", got.Paths[0])
+	}
+	if got.Paths[2].Host != "*" || got.Paths[2].Path != "/" || got.Paths[2].Backend.String() != "web:http" {
+		t.Errorf("Unexpected catch-all path %+v", got.Paths[2])
+	}
+	if got.DefaultBackend == nil || got.DefaultBackend.String() != "default-http-backend:80" {
+		t.Errorf("Unexpected default backend %v", got.DefaultBackend)
+	}
+	if len(got.TLS) != 1 || got.TLS[0].SecretName != "shop-tls" {
+		t.Errorf("Unexpected TLS %v", got.TLS)
+	}
+	if got.GetStatusSymbol() != "●" {
+		t.Errorf("GetStatusSymbol() = %s, want ●", got.GetStatusSymbol())
+	}
+	if backends := got.Backends(); len(backends) != 3 || backends[0].Service != "default-http-backend" {
+		t.Errorf("Expected 3 distinct backends, default first, got %v", backends)
+	}
+}
+
+func TestNewIngressInfo_Pending(t *testing.T) {
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "legacy",
+			Annotations: map[string]string{"kubernetes.io/ingress.class": "traefik"},
+		},
+		Spec: networkingv1.IngressSpec{
+			DefaultBackend: &networkingv1.IngressBackend{
+				Resource: &corev1.TypedLocalObjectReference{Kind: "StorageBucket", Name: "static-assets"},
+			},
+		},
+	}
+
+	got := NewIngressInfo(ingress)
+
+	if got.Class != "traefik" || got.Hosts != "*" || got.Addresses != "<none>" || got.Ports != "80" {
+		t.Errorf("Unexpected ingress info %+v", got)
+	}
+	if got.DefaultBackend == nil || got.DefaultBackend.String() != "StorageBucket/static-assets" {
+		t.Errorf("Unexpected default backend %v", got.DefaultBackend)
+	}
+	if got.GetStatusSymbol() != "◐" {
+		t.Errorf("GetStatusSymbol() = %s, want ◐", got.GetStatusSymbol())
+	}
+}
+
+func TestIngressBackendProblems(t *testing.T) {
+	info := NewIngressInfo(newTestIngress())
+	services := []corev1.Service{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "api"},
+			Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Name: "http", Port: 80}}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "web"},
+			Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Name: "http", Port: 80}}},
+		},
+	}
+
+	got := IngressBackendProblems(&info, services)
+
+	want := map[string]string{
+		"default-http-backend:80": `service "default-http-backend" not found`,
+		"api:8080":                `service "api" has no port 8080`,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Expected %d problems, got %v", len(want), got)
+	}
+	for backend, problem := range want {
+		if got[backend] != problem {
+			t.Errorf("problem for %s = %q, want %q", backend, got[backend], problem)
+		}
+	}
+
+	externalName := corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "api"},
+		Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeExternalName, ExternalName: "api.internal"},
+	}
+	if got := IngressBackendProblems(&info, []corev1.Service{externalName, services[1]}); got["api:8080"] != "" {
+		t.Errorf("Expected ExternalName services to skip the port check, got %q", got["api:8080"])
+	}
+}
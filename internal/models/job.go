@@ -0,0 +1,172 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// JobInfo represents simplified job information for display
+type JobInfo struct {
+	Name        string
+	Namespace   string
+	Status      string // Complete, Failed, Suspended or Running
+	Completions string // Succeeded/desired, e.g. "1/3"
+	Duration    string // Time from start to completion, or until now while running
+	Age         string
+	Active      int32
+	Succeeded   int32
+	Failed      int32
+	Owner       string       // Name of the owning CronJob, if any
+	Cluster     string       // Source kube context (multi-cluster mode only)
+	Job         *batchv1.Job // Keep reference to full job
+}
+
+// NewJobInfo creates a JobInfo from a Kubernetes Job
+func NewJobInfo(job *batchv1.Job) JobInfo {
+	info := JobInfo{
+		Name:      job.Name,
+		Namespace: job.Namespace,
+		Status:    JobStatus(job),
+		Age:       formatAge(job.CreationTimestamp),
+		Active:    job.Status.Active,
+		Succeeded: job.Status.Succeeded,
+		Failed:    job.Status.Failed,
+		Job:       job,
+	}
+
+	// Completions are printed the way kubectl get jobs does
+	switch {
+	case job.Spec.Completions != nil:
+		info.Completions = fmt.Sprintf("%d/%d", job.Status.Succeeded, *job.Spec.Completions)
+	case job.Spec.Parallelism != nil && *job.Spec.Parallelism > 1:
+		info.Completions = fmt.Sprintf("%d/1 of %d", job.Status.Succeeded, *job.Spec.Parallelism)
+	default:
+		info.Completions = fmt.Sprintf("%d/1", job.Status.Succeeded)
+	}
+
+	if job.Status.StartTime != nil {
+		end := time.Now()
+		if job.Status.CompletionTime != nil {
+			end = job.Status.CompletionTime.Time
+		}
+		info.Duration = formatDuration(end.Sub(job.Status.StartTime.Time))
+	}
+
+	for _, owner := range job.OwnerReferences {
+		if owner.Kind == "CronJob" {
+			info.Owner = owner.Name
+			break
+		}
+	}
+
+	return info
+}
+
+// JobStatus summarizes a job from its conditions
+func JobStatus(job *batchv1.Job) string {
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return "Complete"
+		case batchv1.JobFailed:
+			return "Failed"
+		}
+	}
+	if job.Spec.Suspend != nil && *job.Spec.Suspend {
+		return "Suspended"
+	}
+	return "Running"
+}
+
+// GetStatusSymbol returns a visual indicator for job status
+func (j *JobInfo) GetStatusSymbol() string {
+	switch j.Status {
+	case "Complete":
+		return "✔" // Check mark for completed
+	case "Failed":
+		return "✖" // X for failed
+	case "Suspended":
+		return "◌" // Dotted circle for suspended
+	default:
+		return "◐" // Half circle for running
+	}
+}
+
+// CronJobInfo represents simplified cronjob information for display
+type CronJobInfo struct {
+	Name               string
+	Namespace          string
+	Schedule           string
+	TimeZone           string
+	Suspend            bool
+	Active             int // Number of currently running jobs
+	LastSchedule       string
+	LastSuccessfulTime string
+	Age                string
+	Cluster            string           // Source kube context (multi-cluster mode only)
+	CronJob            *batchv1.CronJob // Keep reference to full cronjob
+}
+
+// NewCronJobInfo creates a CronJobInfo from a Kubernetes CronJob
+func NewCronJobInfo(cronJob *batchv1.CronJob) CronJobInfo {
+	info := CronJobInfo{
+		Name:               cronJob.Name,
+		Namespace:          cronJob.Namespace,
+		Schedule:           cronJob.Spec.Schedule,
+		Suspend:            cronJob.Spec.Suspend != nil && *cronJob.Spec.Suspend,
+		Active:             len(cronJob.Status.Active),
+		LastSchedule:       formatOptionalAge(cronJob.Status.LastScheduleTime),
+		LastSuccessfulTime: formatOptionalAge(cronJob.Status.LastSuccessfulTime),
+		Age:                formatAge(cronJob.CreationTimestamp),
+		CronJob:            cronJob,
+	}
+	if cronJob.Spec.TimeZone != nil {
+		info.TimeZone = *cronJob.Spec.TimeZone
+	}
+
+	return info
+}
+
+// formatOptionalAge formats an optional timestamp as an age, or "<none>" if unset
+func formatOptionalAge(timestamp *metav1.Time) string {
+	if timestamp == nil {
+		return "<none>"
+	}
+	return formatAge(*timestamp)
+}
+
+// GetStatusSymbol returns a visual indicator for cronjob status
+func (c *CronJobInfo) GetStatusSymbol() string {
+	if c.Suspend {
+		return "◌" // Dotted circle for suspended
+	}
+	if c.Active > 0 {
+		return "◐" // Half circle while a job is running
+	}
+	return "●"
+}
+
+// CronJobJobs returns the jobs owned by a cronjob, newest first
+func CronJobJobs(cronJob *batchv1.CronJob, jobs []batchv1.Job) []JobInfo {
+	result := make([]JobInfo, 0)
+	for i := range jobs {
+		for _, owner := range jobs[i].OwnerReferences {
+			if owner.Kind == "CronJob" && owner.UID == cronJob.UID {
+				result = append(result, NewJobInfo(&jobs[i]))
+				break
+			}
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[j].Job.CreationTimestamp.Before(&result[i].Job.CreationTimestamp)
+	})
+	return result
+}
@@ -0,0 +1,163 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// int32ptr is a helper for tests
+func int32ptr(i int32) *int32 {
+	return &i
+}
+
+func TestNewJobInfo(t *testing.T) {
+	start := time.Now().Add(-10 * time.Minute)
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "nightly-report-29012345",
+			Namespace:         "batch",
+			CreationTimestamp: metav1.Time{Time: start},
+			OwnerReferences:   []metav1.OwnerReference{{Kind: "CronJob", Name: "nightly-report"}},
+		},
+		Spec: batchv1.JobSpec{Completions: int32ptr(3)},
+		Status: batchv1.JobStatus{
+			Succeeded:      3,
+			StartTime:      &metav1.Time{Time: start},
+			CompletionTime: &metav1.Time{Time: start.Add(4 * time.Minute)},
+			Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobComplete, Status: corev1.ConditionTrue},
+			},
+		},
+	}
+
+	got := NewJobInfo(job)
+
+	if got.Status != "Complete" || got.Completions != "3/3" || got.Duration != "4m" || got.Age != "10m" {
+		t.Errorf("Unexpected job info %+v", got)
+	}
+	if got.Owner != "nightly-report" {
+		t.Errorf("Owner = %s, want nightly-report", got.Owner)
+	}
+	if got.GetStatusSymbol() != "✔" {
+		t.Errorf("GetStatusSymbol() = %s, want ✔", got.GetStatusSymbol())
+	}
+}
+
+func TestNewJobInfo_Status(t *testing.T) {
+	suspend := true
+	tests := []struct {
+		name            string
+		job             batchv1.Job
+		wantStatus      string
+		wantSymbol      string
+		wantCompletions string
+	}{
+		{
+			name:            "running",
+			job:             batchv1.Job{Status: batchv1.JobStatus{Active: 1}},
+			wantStatus:      "Running",
+			wantSymbol:      "◐",
+			wantCompletions: "0/1",
+		},
+		{
+			name: "failed",
+			job: batchv1.Job{Status: batchv1.JobStatus{Failed: 6, Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: "BackoffLimitExceeded"},
+			}}},
+			wantStatus:      "Failed",
+			wantSymbol:      "✖",
+			wantCompletions: "0/1",
+		},
+		{
+			name:            "suspended work queue",
+			job:             batchv1.Job{Spec: batchv1.JobSpec{Suspend: &suspend, Parallelism: int32ptr(4)}},
+			wantStatus:      "Suspended",
+			wantSymbol:      "◌",
+			wantCompletions: "0/1 of 4",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewJobInfo(&tt.job)
+			if got.Status != tt.wantStatus {
+				t.Errorf("Status = %s, want %s", got.Status, tt.wantStatus)
+			}
+			if got.GetStatusSymbol() != tt.wantSymbol {
+				t.Errorf("GetStatusSymbol() = %s, want %s", got.GetStatusSymbol(), tt.wantSymbol)
+			}
+			if got.Completions != tt.wantCompletions {
+				t.Errorf("Completions = %s, want %s", got.Completions, tt.wantCompletions)
+			}
+			if got.Duration != "" {
+				t.Errorf("Expected no duration for a job that has not started, got %s", got.Duration)
+			}
+		})
+	}
+}
+
+func TestNewCronJobInfo(t *testing.T) {
+	suspend := false
+	timeZone := "Europe/London"
+	cronJob := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "nightly-report",
+			Namespace:         "batch",
+			CreationTimestamp: metav1.Time{Time: time.Now().Add(-30 * 24 * time.Hour)},
+		},
+		Spec: batchv1.CronJobSpec{Schedule: "0 2 * * *", Suspend: &suspend, TimeZone: &timeZone},
+		Status: batchv1.CronJobStatus{
+			Active:           []corev1.ObjectReference{{Name: "nightly-report-29012345"}},
+			LastScheduleTime: &metav1.Time{Time: time.Now().Add(-2 * time.Hour)},
+		},
+	}
+
+	got := NewCronJobInfo(cronJob)
+
+	if got.Schedule != "0 2 * * *" || got.TimeZone != "Europe/London" || got.Suspend || got.Active != 1 {
+		t.Errorf("Unexpected cronjob info %+v", got)
+	}
+	if got.LastSchedule != "2h" || got.LastSuccessfulTime != "<none>" || got.Age != "30d" {
+		t.Errorf("Unexpected times: last schedule %s, last successful %s, age %s", got.LastSchedule, got.LastSuccessfulTime, got.Age)
+	}
+	if got.GetStatusSymbol() != "◐" {
+		t.Errorf("GetStatusSymbol() = %s, want ◐", got.GetStatusSymbol())
+	}
+
+	suspend = true
+	cronJob.Status.Active = nil
+	if got := NewCronJobInfo(cronJob); !got.Suspend || got.GetStatusSymbol() != "◌" {
+		t.Errorf("Expected a suspended cronjob, got %+v", got)
+	}
+}
+
+func TestCronJobJobs(t *testing.T) {
+	cronJob := &batchv1.CronJob{ObjectMeta: metav1.ObjectMeta{Name: "nightly-report", UID: types.UID("cron-uid")}}
+	owned := func(name string, age time.Duration, uid types.UID) batchv1.Job {
+		return batchv1.Job{ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			CreationTimestamp: metav1.Time{Time: time.Now().Add(-age)},
+			OwnerReferences:   []metav1.OwnerReference{{Kind: "CronJob", Name: "nightly-report", UID: uid}},
+		}}
+	}
+	jobs := []batchv1.Job{
+		owned("nightly-report-1", 48*time.Hour, "cron-uid"),
+		owned("nightly-report-2", 24*time.Hour, "cron-uid"),
+		owned("nightly-report-old", 12*time.Hour, "recreated-uid"), // Same name, previous cronjob
+		{ObjectMeta: metav1.ObjectMeta{Name: "manual-run"}},
+	}
+
+	got := CronJobJobs(cronJob, jobs)
+
+	if len(got) != 2 || got[0].Name != "nightly-report-2" || got[1].Name != "nightly-report-1" {
+		t.Errorf("Expected owned jobs newest first, got %v", got)
+	}
+	if got := CronJobJobs(cronJob, nil); got == nil || len(got) != 0 {
+		t.Errorf("Expected an empty, non-nil history, got %v", got)
+	}
+}
@@ -0,0 +1,289 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// NetworkPolicyInfo represents simplified networkpolicy information for display
+type NetworkPolicyInfo struct {
+	Name         string
+	Namespace    string
+	PodSelector  string
+	PolicyTypes  []string
+	IngressRules []NetworkPolicyRule
+	EgressRules  []NetworkPolicyRule
+	Age          string
+	Cluster      string                      // Source kube context (multi-cluster mode only)
+	Policy       *networkingv1.NetworkPolicy // Keep reference to full networkpolicy
+}
+
+// NetworkPolicyRule describes the traffic one rule of a policy allows
+type NetworkPolicyRule struct {
+	Policy string   // Policy the rule belongs to, set in pod analyses
+	Peers  []string // Allowed peers; empty allows every peer
+	Ports  []string // Allowed ports; empty allows every port
+}
+
+// NewNetworkPolicyInfo creates a NetworkPolicyInfo from a Kubernetes NetworkPolicy
+func NewNetworkPolicyInfo(policy *networkingv1.NetworkPolicy) NetworkPolicyInfo {
+	ingress, egress := networkPolicyRules(policy, nil)
+	return NetworkPolicyInfo{
+		Name:         policy.Name,
+		Namespace:    policy.Namespace,
+		PodSelector:  formatPodSelector(&policy.Spec.PodSelector),
+		PolicyTypes:  effectivePolicyTypes(policy),
+		IngressRules: ingress,
+		EgressRules:  egress,
+		Age:          formatAge(policy.CreationTimestamp),
+		Policy:       policy,
+	}
+}
+
+// PolicyTypesSummary returns the directions the policy restricts, e.g. "Ingress,Egress"
+func (p *NetworkPolicyInfo) PolicyTypesSummary() string {
+	return strings.Join(p.PolicyTypes, ",")
+}
+
+// DeniesAll reports whether the policy blocks all traffic in a direction it restricts,
+// like the common default-deny policies
+func (p *NetworkPolicyInfo) DeniesAll() bool {
+	for _, policyType := range p.PolicyTypes {
+		if policyType == string(networkingv1.PolicyTypeIngress) && len(p.IngressRules) == 0 {
+			return true
+		}
+		if policyType == string(networkingv1.PolicyTypeEgress) && len(p.EgressRules) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// GetStatusSymbol returns a visual indicator for networkpolicy status
+func (p *NetworkPolicyInfo) GetStatusSymbol() string {
+	if p.DeniesAll() {
+		return "⊘" // Default deny
+	}
+	return "●"
+}
+
+// String formats the rule, e.g. "pods app=web in shop on TCP/8080"
+func (r NetworkPolicyRule) String() string {
+	peers := "any peer"
+	if len(r.Peers) > 0 {
+		peers = strings.Join(r.Peers, "; ")
+	}
+	ports := "any port"
+	if len(r.Ports) > 0 {
+		ports = strings.Join(r.Ports, ", ")
+	}
+	return peers + " on " + ports
+}
+
+// AllowsAll reports whether the rule allows every peer on every port
+func (r NetworkPolicyRule) AllowsAll() bool {
+	return len(r.Peers) == 0 && len(r.Ports) == 0
+}
+
+// effectivePolicyTypes returns the directions a policy restricts. Without explicit
+// policyTypes, a policy always restricts ingress and restricts egress if it has egress rules.
+func effectivePolicyTypes(policy *networkingv1.NetworkPolicy) []string {
+	if len(policy.Spec.PolicyTypes) == 0 {
+		types := []string{string(networkingv1.PolicyTypeIngress)}
+		if len(policy.Spec.Egress) > 0 {
+			types = append(types, string(networkingv1.PolicyTypeEgress))
+		}
+		return types
+	}
+
+	types := make([]string, len(policy.Spec.PolicyTypes))
+	for i, policyType := range policy.Spec.PolicyTypes {
+		types[i] = string(policyType)
+	}
+	return types
+}
+
+// networkPolicyRules describes the ingress and egress rules of a policy. Namespaces,
+// if known, are used to list the namespaces a namespaceSelector matches.
+func networkPolicyRules(policy *networkingv1.NetworkPolicy, namespaces []corev1.Namespace) (ingress, egress []NetworkPolicyRule) {
+	ingress = make([]NetworkPolicyRule, 0, len(policy.Spec.Ingress))
+	for _, rule := range policy.Spec.Ingress {
+		ingress = append(ingress, NetworkPolicyRule{
+			Policy: policy.Name,
+			Peers:  formatPeers(rule.From, policy.Namespace, namespaces),
+			Ports:  formatNetworkPolicyPorts(rule.Ports),
+		})
+	}
+	egress = make([]NetworkPolicyRule, 0, len(policy.Spec.Egress))
+	for _, rule := range policy.Spec.Egress {
+		egress = append(egress, NetworkPolicyRule{
+			Policy: policy.Name,
+			Peers:  formatPeers(rule.To, policy.Namespace, namespaces),
+			Ports:  formatNetworkPolicyPorts(rule.Ports),
+		})
+	}
+	return ingress, egress
+}
+
+// formatPeers describes the peers of a rule, e.g. "pods app=web in namespaces team=shop (shop)"
+func formatPeers(peers []networkingv1.NetworkPolicyPeer, namespace string, namespaces []corev1.Namespace) []string {
+	formatted := make([]string, 0, len(peers))
+	for _, peer := range peers {
+		if block := peer.IPBlock; block != nil {
+			text := "ipBlock " + block.CIDR
+			if len(block.Except) > 0 {
+				text += " except " + strings.Join(block.Except, ", ")
+			}
+			formatted = append(formatted, text)
+			continue
+		}
+
+		pods := "all pods"
+		if peer.PodSelector != nil && !isEmptySelector(peer.PodSelector) {
+			pods = "pods " + formatPodSelector(peer.PodSelector)
+		}
+		switch {
+		case peer.NamespaceSelector == nil:
+			formatted = append(formatted, pods+" in "+namespace)
+		case isEmptySelector(peer.NamespaceSelector):
+			formatted = append(formatted, pods+" in all namespaces")
+		default:
+			text := pods + " in namespaces " + formatPodSelector(peer.NamespaceSelector)
+			if namespaces != nil {
+				text += " (" + strings.Join(matchingNamespaces(peer.NamespaceSelector, namespaces), ", ") + ")"
+			}
+			formatted = append(formatted, text)
+		}
+	}
+	return formatted
+}
+
+// formatNetworkPolicyPorts describes the ports of a rule, e.g. "TCP/8080" or "TCP/8000-8100"
+func formatNetworkPolicyPorts(ports []networkingv1.NetworkPolicyPort) []string {
+	formatted := make([]string, 0, len(ports))
+	for _, port := range ports {
+		protocol := string(corev1.ProtocolTCP)
+		if port.Protocol != nil {
+			protocol = string(*port.Protocol)
+		}
+		switch {
+		case port.Port == nil:
+			formatted = append(formatted, protocol+"/all")
+		case port.EndPort != nil:
+			formatted = append(formatted, fmt.Sprintf("%s/%s-%d", protocol, port.Port.String(), *port.EndPort))
+		default:
+			formatted = append(formatted, protocol+"/"+port.Port.String())
+		}
+	}
+	return formatted
+}
+
+// formatPodSelector formats a label selector, e.g. "app=web,tier in (api)"
+func formatPodSelector(selector *metav1.LabelSelector) string {
+	if isEmptySelector(selector) {
+		return "<all pods>"
+	}
+	return metav1.FormatLabelSelector(selector)
+}
+
+// isEmptySelector reports whether a label selector matches everything
+func isEmptySelector(selector *metav1.LabelSelector) bool {
+	return len(selector.MatchLabels) == 0 && len(selector.MatchExpressions) == 0
+}
+
+// selectorMatches reports whether a label selector matches the labels; invalid
+// selectors match nothing
+func selectorMatches(selector *metav1.LabelSelector, set map[string]string) bool {
+	parsed, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return false
+	}
+	return parsed.Matches(labels.Set(set))
+}
+
+// matchingNamespaces returns the names of the namespaces a namespaceSelector matches
+func matchingNamespaces(selector *metav1.LabelSelector, namespaces []corev1.Namespace) []string {
+	names := make([]string, 0)
+	for i := range namespaces {
+		if selectorMatches(selector, namespaces[i].Labels) {
+			names = append(names, namespaces[i].Name)
+		}
+	}
+	if len(names) == 0 {
+		return []string{"none"}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NetworkPolicyDirection is the effective ingress or egress policy of a pod
+type NetworkPolicyDirection struct {
+	Isolated bool                // A policy selecting the pod restricts this direction
+	Policies []string            // Policies restricting this direction
+	Rules    []NetworkPolicyRule // Traffic allowed by the restricting policies, which add up
+}
+
+// DefaultDeny reports whether all traffic in this direction is blocked
+func (d NetworkPolicyDirection) DefaultDeny() bool {
+	return d.Isolated && len(d.Rules) == 0
+}
+
+// AllowsAll reports whether all traffic in this direction is allowed
+func (d NetworkPolicyDirection) AllowsAll() bool {
+	if !d.Isolated {
+		return true
+	}
+	for _, rule := range d.Rules {
+		if rule.AllowsAll() {
+			return true
+		}
+	}
+	return false
+}
+
+// PodNetworkAnalysis is the effective network policy of a pod, computed from the
+// labels of the pod and the selectors of the policies in its namespace
+type PodNetworkAnalysis struct {
+	Policies []string // Policies selecting the pod
+	Ingress  NetworkPolicyDirection
+	Egress   NetworkPolicyDirection
+}
+
+// AnalyzePodNetworkPolicies finds the policies that select the pod and combines their
+// rules. A pod is isolated in a direction once any selecting policy restricts it, and
+// is then allowed the union of the rules of those policies. Namespaces, if known, are
+// used to list the namespaces a namespaceSelector matches.
+func AnalyzePodNetworkPolicies(pod *corev1.Pod, policies []networkingv1.NetworkPolicy, namespaces []corev1.Namespace) PodNetworkAnalysis {
+	analysis := PodNetworkAnalysis{Policies: make([]string, 0)}
+	for i := range policies {
+		policy := &policies[i]
+		if policy.Namespace != pod.Namespace || !selectorMatches(&policy.Spec.PodSelector, pod.Labels) {
+			continue
+		}
+		analysis.Policies = append(analysis.Policies, policy.Name)
+
+		ingress, egress := networkPolicyRules(policy, namespaces)
+		for _, policyType := range effectivePolicyTypes(policy) {
+			switch policyType {
+			case string(networkingv1.PolicyTypeIngress):
+				analysis.Ingress.restrict(policy.Name, ingress)
+			case string(networkingv1.PolicyTypeEgress):
+				analysis.Egress.restrict(policy.Name, egress)
+			}
+		}
+	}
+	return analysis
+}
+
+// restrict adds a policy that isolates the direction, with the traffic it allows
+func (d *NetworkPolicyDirection) restrict(policy string, rules []NetworkPolicyRule) {
+	d.Isolated = true
+	d.Policies = append(d.Policies, policy)
+	d.Rules = append(d.Rules, rules...)
+}
@@ -0,0 +1,147 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestNewNetworkPolicyInfo(t *testing.T) {
+	port, endPort := intstr.FromInt32(8000), int32(8100)
+	udp := corev1.ProtocolUDP
+	dns := intstr.FromInt32(53)
+	policy := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "shop"},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{{
+				From: []networkingv1.NetworkPolicyPeer{
+					{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "frontend"}}},
+					{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "ops"}}},
+					{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.0/8", Except: []string{"10.1.0.0/16"}}},
+				},
+				Ports: []networkingv1.NetworkPolicyPort{{Port: &port, EndPort: &endPort}},
+			}},
+			Egress: []networkingv1.NetworkPolicyEgressRule{{
+				To:    []networkingv1.NetworkPolicyPeer{{NamespaceSelector: &metav1.LabelSelector{}}},
+				Ports: []networkingv1.NetworkPolicyPort{{Protocol: &udp, Port: &dns}},
+			}},
+		},
+	}
+
+	info := NewNetworkPolicyInfo(policy)
+	if info.PodSelector != "app=web" {
+		t.Errorf("Expected pod selector app=web, got %q", info.PodSelector)
+	}
+	if info.PolicyTypesSummary() != "Ingress,Egress" {
+		t.Errorf("Expected implicit Ingress,Egress policy types, got %q", info.PolicyTypesSummary())
+	}
+	if len(info.IngressRules) != 1 || len(info.EgressRules) != 1 {
+		t.Fatalf("Expected one ingress and one egress rule, got %v and %v", info.IngressRules, info.EgressRules)
+	}
+
+	ingress := info.IngressRules[0].String()
+	for _, expected := range []string{"pods app=frontend in shop", "all pods in namespaces team=ops", "ipBlock 10.0.0.0/8 except 10.1.0.0/16", "TCP/8000-8100"} {
+		if !strings.Contains(ingress, expected) {
+			t.Errorf("Expected ingress rule %q to contain %q", ingress, expected)
+		}
+	}
+	if egress := info.EgressRules[0].String(); egress != "all pods in all namespaces on UDP/53" {
+		t.Errorf("Unexpected egress rule %q", egress)
+	}
+	if info.DeniesAll() || info.GetStatusSymbol() != "●" {
+		t.Error("Expected a policy with rules in every direction not to deny all")
+	}
+}
+
+func TestNetworkPolicyInfo_DefaultDeny(t *testing.T) {
+	policy := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-deny", Namespace: "shop"},
+		Spec: networkingv1.NetworkPolicySpec{
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+		},
+	}
+	info := NewNetworkPolicyInfo(policy)
+	if info.PodSelector != "<all pods>" {
+		t.Errorf("Expected <all pods>, got %q", info.PodSelector)
+	}
+	if !info.DeniesAll() || info.GetStatusSymbol() != "⊘" {
+		t.Error("Expected a policy without rules to deny all")
+	}
+}
+
+func TestAnalyzePodNetworkPolicies(t *testing.T) {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "shop", Labels: map[string]string{"app": "web"}}}
+	port := intstr.FromString("http")
+	policies := []networkingv1.NetworkPolicy{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "default-deny", Namespace: "shop"},
+			Spec:       networkingv1.NetworkPolicySpec{PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "allow-ops", Namespace: "shop"},
+			Spec: networkingv1.NetworkPolicySpec{
+				PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+				Ingress: []networkingv1.NetworkPolicyIngressRule{{
+					From:  []networkingv1.NetworkPolicyPeer{{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "ops"}}}},
+					Ports: []networkingv1.NetworkPolicyPort{{Port: &port}},
+				}},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "db-only", Namespace: "shop"},
+			Spec:       networkingv1.NetworkPolicySpec{PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-namespace", Namespace: "default"},
+			Spec:       networkingv1.NetworkPolicySpec{PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress}},
+		},
+	}
+	namespaces := []corev1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "monitoring", Labels: map[string]string{"team": "ops"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "shop"}},
+	}
+
+	analysis := AnalyzePodNetworkPolicies(pod, policies, namespaces)
+	if len(analysis.Policies) != 2 || analysis.Policies[0] != "default-deny" || analysis.Policies[1] != "allow-ops" {
+		t.Fatalf("Expected default-deny and allow-ops to select the pod, got %v", analysis.Policies)
+	}
+
+	if !analysis.Ingress.Isolated || analysis.Ingress.DefaultDeny() || analysis.Ingress.AllowsAll() {
+		t.Errorf("Expected ingress to be restricted but not denied, got %+v", analysis.Ingress)
+	}
+	if len(analysis.Ingress.Rules) != 1 || analysis.Ingress.Rules[0].Policy != "allow-ops" {
+		t.Fatalf("Expected the allow-ops rule, got %v", analysis.Ingress.Rules)
+	}
+	if rule := analysis.Ingress.Rules[0].String(); rule != "all pods in namespaces team=ops (monitoring) on TCP/http" {
+		t.Errorf("Unexpected ingress rule %q", rule)
+	}
+
+	if analysis.Egress.Isolated || !analysis.Egress.AllowsAll() {
+		t.Errorf("Expected egress not to be isolated, got %+v", analysis.Egress)
+	}
+}
+
+func TestAnalyzePodNetworkPolicies_DefaultDeny(t *testing.T) {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "shop"}}
+	policies := []networkingv1.NetworkPolicy{{
+		ObjectMeta: metav1.ObjectMeta{Name: "deny-egress", Namespace: "shop"},
+		Spec:       networkingv1.NetworkPolicySpec{PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress}},
+	}}
+
+	analysis := AnalyzePodNetworkPolicies(pod, policies, nil)
+	if !analysis.Egress.DefaultDeny() || analysis.Egress.Policies[0] != "deny-egress" {
+		t.Errorf("Expected egress to be denied by deny-egress, got %+v", analysis.Egress)
+	}
+	if analysis.Ingress.Isolated {
+		t.Error("Expected an egress-only policy not to isolate ingress")
+	}
+
+	if analysis := AnalyzePodNetworkPolicies(pod, nil, nil); len(analysis.Policies) != 0 || !analysis.Ingress.AllowsAll() {
+		t.Errorf("Expected a pod without policies to allow all traffic, got %+v", analysis)
+	}
+}
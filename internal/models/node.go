@@ -0,0 +1,275 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// Node role labels, as used by kubectl get nodes
+const (
+	nodeRoleLabelPrefix = "node-role.kubernetes.io/"
+	nodeRoleLabel       = "kubernetes.io/role"
+)
+
+// allocatedResourceNames are the resources summarised in the allocated resources table
+var allocatedResourceNames = []corev1.ResourceName{
+	corev1.ResourceCPU,
+	corev1.ResourceMemory,
+	corev1.ResourceEphemeralStorage,
+}
+
+// NodeInfo represents simplified node information for display
+type NodeInfo struct {
+	Name           string
+	Status         string // Ready, NotReady or Unknown, plus SchedulingDisabled when cordoned
+	Roles          string
+	KubeletVersion string
+	Age            string
+	Unschedulable  bool // Cordoned
+	InternalIP     string
+	Conditions     []NodeCondition
+	Taints         []string
+	Resources      []NodeResource // Capacity and allocatable, sorted by name
+	SystemInfo     corev1.NodeSystemInfo
+	Cluster        string       // Source kube context (multi-cluster mode only)
+	Node           *corev1.Node // Keep reference to full node
+}
+
+// NodeCondition represents a single node condition
+type NodeCondition struct {
+	Type    string
+	Status  string
+	Reason  string
+	Message string
+	Age     string // Time since the last transition
+}
+
+// NodeResource pairs the capacity and allocatable amount of a node resource
+type NodeResource struct {
+	Name        string
+	Capacity    string
+	Allocatable string
+}
+
+// NewNodeInfo creates a NodeInfo from a Kubernetes Node
+func NewNodeInfo(node *corev1.Node) NodeInfo {
+	info := NodeInfo{
+		Name:           node.Name,
+		Roles:          NodeRoles(node),
+		KubeletVersion: node.Status.NodeInfo.KubeletVersion,
+		Age:            formatAge(node.CreationTimestamp),
+		Unschedulable:  node.Spec.Unschedulable,
+		SystemInfo:     node.Status.NodeInfo,
+		Node:           node,
+	}
+
+	info.Status = "Unknown"
+	for _, condition := range node.Status.Conditions {
+		info.Conditions = append(info.Conditions, NodeCondition{
+			Type:    string(condition.Type),
+			Status:  string(condition.Status),
+			Reason:  condition.Reason,
+			Message: condition.Message,
+			Age:     formatAge(condition.LastTransitionTime),
+		})
+		if condition.Type == corev1.NodeReady {
+			switch condition.Status {
+			case corev1.ConditionTrue:
+				info.Status = "Ready"
+			case corev1.ConditionFalse:
+				info.Status = "NotReady"
+			}
+		}
+	}
+	if info.Unschedulable {
+		info.Status += ",SchedulingDisabled"
+	}
+
+	for _, address := range node.Status.Addresses {
+		if address.Type == corev1.NodeInternalIP {
+			info.InternalIP = address.Address
+			break
+		}
+	}
+
+	for _, taint := range node.Spec.Taints {
+		info.Taints = append(info.Taints, FormatTaint(taint))
+	}
+
+	names := make([]string, 0, len(node.Status.Capacity))
+	for name := range node.Status.Capacity {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		capacity := node.Status.Capacity[corev1.ResourceName(name)]
+		allocatable := node.Status.Allocatable[corev1.ResourceName(name)]
+		info.Resources = append(info.Resources, NodeResource{
+			Name:        name,
+			Capacity:    capacity.String(),
+			Allocatable: allocatable.String(),
+		})
+	}
+
+	return info
+}
+
+// GetStatusSymbol returns a visual indicator for node status
+func (n *NodeInfo) GetStatusSymbol() string {
+	switch {
+	case strings.HasPrefix(n.Status, "NotReady"):
+		return "✖" // Not ready
+	case strings.HasPrefix(n.Status, "Unknown"):
+		return "?" // Kubelet stopped reporting
+	case n.Unschedulable:
+		return "◐" // Ready but cordoned
+	default:
+		return "●" // Ready
+	}
+}
+
+// NodeRoles returns the comma separated roles of a node, or "<none>"
+func NodeRoles(node *corev1.Node) string {
+	var roles []string
+	for label, value := range node.Labels {
+		switch {
+		case strings.HasPrefix(label, nodeRoleLabelPrefix):
+			if role := strings.TrimPrefix(label, nodeRoleLabelPrefix); role != "" {
+				roles = append(roles, role)
+			}
+		case label == nodeRoleLabel && value != "":
+			roles = append(roles, value)
+		}
+	}
+	if len(roles) == 0 {
+		return "<none>"
+	}
+	sort.Strings(roles)
+	return strings.Join(roles, ",")
+}
+
+// FormatTaint formats a taint the way kubectl does, e.g. "key=value:NoSchedule"
+func FormatTaint(taint corev1.Taint) string {
+	if taint.Value == "" {
+		return fmt.Sprintf("%s:%s", taint.Key, taint.Effect)
+	}
+	return fmt.Sprintf("%s=%s:%s", taint.Key, taint.Value, taint.Effect)
+}
+
+// NodeAllocation summarises the requests and limits of the pods scheduled on a node
+type NodeAllocation struct {
+	PodCount  int // Non-terminated pods
+	Resources []AllocatedResource
+}
+
+// AllocatedResource is one row of the allocated resources table
+type AllocatedResource struct {
+	Name            string
+	Requests        string
+	RequestsPercent int // Of allocatable
+	Limits          string
+	LimitsPercent   int // Of allocatable; may exceed 100 when overcommitted
+}
+
+// NewNodeAllocation adds up the requests and limits of the non-terminated pods
+// scheduled on node, like the "Allocated resources" section of kubectl describe node
+func NewNodeAllocation(node *corev1.Node, pods []corev1.Pod) NodeAllocation {
+	requests := corev1.ResourceList{}
+	limits := corev1.ResourceList{}
+	allocation := NodeAllocation{}
+
+	for i := range pods {
+		pod := &pods[i]
+		if pod.Spec.NodeName != node.Name || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		allocation.PodCount++
+
+		podRequests, podLimits := PodRequestsAndLimits(pod)
+		addResourceList(requests, podRequests)
+		addResourceList(limits, podLimits)
+	}
+
+	for _, name := range allocatedResourceNames {
+		request := requests[name]
+		limit := limits[name]
+		allocatable := node.Status.Allocatable[name]
+		allocation.Resources = append(allocation.Resources, AllocatedResource{
+			Name:            string(name),
+			Requests:        request.String(),
+			RequestsPercent: percentOf(request, allocatable),
+			Limits:          limit.String(),
+			LimitsPercent:   percentOf(limit, allocatable),
+		})
+	}
+
+	return allocation
+}
+
+// PodRequestsAndLimits returns the effective requests and limits of a pod: the larger
+// of its app containers (plus sidecars) and any single init container, plus pod overhead
+func PodRequestsAndLimits(pod *corev1.Pod) (requests, limits corev1.ResourceList) {
+	return podResources(pod, func(r corev1.ResourceRequirements) corev1.ResourceList { return r.Requests }),
+		podResources(pod, func(r corev1.ResourceRequirements) corev1.ResourceList { return r.Limits })
+}
+
+// podResources computes the effective pod resources selected by get
+func podResources(pod *corev1.Pod, get func(corev1.ResourceRequirements) corev1.ResourceList) corev1.ResourceList {
+	total := corev1.ResourceList{}
+	for _, container := range pod.Spec.Containers {
+		addResourceList(total, get(container.Resources))
+	}
+
+	// Sidecars (restartable init containers) run alongside the app containers, while
+	// regular init containers run one at a time alongside the sidecars started before them
+	sidecars := corev1.ResourceList{}
+	initMax := corev1.ResourceList{}
+	for _, container := range pod.Spec.InitContainers {
+		if container.RestartPolicy != nil && *container.RestartPolicy == corev1.ContainerRestartPolicyAlways {
+			addResourceList(sidecars, get(container.Resources))
+			maxResourceList(initMax, sidecars)
+			continue
+		}
+		running := sidecars.DeepCopy()
+		addResourceList(running, get(container.Resources))
+		maxResourceList(initMax, running)
+	}
+	addResourceList(total, sidecars)
+	maxResourceList(total, initMax)
+
+	addResourceList(total, pod.Spec.Overhead)
+	return total
+}
+
+// addResourceList adds every quantity in add to list
+func addResourceList(list, add corev1.ResourceList) {
+	for name, quantity := range add {
+		if current, ok := list[name]; ok {
+			current.Add(quantity)
+			list[name] = current
+		} else {
+			list[name] = quantity.DeepCopy()
+		}
+	}
+}
+
+// maxResourceList raises each quantity in list to at least the one in other
+func maxResourceList(list, other corev1.ResourceList) {
+	for name, quantity := range other {
+		if current, ok := list[name]; !ok || quantity.Cmp(current) > 0 {
+			list[name] = quantity.DeepCopy()
+		}
+	}
+}
+
+// percentOf returns value as a whole percentage of total, or 0 if total is zero
+func percentOf(value, total resource.Quantity) int {
+	if total.IsZero() {
+		return 0
+	}
+	return int(value.MilliValue() * 100 / total.MilliValue())
+}
@@ -0,0 +1,214 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newTestNode() *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "worker-1",
+			Labels: map[string]string{
+				"node-role.kubernetes.io/worker":  "",
+				"node-role.kubernetes.io/ingress": "",
+			},
+			CreationTimestamp: metav1.Time{Time: time.Now().Add(-10 * 24 * time.Hour)},
+		},
+		Spec: corev1.NodeSpec{
+			Taints: []corev1.Taint{
+				{Key: "dedicated", Value: "ingress", Effect: corev1.TaintEffectNoSchedule},
+				{Key: "node.kubernetes.io/unreachable", Effect: corev1.TaintEffectNoExecute},
+			},
+		},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeMemoryPressure, Status: corev1.ConditionFalse, Reason: "KubeletHasSufficientMemory"},
+				{Type: corev1.NodeReady, Status: corev1.ConditionTrue, Reason: "KubeletReady"},
+			},
+			Addresses: []corev1.NodeAddress{
+				{Type: corev1.NodeHostName, Address: "worker-1"},
+				{Type: corev1.NodeInternalIP, Address: "10.0.0.11"},
+			},
+			Capacity: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("4"),
+				corev1.ResourceMemory: resource.MustParse("16Gi"),
+				corev1.ResourcePods:   resource.MustParse("110"),
+			},
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("3800m"),
+				corev1.ResourceMemory: resource.MustParse("15Gi"),
+				corev1.ResourcePods:   resource.MustParse("110"),
+			},
+			NodeInfo: corev1.NodeSystemInfo{KubeletVersion: "v1.31.2", OSImage: "Ubuntu 24.04"},
+		},
+	}
+}
+
+func TestNewNodeInfo(t *testing.T) {
+	got := NewNodeInfo(newTestNode())
+
+	if got.Status != "Ready" || got.Roles != "ingress,worker" || got.KubeletVersion != "v1.31.2" || got.Age != "10d" {
+		t.Errorf("Unexpected node info %+v", got)
+	}
+	if got.InternalIP != "10.0.0.11" {
+		t.Errorf("InternalIP = %s, want 10.0.0.11", got.InternalIP)
+	}
+	if len(got.Taints) != 2 || got.Taints[0] != "dedicated=ingress:NoSchedule" || got.Taints[1] != "node.kubernetes.io/unreachable:NoExecute" {
+		t.Errorf("Unexpected taints %v", got.Taints)
+	}
+	if len(got.Conditions) != 2 || got.Conditions[1].Reason != "KubeletReady" {
+		t.Errorf("Unexpected conditions %v", got.Conditions)
+	}
+
+	wantResources := []NodeResource{
+		{Name: "cpu", Capacity: "4", Allocatable: "3800m"},
+		{Name: "memory", Capacity: "16Gi", Allocatable: "15Gi"},
+		{Name: "pods", Capacity: "110", Allocatable: "110"},
+	}
+	if len(got.Resources) != len(wantResources) {
+		t.Fatalf("Expected %d resources, got %v", len(wantResources), got.Resources)
+	}
+	for i, want := range wantResources {
+		if got.Resources[i] != want {
+			t.Errorf("Resources[%d] = %+v, want %+v", i, got.Resources[i], want)
+		}
+	}
+	if got.GetStatusSymbol() != "●" {
+		t.Errorf("GetStatusSymbol() = %s, want ●", got.GetStatusSymbol())
+	}
+}
+
+func TestNewNodeInfo_Status(t *testing.T) {
+	tests := []struct {
+		name          string
+		ready         corev1.ConditionStatus
+		unschedulable bool
+		wantStatus    string
+		wantSymbol    string
+	}{
+		{"ready", corev1.ConditionTrue, false, "Ready", "●"},
+		{"cordoned", corev1.ConditionTrue, true, "Ready,SchedulingDisabled", "◐"},
+		{"not ready", corev1.ConditionFalse, false, "NotReady", "✖"},
+		{"unknown and cordoned", corev1.ConditionUnknown, true, "Unknown,SchedulingDisabled", "?"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := newTestNode()
+			node.Spec.Unschedulable = tt.unschedulable
+			node.Status.Conditions = []corev1.NodeCondition{{Type: corev1.NodeReady, Status: tt.ready}}
+
+			got := NewNodeInfo(node)
+			if got.Status != tt.wantStatus {
+				t.Errorf("Status = %s, want %s", got.Status, tt.wantStatus)
+			}
+			if got.GetStatusSymbol() != tt.wantSymbol {
+				t.Errorf("GetStatusSymbol() = %s, want %s", got.GetStatusSymbol(), tt.wantSymbol)
+			}
+		})
+	}
+}
+
+func TestNodeRoles(t *testing.T) {
+	tests := []struct {
+		name   string
+		labels map[string]string
+		want   string
+	}{
+		{"no roles", map[string]string{"kubernetes.io/hostname": "n1"}, "<none>"},
+		{"control plane", map[string]string{"node-role.kubernetes.io/control-plane": ""}, "control-plane"},
+		{"legacy role label", map[string]string{"kubernetes.io/role": "master"}, "master"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Labels: tt.labels}}
+			if got := NodeRoles(node); got != tt.want {
+				t.Errorf("NodeRoles() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// newResourcePod returns a pod on nodeName with one container per request/limit pair
+func newResourcePod(nodeName string, phase corev1.PodPhase, cpuRequest, cpuLimit, memoryRequest string) corev1.Pod {
+	resources := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(cpuRequest),
+			corev1.ResourceMemory: resource.MustParse(memoryRequest),
+		},
+	}
+	if cpuLimit != "" {
+		resources.Limits = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpuLimit)}
+	}
+	return corev1.Pod{
+		Spec: corev1.PodSpec{
+			NodeName:   nodeName,
+			Containers: []corev1.Container{{Name: "app", Resources: resources}},
+		},
+		Status: corev1.PodStatus{Phase: phase},
+	}
+}
+
+func TestNewNodeAllocation(t *testing.T) {
+	node := newTestNode()
+	pods := []corev1.Pod{
+		newResourcePod("worker-1", corev1.PodRunning, "500m", "1", "1Gi"),
+		newResourcePod("worker-1", corev1.PodRunning, "450m", "4", "512Mi"),
+		newResourcePod("worker-1", corev1.PodSucceeded, "2", "", "4Gi"), // Terminated, not counted
+		newResourcePod("worker-2", corev1.PodRunning, "2", "", "4Gi"),   // Other node
+	}
+
+	got := NewNodeAllocation(node, pods)
+
+	if got.PodCount != 2 {
+		t.Errorf("PodCount = %d, want 2", got.PodCount)
+	}
+	want := []AllocatedResource{
+		{Name: "cpu", Requests: "950m", RequestsPercent: 25, Limits: "5", LimitsPercent: 131},
+		{Name: "memory", Requests: "1536Mi", RequestsPercent: 10, Limits: "0", LimitsPercent: 0},
+		{Name: "ephemeral-storage", Requests: "0", RequestsPercent: 0, Limits: "0", LimitsPercent: 0},
+	}
+	if len(got.Resources) != len(want) {
+		t.Fatalf("Expected %d resources, got %v", len(want), got.Resources)
+	}
+	for i := range want {
+		if got.Resources[i] != want[i] {
+			t.Errorf("Resources[%d] = %+v, want %+v", i, got.Resources[i], want[i])
+		}
+	}
+}
+
+func TestPodRequestsAndLimits_InitContainers(t *testing.T) {
+	always := corev1.ContainerRestartPolicyAlways
+	cpu := func(value string) corev1.ResourceRequirements {
+		return corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(value)}}
+	}
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{
+				{Name: "migrate", Resources: cpu("2")},
+				{Name: "proxy", Resources: cpu("100m"), RestartPolicy: &always},
+			},
+			Containers: []corev1.Container{{Name: "app", Resources: cpu("500m")}},
+			Overhead:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("50m")},
+		},
+	}
+
+	requests, _ := PodRequestsAndLimits(pod)
+	// max(app 500m + sidecar 100m, init 2) + overhead 50m
+	if got := requests[corev1.ResourceCPU]; got.String() != "2050m" {
+		t.Errorf("cpu request = %s, want 2050m", got.String())
+	}
+
+	pod.Spec.InitContainers[0].Resources = cpu("200m")
+	requests, _ = PodRequestsAndLimits(pod)
+	if got := requests[corev1.ResourceCPU]; got.String() != "650m" {
+		t.Errorf("cpu request = %s, want 650m", got.String())
+	}
+}
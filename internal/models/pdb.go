@@ -0,0 +1,87 @@
+package models
+
+import (
+	policyv1 "k8s.io/api/policy/v1"
+)
+
+// PodDisruptionBudgetInfo represents simplified poddisruptionbudget information for display
+type PodDisruptionBudgetInfo struct {
+	Name               string
+	Namespace          string
+	MinAvailable       string // "N/A" if unset
+	MaxUnavailable     string // "N/A" if unset
+	CurrentHealthy     int32
+	DesiredHealthy     int32
+	ExpectedPods       int32
+	DisruptionsAllowed int32
+	Selector           string
+	Age                string
+	Cluster            string                        // Source kube context (multi-cluster mode only)
+	Budget             *policyv1.PodDisruptionBudget // Keep reference to full poddisruptionbudget
+}
+
+// NewPodDisruptionBudgetInfo creates a PodDisruptionBudgetInfo from a Kubernetes PodDisruptionBudget
+func NewPodDisruptionBudgetInfo(budget *policyv1.PodDisruptionBudget) PodDisruptionBudgetInfo {
+	info := PodDisruptionBudgetInfo{
+		Name:               budget.Name,
+		Namespace:          budget.Namespace,
+		MinAvailable:       "N/A",
+		MaxUnavailable:     "N/A",
+		CurrentHealthy:     budget.Status.CurrentHealthy,
+		DesiredHealthy:     budget.Status.DesiredHealthy,
+		ExpectedPods:       budget.Status.ExpectedPods,
+		DisruptionsAllowed: budget.Status.DisruptionsAllowed,
+		Selector:           "<none>",
+		Age:                formatAge(budget.CreationTimestamp),
+		Budget:             budget,
+	}
+	if budget.Spec.MinAvailable != nil {
+		info.MinAvailable = budget.Spec.MinAvailable.String()
+	}
+	if budget.Spec.MaxUnavailable != nil {
+		info.MaxUnavailable = budget.Spec.MaxUnavailable.String()
+	}
+	if budget.Spec.Selector != nil {
+		info.Selector = formatPodSelector(budget.Spec.Selector)
+	}
+	return info
+}
+
+// Covers reports whether the budget applies to pods with the given labels. An empty
+// selector covers every pod in the namespace and a missing selector covers none.
+func (p *PodDisruptionBudgetInfo) Covers(namespace string, labels map[string]string) bool {
+	if p.Namespace != namespace || p.Budget == nil || p.Budget.Spec.Selector == nil {
+		return false
+	}
+	return selectorMatches(p.Budget.Spec.Selector, labels)
+}
+
+// BlocksEvictions reports whether the budget currently allows no voluntary disruptions,
+// so drains and evictions of its pods will wait
+func (p *PodDisruptionBudgetInfo) BlocksEvictions() bool {
+	return p.DisruptionsAllowed == 0
+}
+
+// GetStatusSymbol returns a visual indicator for poddisruptionbudget status
+func (p *PodDisruptionBudgetInfo) GetStatusSymbol() string {
+	switch {
+	case p.BlocksEvictions():
+		return "⊘" // Evictions blocked
+	case p.CurrentHealthy < p.DesiredHealthy:
+		return "⚠"
+	default:
+		return "●"
+	}
+}
+
+// BudgetsFor returns the budgets that cover a workload's pods, given the namespace and
+// the labels of its pod template
+func BudgetsFor(namespace string, podLabels map[string]string, budgets []PodDisruptionBudgetInfo) []PodDisruptionBudgetInfo {
+	result := make([]PodDisruptionBudgetInfo, 0)
+	for i := range budgets {
+		if budgets[i].Covers(namespace, podLabels) {
+			result = append(result, budgets[i])
+		}
+	}
+	return result
+}
@@ -0,0 +1,73 @@
+package models
+
+import (
+	"testing"
+
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestNewPodDisruptionBudgetInfo(t *testing.T) {
+	minAvailable := intstr.FromString("50%")
+	budget := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "shop"},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MinAvailable: &minAvailable,
+			Selector:     &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+		},
+		Status: policyv1.PodDisruptionBudgetStatus{CurrentHealthy: 3, DesiredHealthy: 2, ExpectedPods: 3, DisruptionsAllowed: 1},
+	}
+
+	info := NewPodDisruptionBudgetInfo(budget)
+	if info.MinAvailable != "50%" || info.MaxUnavailable != "N/A" {
+		t.Errorf("Expected min available 50%% and max unavailable N/A, got %q and %q", info.MinAvailable, info.MaxUnavailable)
+	}
+	if info.Selector != "app=web" {
+		t.Errorf("Expected selector app=web, got %q", info.Selector)
+	}
+	if info.BlocksEvictions() || info.GetStatusSymbol() != "●" {
+		t.Error("Expected a budget allowing disruptions not to block evictions")
+	}
+
+	budget.Status.DisruptionsAllowed = 0
+	info = NewPodDisruptionBudgetInfo(budget)
+	if !info.BlocksEvictions() || info.GetStatusSymbol() != "⊘" {
+		t.Error("Expected a budget allowing no disruptions to block evictions")
+	}
+
+	budget.Status = policyv1.PodDisruptionBudgetStatus{CurrentHealthy: 1, DesiredHealthy: 2, DisruptionsAllowed: 1}
+	info = NewPodDisruptionBudgetInfo(budget)
+	if info.GetStatusSymbol() != "⚠" {
+		t.Errorf("Expected ⚠ for an unhealthy budget, got %s", info.GetStatusSymbol())
+	}
+}
+
+func TestBudgetsFor(t *testing.T) {
+	maxUnavailable := intstr.FromInt32(1)
+	newBudget := func(name, namespace string, selector *metav1.LabelSelector) PodDisruptionBudgetInfo {
+		return NewPodDisruptionBudgetInfo(&policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       policyv1.PodDisruptionBudgetSpec{MaxUnavailable: &maxUnavailable, Selector: selector},
+		})
+	}
+	budgets := []PodDisruptionBudgetInfo{
+		newBudget("web", "shop", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}),
+		newBudget("everything", "shop", &metav1.LabelSelector{}),
+		newBudget("no-selector", "shop", nil),
+		newBudget("db", "shop", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}),
+		newBudget("other-namespace", "default", &metav1.LabelSelector{}),
+	}
+	if budgets[0].MaxUnavailable != "1" || budgets[2].Selector != "<none>" {
+		t.Errorf("Unexpected budget info %+v", budgets)
+	}
+
+	podLabels := map[string]string{"app": "web", "tier": "frontend"}
+	covering := BudgetsFor("shop", podLabels, budgets)
+	if len(covering) != 2 || covering[0].Name != "web" || covering[1].Name != "everything" {
+		t.Errorf("Expected web and everything to cover the workload, got %v", covering)
+	}
+	if covering := BudgetsFor("staging", podLabels, budgets); len(covering) != 0 {
+		t.Errorf("Expected no budgets in another namespace, got %v", covering)
+	}
+}
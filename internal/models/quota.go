@@ -0,0 +1,127 @@
+package models
+
+import (
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ResourceQuotaInfo represents the usage of a ResourceQuota against its hard limits
+type ResourceQuotaInfo struct {
+	Name      string
+	Namespace string
+	Resources []QuotaUsage
+}
+
+// QuotaUsage is the usage of one resource in a quota
+type QuotaUsage struct {
+	Name    string
+	Used    string
+	Hard    string
+	Percent int // Of hard; may exceed 100 when the quota was lowered below usage, and is 100 for a hard limit of 0
+}
+
+// NewResourceQuotaInfo creates a ResourceQuotaInfo from a Kubernetes ResourceQuota,
+// with one row per hard limit sorted by resource name
+func NewResourceQuotaInfo(quota *corev1.ResourceQuota) ResourceQuotaInfo {
+	info := ResourceQuotaInfo{Name: quota.Name, Namespace: quota.Namespace}
+	names := make([]string, 0, len(quota.Status.Hard))
+	for name := range quota.Status.Hard {
+		names = append(names, string(name))
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		hard := quota.Status.Hard[corev1.ResourceName(name)]
+		used, ok := quota.Status.Used[corev1.ResourceName(name)]
+		if !ok {
+			used = resource.Quantity{}
+		}
+		info.Resources = append(info.Resources, QuotaUsage{
+			Name:    name,
+			Used:    used.String(),
+			Hard:    hard.String(),
+			Percent: quotaPercent(used, hard),
+		})
+	}
+	return info
+}
+
+// quotaPercent returns used as a whole percentage of hard. A hard limit of zero
+// forbids the resource outright, so it counts as fully used even when nothing is.
+func quotaPercent(used, hard resource.Quantity) int {
+	if hard.IsZero() {
+		return 100
+	}
+	return percentOf(used, hard)
+}
+
+// Exhausted returns the resources whose usage has reached the hard limit, including
+// those with a hard limit of zero, so that new objects requesting them are rejected
+func (q *ResourceQuotaInfo) Exhausted() []string {
+	var exhausted []string
+	for _, usage := range q.Resources {
+		if usage.Percent >= 100 {
+			exhausted = append(exhausted, usage.Name)
+		}
+	}
+	return exhausted
+}
+
+// LimitRangeInfo represents the constraints of a LimitRange
+type LimitRangeInfo struct {
+	Name      string
+	Namespace string
+	Limits    []LimitRangeLimit
+}
+
+// LimitRangeLimit is one row of a limit range, like the table of kubectl describe limitrange
+type LimitRangeLimit struct {
+	Type                 string // Container, Pod or PersistentVolumeClaim
+	Resource             string
+	Min                  string
+	Max                  string
+	DefaultRequest       string
+	Default              string
+	MaxLimitRequestRatio string
+}
+
+// NewLimitRangeInfo creates a LimitRangeInfo from a Kubernetes LimitRange, with one
+// row per type and resource. Unset values are shown as "-".
+func NewLimitRangeInfo(limitRange *corev1.LimitRange) LimitRangeInfo {
+	info := LimitRangeInfo{Name: limitRange.Name, Namespace: limitRange.Namespace}
+	for _, item := range limitRange.Spec.Limits {
+		names := make([]string, 0)
+		for _, list := range []corev1.ResourceList{item.Min, item.Max, item.DefaultRequest, item.Default, item.MaxLimitRequestRatio} {
+			for name := range list {
+				if !slices.Contains(names, string(name)) {
+					names = append(names, string(name))
+				}
+			}
+		}
+		slices.Sort(names)
+
+		for _, name := range names {
+			resourceName := corev1.ResourceName(name)
+			info.Limits = append(info.Limits, LimitRangeLimit{
+				Type:                 string(item.Type),
+				Resource:             name,
+				Min:                  limitValue(item.Min, resourceName),
+				Max:                  limitValue(item.Max, resourceName),
+				DefaultRequest:       limitValue(item.DefaultRequest, resourceName),
+				Default:              limitValue(item.Default, resourceName),
+				MaxLimitRequestRatio: limitValue(item.MaxLimitRequestRatio, resourceName),
+			})
+		}
+	}
+	return info
+}
+
+// limitValue returns a quantity of a limit range item, or "-" if unset
+func limitValue(list corev1.ResourceList, name corev1.ResourceName) string {
+	if quantity, ok := list[name]; ok {
+		return quantity.String()
+	}
+	return "-"
+}
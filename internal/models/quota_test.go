@@ -0,0 +1,79 @@
+package models
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewResourceQuotaInfo(t *testing.T) {
+	quota := &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "compute", Namespace: "shop"},
+		Status: corev1.ResourceQuotaStatus{
+			Hard: corev1.ResourceList{
+				corev1.ResourcePods:           resource.MustParse("10"),
+				corev1.ResourceRequestsCPU:    resource.MustParse("4"),
+				corev1.ResourceRequestsMemory: resource.MustParse("8Gi"),
+				corev1.ResourceServices:       resource.MustParse("0"),
+			},
+			Used: corev1.ResourceList{
+				corev1.ResourcePods:        resource.MustParse("10"),
+				corev1.ResourceRequestsCPU: resource.MustParse("1500m"),
+			},
+		},
+	}
+
+	info := NewResourceQuotaInfo(quota)
+	if len(info.Resources) != 4 {
+		t.Fatalf("Expected 4 resources, got %v", info.Resources)
+	}
+	expected := []QuotaUsage{
+		{Name: "pods", Used: "10", Hard: "10", Percent: 100},
+		{Name: "requests.cpu", Used: "1500m", Hard: "4", Percent: 37},
+		{Name: "requests.memory", Used: "0", Hard: "8Gi", Percent: 0},
+		{Name: "services", Used: "0", Hard: "0", Percent: 100}, // A hard limit of 0 allows none
+	}
+	for i, usage := range expected {
+		if info.Resources[i] != usage {
+			t.Errorf("Expected resource %d to be %+v, got %+v", i, usage, info.Resources[i])
+		}
+	}
+	if exhausted := info.Exhausted(); len(exhausted) != 2 || exhausted[0] != "pods" || exhausted[1] != "services" {
+		t.Errorf("Expected pods and services to be exhausted, got %v", exhausted)
+	}
+}
+
+func TestNewLimitRangeInfo(t *testing.T) {
+	limitRange := &corev1.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{Name: "limits", Namespace: "shop"},
+		Spec: corev1.LimitRangeSpec{Limits: []corev1.LimitRangeItem{
+			{
+				Type:           corev1.LimitTypeContainer,
+				Max:            corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+				DefaultRequest: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m"), corev1.ResourceMemory: resource.MustParse("128Mi")},
+				Default:        corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+			},
+			{
+				Type: corev1.LimitTypePersistentVolumeClaim,
+				Min:  corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+			},
+		}},
+	}
+
+	info := NewLimitRangeInfo(limitRange)
+	expected := []LimitRangeLimit{
+		{Type: "Container", Resource: "cpu", Min: "-", Max: "2", DefaultRequest: "100m", Default: "-", MaxLimitRequestRatio: "-"},
+		{Type: "Container", Resource: "memory", Min: "-", Max: "-", DefaultRequest: "128Mi", Default: "256Mi", MaxLimitRequestRatio: "-"},
+		{Type: "PersistentVolumeClaim", Resource: "storage", Min: "1Gi", Max: "-", DefaultRequest: "-", Default: "-", MaxLimitRequestRatio: "-"},
+	}
+	if len(info.Limits) != len(expected) {
+		t.Fatalf("Expected %d limits, got %v", len(expected), info.Limits)
+	}
+	for i, limit := range expected {
+		if info.Limits[i] != limit {
+			t.Errorf("Expected limit %d to be %+v, got %+v", i, limit, info.Limits[i])
+		}
+	}
+}
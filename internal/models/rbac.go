@@ -0,0 +1,517 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// ServiceAccountInfo represents simplified serviceaccount information for display
+type ServiceAccountInfo struct {
+	Name             string
+	Namespace        string
+	Secrets          int
+	ImagePullSecrets []string
+	AutomountToken   string // "true", "false" or "<default>" when unset
+	Age              string
+	Cluster          string                 // Source kube context (multi-cluster mode only)
+	ServiceAccount   *corev1.ServiceAccount // Keep reference to full serviceaccount
+}
+
+// NewServiceAccountInfo creates a ServiceAccountInfo from a Kubernetes ServiceAccount
+func NewServiceAccountInfo(serviceAccount *corev1.ServiceAccount) ServiceAccountInfo {
+	info := ServiceAccountInfo{
+		Name:           serviceAccount.Name,
+		Namespace:      serviceAccount.Namespace,
+		Secrets:        len(serviceAccount.Secrets),
+		AutomountToken: "<default>",
+		Age:            formatAge(serviceAccount.CreationTimestamp),
+		ServiceAccount: serviceAccount,
+	}
+	for _, secret := range serviceAccount.ImagePullSecrets {
+		info.ImagePullSecrets = append(info.ImagePullSecrets, secret.Name)
+	}
+	if serviceAccount.AutomountServiceAccountToken != nil {
+		info.AutomountToken = fmt.Sprintf("%t", *serviceAccount.AutomountServiceAccountToken)
+	}
+	return info
+}
+
+// Subject returns the serviceaccount as an RBAC subject
+func (s *ServiceAccountInfo) Subject() Subject {
+	return Subject{Kind: rbacv1.ServiceAccountKind, Name: s.Name, Namespace: s.Namespace}
+}
+
+// GetStatusSymbol returns a visual indicator for serviceaccount status
+func (s *ServiceAccountInfo) GetStatusSymbol() string {
+	return "●"
+}
+
+// PolicyRuleInfo is an RBAC rule formatted like kubectl describe role
+type PolicyRuleInfo struct {
+	Resources       string // Resources qualified by API group, e.g. "deployments.apps"
+	NonResourceURLs string
+	ResourceNames   string
+	Verbs           string
+}
+
+// NewPolicyRuleInfo creates a PolicyRuleInfo from an RBAC policy rule
+func NewPolicyRuleInfo(rule rbacv1.PolicyRule) PolicyRuleInfo {
+	var resources []string
+	for _, group := range rule.APIGroups {
+		for _, resource := range rule.Resources {
+			if group == "" {
+				resources = append(resources, resource)
+			} else {
+				resources = append(resources, resource+"."+group)
+			}
+		}
+	}
+	return PolicyRuleInfo{
+		Resources:       strings.Join(resources, ", "),
+		NonResourceURLs: strings.Join(rule.NonResourceURLs, ", "),
+		ResourceNames:   strings.Join(rule.ResourceNames, ", "),
+		Verbs:           strings.Join(rule.Verbs, ", "),
+	}
+}
+
+// String formats the rule on one line, e.g. "pods, pods/log: get, list [names: web]"
+func (r PolicyRuleInfo) String() string {
+	target := r.Resources
+	if target == "" {
+		target = r.NonResourceURLs
+	}
+	text := target + ": " + r.Verbs
+	if r.ResourceNames != "" {
+		text += " [names: " + r.ResourceNames + "]"
+	}
+	return text
+}
+
+// RoleInfo represents simplified role or clusterrole information for display
+type RoleInfo struct {
+	Name        string
+	Namespace   string // Empty for clusterroles
+	Kind        string // Role or ClusterRole
+	Rules       []PolicyRuleInfo
+	AggregateOf []string // Label selectors of an aggregated clusterrole, e.g. "rbac.example.com/aggregate-to-view=true"
+	Age         string
+	Cluster     string // Source kube context (multi-cluster mode only)
+}
+
+// NewRoleInfo creates a RoleInfo from a Kubernetes Role
+func NewRoleInfo(role *rbacv1.Role) RoleInfo {
+	return RoleInfo{
+		Name:      role.Name,
+		Namespace: role.Namespace,
+		Kind:      "Role",
+		Rules:     policyRuleInfos(role.Rules),
+		Age:       formatAge(role.CreationTimestamp),
+	}
+}
+
+// NewClusterRoleInfo creates a RoleInfo from a Kubernetes ClusterRole
+func NewClusterRoleInfo(clusterRole *rbacv1.ClusterRole) RoleInfo {
+	info := RoleInfo{
+		Name:  clusterRole.Name,
+		Kind:  "ClusterRole",
+		Rules: policyRuleInfos(clusterRole.Rules),
+		Age:   formatAge(clusterRole.CreationTimestamp),
+	}
+	if clusterRole.AggregationRule != nil {
+		for _, selector := range clusterRole.AggregationRule.ClusterRoleSelectors {
+			for key, value := range selector.MatchLabels {
+				info.AggregateOf = append(info.AggregateOf, key+"="+value)
+			}
+		}
+		sort.Strings(info.AggregateOf)
+	}
+	return info
+}
+
+// policyRuleInfos formats each rule of a role
+func policyRuleInfos(rules []rbacv1.PolicyRule) []PolicyRuleInfo {
+	infos := make([]PolicyRuleInfo, len(rules))
+	for i, rule := range rules {
+		infos[i] = NewPolicyRuleInfo(rule)
+	}
+	return infos
+}
+
+// GetStatusSymbol returns a visual indicator for role status
+func (r *RoleInfo) GetStatusSymbol() string {
+	if len(r.AggregateOf) > 0 {
+		return "◈" // Rules are collected from other clusterroles
+	}
+	return "●"
+}
+
+// RoleBindingInfo represents simplified rolebinding or clusterrolebinding information for display
+type RoleBindingInfo struct {
+	Name      string
+	Namespace string // Empty for clusterrolebindings
+	Kind      string // RoleBinding or ClusterRoleBinding
+	RoleKind  string // Role or ClusterRole
+	RoleName  string
+	Subjects  []Subject
+	Age       string
+	Cluster   string // Source kube context (multi-cluster mode only)
+}
+
+// NewRoleBindingInfo creates a RoleBindingInfo from a Kubernetes RoleBinding
+func NewRoleBindingInfo(binding *rbacv1.RoleBinding) RoleBindingInfo {
+	return RoleBindingInfo{
+		Name:      binding.Name,
+		Namespace: binding.Namespace,
+		Kind:      "RoleBinding",
+		RoleKind:  binding.RoleRef.Kind,
+		RoleName:  binding.RoleRef.Name,
+		Subjects:  subjectsOf(binding.Subjects),
+		Age:       formatAge(binding.CreationTimestamp),
+	}
+}
+
+// NewClusterRoleBindingInfo creates a RoleBindingInfo from a Kubernetes ClusterRoleBinding
+func NewClusterRoleBindingInfo(binding *rbacv1.ClusterRoleBinding) RoleBindingInfo {
+	return RoleBindingInfo{
+		Name:     binding.Name,
+		Kind:     "ClusterRoleBinding",
+		RoleKind: binding.RoleRef.Kind,
+		RoleName: binding.RoleRef.Name,
+		Subjects: subjectsOf(binding.Subjects),
+		Age:      formatAge(binding.CreationTimestamp),
+	}
+}
+
+// RoleRef returns the bound role as Kind/name, e.g. "ClusterRole/edit"
+func (b *RoleBindingInfo) RoleRef() string {
+	return b.RoleKind + "/" + b.RoleName
+}
+
+// SubjectsSummary lists the bound subjects on one line
+func (b *RoleBindingInfo) SubjectsSummary() string {
+	if len(b.Subjects) == 0 {
+		return "<none>"
+	}
+	subjects := make([]string, len(b.Subjects))
+	for i, subject := range b.Subjects {
+		subjects[i] = subject.String()
+	}
+	return strings.Join(subjects, ", ")
+}
+
+// GetStatusSymbol returns a visual indicator for binding status
+func (b *RoleBindingInfo) GetStatusSymbol() string {
+	if len(b.Subjects) == 0 {
+		return "○" // Grants nothing
+	}
+	return "●"
+}
+
+// Subject is a user, group or serviceaccount that RBAC grants permissions to
+type Subject struct {
+	Kind      string // User, Group or ServiceAccount
+	Name      string
+	Namespace string // ServiceAccounts only
+}
+
+// subjectsOf converts the subjects of a binding
+func subjectsOf(subjects []rbacv1.Subject) []Subject {
+	result := make([]Subject, len(subjects))
+	for i, subject := range subjects {
+		result[i] = Subject{Kind: subject.Kind, Name: subject.Name, Namespace: subject.Namespace}
+	}
+	return result
+}
+
+// String formats the subject, e.g. "ServiceAccount shop/web" or "User alice"
+func (s Subject) String() string {
+	if s.Kind == rbacv1.ServiceAccountKind {
+		return fmt.Sprintf("%s %s/%s", s.Kind, s.Namespace, s.Name)
+	}
+	return s.Kind + " " + s.Name
+}
+
+// IsZero reports whether no subject is set
+func (s Subject) IsZero() bool {
+	return s == Subject{}
+}
+
+// ParseSubject parses a subject typed by the user. Accepted forms are
+// "user:alice", "group:devs", "sa:name" or "sa:namespace/name" (also
+// "serviceaccount:"), and the username form "system:serviceaccount:namespace:name".
+// Serviceaccounts without a namespace are looked up in defaultNamespace.
+func ParseSubject(text, defaultNamespace string) (Subject, error) {
+	text = strings.TrimSpace(text)
+	if rest, ok := strings.CutPrefix(text, serviceAccountUsernamePrefix); ok {
+		namespace, name, found := strings.Cut(rest, ":")
+		if !found || namespace == "" || name == "" {
+			return Subject{}, fmt.Errorf("invalid serviceaccount username %q", text)
+		}
+		return Subject{Kind: rbacv1.ServiceAccountKind, Name: name, Namespace: namespace}, nil
+	}
+
+	kind, name, found := strings.Cut(text, ":")
+	if !found || name == "" {
+		return Subject{}, fmt.Errorf("invalid subject %q: use user:NAME, group:NAME or sa:NAMESPACE/NAME", text)
+	}
+	switch strings.ToLower(kind) {
+	case "user", "u":
+		return Subject{Kind: rbacv1.UserKind, Name: name}, nil
+	case "group", "g":
+		return Subject{Kind: rbacv1.GroupKind, Name: name}, nil
+	case "serviceaccount", "sa":
+		namespace := defaultNamespace
+		if ns, saName, ok := strings.Cut(name, "/"); ok {
+			namespace, name = ns, saName
+		}
+		if namespace == "" || name == "" {
+			return Subject{}, fmt.Errorf("invalid serviceaccount %q: use sa:NAMESPACE/NAME", text)
+		}
+		return Subject{Kind: rbacv1.ServiceAccountKind, Name: name, Namespace: namespace}, nil
+	default:
+		return Subject{}, fmt.Errorf("unknown subject kind %q: use user, group or sa", kind)
+	}
+}
+
+// serviceAccountUsernamePrefix starts the username serviceaccounts authenticate as
+const serviceAccountUsernamePrefix = "system:serviceaccount:"
+
+// implicitGroups returns the groups the API server adds to every request from the subject
+func (s Subject) implicitGroups() []string {
+	switch s.Kind {
+	case rbacv1.ServiceAccountKind:
+		return []string{"system:serviceaccounts", "system:serviceaccounts:" + s.Namespace, "system:authenticated"}
+	case rbacv1.UserKind:
+		return []string{"system:authenticated"}
+	default:
+		return nil
+	}
+}
+
+// matchedBy reports whether a binding subject applies to the subject, directly,
+// through the serviceaccount username or through an implicit group
+func (s Subject) matchedBy(bound Subject) bool {
+	switch bound.Kind {
+	case rbacv1.ServiceAccountKind:
+		return s.Kind == rbacv1.ServiceAccountKind && s.Name == bound.Name && s.Namespace == bound.Namespace
+	case rbacv1.UserKind:
+		if s.Kind == rbacv1.ServiceAccountKind {
+			return bound.Name == serviceAccountUsernamePrefix+s.Namespace+":"+s.Name
+		}
+		return s.Kind == rbacv1.UserKind && s.Name == bound.Name
+	case rbacv1.GroupKind:
+		if s.Kind == rbacv1.GroupKind {
+			return s.Name == bound.Name
+		}
+		for _, group := range s.implicitGroups() {
+			if group == bound.Name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// AccessQuery asks whether a subject may perform a verb on a resource. A zero
+// Subject asks who can.
+type AccessQuery struct {
+	Subject     Subject
+	Verb        string
+	Group       string // API group, empty for the core group
+	Resource    string // Plural resource name, e.g. "pods"
+	Subresource string // e.g. "log", empty for the resource itself
+	Namespace   string // Empty for cluster-scoped resources or all namespaces
+}
+
+// ParseResource splits a resource typed like kubectl, e.g. "deployments.apps" or
+// "pods/log", into its resource, API group and subresource
+func ParseResource(text string) (resource, group, subresource string) {
+	text, subresource, _ = strings.Cut(strings.TrimSpace(text), "/")
+	resource, group, _ = strings.Cut(text, ".")
+	return resource, group, subresource
+}
+
+// String phrases the query as a question, e.g. "Can User alice list pods in shop?"
+func (q AccessQuery) String() string {
+	resource := q.Resource
+	if q.Group != "" {
+		resource += "." + q.Group
+	}
+	if q.Subresource != "" {
+		resource += "/" + q.Subresource
+	}
+	scope := "cluster-wide"
+	if q.Namespace != "" {
+		scope = "in " + q.Namespace
+	}
+	if q.Subject.IsZero() {
+		return fmt.Sprintf("Who can %s %s %s?", q.Verb, resource, scope)
+	}
+	return fmt.Sprintf("Can %s %s %s %s?", q.Subject, q.Verb, resource, scope)
+}
+
+// AccessGrant explains which binding, role and rule give a subject access
+type AccessGrant struct {
+	Subject Subject // Subject named in the binding
+	Binding string  // e.g. "RoleBinding shop/web-read" or "ClusterRoleBinding admins"
+	Role    string  // e.g. "ClusterRole/view"
+	Rule    string  // Matching rule, empty when listing bindings only
+}
+
+// String formats the grant, e.g. "User alice via ClusterRoleBinding admins → ClusterRole/cluster-admin"
+func (g AccessGrant) String() string {
+	text := fmt.Sprintf("%s via %s → %s", g.Subject, g.Binding, g.Role)
+	if g.Rule != "" {
+		text += " (" + g.Rule + ")"
+	}
+	return text
+}
+
+// AccessAnswer is the answer to an access query
+type AccessAnswer struct {
+	Question string
+	Allowed  bool
+	Reason   string        // Explanation from the API server, if it gave one
+	Grants   []AccessGrant // Local evaluation only
+	Local    bool          // Answered from bindings rather than by the API server
+	WhoCan   bool          // Grants list every subject rather than answering for one
+}
+
+// RBACPolicy holds the roles and bindings needed to evaluate access locally
+type RBACPolicy struct {
+	Roles               []rbacv1.Role
+	ClusterRoles        []rbacv1.ClusterRole
+	RoleBindings        []rbacv1.RoleBinding
+	ClusterRoleBindings []rbacv1.ClusterRoleBinding
+}
+
+// Evaluate answers the query from the bindings, like kubectl auth can-i --as or
+// kubectl who-can. Rules restricted to resourceNames never match, since the query
+// is about every object of the resource, and group memberships of users are not
+// known, so only the implicit system:authenticated group is considered for them.
+func (p *RBACPolicy) Evaluate(query AccessQuery) AccessAnswer {
+	grants := p.grants(query.Subject, func(rules []rbacv1.PolicyRule) (string, bool) {
+		for _, rule := range rules {
+			if ruleAllows(rule, query) {
+				return NewPolicyRuleInfo(rule).String(), true
+			}
+		}
+		return "", false
+	}, query.Namespace)
+
+	return AccessAnswer{
+		Question: query.String(),
+		Allowed:  len(grants) > 0,
+		Grants:   grants,
+		Local:    true,
+		WhoCan:   query.Subject.IsZero(),
+	}
+}
+
+// BindingsFor returns every binding that applies to the subject, whether in the
+// given namespace or cluster-wide
+func (p *RBACPolicy) BindingsFor(subject Subject, namespace string) []AccessGrant {
+	return p.grants(subject, func([]rbacv1.PolicyRule) (string, bool) {
+		return "", true
+	}, namespace)
+}
+
+// grants walks the clusterrolebindings and the namespace's rolebindings, returning a
+// grant for every matching subject whose bound role has rules accepted by match
+func (p *RBACPolicy) grants(subject Subject, match func([]rbacv1.PolicyRule) (string, bool), namespace string) []AccessGrant {
+	grants := make([]AccessGrant, 0)
+	add := func(binding, roleKind, roleName, roleNamespace string, subjects []rbacv1.Subject) {
+		rule, ok := match(p.roleRules(roleKind, roleName, roleNamespace))
+		if !ok {
+			return
+		}
+		for _, bound := range subjectsOf(subjects) {
+			if !subject.IsZero() && !subject.matchedBy(bound) {
+				continue
+			}
+			grants = append(grants, AccessGrant{
+				Subject: bound,
+				Binding: binding,
+				Role:    roleKind + "/" + roleName,
+				Rule:    rule,
+			})
+		}
+	}
+
+	for i := range p.ClusterRoleBindings {
+		binding := &p.ClusterRoleBindings[i]
+		add("ClusterRoleBinding "+binding.Name, binding.RoleRef.Kind, binding.RoleRef.Name, "", binding.Subjects)
+	}
+	if namespace != "" {
+		for i := range p.RoleBindings {
+			binding := &p.RoleBindings[i]
+			if binding.Namespace != namespace {
+				continue
+			}
+			add("RoleBinding "+binding.Namespace+"/"+binding.Name, binding.RoleRef.Kind, binding.RoleRef.Name, binding.Namespace, binding.Subjects)
+		}
+	}
+
+	sort.SliceStable(grants, func(i, j int) bool {
+		return grants[i].Subject.String() < grants[j].Subject.String()
+	})
+	return grants
+}
+
+// roleRules returns the rules of the referenced role, or none if it does not exist
+func (p *RBACPolicy) roleRules(kind, name, namespace string) []rbacv1.PolicyRule {
+	switch kind {
+	case "ClusterRole":
+		for i := range p.ClusterRoles {
+			if p.ClusterRoles[i].Name == name {
+				return p.ClusterRoles[i].Rules
+			}
+		}
+	case "Role":
+		for i := range p.Roles {
+			if p.Roles[i].Namespace == namespace && p.Roles[i].Name == name {
+				return p.Roles[i].Rules
+			}
+		}
+	}
+	return nil
+}
+
+// ruleAllows reports whether an RBAC rule permits the query, following the
+// matching rules of the API server's RBAC authorizer
+func ruleAllows(rule rbacv1.PolicyRule, query AccessQuery) bool {
+	if len(rule.ResourceNames) > 0 {
+		return false
+	}
+	if !containsOrWildcard(rule.Verbs, query.Verb) || !containsOrWildcard(rule.APIGroups, query.Group) {
+		return false
+	}
+
+	resource := query.Resource
+	if query.Subresource != "" {
+		resource += "/" + query.Subresource
+	}
+	for _, ruleResource := range rule.Resources {
+		if ruleResource == rbacv1.ResourceAll || ruleResource == resource {
+			return true
+		}
+		if query.Subresource != "" && ruleResource == "*/"+query.Subresource {
+			return true
+		}
+	}
+	return false
+}
+
+// containsOrWildcard reports whether values contains value or the "*" wildcard
+func containsOrWildcard(values []string, value string) bool {
+	for _, v := range values {
+		if v == value || v == "*" {
+			return true
+		}
+	}
+	return false
+}
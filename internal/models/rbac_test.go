@@ -0,0 +1,228 @@
+package models
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newTestPolicy() *RBACPolicy {
+	return &RBACPolicy{
+		Roles: []rbacv1.Role{{
+			ObjectMeta: metav1.ObjectMeta{Name: "pod-reader", Namespace: "shop"},
+			Rules: []rbacv1.PolicyRule{
+				{APIGroups: []string{""}, Resources: []string{"pods", "pods/log"}, Verbs: []string{"get", "list", "watch"}},
+				{APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{"web-tls"}, Verbs: []string{"get"}},
+			},
+		}},
+		ClusterRoles: []rbacv1.ClusterRole{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster-admin"},
+				Rules:      []rbacv1.PolicyRule{{APIGroups: []string{"*"}, Resources: []string{"*"}, Verbs: []string{"*"}}},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "scaler"},
+				Rules:      []rbacv1.PolicyRule{{APIGroups: []string{"apps"}, Resources: []string{"*/scale"}, Verbs: []string{"update"}}},
+			},
+		},
+		RoleBindings: []rbacv1.RoleBinding{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "web-read", Namespace: "shop"},
+				RoleRef:    rbacv1.RoleRef{Kind: "Role", Name: "pod-reader"},
+				Subjects:   []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: "web", Namespace: "shop"}},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "ops-scale", Namespace: "shop"},
+				RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: "scaler"},
+				Subjects:   []rbacv1.Subject{{Kind: rbacv1.GroupKind, Name: "system:serviceaccounts:shop"}},
+			},
+			{
+				// Rolebindings in other namespaces grant nothing in shop
+				ObjectMeta: metav1.ObjectMeta{Name: "admins", Namespace: "billing"},
+				RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: "cluster-admin"},
+				Subjects:   []rbacv1.Subject{{Kind: rbacv1.UserKind, Name: "bob"}},
+			},
+		},
+		ClusterRoleBindings: []rbacv1.ClusterRoleBinding{{
+			ObjectMeta: metav1.ObjectMeta{Name: "admins"},
+			RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: "cluster-admin"},
+			Subjects:   []rbacv1.Subject{{Kind: rbacv1.UserKind, Name: "alice"}},
+		}},
+	}
+}
+
+func TestRBACPolicy_Evaluate(t *testing.T) {
+	policy := newTestPolicy()
+	web := Subject{Kind: rbacv1.ServiceAccountKind, Name: "web", Namespace: "shop"}
+
+	tests := []struct {
+		name  string
+		query AccessQuery
+		want  bool
+		role  string
+	}{
+		{"serviceaccount reads pods", AccessQuery{Subject: web, Verb: "list", Resource: "pods", Namespace: "shop"}, true, "Role/pod-reader"},
+		{"serviceaccount reads pod logs", AccessQuery{Subject: web, Verb: "get", Resource: "pods", Subresource: "log", Namespace: "shop"}, true, "Role/pod-reader"},
+		{"serviceaccount cannot delete pods", AccessQuery{Subject: web, Verb: "delete", Resource: "pods", Namespace: "shop"}, false, ""},
+		{"rolebinding is namespaced", AccessQuery{Subject: web, Verb: "list", Resource: "pods", Namespace: "billing"}, false, ""},
+		{"resourceNames rules need a name", AccessQuery{Subject: web, Verb: "get", Resource: "secrets", Namespace: "shop"}, false, ""},
+		{"implicit serviceaccount group", AccessQuery{Subject: web, Verb: "update", Group: "apps", Resource: "deployments", Subresource: "scale", Namespace: "shop"}, true, "ClusterRole/scaler"},
+		{"wildcard subresource does not grant the resource", AccessQuery{Subject: web, Verb: "update", Group: "apps", Resource: "deployments", Namespace: "shop"}, false, ""},
+		{"cluster admin anywhere", AccessQuery{Subject: Subject{Kind: rbacv1.UserKind, Name: "alice"}, Verb: "delete", Resource: "nodes"}, true, "ClusterRole/cluster-admin"},
+		{"rolebinding does not grant cluster-wide", AccessQuery{Subject: Subject{Kind: rbacv1.UserKind, Name: "bob"}, Verb: "list", Resource: "pods"}, false, ""},
+		{"rolebinding grants in its namespace", AccessQuery{Subject: Subject{Kind: rbacv1.UserKind, Name: "bob"}, Verb: "list", Resource: "pods", Namespace: "billing"}, true, "ClusterRole/cluster-admin"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			answer := policy.Evaluate(tt.query)
+			if answer.Allowed != tt.want || !answer.Local {
+				t.Fatalf("Evaluate(%s) = %+v, want allowed=%t", tt.query, answer, tt.want)
+			}
+			if tt.want && answer.Grants[0].Role != tt.role {
+				t.Errorf("Evaluate(%s) granted by %s, want %s", tt.query, answer.Grants[0].Role, tt.role)
+			}
+		})
+	}
+}
+
+func TestRBACPolicy_WhoCan(t *testing.T) {
+	answer := newTestPolicy().Evaluate(AccessQuery{Verb: "list", Resource: "pods", Namespace: "shop"})
+
+	if answer.Question != "Who can list pods in shop?" || !answer.WhoCan {
+		t.Errorf("Question = %q", answer.Question)
+	}
+	if len(answer.Grants) != 2 {
+		t.Fatalf("Grants = %+v, want web and alice", answer.Grants)
+	}
+	want := []string{
+		"ServiceAccount shop/web via RoleBinding shop/web-read → Role/pod-reader (pods, pods/log: get, list, watch)",
+		"User alice via ClusterRoleBinding admins → ClusterRole/cluster-admin (*.*: *)",
+	}
+	for i, grant := range answer.Grants {
+		if grant.String() != want[i] {
+			t.Errorf("Grants[%d] = %q, want %q", i, grant.String(), want[i])
+		}
+	}
+}
+
+func TestRBACPolicy_BindingsFor(t *testing.T) {
+	policy := newTestPolicy()
+
+	got := policy.BindingsFor(Subject{Kind: rbacv1.ServiceAccountKind, Name: "web", Namespace: "shop"}, "shop")
+	if len(got) != 2 || got[0].Binding != "RoleBinding shop/ops-scale" || got[1].Binding != "RoleBinding shop/web-read" {
+		t.Errorf("BindingsFor(web) = %+v", got)
+	}
+	if got := policy.BindingsFor(Subject{Kind: rbacv1.UserKind, Name: "carol"}, "shop"); got == nil || len(got) != 0 {
+		t.Errorf("BindingsFor(carol) = %#v, want empty non-nil slice", got)
+	}
+}
+
+func TestParseSubject(t *testing.T) {
+	tests := []struct {
+		text    string
+		want    Subject
+		wantErr bool
+	}{
+		{"user:alice", Subject{Kind: "User", Name: "alice"}, false},
+		{"group:system:masters", Subject{Kind: "Group", Name: "system:masters"}, false},
+		{"sa:web", Subject{Kind: "ServiceAccount", Name: "web", Namespace: "default"}, false},
+		{"serviceaccount:shop/web", Subject{Kind: "ServiceAccount", Name: "web", Namespace: "shop"}, false},
+		{"system:serviceaccount:shop:web", Subject{Kind: "ServiceAccount", Name: "web", Namespace: "shop"}, false},
+		{"alice", Subject{}, true},
+		{"robot:r2", Subject{}, true},
+		{"system:serviceaccount:shop", Subject{}, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseSubject(tt.text, "default")
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseSubject(%q) = %+v, %v, want %+v", tt.text, got, err, tt.want)
+		}
+	}
+}
+
+func TestParseResource(t *testing.T) {
+	tests := []struct {
+		text, resource, group, subresource string
+	}{
+		{"pods", "pods", "", ""},
+		{"deployments.apps", "deployments", "apps", ""},
+		{"pods/log", "pods", "", "log"},
+		{"deployments.apps/scale", "deployments", "apps", "scale"},
+		{"certificates.cert-manager.io", "certificates", "cert-manager.io", ""},
+	}
+
+	for _, tt := range tests {
+		resource, group, subresource := ParseResource(tt.text)
+		if resource != tt.resource || group != tt.group || subresource != tt.subresource {
+			t.Errorf("ParseResource(%q) = %q, %q, %q", tt.text, resource, group, subresource)
+		}
+	}
+}
+
+func TestNewRoleInfo(t *testing.T) {
+	policy := newTestPolicy()
+
+	role := NewRoleInfo(&policy.Roles[0])
+	if role.Kind != "Role" || role.Namespace != "shop" || len(role.Rules) != 2 || role.GetStatusSymbol() != "●" {
+		t.Errorf("Unexpected role info %+v", role)
+	}
+	if got := role.Rules[1].String(); got != "secrets: get [names: web-tls]" {
+		t.Errorf("Rules[1] = %q", got)
+	}
+
+	aggregated := &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{Name: "monitoring"},
+		AggregationRule: &rbacv1.AggregationRule{ClusterRoleSelectors: []metav1.LabelSelector{
+			{MatchLabels: map[string]string{"rbac.example.com/aggregate-to-monitoring": "true"}},
+		}},
+		Rules: []rbacv1.PolicyRule{{NonResourceURLs: []string{"/metrics"}, Verbs: []string{"get"}}},
+	}
+	clusterRole := NewClusterRoleInfo(aggregated)
+	if clusterRole.Kind != "ClusterRole" || clusterRole.GetStatusSymbol() != "◈" {
+		t.Errorf("Unexpected clusterrole info %+v", clusterRole)
+	}
+	if len(clusterRole.AggregateOf) != 1 || clusterRole.Rules[0].String() != "/metrics: get" {
+		t.Errorf("Unexpected aggregated clusterrole info %+v", clusterRole)
+	}
+}
+
+func TestNewRoleBindingInfo(t *testing.T) {
+	policy := newTestPolicy()
+
+	binding := NewRoleBindingInfo(&policy.RoleBindings[0])
+	if binding.RoleRef() != "Role/pod-reader" || binding.SubjectsSummary() != "ServiceAccount shop/web" {
+		t.Errorf("Unexpected binding info %+v", binding)
+	}
+
+	clusterBinding := NewClusterRoleBindingInfo(&rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "empty"},
+		RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: "view"},
+	})
+	if clusterBinding.Kind != "ClusterRoleBinding" || clusterBinding.SubjectsSummary() != "<none>" || clusterBinding.GetStatusSymbol() != "○" {
+		t.Errorf("Unexpected clusterrolebinding info %+v", clusterBinding)
+	}
+}
+
+func TestNewServiceAccountInfo(t *testing.T) {
+	automount := false
+	got := NewServiceAccountInfo(&corev1.ServiceAccount{
+		ObjectMeta:                   metav1.ObjectMeta{Name: "web", Namespace: "shop"},
+		Secrets:                      []corev1.ObjectReference{{Name: "web-token"}},
+		ImagePullSecrets:             []corev1.LocalObjectReference{{Name: "registry"}},
+		AutomountServiceAccountToken: &automount,
+	})
+
+	if got.Secrets != 1 || got.AutomountToken != "false" || len(got.ImagePullSecrets) != 1 {
+		t.Errorf("Unexpected serviceaccount info %+v", got)
+	}
+	if got.Subject() != (Subject{Kind: "ServiceAccount", Name: "web", Namespace: "shop"}) {
+		t.Errorf("Subject() = %+v", got.Subject())
+	}
+	if got := NewServiceAccountInfo(&corev1.ServiceAccount{}).AutomountToken; got != "<default>" {
+		t.Errorf("AutomountToken = %q, want <default>", got)
+	}
+}
@@ -2,12 +2,14 @@ package models
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 // PodInfo represents simplified pod information for display
@@ -21,6 +23,7 @@ type PodInfo struct {
 	IP         string
 	Node       string
 	Containers []ContainerInfo
+	Cluster    string      // Source kube context (multi-cluster mode only)
 	Pod        *corev1.Pod // Keep reference to full pod
 }
 
@@ -108,8 +111,11 @@ func NewPodInfo(pod *corev1.Pod) PodInfo {
 
 // formatAge formats a timestamp as a human-readable age
 func formatAge(timestamp metav1.Time) string {
-	duration := time.Since(timestamp.Time)
+	return formatDuration(time.Since(timestamp.Time))
+}
 
+// formatDuration formats a duration in its largest whole unit, e.g. "45m" or "3d"
+func formatDuration(duration time.Duration) string {
 	if duration < time.Minute {
 		return fmt.Sprintf("%ds", int(duration.Seconds()))
 	}
@@ -169,6 +175,7 @@ type ServiceInfo struct {
 	Ports      string
 	Age        string
 	Selector   map[string]string
+	Cluster    string          // Source kube context (multi-cluster mode only)
 	Service    *corev1.Service // Keep reference to full service
 }
 
@@ -234,6 +241,7 @@ type DeploymentInfo struct {
 	Age        string
 	Replicas   int32
 	Strategy   string
+	Cluster    string             // Source kube context (multi-cluster mode only)
 	Deployment *appsv1.Deployment // Keep reference to full deployment
 }
 
@@ -279,13 +287,15 @@ func (d *DeploymentInfo) IsHealthy() bool {
 
 // StatefulSetInfo represents simplified statefulset information for display
 type StatefulSetInfo struct {
-	Name        string
-	Namespace   string
-	Ready       string
-	Age         string
-	Replicas    int32
-	Strategy    string
-	StatefulSet *appsv1.StatefulSet // Keep reference to full statefulset
+	Name                 string
+	Namespace            string
+	Ready                string
+	Age                  string
+	Replicas             int32
+	Strategy             string
+	VolumeClaimTemplates []VolumeClaimTemplate // Per-replica claims, named <template>-<statefulset>-<ordinal>
+	Cluster              string                // Source kube context (multi-cluster mode only)
+	StatefulSet          *appsv1.StatefulSet   // Keep reference to full statefulset
 }
 
 // NewStatefulSetInfo creates a StatefulSetInfo from a Kubernetes StatefulSet
@@ -307,6 +317,10 @@ func NewStatefulSetInfo(statefulSet *appsv1.StatefulSet) StatefulSetInfo {
 	// Calculate ready status
 	info.Ready = fmt.Sprintf("%d/%d", statefulSet.Status.ReadyReplicas, replicas)
 
+	for i := range statefulSet.Spec.VolumeClaimTemplates {
+		info.VolumeClaimTemplates = append(info.VolumeClaimTemplates, newVolumeClaimTemplate(&statefulSet.Spec.VolumeClaimTemplates[i]))
+	}
+
 	return info
 }
 
@@ -325,3 +339,269 @@ func (s *StatefulSetInfo) GetStatusSymbol() string {
 func (s *StatefulSetInfo) IsHealthy() bool {
 	return s.StatefulSet.Status.ReadyReplicas == s.Replicas
 }
+
+// DaemonSetInfo represents simplified daemonset information for display
+type DaemonSetInfo struct {
+	Name         string
+	Namespace    string
+	Desired      int32
+	Current      int32
+	Ready        int32
+	UpToDate     int32
+	Available    int32
+	Misscheduled int32 // Running on nodes that should not run the daemon pod
+	NodeSelector string
+	Age          string
+	Strategy     string
+	Cluster      string            // Source kube context (multi-cluster mode only)
+	DaemonSet    *appsv1.DaemonSet // Keep reference to full daemonset
+}
+
+// NewDaemonSetInfo creates a DaemonSetInfo from a Kubernetes DaemonSet
+func NewDaemonSetInfo(daemonSet *appsv1.DaemonSet) DaemonSetInfo {
+	info := DaemonSetInfo{
+		Name:         daemonSet.Name,
+		Namespace:    daemonSet.Namespace,
+		Desired:      daemonSet.Status.DesiredNumberScheduled,
+		Current:      daemonSet.Status.CurrentNumberScheduled,
+		Ready:        daemonSet.Status.NumberReady,
+		UpToDate:     daemonSet.Status.UpdatedNumberScheduled,
+		Available:    daemonSet.Status.NumberAvailable,
+		Misscheduled: daemonSet.Status.NumberMisscheduled,
+		Age:          formatAge(daemonSet.CreationTimestamp),
+		Strategy:     string(daemonSet.Spec.UpdateStrategy.Type),
+		DaemonSet:    daemonSet,
+	}
+
+	// Format the node selector like kubectl get daemonsets
+	selector := make([]string, 0, len(daemonSet.Spec.Template.Spec.NodeSelector))
+	for key, value := range daemonSet.Spec.Template.Spec.NodeSelector {
+		selector = append(selector, key+"="+value)
+	}
+	sort.Strings(selector)
+	info.NodeSelector = strings.Join(selector, ",")
+	if info.NodeSelector == "" {
+		info.NodeSelector = "<none>"
+	}
+
+	return info
+}
+
+// GetStatusSymbol returns a visual indicator for daemonset status
+func (d *DaemonSetInfo) GetStatusSymbol() string {
+	if d.IsHealthy() {
+		return "●" // Running and up to date on every node
+	}
+	if d.Ready == 0 {
+		return "✖" // No daemon pods ready
+	}
+	return "◑" // Missing, not ready or outdated on some nodes
+}
+
+// IsHealthy returns true if a ready, up-to-date daemon pod runs on every desired node
+func (d *DaemonSetInfo) IsHealthy() bool {
+	return d.Ready == d.Desired && d.UpToDate == d.Desired && d.Available == d.Desired
+}
+
+// deploymentRevisionAnnotation is set by the deployment controller on the replicasets it manages
+const deploymentRevisionAnnotation = "deployment.kubernetes.io/revision"
+
+// ReplicaSetInfo represents simplified replicaset information for display
+type ReplicaSetInfo struct {
+	Name       string
+	Namespace  string
+	Desired    int32
+	Current    int32
+	Ready      int32
+	Available  int32
+	Owner      string // Name of the owning Deployment, if any
+	Revision   string // Deployment revision this replicaset belongs to
+	Images     []string
+	Age        string
+	Cluster    string             // Source kube context (multi-cluster mode only)
+	ReplicaSet *appsv1.ReplicaSet // Keep reference to full replicaset
+}
+
+// NewReplicaSetInfo creates a ReplicaSetInfo from a Kubernetes ReplicaSet
+func NewReplicaSetInfo(replicaSet *appsv1.ReplicaSet) ReplicaSetInfo {
+	desired := int32(0)
+	if replicaSet.Spec.Replicas != nil {
+		desired = *replicaSet.Spec.Replicas
+	}
+
+	info := ReplicaSetInfo{
+		Name:       replicaSet.Name,
+		Namespace:  replicaSet.Namespace,
+		Desired:    desired,
+		Current:    replicaSet.Status.Replicas,
+		Ready:      replicaSet.Status.ReadyReplicas,
+		Available:  replicaSet.Status.AvailableReplicas,
+		Revision:   replicaSet.Annotations[deploymentRevisionAnnotation],
+		Age:        formatAge(replicaSet.CreationTimestamp),
+		ReplicaSet: replicaSet,
+	}
+
+	for _, owner := range replicaSet.OwnerReferences {
+		if owner.Kind == "Deployment" {
+			info.Owner = owner.Name
+			break
+		}
+	}
+	for _, container := range replicaSet.Spec.Template.Spec.Containers {
+		info.Images = append(info.Images, container.Image)
+	}
+
+	return info
+}
+
+// GetStatusSymbol returns a visual indicator for replicaset status
+func (r *ReplicaSetInfo) GetStatusSymbol() string {
+	if r.Desired == 0 {
+		return "○" // Scaled down, e.g. an old deployment revision
+	}
+	if r.Ready == r.Desired {
+		return "●" // Fully healthy
+	}
+	if r.Ready == 0 {
+		return "✖" // No replicas ready
+	}
+	return "◑" // Partially ready
+}
+
+// IsHealthy returns true if every desired replica is ready
+func (r *ReplicaSetInfo) IsHealthy() bool {
+	return r.Ready == r.Desired
+}
+
+// GenericResourceInfo represents any API object, including custom resources,
+// for display in the generic resource browser
+type GenericResourceInfo struct {
+	Name       string
+	Namespace  string // Empty for cluster-scoped resources
+	Kind       string
+	APIVersion string
+	Status     string // Derived from the Ready condition or status.phase when present
+	Age        string
+	Labels     map[string]string
+	Cells      []string                   // Server-rendered table cells, when available
+	Cluster    string                     // Source kube context (multi-cluster mode only)
+	Object     *unstructured.Unstructured // Keep reference to full object
+}
+
+// NewGenericResourceInfo creates a GenericResourceInfo from an unstructured object
+func NewGenericResourceInfo(obj *unstructured.Unstructured) GenericResourceInfo {
+	return GenericResourceInfo{
+		Name:       obj.GetName(),
+		Namespace:  obj.GetNamespace(),
+		Kind:       obj.GetKind(),
+		APIVersion: obj.GetAPIVersion(),
+		Status:     genericStatus(obj),
+		Age:        formatAge(obj.GetCreationTimestamp()),
+		Labels:     obj.GetLabels(),
+		Object:     obj,
+	}
+}
+
+// NewGenericResourceTable converts a server-side Table into column names and rows.
+// Only default (priority 0) columns are kept, matching kubectl get without -o wide.
+func NewGenericResourceTable(table *metav1.Table) ([]string, []GenericResourceInfo, error) {
+	var columns []string
+	var indexes []int
+	nameIdx := -1
+	for i, column := range table.ColumnDefinitions {
+		if column.Priority != 0 {
+			continue
+		}
+		if column.Format == "name" && nameIdx < 0 {
+			nameIdx = i
+		}
+		columns = append(columns, column.Name)
+		indexes = append(indexes, i)
+	}
+
+	rows := make([]GenericResourceInfo, 0, len(table.Rows))
+	for _, row := range table.Rows {
+		var info GenericResourceInfo
+		if len(row.Object.Raw) > 0 {
+			obj := &unstructured.Unstructured{}
+			if err := obj.UnmarshalJSON(row.Object.Raw); err != nil {
+				return nil, nil, fmt.Errorf("failed to decode table row: %w", err)
+			}
+			info = NewGenericResourceInfo(obj)
+		} else if nameIdx >= 0 && nameIdx < len(row.Cells) {
+			// Servers that ignore includeObject still return the name column
+			info = GenericResourceInfo{Name: formatCell(row.Cells[nameIdx])}
+		}
+
+		info.Cells = make([]string, len(indexes))
+		for i, idx := range indexes {
+			if idx < len(row.Cells) {
+				info.Cells[i] = formatCell(row.Cells[idx])
+			}
+		}
+		rows = append(rows, info)
+	}
+
+	return columns, rows, nil
+}
+
+// formatCell renders a table cell the way kubectl prints it
+func formatCell(cell interface{}) string {
+	switch v := cell.(type) {
+	case nil:
+		return "<none>"
+	case string:
+		return v
+	case float64:
+		// JSON numbers decode as float64; integer columns should not print decimals
+		if v == float64(int64(v)) {
+			return fmt.Sprintf("%d", int64(v))
+		}
+		return fmt.Sprintf("%g", v)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// genericStatus summarizes an object's status using the conventions most
+// controllers follow: a Ready condition, or failing that a phase
+func genericStatus(obj *unstructured.Unstructured) string {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, item := range conditions {
+		condition, ok := item.(map[string]interface{})
+		if !ok || condition["type"] != "Ready" {
+			continue
+		}
+		switch condition["status"] {
+		case "True":
+			return "Ready"
+		case "False":
+			if reason, ok := condition["reason"].(string); ok && reason != "" {
+				return reason
+			}
+			return "NotReady"
+		default:
+			return "Unknown"
+		}
+	}
+
+	if phase, found, _ := unstructured.NestedString(obj.Object, "status", "phase"); found && phase != "" {
+		return phase
+	}
+
+	return ""
+}
+
+// GetStatusSymbol returns a visual indicator for the object's status
+func (g *GenericResourceInfo) GetStatusSymbol() string {
+	switch g.Status {
+	case "":
+		return "●" // No status reported; the object simply exists
+	case "Ready", "Active", "Running", "Bound", "Succeeded":
+		return "●"
+	case "Unknown", "Pending":
+		return "◐"
+	default:
+		return "✖"
+	}
+}
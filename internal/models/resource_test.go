@@ -1,12 +1,15 @@
 package models
 
 import (
+	"strings"
 	"testing"
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 func TestNewPodInfo(t *testing.T) {
@@ -833,3 +836,305 @@ func TestStatefulSetInfo_IsHealthy(t *testing.T) {
 		})
 	}
 }
+
+func TestNewDaemonSetInfo(t *testing.T) {
+	daemonSet := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fluent-bit",
+			Namespace: "logging",
+			CreationTimestamp: metav1.Time{
+				Time: time.Now().Add(-5 * 24 * time.Hour),
+			},
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					NodeSelector: map[string]string{"kubernetes.io/os": "linux", "logging": "enabled"},
+				},
+			},
+			UpdateStrategy: appsv1.DaemonSetUpdateStrategy{Type: appsv1.RollingUpdateDaemonSetStrategyType},
+		},
+		Status: appsv1.DaemonSetStatus{
+			DesiredNumberScheduled: 5,
+			CurrentNumberScheduled: 4,
+			NumberReady:            4,
+			UpdatedNumberScheduled: 5,
+			NumberAvailable:        4,
+		},
+	}
+
+	got := NewDaemonSetInfo(daemonSet)
+
+	if got.Desired != 5 || got.Current != 4 || got.Ready != 4 || got.UpToDate != 5 || got.Available != 4 {
+		t.Errorf("Unexpected counts %+v", got)
+	}
+	if got.NodeSelector != "kubernetes.io/os=linux,logging=enabled" {
+		t.Errorf("NodeSelector = %s, want kubernetes.io/os=linux,logging=enabled", got.NodeSelector)
+	}
+	if got.Age != "5d" || got.Strategy != "RollingUpdate" {
+		t.Errorf("Unexpected age %s or strategy %s", got.Age, got.Strategy)
+	}
+
+	daemonSet.Spec.Template.Spec.NodeSelector = nil
+	if got := NewDaemonSetInfo(daemonSet); got.NodeSelector != "<none>" {
+		t.Errorf("NodeSelector = %s, want <none>", got.NodeSelector)
+	}
+}
+
+func TestDaemonSetInfo_GetStatusSymbol(t *testing.T) {
+	tests := []struct {
+		name                            string
+		desired, ready, upToDate, avail int32
+		want                            string
+		wantHealthy                     bool
+	}{
+		{"on every node", 3, 3, 3, 3, "●", true},
+		{"missing on one node", 3, 2, 3, 2, "◑", false},
+		{"rolling out", 3, 3, 1, 3, "◑", false},
+		{"none ready", 3, 0, 3, 0, "✖", false},
+		{"no eligible nodes", 0, 0, 0, 0, "●", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := DaemonSetInfo{Desired: tt.desired, Ready: tt.ready, UpToDate: tt.upToDate, Available: tt.avail}
+			if got := info.GetStatusSymbol(); got != tt.want {
+				t.Errorf("GetStatusSymbol() = %s, want %s", got, tt.want)
+			}
+			if got := info.IsHealthy(); got != tt.wantHealthy {
+				t.Errorf("IsHealthy() = %v, want %v", got, tt.wantHealthy)
+			}
+		})
+	}
+}
+
+func TestNewReplicaSetInfo(t *testing.T) {
+	replicas := int32(3)
+	replicaSet := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "web-7d4b9c8f5",
+			Namespace:   "shop",
+			Annotations: map[string]string{"deployment.kubernetes.io/revision": "4"},
+			OwnerReferences: []metav1.OwnerReference{
+				{Kind: "Deployment", Name: "web"},
+			},
+			CreationTimestamp: metav1.Time{
+				Time: time.Now().Add(-3 * time.Hour),
+			},
+		},
+		Spec: appsv1.ReplicaSetSpec{
+			Replicas: &replicas,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "web", Image: "shop/web:1.4.2"}},
+				},
+			},
+		},
+		Status: appsv1.ReplicaSetStatus{Replicas: 3, ReadyReplicas: 2, AvailableReplicas: 2},
+	}
+
+	got := NewReplicaSetInfo(replicaSet)
+
+	if got.Desired != 3 || got.Current != 3 || got.Ready != 2 || got.Available != 2 {
+		t.Errorf("Unexpected counts %+v", got)
+	}
+	if got.Owner != "web" || got.Revision != "4" || got.Age != "3h" {
+		t.Errorf("Unexpected owner %s, revision %s or age %s", got.Owner, got.Revision, got.Age)
+	}
+	if len(got.Images) != 1 || got.Images[0] != "shop/web:1.4.2" {
+		t.Errorf("Unexpected images %v", got.Images)
+	}
+}
+
+func TestReplicaSetInfo_GetStatusSymbol(t *testing.T) {
+	tests := []struct {
+		name           string
+		desired, ready int32
+		want           string
+	}{
+		{"healthy", 3, 3, "●"},
+		{"partially ready", 3, 1, "◑"},
+		{"none ready", 3, 0, "✖"},
+		{"old revision scaled down", 0, 0, "○"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := ReplicaSetInfo{Desired: tt.desired, Ready: tt.ready}
+			if got := info.GetStatusSymbol(); got != tt.want {
+				t.Errorf("GetStatusSymbol() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewGenericResourceInfo(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "cert-manager.io/v1",
+		"kind":       "Certificate",
+		"metadata": map[string]interface{}{
+			"name":      "web-tls",
+			"namespace": "shop",
+			"labels":    map[string]interface{}{"app": "web"},
+		},
+		"status": map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Issuing", "status": "False"},
+				map[string]interface{}{"type": "Ready", "status": "True"},
+			},
+		},
+	}}
+
+	info := NewGenericResourceInfo(obj)
+	if info.Name != "web-tls" || info.Namespace != "shop" {
+		t.Errorf("Unexpected name %s/%s", info.Namespace, info.Name)
+	}
+	if info.Kind != "Certificate" || info.APIVersion != "cert-manager.io/v1" {
+		t.Errorf("Unexpected type %s %s", info.APIVersion, info.Kind)
+	}
+	if info.Status != "Ready" {
+		t.Errorf("Expected status Ready, got %s", info.Status)
+	}
+	if info.Labels["app"] != "web" {
+		t.Errorf("Expected labels to be copied, got %v", info.Labels)
+	}
+	if info.Object != obj {
+		t.Error("Expected reference to the full object")
+	}
+}
+
+func TestGenericResourceInfo_Status(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     map[string]interface{}
+		wantStatus string
+		wantSymbol string
+	}{
+		{
+			name:       "no status",
+			wantStatus: "",
+			wantSymbol: "●",
+		},
+		{
+			name: "not ready with reason",
+			status: map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "False", "reason": "IssuerNotFound"},
+				},
+			},
+			wantStatus: "IssuerNotFound",
+			wantSymbol: "✖",
+		},
+		{
+			name: "not ready without reason",
+			status: map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "False"},
+				},
+			},
+			wantStatus: "NotReady",
+			wantSymbol: "✖",
+		},
+		{
+			name: "unknown readiness",
+			status: map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "Unknown"},
+				},
+			},
+			wantStatus: "Unknown",
+			wantSymbol: "◐",
+		},
+		{
+			name:       "phase",
+			status:     map[string]interface{}{"phase": "Bound"},
+			wantStatus: "Bound",
+			wantSymbol: "●",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "example.com/v1",
+				"kind":       "Widget",
+				"metadata":   map[string]interface{}{"name": "gizmo"},
+			}}
+			if tt.status != nil {
+				obj.Object["status"] = tt.status
+			}
+
+			info := NewGenericResourceInfo(obj)
+			if info.Status != tt.wantStatus {
+				t.Errorf("Status = %q, want %q", info.Status, tt.wantStatus)
+			}
+			if got := info.GetStatusSymbol(); got != tt.wantSymbol {
+				t.Errorf("GetStatusSymbol() = %v, want %v", got, tt.wantSymbol)
+			}
+		})
+	}
+}
+
+func TestNewGenericResourceTable(t *testing.T) {
+	table := &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Name", Type: "string", Format: "name"},
+			{Name: "Ready", Type: "string"},
+			{Name: "Issuer", Type: "string", Priority: 1},
+			{Name: "Replicas", Type: "integer"},
+			{Name: "Age", Type: "date"},
+		},
+		Rows: []metav1.TableRow{
+			{
+				Cells: []interface{}{"web-tls", "True", "letsencrypt", float64(3), "3d"},
+				Object: runtime.RawExtension{Raw: []byte(`{
+					"apiVersion": "cert-manager.io/v1",
+					"kind": "Certificate",
+					"metadata": {"name": "web-tls", "namespace": "shop"}
+				}`)},
+			},
+			{
+				Cells: []interface{}{"api-tls", nil, "letsencrypt", float64(1.5), "1h"},
+			},
+		},
+	}
+
+	columns, rows, err := NewGenericResourceTable(table)
+	if err != nil {
+		t.Fatalf("NewGenericResourceTable failed: %v", err)
+	}
+
+	wantColumns := []string{"Name", "Ready", "Replicas", "Age"}
+	if strings.Join(columns, ",") != strings.Join(wantColumns, ",") {
+		t.Errorf("columns = %v, want %v", columns, wantColumns)
+	}
+
+	if len(rows) != 2 {
+		t.Fatalf("Expected 2 rows, got %d", len(rows))
+	}
+	if rows[0].Namespace != "shop" || rows[0].Kind != "Certificate" || rows[0].Object == nil {
+		t.Errorf("Expected first row decoded from its object, got %+v", rows[0])
+	}
+	if strings.Join(rows[0].Cells, ",") != "web-tls,True,3,3d" {
+		t.Errorf("Unexpected cells %v", rows[0].Cells)
+	}
+
+	// Rows without an object fall back to the name column
+	if rows[1].Name != "api-tls" {
+		t.Errorf("Expected name from cells, got %q", rows[1].Name)
+	}
+	if strings.Join(rows[1].Cells, ",") != "api-tls,<none>,1.5,1h" {
+		t.Errorf("Unexpected cells %v", rows[1].Cells)
+	}
+}
+
+func TestNewGenericResourceTableInvalidObject(t *testing.T) {
+	table := &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{{Name: "Name", Format: "name"}},
+		Rows:              []metav1.TableRow{{Cells: []interface{}{"bad"}, Object: runtime.RawExtension{Raw: []byte("{")}}},
+	}
+
+	if _, _, err := NewGenericResourceTable(table); err == nil {
+		t.Error("Expected error for undecodable row object")
+	}
+}
@@ -0,0 +1,143 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// RevisionAnnotation holds the revision of a deployment and of its replicasets
+	RevisionAnnotation = "deployment.kubernetes.io/revision"
+	// ChangeCauseAnnotation records why a revision was made, e.g. the command that made it
+	ChangeCauseAnnotation = "kubernetes.io/change-cause"
+)
+
+// RevisionInfo is one revision of a deployment or statefulset's pod template
+type RevisionInfo struct {
+	Revision    int64
+	Name        string // ReplicaSet or ControllerRevision holding the revision
+	Images      string // Container images of the template, comma separated
+	ChangeCause string
+	Current     bool // The revision the workload runs
+	Age         string
+	Template    *corev1.PodTemplateSpec
+}
+
+// DeploymentRevisions returns the revisions of a deployment from the replicasets it
+// owns, newest first
+func DeploymentRevisions(deployment *appsv1.Deployment, replicaSets []appsv1.ReplicaSet) []RevisionInfo {
+	current := deployment.Annotations[RevisionAnnotation]
+	result := make([]RevisionInfo, 0)
+	for i := range replicaSets {
+		replicaSet := &replicaSets[i]
+		if !ownedBy(replicaSet.OwnerReferences, "Deployment", deployment.UID) {
+			continue
+		}
+		revision, err := strconv.ParseInt(replicaSet.Annotations[RevisionAnnotation], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		// The controller adds the pod-template-hash label to the template of each
+		// replicaset; it is not part of the deployment's template
+		template := replicaSet.Spec.Template.DeepCopy()
+		delete(template.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+		if len(template.Labels) == 0 {
+			template.Labels = nil
+		}
+
+		result = append(result, RevisionInfo{
+			Revision:    revision,
+			Name:        replicaSet.Name,
+			Images:      templateImages(template),
+			ChangeCause: replicaSet.Annotations[ChangeCauseAnnotation],
+			Current:     replicaSet.Annotations[RevisionAnnotation] == current,
+			Age:         formatAge(replicaSet.CreationTimestamp),
+			Template:    template,
+		})
+	}
+	sortRevisions(result)
+	return result
+}
+
+// StatefulSetRevisions returns the revisions of a statefulset from the controller
+// revisions it owns, newest first
+func StatefulSetRevisions(statefulSet *appsv1.StatefulSet, revisions []appsv1.ControllerRevision) []RevisionInfo {
+	result := make([]RevisionInfo, 0)
+	for i := range revisions {
+		revision := &revisions[i]
+		if !ownedBy(revision.OwnerReferences, "StatefulSet", statefulSet.UID) {
+			continue
+		}
+
+		// The revision stores the patch that restores the statefulset's template
+		var data struct {
+			Spec struct {
+				Template corev1.PodTemplateSpec `json:"template"`
+			} `json:"spec"`
+		}
+		if err := json.Unmarshal(revision.Data.Raw, &data); err != nil {
+			continue
+		}
+
+		result = append(result, RevisionInfo{
+			Revision:    revision.Revision,
+			Name:        revision.Name,
+			Images:      templateImages(&data.Spec.Template),
+			ChangeCause: revision.Annotations[ChangeCauseAnnotation],
+			Current:     revision.Name == statefulSet.Status.UpdateRevision,
+			Age:         formatAge(revision.CreationTimestamp),
+			Template:    &data.Spec.Template,
+		})
+	}
+	sortRevisions(result)
+	return result
+}
+
+// TemplateDiff returns the line diff between the YAML of two pod templates
+func TemplateDiff(from, to *corev1.PodTemplateSpec) ([]DiffLine, error) {
+	fromYAML, err := yaml.Marshal(from)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal pod template: %w", err)
+	}
+	toYAML, err := yaml.Marshal(to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal pod template: %w", err)
+	}
+	return DiffLines(string(fromYAML), string(toYAML)), nil
+}
+
+// ownedBy reports whether owners include the controller of the given kind
+func ownedBy(owners []metav1.OwnerReference, kind string, uid types.UID) bool {
+	for _, owner := range owners {
+		if owner.Kind == kind && owner.UID == uid {
+			return true
+		}
+	}
+	return false
+}
+
+// templateImages returns the container images of a pod template, comma separated
+func templateImages(template *corev1.PodTemplateSpec) string {
+	images := make([]string, len(template.Spec.Containers))
+	for i, container := range template.Spec.Containers {
+		images[i] = container.Image
+	}
+	return strings.Join(images, ", ")
+}
+
+// sortRevisions orders revisions newest first
+func sortRevisions(revisions []RevisionInfo) {
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Revision > revisions[j].Revision
+	})
+}
@@ -0,0 +1,111 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func newTestReplicaSet(name, revision, image string, owner metav1.OwnerReference) appsv1.ReplicaSet {
+	return appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Annotations:     map[string]string{RevisionAnnotation: revision, ChangeCauseAnnotation: "set image " + image},
+			OwnerReferences: []metav1.OwnerReference{owner},
+		},
+		Spec: appsv1.ReplicaSetSpec{Template: corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web", appsv1.DefaultDeploymentUniqueLabelKey: name}},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "web", Image: image}}},
+		}},
+	}
+}
+
+func TestDeploymentRevisions(t *testing.T) {
+	deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+		Name:        "web",
+		UID:         "web-uid",
+		Annotations: map[string]string{RevisionAnnotation: "3"},
+	}}
+	owner := metav1.OwnerReference{Kind: "Deployment", Name: "web", UID: "web-uid"}
+	replicaSets := []appsv1.ReplicaSet{
+		newTestReplicaSet("web-1", "1", "web:1.0", owner),
+		newTestReplicaSet("web-3", "3", "web:1.2", owner),
+		newTestReplicaSet("web-2", "2", "web:1.1", owner),
+		newTestReplicaSet("api-1", "1", "api:1.0", metav1.OwnerReference{Kind: "Deployment", Name: "api", UID: "api-uid"}),
+		newTestReplicaSet("web-x", "", "web:broken", owner),
+	}
+
+	revisions := DeploymentRevisions(deployment, replicaSets)
+	if len(revisions) != 3 {
+		t.Fatalf("Expected 3 revisions, got %d", len(revisions))
+	}
+	if revisions[0].Revision != 3 || revisions[1].Revision != 2 || revisions[2].Revision != 1 {
+		t.Errorf("Expected revisions newest first, got %d, %d, %d", revisions[0].Revision, revisions[1].Revision, revisions[2].Revision)
+	}
+	if !revisions[0].Current || revisions[1].Current {
+		t.Error("Expected only revision 3 to be current")
+	}
+	if revisions[0].Name != "web-3" || revisions[0].Images != "web:1.2" || revisions[0].ChangeCause != "set image web:1.2" {
+		t.Errorf("Unexpected revision: %+v", revisions[0])
+	}
+	if _, ok := revisions[0].Template.Labels[appsv1.DefaultDeploymentUniqueLabelKey]; ok || revisions[0].Template.Labels["app"] != "web" {
+		t.Errorf("Expected the pod-template-hash label to be removed, got %v", revisions[0].Template.Labels)
+	}
+	if _, ok := replicaSets[1].Spec.Template.Labels[appsv1.DefaultDeploymentUniqueLabelKey]; !ok {
+		t.Error("Expected the replicaset itself to be left alone")
+	}
+}
+
+func TestStatefulSetRevisions(t *testing.T) {
+	statefulSet := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", UID: "db-uid"},
+		Status:     appsv1.StatefulSetStatus{UpdateRevision: "db-7b9c"},
+	}
+	owner := metav1.OwnerReference{Kind: "StatefulSet", Name: "db", UID: "db-uid"}
+	revisions := StatefulSetRevisions(statefulSet, []appsv1.ControllerRevision{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "db-5d8f", OwnerReferences: []metav1.OwnerReference{owner}},
+			Data:       runtime.RawExtension{Raw: []byte(`{"spec":{"template":{"$patch":"replace","spec":{"containers":[{"name":"db","image":"postgres:15"}]}}}}`)},
+			Revision:   1,
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "db-7b9c", OwnerReferences: []metav1.OwnerReference{owner}},
+			Data:       runtime.RawExtension{Raw: []byte(`{"spec":{"template":{"$patch":"replace","spec":{"containers":[{"name":"db","image":"postgres:16"}]}}}}`)},
+			Revision:   2,
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "db-bad", OwnerReferences: []metav1.OwnerReference{owner}},
+			Data:       runtime.RawExtension{Raw: []byte(`not json`)},
+			Revision:   3,
+		},
+	})
+
+	if len(revisions) != 2 {
+		t.Fatalf("Expected 2 revisions, got %d", len(revisions))
+	}
+	if revisions[0].Revision != 2 || !revisions[0].Current || revisions[0].Images != "postgres:16" {
+		t.Errorf("Unexpected newest revision: %+v", revisions[0])
+	}
+	if revisions[1].Revision != 1 || revisions[1].Current || revisions[1].Images != "postgres:15" {
+		t.Errorf("Unexpected oldest revision: %+v", revisions[1])
+	}
+
+	diff, err := TemplateDiff(revisions[1].Template, revisions[0].Template)
+	if err != nil {
+		t.Fatalf("TemplateDiff failed: %v", err)
+	}
+	var changed []string
+	for _, line := range diff {
+		if line.Op != DiffEqual {
+			changed = append(changed, line.String())
+		}
+	}
+	if len(changed) != 2 || !strings.Contains(changed[0], "- ") || !strings.Contains(changed[0], "postgres:15") ||
+		!strings.Contains(changed[1], "+ ") || !strings.Contains(changed[1], "postgres:16") {
+		t.Errorf("Expected the image change only, got %q", changed)
+	}
+}
@@ -0,0 +1,137 @@
+package models
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+// RestartedAtAnnotation is the pod template annotation kubectl rollout restart sets
+// to roll out new pods
+const RestartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+// RolloutStatus is the progress of a workload's rollout, summarised like
+// kubectl rollout status
+type RolloutStatus struct {
+	Desired     int32
+	Updated     int32 // Pods running the latest pod template
+	Ready       int32
+	Available   int32
+	Paused      bool
+	Complete    bool
+	Failed      bool   // The deployment exceeded its progress deadline
+	Message     string // What the rollout is waiting for, or why it failed
+	RestartedAt string // When the pod template was last restarted, empty if never
+}
+
+// NewDeploymentRolloutStatus returns the rollout status of a deployment
+func NewDeploymentRolloutStatus(deployment *appsv1.Deployment) RolloutStatus {
+	desired := int32(1)
+	if deployment.Spec.Replicas != nil {
+		desired = *deployment.Spec.Replicas
+	}
+	status := RolloutStatus{
+		Desired:     desired,
+		Updated:     deployment.Status.UpdatedReplicas,
+		Ready:       deployment.Status.ReadyReplicas,
+		Available:   deployment.Status.AvailableReplicas,
+		Paused:      deployment.Spec.Paused,
+		RestartedAt: deployment.Spec.Template.Annotations[RestartedAtAnnotation],
+	}
+
+	for _, condition := range deployment.Status.Conditions {
+		if condition.Type == appsv1.DeploymentProgressing && condition.Reason == "ProgressDeadlineExceeded" {
+			status.Failed = true
+			status.Message = fmt.Sprintf("deployment %q exceeded its progress deadline", deployment.Name)
+			return status
+		}
+	}
+
+	switch {
+	case deployment.Generation > deployment.Status.ObservedGeneration:
+		status.Message = "Waiting for the deployment spec update to be observed"
+	case status.Paused:
+		status.Message = "Rollout is paused"
+	case status.Updated < desired:
+		status.Message = fmt.Sprintf("Waiting for rollout to finish: %d of %d new replicas have been updated", status.Updated, desired)
+	case deployment.Status.Replicas > status.Updated:
+		status.Message = fmt.Sprintf("Waiting for rollout to finish: %d old replicas are pending termination",
+			deployment.Status.Replicas-status.Updated)
+	case status.Available < status.Updated:
+		status.Message = fmt.Sprintf("Waiting for rollout to finish: %d of %d updated replicas are available", status.Available, status.Updated)
+	default:
+		status.Complete = true
+		status.Message = fmt.Sprintf("deployment %q successfully rolled out", deployment.Name)
+	}
+	return status
+}
+
+// NewStatefulSetRolloutStatus returns the rollout status of a statefulset. Like kubectl,
+// a partitioned rollout is complete once the pods at or above the partition are updated,
+// since the pods below it keep the current revision.
+func NewStatefulSetRolloutStatus(statefulSet *appsv1.StatefulSet) RolloutStatus {
+	desired := int32(1)
+	if statefulSet.Spec.Replicas != nil {
+		desired = *statefulSet.Spec.Replicas
+	}
+	partition := int32(0)
+	if rollingUpdate := statefulSet.Spec.UpdateStrategy.RollingUpdate; rollingUpdate != nil && rollingUpdate.Partition != nil {
+		partition = *rollingUpdate.Partition
+	}
+	status := RolloutStatus{
+		Desired:     desired,
+		Updated:     statefulSet.Status.UpdatedReplicas,
+		Ready:       statefulSet.Status.ReadyReplicas,
+		Available:   statefulSet.Status.AvailableReplicas,
+		RestartedAt: statefulSet.Spec.Template.Annotations[RestartedAtAnnotation],
+	}
+
+	switch {
+	case statefulSet.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType:
+		status.Message = "Rollout status is not available with the OnDelete update strategy: pods are updated when deleted"
+	case statefulSet.Generation > statefulSet.Status.ObservedGeneration:
+		status.Message = "Waiting for the statefulset spec update to be observed"
+	case status.Ready < desired:
+		status.Message = fmt.Sprintf("Waiting for %d pods to be ready", desired-status.Ready)
+	case partition > 0 && status.Updated < desired-partition:
+		status.Message = fmt.Sprintf("Waiting for partitioned rollout to finish: %d of %d new pods have been updated",
+			status.Updated, max(0, desired-partition))
+	case partition > 0:
+		status.Complete = true
+		status.Message = fmt.Sprintf("partitioned rollout complete: %d new pods have been updated", status.Updated)
+	case statefulSet.Status.UpdateRevision != statefulSet.Status.CurrentRevision:
+		status.Message = fmt.Sprintf("Waiting for statefulset rolling update to complete: %d of %d pods have been updated", status.Updated, desired)
+	default:
+		status.Complete = true
+		status.Message = fmt.Sprintf("statefulset rolling update complete %d pods at revision %s", status.Ready, statefulSet.Status.CurrentRevision)
+	}
+	return status
+}
+
+// NewDaemonSetRolloutStatus returns the rollout status of a daemonset
+func NewDaemonSetRolloutStatus(daemonSet *appsv1.DaemonSet) RolloutStatus {
+	status := RolloutStatus{
+		Desired:     daemonSet.Status.DesiredNumberScheduled,
+		Updated:     daemonSet.Status.UpdatedNumberScheduled,
+		Ready:       daemonSet.Status.NumberReady,
+		Available:   daemonSet.Status.NumberAvailable,
+		RestartedAt: daemonSet.Spec.Template.Annotations[RestartedAtAnnotation],
+	}
+
+	switch {
+	case daemonSet.Spec.UpdateStrategy.Type == appsv1.OnDeleteDaemonSetStrategyType:
+		status.Message = "Rollout status is not available with the OnDelete update strategy: pods are updated when deleted"
+	case daemonSet.Generation > daemonSet.Status.ObservedGeneration:
+		status.Message = "Waiting for the daemonset spec update to be observed"
+	case status.Updated < status.Desired:
+		status.Message = fmt.Sprintf("Waiting for daemon set %q rollout to finish: %d out of %d new pods have been updated",
+			daemonSet.Name, status.Updated, status.Desired)
+	case status.Available < status.Desired:
+		status.Message = fmt.Sprintf("Waiting for daemon set %q rollout to finish: %d of %d updated pods are available",
+			daemonSet.Name, status.Available, status.Desired)
+	default:
+		status.Complete = true
+		status.Message = fmt.Sprintf("daemon set %q successfully rolled out", daemonSet.Name)
+	}
+	return status
+}
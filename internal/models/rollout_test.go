@@ -0,0 +1,180 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewDeploymentRolloutStatus(t *testing.T) {
+	replicas := int32(3)
+	newDeployment := func(status appsv1.DeploymentStatus) *appsv1.Deployment {
+		status.ObservedGeneration = 2
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Generation: 2},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: &replicas,
+				Template: corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{RestartedAtAnnotation: "2026-10-16T09:00:00Z"},
+				}},
+			},
+			Status: status,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		deployment *appsv1.Deployment
+		complete   bool
+		failed     bool
+		message    string
+	}{
+		{
+			name:       "updating",
+			deployment: newDeployment(appsv1.DeploymentStatus{Replicas: 4, UpdatedReplicas: 1, ReadyReplicas: 3}),
+			message:    "1 of 3 new replicas have been updated",
+		},
+		{
+			name:       "old replicas terminating",
+			deployment: newDeployment(appsv1.DeploymentStatus{Replicas: 4, UpdatedReplicas: 3, ReadyReplicas: 3}),
+			message:    "1 old replicas are pending termination",
+		},
+		{
+			name:       "waiting for availability",
+			deployment: newDeployment(appsv1.DeploymentStatus{Replicas: 3, UpdatedReplicas: 3, ReadyReplicas: 3, AvailableReplicas: 2}),
+			message:    "2 of 3 updated replicas are available",
+		},
+		{
+			name:       "complete",
+			deployment: newDeployment(appsv1.DeploymentStatus{Replicas: 3, UpdatedReplicas: 3, ReadyReplicas: 3, AvailableReplicas: 3}),
+			complete:   true,
+			message:    "successfully rolled out",
+		},
+		{
+			name: "progress deadline exceeded",
+			deployment: newDeployment(appsv1.DeploymentStatus{
+				Replicas:        4,
+				UpdatedReplicas: 1,
+				Conditions: []appsv1.DeploymentCondition{
+					{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionFalse, Reason: "ProgressDeadlineExceeded"},
+				},
+			}),
+			failed:  true,
+			message: "exceeded its progress deadline",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := NewDeploymentRolloutStatus(tt.deployment)
+			if status.Complete != tt.complete || status.Failed != tt.failed {
+				t.Errorf("Complete = %v, Failed = %v, want %v, %v", status.Complete, status.Failed, tt.complete, tt.failed)
+			}
+			if !strings.Contains(status.Message, tt.message) {
+				t.Errorf("Message = %q, want it to contain %q", status.Message, tt.message)
+			}
+			if status.Desired != 3 || status.RestartedAt != "2026-10-16T09:00:00Z" {
+				t.Errorf("Desired = %d, RestartedAt = %q", status.Desired, status.RestartedAt)
+			}
+		})
+	}
+
+	paused := newDeployment(appsv1.DeploymentStatus{Replicas: 4, UpdatedReplicas: 1})
+	paused.Spec.Paused = true
+	if status := NewDeploymentRolloutStatus(paused); !status.Paused || status.Complete || status.Message != "Rollout is paused" {
+		t.Errorf("Expected a paused rollout, got %+v", status)
+	}
+
+	unobserved := newDeployment(appsv1.DeploymentStatus{Replicas: 3, UpdatedReplicas: 3, ReadyReplicas: 3, AvailableReplicas: 3})
+	unobserved.Generation = 3
+	if status := NewDeploymentRolloutStatus(unobserved); status.Complete {
+		t.Error("Expected a rollout to wait for the spec update to be observed")
+	}
+}
+
+func TestNewStatefulSetRolloutStatus(t *testing.T) {
+	replicas := int32(2)
+	statefulSet := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "db"},
+		Spec:       appsv1.StatefulSetSpec{Replicas: &replicas},
+		Status: appsv1.StatefulSetStatus{
+			ReadyReplicas:   2,
+			UpdatedReplicas: 1,
+			CurrentRevision: "db-1",
+			UpdateRevision:  "db-2",
+		},
+	}
+
+	if status := NewStatefulSetRolloutStatus(statefulSet); status.Complete || !strings.Contains(status.Message, "1 of 2 pods have been updated") {
+		t.Errorf("Expected the rollout to be in progress, got %+v", status)
+	}
+
+	statefulSet.Status.UpdatedReplicas = 2
+	statefulSet.Status.CurrentRevision = "db-2"
+	if status := NewStatefulSetRolloutStatus(statefulSet); !status.Complete || !strings.Contains(status.Message, "revision db-2") {
+		t.Errorf("Expected the rollout to be complete, got %+v", status)
+	}
+
+	statefulSet.Spec.UpdateStrategy.Type = appsv1.OnDeleteStatefulSetStrategyType
+	if status := NewStatefulSetRolloutStatus(statefulSet); status.Complete || !strings.Contains(status.Message, "OnDelete") {
+		t.Errorf("Expected no rollout status with OnDelete, got %+v", status)
+	}
+}
+
+func TestNewStatefulSetRolloutStatusPartitioned(t *testing.T) {
+	replicas, partition := int32(3), int32(2)
+	statefulSet := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "db"},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: &replicas,
+			UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+				Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: &partition},
+			},
+		},
+		Status: appsv1.StatefulSetStatus{
+			ReadyReplicas:   3,
+			UpdatedReplicas: 0,
+			CurrentRevision: "db-1",
+			UpdateRevision:  "db-2",
+		},
+	}
+
+	if status := NewStatefulSetRolloutStatus(statefulSet); status.Complete || !strings.Contains(status.Message, "0 of 1 new pods") {
+		t.Errorf("Expected the partitioned rollout to be in progress, got %+v", status)
+	}
+
+	// Only the pod above the partition is updated; the revisions never converge
+	statefulSet.Status.UpdatedReplicas = 1
+	if status := NewStatefulSetRolloutStatus(statefulSet); !status.Complete || !strings.Contains(status.Message, "partitioned rollout complete") {
+		t.Errorf("Expected the partitioned rollout to be complete, got %+v", status)
+	}
+}
+
+func TestNewDaemonSetRolloutStatus(t *testing.T) {
+	daemonSet := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "agent"},
+		Status: appsv1.DaemonSetStatus{
+			DesiredNumberScheduled: 3,
+			UpdatedNumberScheduled: 3,
+			NumberReady:            3,
+			NumberAvailable:        2,
+		},
+	}
+
+	status := NewDaemonSetRolloutStatus(daemonSet)
+	if status.Complete || !strings.Contains(status.Message, "2 of 3 updated pods are available") {
+		t.Errorf("Expected the rollout to wait for availability, got %+v", status)
+	}
+	if status.Desired != 3 || status.Updated != 3 || status.Ready != 3 || status.Available != 2 {
+		t.Errorf("Unexpected counts: %+v", status)
+	}
+
+	daemonSet.Status.NumberAvailable = 3
+	if status := NewDaemonSetRolloutStatus(daemonSet); !status.Complete {
+		t.Errorf("Expected the rollout to be complete, got %+v", status)
+	}
+}
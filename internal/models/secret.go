@@ -0,0 +1,199 @@
+package models
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// certificateExpiryWarning is how close to expiry a TLS secret is flagged
+const certificateExpiryWarning = 14 * 24 * time.Hour
+
+// SecretInfo represents simplified secret information for display.
+// Only key names and sizes are exposed; values stay in the Secret until revealed.
+type SecretInfo struct {
+	Name         string
+	Namespace    string
+	Type         string
+	DataCount    int
+	Age          string
+	Keys         []ConfigKey       // Names and sizes only, Value is always empty
+	Certificates []CertificateInfo // Decoded tls.crt of kubernetes.io/tls secrets
+	Registries   []RegistryAuth    // Decoded registries of docker config secrets
+	DecodeError  string            // Set if the typed payload could not be decoded
+	Cluster      string            // Source kube context (multi-cluster mode only)
+	Secret       *corev1.Secret    // Keep reference to full secret
+}
+
+// CertificateInfo describes an X.509 certificate without its key material
+type CertificateInfo struct {
+	Subject   string
+	Issuer    string
+	DNSNames  []string
+	NotBefore time.Time
+	NotAfter  time.Time
+}
+
+// RegistryAuth describes a registry entry in a docker config secret, without the password
+type RegistryAuth struct {
+	Host     string
+	Username string
+}
+
+// NewSecretInfo creates a SecretInfo from a Kubernetes Secret
+func NewSecretInfo(secret *corev1.Secret) SecretInfo {
+	keys := make([]ConfigKey, 0, len(secret.Data))
+	for name, value := range secret.Data {
+		keys = append(keys, ConfigKey{Name: name, Size: len(value)})
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Name < keys[j].Name
+	})
+
+	info := SecretInfo{
+		Name:      secret.Name,
+		Namespace: secret.Namespace,
+		Type:      string(secret.Type),
+		DataCount: len(keys),
+		Age:       formatAge(secret.CreationTimestamp),
+		Keys:      keys,
+		Secret:    secret,
+	}
+
+	var err error
+	switch secret.Type {
+	case corev1.SecretTypeTLS:
+		if cert, ok := secret.Data[corev1.TLSCertKey]; ok {
+			info.Certificates, err = ParseCertificates(cert)
+		}
+	case corev1.SecretTypeDockerConfigJson:
+		info.Registries, err = ParseDockerConfig(secret.Data[corev1.DockerConfigJsonKey])
+	case corev1.SecretTypeDockercfg:
+		info.Registries, err = ParseDockerConfig(secret.Data[corev1.DockerConfigKey])
+	}
+	if err != nil {
+		info.DecodeError = err.Error()
+	}
+
+	return info
+}
+
+// GetStatusSymbol returns a visual indicator for secret status.
+// TLS secrets are flagged when their certificate has expired or is about to.
+func (s *SecretInfo) GetStatusSymbol() string {
+	if len(s.Certificates) == 0 {
+		return "●"
+	}
+	remaining := time.Until(s.Certificates[0].NotAfter)
+	if remaining <= 0 {
+		return "✖" // Expired
+	}
+	if remaining < certificateExpiryWarning {
+		return "◑" // Expiring soon
+	}
+	return "●"
+}
+
+// RevealKey returns the decoded value of a key. Values that are not valid
+// UTF-8 text are rendered as a hex dump.
+func (s *SecretInfo) RevealKey(name string) string {
+	if s.Secret == nil {
+		return ""
+	}
+	value := s.Secret.Data[name]
+	if !utf8.Valid(value) {
+		return hex.Dump(value)
+	}
+	return string(value)
+}
+
+// ParseCertificates decodes every certificate in a PEM bundle, leaf first
+func ParseCertificates(data []byte) ([]CertificateInfo, error) {
+	var certs []CertificateInfo
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate: %w", err)
+		}
+		certs = append(certs, CertificateInfo{
+			Subject:   cert.Subject.String(),
+			Issuer:    cert.Issuer.String(),
+			DNSNames:  cert.DNSNames,
+			NotBefore: cert.NotBefore,
+			NotAfter:  cert.NotAfter,
+		})
+	}
+
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no PEM certificate found")
+	}
+	return certs, nil
+}
+
+// Expiry describes when the certificate expires relative to now, e.g. "in 89d" or "expired 3d ago"
+func (c CertificateInfo) Expiry() string {
+	remaining := time.Until(c.NotAfter)
+	if remaining <= 0 {
+		return fmt.Sprintf("expired %s ago", formatDuration(-remaining))
+	}
+	return "in " + formatDuration(remaining)
+}
+
+// dockerConfigEntry is one registry entry of a docker config file
+type dockerConfigEntry struct {
+	Username string `json:"username"`
+	Auth     string `json:"auth"`
+}
+
+// ParseDockerConfig lists the registries in a .dockerconfigjson or legacy .dockercfg payload
+func ParseDockerConfig(data []byte) ([]RegistryAuth, error) {
+	var config struct {
+		Auths map[string]dockerConfigEntry `json:"auths"`
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse docker config: %w", err)
+	}
+
+	entries := config.Auths
+	if entries == nil {
+		// Legacy .dockercfg files are the auths map itself
+		if err := json.Unmarshal(data, &entries); err != nil {
+			return nil, fmt.Errorf("failed to parse docker config: %w", err)
+		}
+	}
+
+	registries := make([]RegistryAuth, 0, len(entries))
+	for host, entry := range entries {
+		username := entry.Username
+		if username == "" && entry.Auth != "" {
+			// auth is base64("username:password")
+			if decoded, err := base64.StdEncoding.DecodeString(entry.Auth); err == nil {
+				username, _, _ = strings.Cut(string(decoded), ":")
+			}
+		}
+		registries = append(registries, RegistryAuth{Host: host, Username: username})
+	}
+	sort.Slice(registries, func(i, j int) bool {
+		return registries[i].Host < registries[j].Host
+	})
+
+	return registries, nil
+}
@@ -0,0 +1,275 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+)
+
+// defaultStorageClassAnnotation marks the storage class used by claims that name none
+const defaultStorageClassAnnotation = "storageclass.kubernetes.io/is-default-class"
+
+// accessModeAbbreviations are the short access mode names used by kubectl get pvc
+var accessModeAbbreviations = map[corev1.PersistentVolumeAccessMode]string{
+	corev1.ReadWriteOnce:    "RWO",
+	corev1.ReadOnlyMany:     "ROX",
+	corev1.ReadWriteMany:    "RWX",
+	corev1.ReadWriteOncePod: "RWOP",
+}
+
+// PersistentVolumeClaimInfo represents simplified persistentvolumeclaim information for display
+type PersistentVolumeClaimInfo struct {
+	Name         string
+	Namespace    string
+	Status       string // Pending, Bound or Lost
+	Volume       string // Bound persistentvolume, empty while pending
+	Capacity     string // Provisioned capacity, empty while pending
+	Requested    string // Requested storage
+	AccessModes  string
+	StorageClass string
+	VolumeMode   string
+	Age          string
+	Cluster      string                        // Source kube context (multi-cluster mode only)
+	Claim        *corev1.PersistentVolumeClaim // Keep reference to full claim
+}
+
+// NewPersistentVolumeClaimInfo creates a PersistentVolumeClaimInfo from a Kubernetes PersistentVolumeClaim
+func NewPersistentVolumeClaimInfo(claim *corev1.PersistentVolumeClaim) PersistentVolumeClaimInfo {
+	info := PersistentVolumeClaimInfo{
+		Name:         claim.Name,
+		Namespace:    claim.Namespace,
+		Status:       string(claim.Status.Phase),
+		Volume:       claim.Spec.VolumeName,
+		AccessModes:  FormatAccessModes(claim.Status.AccessModes),
+		StorageClass: "<none>",
+		VolumeMode:   string(corev1.PersistentVolumeFilesystem),
+		Age:          formatAge(claim.CreationTimestamp),
+		Claim:        claim,
+	}
+	if info.Status == "" {
+		info.Status = string(corev1.ClaimPending)
+	}
+	if claim.Spec.StorageClassName != nil && *claim.Spec.StorageClassName != "" {
+		info.StorageClass = *claim.Spec.StorageClassName
+	}
+	if claim.Spec.VolumeMode != nil {
+		info.VolumeMode = string(*claim.Spec.VolumeMode)
+	}
+	if requested, ok := claim.Spec.Resources.Requests[corev1.ResourceStorage]; ok {
+		info.Requested = requested.String()
+	}
+	if capacity, ok := claim.Status.Capacity[corev1.ResourceStorage]; ok {
+		info.Capacity = capacity.String()
+	}
+	// Until bound, show the access modes the claim asks for
+	if info.AccessModes == "" {
+		info.AccessModes = FormatAccessModes(claim.Spec.AccessModes)
+	}
+
+	return info
+}
+
+// GetStatusSymbol returns a visual indicator for claim status
+func (c *PersistentVolumeClaimInfo) GetStatusSymbol() string {
+	switch corev1.PersistentVolumeClaimPhase(c.Status) {
+	case corev1.ClaimBound:
+		return "●"
+	case corev1.ClaimLost:
+		return "✖" // Bound volume no longer exists
+	default:
+		return "◐" // Waiting for a volume
+	}
+}
+
+// PersistentVolumeInfo represents simplified persistentvolume information for display
+type PersistentVolumeInfo struct {
+	Name          string
+	Capacity      string
+	AccessModes   string
+	ReclaimPolicy string
+	Status        string // Available, Bound, Released, Failed or Pending
+	Claim         string // namespace/name of the bound claim, empty if unbound
+	StorageClass  string
+	Reason        string
+	Source        string // Volume plugin backing the volume, e.g. CSI (ebs.csi.aws.com)
+	Age           string
+	Cluster       string                   // Source kube context (multi-cluster mode only)
+	Volume        *corev1.PersistentVolume // Keep reference to full volume
+}
+
+// NewPersistentVolumeInfo creates a PersistentVolumeInfo from a Kubernetes PersistentVolume
+func NewPersistentVolumeInfo(volume *corev1.PersistentVolume) PersistentVolumeInfo {
+	info := PersistentVolumeInfo{
+		Name:          volume.Name,
+		AccessModes:   FormatAccessModes(volume.Spec.AccessModes),
+		ReclaimPolicy: string(volume.Spec.PersistentVolumeReclaimPolicy),
+		Status:        string(volume.Status.Phase),
+		StorageClass:  volume.Spec.StorageClassName,
+		Reason:        volume.Status.Reason,
+		Source:        persistentVolumeSource(volume),
+		Age:           formatAge(volume.CreationTimestamp),
+		Volume:        volume,
+	}
+	if info.StorageClass == "" {
+		info.StorageClass = "<none>"
+	}
+	if capacity, ok := volume.Spec.Capacity[corev1.ResourceStorage]; ok {
+		info.Capacity = capacity.String()
+	}
+	if ref := volume.Spec.ClaimRef; ref != nil {
+		info.Claim = ref.Namespace + "/" + ref.Name
+	}
+
+	return info
+}
+
+// persistentVolumeSource names the volume plugin that backs a persistentvolume
+func persistentVolumeSource(volume *corev1.PersistentVolume) string {
+	source := volume.Spec.PersistentVolumeSource
+	switch {
+	case source.CSI != nil:
+		return fmt.Sprintf("CSI (%s)", source.CSI.Driver)
+	case source.HostPath != nil:
+		return "HostPath (" + source.HostPath.Path + ")"
+	case source.Local != nil:
+		return "Local (" + source.Local.Path + ")"
+	case source.NFS != nil:
+		return fmt.Sprintf("NFS (%s:%s)", source.NFS.Server, source.NFS.Path)
+	default:
+		return ""
+	}
+}
+
+// GetStatusSymbol returns a visual indicator for volume status
+func (v *PersistentVolumeInfo) GetStatusSymbol() string {
+	switch corev1.PersistentVolumePhase(v.Status) {
+	case corev1.VolumeBound:
+		return "●"
+	case corev1.VolumeAvailable:
+		return "○" // Free to be claimed
+	case corev1.VolumeReleased:
+		return "◑" // Claim deleted, volume not yet reclaimed
+	case corev1.VolumeFailed:
+		return "✖"
+	default:
+		return "◐"
+	}
+}
+
+// StorageClassInfo represents simplified storageclass information for display
+type StorageClassInfo struct {
+	Name                 string
+	Provisioner          string
+	ReclaimPolicy        string
+	VolumeBindingMode    string
+	AllowVolumeExpansion bool
+	IsDefault            bool
+	Parameters           []string // Sorted "key=value" pairs
+	Age                  string
+	Cluster              string                  // Source kube context (multi-cluster mode only)
+	StorageClass         *storagev1.StorageClass // Keep reference to full storage class
+}
+
+// NewStorageClassInfo creates a StorageClassInfo from a Kubernetes StorageClass
+func NewStorageClassInfo(storageClass *storagev1.StorageClass) StorageClassInfo {
+	info := StorageClassInfo{
+		Name:              storageClass.Name,
+		Provisioner:       storageClass.Provisioner,
+		ReclaimPolicy:     string(corev1.PersistentVolumeReclaimDelete),
+		VolumeBindingMode: string(storagev1.VolumeBindingImmediate),
+		IsDefault:         storageClass.Annotations[defaultStorageClassAnnotation] == "true",
+		Age:               formatAge(storageClass.CreationTimestamp),
+		StorageClass:      storageClass,
+	}
+	if storageClass.ReclaimPolicy != nil {
+		info.ReclaimPolicy = string(*storageClass.ReclaimPolicy)
+	}
+	if storageClass.VolumeBindingMode != nil {
+		info.VolumeBindingMode = string(*storageClass.VolumeBindingMode)
+	}
+	if storageClass.AllowVolumeExpansion != nil {
+		info.AllowVolumeExpansion = *storageClass.AllowVolumeExpansion
+	}
+	for key, value := range storageClass.Parameters {
+		info.Parameters = append(info.Parameters, key+"="+value)
+	}
+	sort.Strings(info.Parameters)
+
+	return info
+}
+
+// GetStatusSymbol returns a visual indicator for storage class status
+func (s *StorageClassInfo) GetStatusSymbol() string {
+	if s.IsDefault {
+		return "★" // Default class
+	}
+	return "●"
+}
+
+// FormatAccessModes abbreviates access modes the way kubectl does, e.g. "RWO,RWX"
+func FormatAccessModes(modes []corev1.PersistentVolumeAccessMode) string {
+	abbreviations := make([]string, 0, len(modes))
+	for _, mode := range modes {
+		if abbreviation, ok := accessModeAbbreviations[mode]; ok {
+			abbreviations = append(abbreviations, abbreviation)
+		} else {
+			abbreviations = append(abbreviations, string(mode))
+		}
+	}
+	return strings.Join(abbreviations, ",")
+}
+
+// ClaimPods returns the pods that mount a claim, either directly or through a generic
+// ephemeral volume, sorted by name. The result is never nil.
+func ClaimPods(claim *corev1.PersistentVolumeClaim, pods []corev1.Pod) []PodInfo {
+	result := make([]PodInfo, 0)
+	for i := range pods {
+		pod := &pods[i]
+		if pod.Namespace != claim.Namespace {
+			continue
+		}
+		for _, volume := range pod.Spec.Volumes {
+			direct := volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.ClaimName == claim.Name
+			// Ephemeral volumes get a claim named <pod>-<volume>
+			ephemeral := volume.Ephemeral != nil && pod.Name+"-"+volume.Name == claim.Name
+			if direct || ephemeral {
+				result = append(result, NewPodInfo(pod))
+				break
+			}
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+// VolumeClaimTemplate summarises a statefulset volume claim template
+type VolumeClaimTemplate struct {
+	Name         string
+	Storage      string
+	AccessModes  string
+	StorageClass string
+}
+
+// String formats the template like "data (10Gi, RWO, gp3)"
+func (t VolumeClaimTemplate) String() string {
+	return fmt.Sprintf("%s (%s, %s, %s)", t.Name, t.Storage, t.AccessModes, t.StorageClass)
+}
+
+// newVolumeClaimTemplate summarises a claim template, defaulting an unset class to "<default>"
+func newVolumeClaimTemplate(claim *corev1.PersistentVolumeClaim) VolumeClaimTemplate {
+	template := VolumeClaimTemplate{
+		Name:         claim.Name,
+		AccessModes:  FormatAccessModes(claim.Spec.AccessModes),
+		StorageClass: "<default>",
+	}
+	if storage, ok := claim.Spec.Resources.Requests[corev1.ResourceStorage]; ok {
+		template.Storage = storage.String()
+	}
+	if claim.Spec.StorageClassName != nil {
+		template.StorageClass = *claim.Spec.StorageClassName
+	}
+	return template
+}
@@ -0,0 +1,234 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newTestClaim(phase corev1.PersistentVolumeClaimPhase) *corev1.PersistentVolumeClaim {
+	className := "gp3"
+	claim := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "data-postgres-0",
+			Namespace:         "database",
+			CreationTimestamp: metav1.Time{Time: time.Now().Add(-3 * time.Hour)},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			StorageClassName: &className,
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+			},
+		},
+		Status: corev1.PersistentVolumeClaimStatus{Phase: phase},
+	}
+	if phase == corev1.ClaimBound {
+		claim.Spec.VolumeName = "pvc-1234"
+		claim.Status.AccessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce, corev1.ReadOnlyMany}
+		claim.Status.Capacity = corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("20Gi")}
+	}
+	return claim
+}
+
+func TestNewPersistentVolumeClaimInfo(t *testing.T) {
+	bound := NewPersistentVolumeClaimInfo(newTestClaim(corev1.ClaimBound))
+	if bound.Status != "Bound" || bound.Volume != "pvc-1234" || bound.Capacity != "20Gi" || bound.Requested != "10Gi" {
+		t.Errorf("Unexpected bound claim info %+v", bound)
+	}
+	if bound.AccessModes != "RWO,ROX" || bound.StorageClass != "gp3" || bound.VolumeMode != "Filesystem" || bound.Age != "3h" {
+		t.Errorf("Unexpected bound claim info %+v", bound)
+	}
+
+	pending := NewPersistentVolumeClaimInfo(newTestClaim(""))
+	if pending.Status != "Pending" || pending.Volume != "" || pending.Capacity != "" {
+		t.Errorf("Unexpected pending claim info %+v", pending)
+	}
+	if pending.AccessModes != "RWO" {
+		t.Errorf("Pending claim should show requested access modes, got %q", pending.AccessModes)
+	}
+
+	noClass := newTestClaim(corev1.ClaimPending)
+	noClass.Spec.StorageClassName = nil
+	if got := NewPersistentVolumeClaimInfo(noClass).StorageClass; got != "<none>" {
+		t.Errorf("StorageClass = %q, want <none>", got)
+	}
+}
+
+func TestPersistentVolumeClaimInfo_GetStatusSymbol(t *testing.T) {
+	tests := []struct {
+		phase corev1.PersistentVolumeClaimPhase
+		want  string
+	}{
+		{corev1.ClaimBound, "●"},
+		{corev1.ClaimPending, "◐"},
+		{corev1.ClaimLost, "✖"},
+	}
+	for _, tt := range tests {
+		info := NewPersistentVolumeClaimInfo(newTestClaim(tt.phase))
+		if got := info.GetStatusSymbol(); got != tt.want {
+			t.Errorf("GetStatusSymbol() for %s = %q, want %q", tt.phase, got, tt.want)
+		}
+	}
+}
+
+func TestNewPersistentVolumeInfo(t *testing.T) {
+	volume := &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "pvc-1234",
+			CreationTimestamp: metav1.Time{Time: time.Now().Add(-3 * time.Hour)},
+		},
+		Spec: corev1.PersistentVolumeSpec{
+			Capacity:                      corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("20Gi")},
+			AccessModes:                   []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOncePod},
+			PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimRetain,
+			StorageClassName:              "gp3",
+			ClaimRef:                      &corev1.ObjectReference{Namespace: "database", Name: "data-postgres-0"},
+			PersistentVolumeSource: corev1.PersistentVolumeSource{
+				CSI: &corev1.CSIPersistentVolumeSource{Driver: "ebs.csi.aws.com", VolumeHandle: "vol-1"},
+			},
+		},
+		Status: corev1.PersistentVolumeStatus{Phase: corev1.VolumeBound},
+	}
+
+	got := NewPersistentVolumeInfo(volume)
+	if got.Capacity != "20Gi" || got.AccessModes != "RWOP" || got.ReclaimPolicy != "Retain" || got.Status != "Bound" {
+		t.Errorf("Unexpected volume info %+v", got)
+	}
+	if got.Claim != "database/data-postgres-0" || got.StorageClass != "gp3" || got.Source != "CSI (ebs.csi.aws.com)" {
+		t.Errorf("Unexpected volume info %+v", got)
+	}
+	if got.GetStatusSymbol() != "●" {
+		t.Errorf("GetStatusSymbol() = %q, want ●", got.GetStatusSymbol())
+	}
+
+	volume.Spec.ClaimRef = nil
+	volume.Spec.StorageClassName = ""
+	volume.Status.Phase = corev1.VolumeReleased
+	got = NewPersistentVolumeInfo(volume)
+	if got.Claim != "" || got.StorageClass != "<none>" || got.GetStatusSymbol() != "◑" {
+		t.Errorf("Unexpected released volume info %+v", got)
+	}
+}
+
+func TestNewStorageClassInfo(t *testing.T) {
+	retain := corev1.PersistentVolumeReclaimRetain
+	waitForConsumer := storagev1.VolumeBindingWaitForFirstConsumer
+	expand := true
+	storageClass := &storagev1.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "gp3",
+			Annotations: map[string]string{defaultStorageClassAnnotation: "true"},
+		},
+		Provisioner:          "ebs.csi.aws.com",
+		ReclaimPolicy:        &retain,
+		VolumeBindingMode:    &waitForConsumer,
+		AllowVolumeExpansion: &expand,
+		Parameters:           map[string]string{"type": "gp3", "encrypted": "true"},
+	}
+
+	got := NewStorageClassInfo(storageClass)
+	if got.Provisioner != "ebs.csi.aws.com" || got.ReclaimPolicy != "Retain" || got.VolumeBindingMode != "WaitForFirstConsumer" {
+		t.Errorf("Unexpected storage class info %+v", got)
+	}
+	if !got.IsDefault || !got.AllowVolumeExpansion || got.GetStatusSymbol() != "★" {
+		t.Errorf("Unexpected storage class info %+v", got)
+	}
+	if len(got.Parameters) != 2 || got.Parameters[0] != "encrypted=true" || got.Parameters[1] != "type=gp3" {
+		t.Errorf("Parameters = %v, want sorted key=value pairs", got.Parameters)
+	}
+
+	// API defaults apply when fields are unset
+	got = NewStorageClassInfo(&storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: "standard"}})
+	if got.ReclaimPolicy != "Delete" || got.VolumeBindingMode != "Immediate" || got.IsDefault || got.GetStatusSymbol() != "●" {
+		t.Errorf("Unexpected defaulted storage class info %+v", got)
+	}
+}
+
+func TestClaimPods(t *testing.T) {
+	claim := newTestClaim(corev1.ClaimBound)
+	claim.Name = "data"
+	claimVolume := func(name, claimName string) corev1.Volume {
+		return corev1.Volume{Name: name, VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: claimName},
+		}}
+	}
+	pod := func(name, namespace string, volumes ...corev1.Volume) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       corev1.PodSpec{Volumes: volumes},
+		}
+	}
+	pods := []corev1.Pod{
+		pod("writer", "database", claimVolume("storage", "data")),
+		pod("reader", "database", claimVolume("storage", "data"), claimVolume("other", "data")),
+		pod("unrelated", "database", claimVolume("storage", "logs")),
+		pod("elsewhere", "other", claimVolume("storage", "data")),
+		// Generic ephemeral volume "data" on pod "scratch" creates claim "scratch-data"
+		pod("scratch", "database", corev1.Volume{Name: "data", VolumeSource: corev1.VolumeSource{
+			Ephemeral: &corev1.EphemeralVolumeSource{},
+		}}),
+	}
+
+	got := ClaimPods(claim, pods)
+	if len(got) != 2 || got[0].Name != "reader" || got[1].Name != "writer" {
+		t.Errorf("ClaimPods() = %+v, want reader and writer", got)
+	}
+
+	claim.Name = "scratch-data"
+	got = ClaimPods(claim, pods)
+	if len(got) != 1 || got[0].Name != "scratch" {
+		t.Errorf("ClaimPods() for ephemeral claim = %+v, want scratch", got)
+	}
+
+	claim.Name = "unused"
+	if got := ClaimPods(claim, pods); got == nil || len(got) != 0 {
+		t.Errorf("ClaimPods() for unused claim = %#v, want empty non-nil slice", got)
+	}
+}
+
+func TestFormatAccessModes(t *testing.T) {
+	modes := []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany, "Custom"}
+	if got := FormatAccessModes(modes); got != "RWX,Custom" {
+		t.Errorf("FormatAccessModes() = %q, want RWX,Custom", got)
+	}
+	if got := FormatAccessModes(nil); got != "" {
+		t.Errorf("FormatAccessModes(nil) = %q, want empty", got)
+	}
+}
+
+func TestNewStatefulSetInfo_VolumeClaimTemplates(t *testing.T) {
+	className := "gp3"
+	statefulSet := &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "postgres"}}
+	statefulSet.Spec.VolumeClaimTemplates = []corev1.PersistentVolumeClaim{{
+		ObjectMeta: metav1.ObjectMeta{Name: "data"},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			StorageClassName: &className,
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+			},
+		},
+	}, {
+		ObjectMeta: metav1.ObjectMeta{Name: "wal"},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+			},
+		},
+	}}
+
+	got := NewStatefulSetInfo(statefulSet).VolumeClaimTemplates
+	if len(got) != 2 {
+		t.Fatalf("VolumeClaimTemplates = %+v, want 2", got)
+	}
+	if got[0].String() != "data (10Gi, RWO, gp3)" || got[1].String() != "wal (1Gi, RWO, <default>)" {
+		t.Errorf("VolumeClaimTemplates = %v %v", got[0], got[1])
+	}
+}
@@ -0,0 +1,267 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/williajm/k8s-tui/internal/models"
+	"github.com/williajm/k8s-tui/internal/ui/styles"
+)
+
+// AccessQueryMode selects how an access query is answered
+type AccessQueryMode int
+
+const (
+	// AccessQueryBindings evaluates the cluster's bindings locally, for any subject
+	AccessQueryBindings AccessQueryMode = iota
+	// AccessQueryCanI asks the API server about the current user
+	AccessQueryCanI
+)
+
+// Fields of the access query form, in tab order
+const (
+	accessFieldSubject = iota
+	accessFieldVerb
+	accessFieldResource
+	accessFieldNamespace
+	accessFieldCount
+)
+
+var accessFieldLabels = [accessFieldCount]string{"Subject", "Verb", "Resource", "Namespace"}
+
+// AccessQueryPanel is a form for asking "can subject X do verb Y on resource Z in
+// namespace N", "who can" (blank subject) and "can I" questions
+type AccessQueryPanel struct {
+	mode    AccessQueryMode
+	values  [accessFieldCount]string
+	focused int
+	answer  *models.AccessAnswer
+	err     error
+	running bool
+	width   int
+	height  int
+}
+
+// NewAccessQueryPanel creates a new access query panel
+func NewAccessQueryPanel() *AccessQueryPanel {
+	return &AccessQueryPanel{
+		values: [accessFieldCount]string{accessFieldVerb: "get", accessFieldResource: "pods"},
+	}
+}
+
+// SetSize sets the dimensions
+func (a *AccessQueryPanel) SetSize(width, height int) {
+	a.width = width
+	a.height = height
+}
+
+// Open prepares the form for a new question about the subject in the namespace,
+// keeping the verb and resource of the previous question
+func (a *AccessQueryPanel) Open(subject, namespace string) {
+	a.values[accessFieldSubject] = subject
+	a.values[accessFieldNamespace] = namespace
+	a.answer = nil
+	a.err = nil
+	a.running = false
+	a.focused = accessFieldVerb
+	if subject == "" && a.mode == AccessQueryBindings {
+		a.focused = accessFieldSubject
+	}
+}
+
+// Mode returns how the query will be answered
+func (a *AccessQueryPanel) Mode() AccessQueryMode {
+	return a.mode
+}
+
+// ToggleMode switches between evaluating bindings and asking about the current user
+func (a *AccessQueryPanel) ToggleMode() {
+	if a.mode == AccessQueryBindings {
+		a.mode = AccessQueryCanI
+	} else {
+		a.mode = AccessQueryBindings
+	}
+	a.answer = nil
+	a.err = nil
+	if a.mode == AccessQueryCanI && a.focused == accessFieldSubject {
+		a.focused = accessFieldVerb
+	}
+}
+
+// NextField moves the cursor to the next field
+func (a *AccessQueryPanel) NextField() {
+	a.focused = (a.focused + 1) % accessFieldCount
+	if a.mode == AccessQueryCanI && a.focused == accessFieldSubject {
+		a.focused = accessFieldVerb
+	}
+}
+
+// PrevField moves the cursor to the previous field
+func (a *AccessQueryPanel) PrevField() {
+	a.focused = (a.focused + accessFieldCount - 1) % accessFieldCount
+	if a.mode == AccessQueryCanI && a.focused == accessFieldSubject {
+		a.focused = accessFieldNamespace
+	}
+}
+
+// InsertText appends typed text to the focused field
+func (a *AccessQueryPanel) InsertText(text string) {
+	a.values[a.focused] += text
+}
+
+// DeleteChar removes the last character of the focused field
+func (a *AccessQueryPanel) DeleteChar() {
+	value := []rune(a.values[a.focused])
+	if len(value) > 0 {
+		a.values[a.focused] = string(value[:len(value)-1])
+	}
+}
+
+// Subject returns the typed subject; blank asks who can
+func (a *AccessQueryPanel) Subject() string {
+	return strings.TrimSpace(a.values[accessFieldSubject])
+}
+
+// Verb returns the typed verb
+func (a *AccessQueryPanel) Verb() string {
+	return strings.TrimSpace(a.values[accessFieldVerb])
+}
+
+// Resource returns the typed resource, e.g. "deployments.apps" or "pods/log"
+func (a *AccessQueryPanel) Resource() string {
+	return strings.TrimSpace(a.values[accessFieldResource])
+}
+
+// Namespace returns the typed namespace; blank asks about cluster-wide access
+func (a *AccessQueryPanel) Namespace() string {
+	return strings.TrimSpace(a.values[accessFieldNamespace])
+}
+
+// SetRunning marks the query as being answered
+func (a *AccessQueryPanel) SetRunning() {
+	a.running = true
+	a.answer = nil
+	a.err = nil
+}
+
+// SetAnswer shows the answer to the query
+func (a *AccessQueryPanel) SetAnswer(answer models.AccessAnswer) {
+	a.running = false
+	a.answer = &answer
+	a.err = nil
+}
+
+// SetError shows why the query could not be answered
+func (a *AccessQueryPanel) SetError(err error) {
+	a.running = false
+	a.answer = nil
+	a.err = err
+}
+
+// View renders the panel
+//
+//nolint:gocritic // Multiple appends for clarity
+func (a *AccessQueryPanel) View() string {
+	var lines []string
+
+	lines = append(lines, styles.DetailHeaderStyle.Render("RBAC Access Query"))
+	lines = append(lines, "")
+
+	bindings, canI := "  Bindings (any subject)  ", "  Can I (current user)  "
+	if a.mode == AccessQueryBindings {
+		bindings = styles.SelectedListItemStyle.Render("▸ Bindings (any subject) ")
+	} else {
+		canI = styles.SelectedListItemStyle.Render("▸ Can I (current user) ")
+	}
+	lines = append(lines, styles.RenderDetailRow("Mode", bindings+canI))
+	lines = append(lines, "")
+
+	for field := 0; field < accessFieldCount; field++ {
+		value := a.values[field]
+		if field == a.focused {
+			value += "_"
+		}
+		switch {
+		case field == accessFieldSubject && a.mode == AccessQueryCanI:
+			value = "<current user>"
+		case field == accessFieldSubject && a.values[field] == "":
+			value = strings.TrimSpace(value + " <blank: who can>")
+		case field == accessFieldNamespace && a.values[field] == "":
+			value = strings.TrimSpace(value + " <cluster-wide>")
+		}
+		label := accessFieldLabels[field]
+		if field == a.focused {
+			label = "▸ " + label
+		}
+		lines = append(lines, styles.RenderDetailRow(label, value))
+	}
+	lines = append(lines, "")
+	if a.mode == AccessQueryBindings {
+		lines = append(lines, "  Subjects: user:NAME, group:NAME or sa:NAMESPACE/NAME. Resources: pods, deployments.apps, pods/log")
+	} else {
+		lines = append(lines, "  Resources: pods, deployments.apps, pods/log")
+	}
+	lines = append(lines, "")
+
+	lines = append(lines, a.answerLines()...)
+
+	// Keep the key help visible when there are many grants
+	maxLines := a.height - 4
+	if maxLines > 3 && len(lines) > maxLines-2 {
+		hidden := len(lines) - (maxLines - 3)
+		lines = append(lines[:maxLines-3], fmt.Sprintf("  ... %d more", hidden))
+	}
+
+	lines = append(lines, "")
+	lines = append(lines,
+		styles.RenderKeyHelp("[Tab/↑↓]", "Next field")+"  "+
+			styles.RenderKeyHelp("[Ctrl+T]", "Switch mode")+"  "+
+			styles.RenderKeyHelp("[Enter]", "Check")+"  "+
+			styles.RenderKeyHelp("[Esc]", "Close"))
+
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+
+	return styles.BorderStyle.
+		Width(a.width).
+		Height(a.height).
+		Render(content)
+}
+
+// answerLines renders the answer, or the progress or error of the query
+func (a *AccessQueryPanel) answerLines() []string {
+	switch {
+	case a.running:
+		return []string{"  Checking..."}
+	case a.err != nil:
+		return []string{styles.ErrorStyle.Render("  Error: " + a.err.Error())}
+	case a.answer == nil:
+		return nil
+	}
+
+	answer := a.answer
+	var lines []string
+	lines = append(lines, styles.DetailHeaderStyle.Render(answer.Question))
+	lines = append(lines, "")
+
+	switch {
+	case answer.WhoCan && !answer.Allowed:
+		lines = append(lines, styles.StatusErrorStyle.Render("  ✖ No subject is granted this"))
+	case answer.WhoCan:
+		lines = append(lines, fmt.Sprintf("  %d grants:", len(answer.Grants)))
+	case answer.Allowed:
+		lines = append(lines, styles.StatusRunningStyle.Render("  ✔ yes"))
+	default:
+		lines = append(lines, styles.StatusErrorStyle.Render("  ✖ no"))
+	}
+	if answer.Reason != "" {
+		lines = append(lines, "  "+answer.Reason)
+	}
+	for _, grant := range answer.Grants {
+		lines = append(lines, "  "+grant.String())
+	}
+	if answer.Local && !answer.Allowed {
+		lines = append(lines, "", "  Evaluated from bindings: rules limited to resourceNames and group memberships of users are not considered")
+	}
+	return lines
+}
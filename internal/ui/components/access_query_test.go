@@ -0,0 +1,127 @@
+package components
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/williajm/k8s-tui/internal/models"
+)
+
+func TestAccessQueryPanel_Editing(t *testing.T) {
+	panel := NewAccessQueryPanel()
+	panel.SetSize(120, 30)
+	panel.Open("", "shop")
+
+	if panel.Verb() != "get" || panel.Resource() != "pods" || panel.Namespace() != "shop" {
+		t.Fatalf("Unexpected defaults %q %q %q", panel.Verb(), panel.Resource(), panel.Namespace())
+	}
+
+	// A blank subject focuses the subject field
+	panel.InsertText("user:alicex")
+	panel.DeleteChar()
+	if panel.Subject() != "user:alice" {
+		t.Errorf("Subject() = %q, want user:alice", panel.Subject())
+	}
+
+	panel.NextField()
+	panel.DeleteChar()
+	panel.DeleteChar()
+	panel.DeleteChar()
+	panel.InsertText("list")
+	panel.PrevField()
+	panel.PrevField()
+	panel.InsertText("-")
+	if panel.Verb() != "list" || panel.Namespace() != "shop-" {
+		t.Errorf("Verb() = %q, Namespace() = %q", panel.Verb(), panel.Namespace())
+	}
+}
+
+func TestAccessQueryPanel_CanIModeSkipsSubject(t *testing.T) {
+	panel := NewAccessQueryPanel()
+	panel.Open("sa:shop/web", "shop")
+
+	panel.ToggleMode()
+	if panel.Mode() != AccessQueryCanI {
+		t.Fatal("Expected can-i mode")
+	}
+	for i := 0; i < accessFieldCount*2; i++ {
+		panel.NextField()
+		if panel.focused == accessFieldSubject {
+			t.Fatal("The subject field should be skipped in can-i mode")
+		}
+	}
+	panel.SetSize(120, 30)
+	if view := panel.View(); !strings.Contains(view, "<current user>") {
+		t.Error("Expected the subject to show the current user")
+	}
+
+	panel.ToggleMode()
+	if panel.Mode() != AccessQueryBindings {
+		t.Error("Expected bindings mode after toggling twice")
+	}
+}
+
+func TestAccessQueryPanel_View(t *testing.T) {
+	panel := NewAccessQueryPanel()
+	panel.SetSize(140, 30)
+	panel.Open("", "shop")
+
+	view := panel.View()
+	for _, expected := range []string{"RBAC Access Query", "Bindings (any subject)", "<blank: who can>", "pods"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected view to contain %q", expected)
+		}
+	}
+
+	panel.SetRunning()
+	if !strings.Contains(panel.View(), "Checking...") {
+		t.Error("Expected a progress message while running")
+	}
+
+	panel.SetAnswer(models.AccessAnswer{
+		Question: "Who can list pods in shop?",
+		Allowed:  true,
+		WhoCan:   true,
+		Local:    true,
+		Grants: []models.AccessGrant{{
+			Subject: models.Subject{Kind: "User", Name: "alice"},
+			Binding: "ClusterRoleBinding admins",
+			Role:    "ClusterRole/cluster-admin",
+		}},
+	})
+	view = panel.View()
+	for _, expected := range []string{"Who can list pods in shop?", "1 grants", "User alice via ClusterRoleBinding admins"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected view to contain %q", expected)
+		}
+	}
+
+	panel.SetAnswer(models.AccessAnswer{Question: "Can I delete nodes cluster-wide?", Reason: "no RBAC policy matched"})
+	view = panel.View()
+	if !strings.Contains(view, "✖ no") || !strings.Contains(view, "no RBAC policy matched") {
+		t.Error("Expected a denied answer with the API server's reason")
+	}
+
+	panel.SetError(errors.New("invalid subject"))
+	if !strings.Contains(panel.View(), "Error: invalid subject") {
+		t.Error("Expected the query error")
+	}
+}
+
+func TestAccessQueryPanel_TruncatesGrants(t *testing.T) {
+	panel := NewAccessQueryPanel()
+	panel.SetSize(140, 20)
+	panel.Open("", "")
+
+	grants := make([]models.AccessGrant, 30)
+	for i := range grants {
+		grants[i] = models.AccessGrant{Subject: models.Subject{Kind: "Group", Name: "team"}, Binding: "ClusterRoleBinding b", Role: "ClusterRole/r"}
+	}
+	panel.SetAnswer(models.AccessAnswer{Question: "Who can get pods cluster-wide?", Allowed: true, WhoCan: true, Grants: grants})
+
+	view := panel.View()
+	if !strings.Contains(view, "more") || !strings.Contains(view, "Close") {
+		t.Error("Expected long answers to be truncated with the key help still visible")
+	}
+}
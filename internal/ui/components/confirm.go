@@ -0,0 +1,67 @@
+package components
+
+import (
+	"github.com/charmbracelet/lipgloss"
+	"github.com/williajm/k8s-tui/internal/ui/styles"
+)
+
+// ConfirmDialog asks the user to confirm an action before it is carried out
+type ConfirmDialog struct {
+	title   string
+	message string
+	width   int
+	visible bool
+}
+
+// NewConfirmDialog creates a new, hidden confirmation dialog
+func NewConfirmDialog() *ConfirmDialog {
+	return &ConfirmDialog{
+		width: 50,
+	}
+}
+
+// SetWidth sets the width of the dialog
+func (c *ConfirmDialog) SetWidth(width int) {
+	c.width = width
+}
+
+// Show shows the dialog with the given title and question
+func (c *ConfirmDialog) Show(title, message string) {
+	c.title = title
+	c.message = message
+	c.visible = true
+}
+
+// Hide hides the dialog
+func (c *ConfirmDialog) Hide() {
+	c.visible = false
+}
+
+// IsVisible returns whether the dialog is visible
+func (c *ConfirmDialog) IsVisible() bool {
+	return c.visible
+}
+
+// View renders the dialog
+func (c *ConfirmDialog) View() string {
+	if !c.visible {
+		return ""
+	}
+
+	title := styles.DetailHeaderStyle.Render(c.title)
+	message := lipgloss.NewStyle().Width(c.width - 4).Render(c.message)
+	helpText := styles.RenderKeyHelp("[y]", "Yes") + "  " + styles.RenderKeyHelp("[n/Esc]", "No")
+
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		title,
+		"",
+		message,
+		"",
+		helpText,
+	)
+
+	return styles.BorderStyle.
+		Width(c.width).
+		Render(content)
+}
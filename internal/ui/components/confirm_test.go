@@ -0,0 +1,44 @@
+package components
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestConfirmDialog_ShowHide(t *testing.T) {
+	c := NewConfirmDialog()
+	c.SetWidth(80)
+
+	if c.IsVisible() {
+		t.Error("NewConfirmDialog() should be hidden")
+	}
+	if c.View() != "" {
+		t.Error("View() should be empty while hidden")
+	}
+
+	c.Show("Reveal Secret", "Reveal key password of secret default/db-credentials?")
+	if !c.IsVisible() {
+		t.Error("Show() should make the dialog visible")
+	}
+
+	view := c.View()
+	for _, want := range []string{"Reveal Secret", "db-credentials", "[y]", "Yes", "No"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("View() missing %q", want)
+		}
+	}
+
+	c.Hide()
+	if c.IsVisible() {
+		t.Error("Hide() should hide the dialog")
+	}
+}
+
+func TestConfirmDialog_SetWidth(t *testing.T) {
+	c := NewConfirmDialog()
+	c.SetWidth(70)
+
+	if c.width != 70 {
+		t.Errorf("SetWidth(70) resulted in width = %d, want 70", c.width)
+	}
+}
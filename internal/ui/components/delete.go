@@ -0,0 +1,209 @@
+package components
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/williajm/k8s-tui/internal/ui/styles"
+)
+
+// deletePropagationPolicies are the propagation policies offered by the delete dialog,
+// with what each does to the dependents of the object. The first sends no policy, so
+// the API server applies the default of the kind, like kubectl delete.
+var deletePropagationPolicies = []struct {
+	name        string
+	description string
+}{
+	{"", "the API server's default for the kind"},
+	{"Background", "dependents are deleted after the object"},
+	{"Foreground", "the object is deleted after its dependents"},
+	{"Orphan", "dependents are kept"},
+}
+
+// deleteGracePeriods are the grace periods offered by the delete dialog, in seconds.
+// -1 keeps the object's own grace period.
+var deleteGracePeriods = []int64{-1, 0, 5, 30, 60}
+
+// deleteState is the progress of the delete dialog
+type deleteState int
+
+const (
+	deleteStateDryRun   deleteState = iota // Server-side dry run in progress
+	deleteStateReady                       // Dry run passed; the delete can be confirmed
+	deleteStateFailed                      // Dry run failed
+	deleteStateDeleting                    // Delete in progress
+	deleteStateRetry                       // Delete failed after its dry run passed; it can be retried
+)
+
+// DeleteDialog confirms the delete of an object, after a server-side dry run of the
+// delete with the chosen propagation policy and grace period
+type DeleteDialog struct {
+	kind        string
+	namespace   string
+	name        string
+	propagation int
+	gracePeriod int
+	state       deleteState
+	result      string
+	width       int
+	visible     bool
+}
+
+// NewDeleteDialog creates a new, hidden delete dialog
+func NewDeleteDialog() *DeleteDialog {
+	return &DeleteDialog{
+		width: 60,
+	}
+}
+
+// SetWidth sets the width of the dialog
+func (d *DeleteDialog) SetWidth(width int) {
+	d.width = width
+}
+
+// Show shows the dialog for an object, with the default options, while the dry run
+// of its delete runs
+func (d *DeleteDialog) Show(kind, namespace, name string) {
+	d.kind = kind
+	d.namespace = namespace
+	d.name = name
+	d.propagation = 0
+	d.gracePeriod = 0
+	d.SetDryRunning()
+	d.visible = true
+}
+
+// Hide hides the dialog
+func (d *DeleteDialog) Hide() {
+	d.visible = false
+}
+
+// IsVisible returns whether the dialog is visible
+func (d *DeleteDialog) IsVisible() bool {
+	return d.visible
+}
+
+// NextPropagationPolicy selects the next propagation policy
+func (d *DeleteDialog) NextPropagationPolicy() {
+	d.propagation = (d.propagation + 1) % len(deletePropagationPolicies)
+}
+
+// NextGracePeriod selects the next grace period
+func (d *DeleteDialog) NextGracePeriod() {
+	d.gracePeriod = (d.gracePeriod + 1) % len(deleteGracePeriods)
+}
+
+// PropagationPolicy returns the selected propagation policy, or "" for the server default
+func (d *DeleteDialog) PropagationPolicy() string {
+	return deletePropagationPolicies[d.propagation].name
+}
+
+// GracePeriod returns the selected grace period in seconds, and false if the
+// object's own grace period is kept
+func (d *DeleteDialog) GracePeriod() (int64, bool) {
+	seconds := deleteGracePeriods[d.gracePeriod]
+	return seconds, seconds >= 0
+}
+
+// SetDryRunning marks the dry run of the delete as in progress
+func (d *DeleteDialog) SetDryRunning() {
+	d.state = deleteStateDryRun
+	d.result = ""
+}
+
+// SetDryRunResult records the outcome of the dry run
+func (d *DeleteDialog) SetDryRunResult(err error) {
+	if err != nil {
+		d.state = deleteStateFailed
+		d.result = "Dry run failed: " + err.Error()
+		return
+	}
+	d.state = deleteStateReady
+	d.result = "Dry run passed: the API server accepted the delete"
+}
+
+// SetDeleting marks the delete as in progress
+func (d *DeleteDialog) SetDeleting() {
+	d.state = deleteStateDeleting
+}
+
+// SetDeleteFailed records a failed delete. The dry run passed with the same options,
+// so the delete can be retried, e.g. after a transient error.
+func (d *DeleteDialog) SetDeleteFailed(err error) {
+	d.state = deleteStateRetry
+	d.result = "Delete failed: " + err.Error()
+}
+
+// IsDeleting reports whether the delete is in progress
+func (d *DeleteDialog) IsDeleting() bool {
+	return d.state == deleteStateDeleting
+}
+
+// CanConfirm reports whether the dry run passed with the selected options, so the
+// delete can be carried out or retried
+func (d *DeleteDialog) CanConfirm() bool {
+	return d.state == deleteStateReady || d.state == deleteStateRetry
+}
+
+// View renders the dialog
+func (d *DeleteDialog) View() string {
+	if !d.visible {
+		return ""
+	}
+
+	target := d.name
+	if d.namespace != "" {
+		target = d.namespace + "/" + d.name
+	}
+	policy := deletePropagationPolicies[d.propagation]
+	policyName := policy.name
+	if policyName == "" {
+		policyName = "Default"
+	}
+	grace := "object default"
+	if seconds, ok := d.GracePeriod(); ok {
+		grace = fmt.Sprintf("%ds", seconds)
+		if seconds == 0 {
+			grace = "0s (immediate)"
+		}
+	}
+
+	var status string
+	switch d.state {
+	case deleteStateDryRun:
+		status = styles.StatusPendingStyle.Render("Running server-side dry run...")
+	case deleteStateReady:
+		status = styles.StatusRunningStyle.Render("✔ " + d.result)
+	case deleteStateFailed, deleteStateRetry:
+		status = styles.StatusErrorStyle.Render("✖ " + d.result)
+	case deleteStateDeleting:
+		status = styles.StatusPendingStyle.Render("Deleting...")
+	}
+
+	helpText := styles.RenderKeyHelp("[p]", "Propagation") + "  " + styles.RenderKeyHelp("[g]", "Grace period")
+	switch d.state {
+	case deleteStateReady:
+		helpText += "  " + styles.RenderKeyHelp("[y]", "Delete")
+	case deleteStateRetry:
+		helpText += "  " + styles.RenderKeyHelp("[y]", "Retry delete")
+	}
+	helpText += "  " + styles.RenderKeyHelp("[n/Esc]", "Cancel")
+
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		styles.DetailHeaderStyle.Render("Delete "+d.kind),
+		"",
+		styles.RenderDetailRow("Kind", d.kind),
+		styles.RenderDetailRow("Object", target),
+		styles.RenderDetailRow("Propagation", policyName+" ("+policy.description+")"),
+		styles.RenderDetailRow("Grace Period", grace),
+		"",
+		lipgloss.NewStyle().Width(d.width-4).Render(status),
+		"",
+		helpText,
+	)
+
+	return styles.BorderStyle.
+		Width(d.width).
+		Render(content)
+}
@@ -0,0 +1,109 @@
+package components
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestDeleteDialog_ShowHide(t *testing.T) {
+	d := NewDeleteDialog()
+	d.SetWidth(100)
+
+	if d.IsVisible() || d.View() != "" {
+		t.Error("NewDeleteDialog() should be hidden")
+	}
+
+	d.Show("Deployment", "shop", "web")
+	if !d.IsVisible() || d.CanConfirm() {
+		t.Error("Show() should show the dialog without allowing a delete before the dry run")
+	}
+	view := d.View()
+	for _, want := range []string{"Delete Deployment", "shop/web", "Default (the API server's default", "object default", "Running server-side dry run"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("View() missing %q", want)
+		}
+	}
+	if strings.Contains(view, "[y]") {
+		t.Error("View() should not offer the delete before the dry run passes")
+	}
+
+	d.Hide()
+	if d.IsVisible() {
+		t.Error("Hide() should hide the dialog")
+	}
+}
+
+func TestDeleteDialog_Options(t *testing.T) {
+	d := NewDeleteDialog()
+	d.Show("Pod", "default", "web-1")
+
+	if d.PropagationPolicy() != "" {
+		t.Errorf("Expected no propagation policy by default, got %s", d.PropagationPolicy())
+	}
+	if _, ok := d.GracePeriod(); ok {
+		t.Error("Expected the object's grace period by default")
+	}
+
+	d.NextPropagationPolicy()
+	if d.PropagationPolicy() != "Background" {
+		t.Errorf("Expected Background propagation, got %s", d.PropagationPolicy())
+	}
+	d.NextPropagationPolicy()
+	d.NextPropagationPolicy()
+	if d.PropagationPolicy() != "Orphan" {
+		t.Errorf("Expected Orphan propagation, got %s", d.PropagationPolicy())
+	}
+	d.NextPropagationPolicy()
+	if d.PropagationPolicy() != "" {
+		t.Errorf("Expected propagation to wrap around to the default, got %s", d.PropagationPolicy())
+	}
+
+	d.NextGracePeriod()
+	if seconds, ok := d.GracePeriod(); !ok || seconds != 0 {
+		t.Errorf("Expected a grace period of 0, got %d (%v)", seconds, ok)
+	}
+	if !strings.Contains(d.View(), "0s (immediate)") {
+		t.Error("View() should show the immediate grace period")
+	}
+
+	// Showing the dialog again resets the options
+	d.Show("Pod", "default", "web-2")
+	if _, ok := d.GracePeriod(); ok || d.PropagationPolicy() != "" {
+		t.Error("Show() should reset the options")
+	}
+}
+
+func TestDeleteDialog_DryRun(t *testing.T) {
+	d := NewDeleteDialog()
+	d.SetWidth(100)
+	d.Show("Node", "", "worker-1")
+
+	d.SetDryRunResult(errors.New("nodes \"worker-1\" is forbidden"))
+	if d.CanConfirm() || !strings.Contains(d.View(), "Dry run failed") {
+		t.Error("A failed dry run should block the delete")
+	}
+
+	d.SetDryRunning()
+	d.SetDryRunResult(nil)
+	view := d.View()
+	if !d.CanConfirm() || !strings.Contains(view, "Dry run passed") || !strings.Contains(view, "[y]") {
+		t.Error("A passed dry run should allow the delete")
+	}
+	if strings.Contains(view, "/worker-1") {
+		t.Error("Cluster-scoped objects should be shown without a namespace")
+	}
+
+	d.SetDeleting()
+	if d.CanConfirm() || !d.IsDeleting() {
+		t.Error("The delete should not be confirmed twice")
+	}
+	d.SetDeleteFailed(errors.New("conflict"))
+	view = d.View()
+	if !strings.Contains(view, "Delete failed: conflict") {
+		t.Error("View() should show the delete error")
+	}
+	if !d.CanConfirm() || !strings.Contains(view, "Retry delete") {
+		t.Error("A failed delete should be retryable")
+	}
+}
@@ -20,6 +20,7 @@ type DescribeViewer struct {
 	height    int
 	yamlCache string
 	jsonCache string
+	editable  bool // Whether the YAML can be edited in $EDITOR
 }
 
 // NewDescribeViewer creates a new describe viewer component
@@ -72,6 +73,16 @@ func (d *DescribeViewer) SetFormat(format models.DescribeFormat) {
 	d.updateViewportContent()
 }
 
+// Format returns the display format
+func (d *DescribeViewer) Format() models.DescribeFormat {
+	return d.format
+}
+
+// SetEditable sets whether the resource can be edited from the YAML format
+func (d *DescribeViewer) SetEditable(editable bool) {
+	d.editable = editable
+}
+
 // CycleFormat cycles through available formats
 func (d *DescribeViewer) CycleFormat() {
 	switch d.format {
@@ -152,9 +163,14 @@ func (d *DescribeViewer) renderFooter() string {
 		styles.RenderKeyHelp("[d]", "Describe"),
 		styles.RenderKeyHelp("[y]", "YAML"),
 		styles.RenderKeyHelp("[j]", "JSON"),
+	}
+	if d.editable && d.format == models.FormatYAML {
+		shortcuts = append(shortcuts, styles.RenderKeyHelp("[e]", "Edit in $EDITOR"))
+	}
+	shortcuts = append(shortcuts,
 		styles.RenderKeyHelp("[q/Esc]", "Back"),
 		styles.RenderKeyHelp("[Ctrl+C]", "Quit"),
-	}
+	)
 	shortcutsLine := strings.Join(shortcuts, "  ")
 
 	// Combine format and shortcuts
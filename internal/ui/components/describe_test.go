@@ -407,6 +407,26 @@ func TestDescribeViewer_RenderFooter(t *testing.T) {
 	}
 }
 
+func TestDescribeViewer_EditHint(t *testing.T) {
+	dv := NewDescribeViewer()
+	dv.SetSize(120, 30)
+
+	dv.SetEditable(true)
+	if strings.Contains(dv.renderFooter(), "[e]") {
+		t.Error("renderFooter() should only offer editing in the YAML format")
+	}
+
+	dv.SetFormat(models.FormatYAML)
+	if dv.Format() != models.FormatYAML || !strings.Contains(dv.renderFooter(), "Edit in $EDITOR") {
+		t.Error("renderFooter() should offer editing in the YAML format")
+	}
+
+	dv.SetEditable(false)
+	if strings.Contains(dv.renderFooter(), "[e]") {
+		t.Error("renderFooter() should not offer editing read-only resources")
+	}
+}
+
 func TestDescribeViewer_CompleteWorkflow(t *testing.T) {
 	// Test a complete workflow
 	dv := NewDescribeViewer()
@@ -1,8 +1,13 @@
 package components
 
 import (
+	"fmt"
 	"strings"
 	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/williajm/k8s-tui/internal/models"
 )
@@ -238,7 +243,7 @@ func TestDetailView_ViewService(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			d := NewDetailView()
-			view := d.ViewService(tt.service)
+			view := d.ViewService(tt.service, nil, 0)
 
 			if view == "" {
 				t.Fatal("ViewService returned empty string")
@@ -270,7 +275,7 @@ func TestDetailView_ViewServiceWithMultipleSelectors(t *testing.T) {
 	}
 
 	d := NewDetailView()
-	view := d.ViewService(service)
+	view := d.ViewService(service, nil, 0)
 
 	// Check all selectors are displayed
 	expectedKeys := []string{"app", "version", "tier"}
@@ -281,6 +286,39 @@ func TestDetailView_ViewServiceWithMultipleSelectors(t *testing.T) {
 	}
 }
 
+func TestDetailView_ViewServiceEndpoints(t *testing.T) {
+	service := &models.ServiceInfo{Name: "web", Namespace: "default", Selector: map[string]string{"app": "web"}}
+	d := NewDetailView()
+	d.SetSize(160, 40)
+
+	if view := d.ViewService(service, nil, 0); !strings.Contains(view, "Loading...") {
+		t.Error("Expected endpoints to show as loading")
+	}
+	if view := d.ViewService(service, []models.EndpointInfo{}, 0); !strings.Contains(view, "Service has no endpoints") ||
+		!strings.Contains(view, "no pods match the selector") {
+		t.Error("Expected the view to flag a service without endpoints")
+	}
+	if view := d.ViewService(&models.ServiceInfo{Name: "external"}, []models.EndpointInfo{}, 0); !strings.Contains(view, "no selector") {
+		t.Error("Expected the view to explain that a selectorless service has manual endpoints")
+	}
+
+	endpoints := []models.EndpointInfo{
+		{Address: "10.0.0.1", Pod: "web-1", Node: "node-1", Ports: "8080/TCP", Ready: true, Serving: true},
+		{Address: "10.0.0.2", Pod: "web-2", Ports: "8080/TCP", Terminating: true},
+	}
+	view := d.ViewService(service, endpoints, 1)
+	for _, expected := range []string{"1 ready, 1 terminating, 0 not ready", "10.0.0.1", "web-1", "node-1", "Ready", "Terminating", "▸ ⊗", "Go to pod"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected view to contain %q", expected)
+		}
+	}
+
+	endpoints[0].Ready = false
+	if view := d.ViewService(service, endpoints, 0); !strings.Contains(view, "No ready endpoints") {
+		t.Error("Expected the view to flag a service without ready endpoints")
+	}
+}
+
 func TestDetailView_ViewDeployment(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -329,7 +367,7 @@ func TestDetailView_ViewDeployment(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			d := NewDetailView()
-			view := d.ViewDeployment(tt.deployment)
+			view := d.ViewDeployment(tt.deployment, nil, nil, nil)
 
 			if view == "" {
 				t.Fatal("ViewDeployment returned empty string")
@@ -388,7 +426,7 @@ func TestDetailView_ViewStatefulSet(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			d := NewDetailView()
-			view := d.ViewStatefulSet(tt.statefulSet)
+			view := d.ViewStatefulSet(tt.statefulSet, nil, nil, nil)
 
 			if view == "" {
 				t.Fatal("ViewStatefulSet returned empty string")
@@ -462,9 +500,9 @@ func TestDetailView_AllResourceTypes(t *testing.T) {
 	// Test all view methods
 	views := map[string]string{
 		"pod":         d.ViewPod(pod),
-		"service":     d.ViewService(service),
-		"deployment":  d.ViewDeployment(deployment),
-		"statefulset": d.ViewStatefulSet(statefulSet),
+		"service":     d.ViewService(service, nil, 0),
+		"deployment":  d.ViewDeployment(deployment, nil, nil, nil),
+		"statefulset": d.ViewStatefulSet(statefulSet, nil, nil, nil),
 	}
 
 	for resourceType, view := range views {
@@ -477,3 +515,742 @@ func TestDetailView_AllResourceTypes(t *testing.T) {
 		}
 	}
 }
+
+func TestDetailView_ViewGeneric(t *testing.T) {
+	tests := []struct {
+		name            string
+		obj             *models.GenericResourceInfo
+		expectedStrings []string
+		missingStrings  []string
+	}{
+		{
+			name: "namespaced custom resource",
+			obj: &models.GenericResourceInfo{
+				Name:       "web-tls",
+				Namespace:  "shop",
+				Kind:       "Certificate",
+				APIVersion: "cert-manager.io/v1",
+				Status:     "Ready",
+				Age:        "3d",
+				Labels:     map[string]string{"app": "web"},
+			},
+			expectedStrings: []string{
+				"Certificate Details",
+				"web-tls",
+				"Namespace",
+				"shop",
+				"cert-manager.io/v1",
+				"Ready",
+				"3d",
+				"Labels",
+				"app",
+			},
+		},
+		{
+			name: "cluster-scoped resource without status",
+			obj: &models.GenericResourceInfo{
+				Name:       "letsencrypt",
+				Kind:       "ClusterIssuer",
+				APIVersion: "cert-manager.io/v1",
+				Age:        "10d",
+			},
+			expectedStrings: []string{"ClusterIssuer Details", "letsencrypt"},
+			missingStrings:  []string{"Namespace", "Status", "Labels"},
+		},
+		{
+			name:            "nil resource",
+			obj:             nil,
+			expectedStrings: []string{"No resource selected"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDetailView()
+			d.SetSize(100, 30)
+			view := d.ViewGeneric(tt.obj)
+
+			for _, expected := range tt.expectedStrings {
+				if !strings.Contains(view, expected) {
+					t.Errorf("expected view to contain %q, but it didn't", expected)
+				}
+			}
+			for _, missing := range tt.missingStrings {
+				if strings.Contains(view, missing) {
+					t.Errorf("expected view not to contain %q", missing)
+				}
+			}
+		})
+	}
+}
+
+func TestDetailView_ViewConfigMap(t *testing.T) {
+	configMap := &models.ConfigMapInfo{
+		Name:      "app-config",
+		Namespace: "default",
+		DataCount: 3,
+		Age:       "2h",
+		Keys: []models.ConfigKey{
+			{Name: "LOG_LEVEL", Value: "info", Size: 4},
+			{Name: "logo.png", Binary: true, Size: 2048},
+			{Name: "settings.yaml", Value: "debug: true\nport: 8080\n", Size: 23},
+		},
+	}
+
+	d := NewDetailView()
+	d.SetSize(100, 30)
+	view := d.ViewConfigMap(configMap, 2)
+
+	for _, expected := range []string{
+		"ConfigMap Details",
+		"app-config",
+		"LOG_LEVEL",
+		`"info"`,
+		"binary, 2.0 KiB",
+		"▸ settings.yaml",
+		`"debug: true..."`,
+		"View value",
+	} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("expected view to contain %q, but it didn't", expected)
+		}
+	}
+	if strings.Contains(view, "port: 8080") {
+		t.Error("expected multi-line values to be truncated to their first line")
+	}
+
+	empty := d.ViewConfigMap(&models.ConfigMapInfo{Name: "empty"}, 0)
+	if !strings.Contains(empty, "<none>") || strings.Contains(empty, "View value") {
+		t.Error("expected an empty configmap to show no keys")
+	}
+
+	if !strings.Contains(d.ViewConfigMap(nil, 0), "No configmap selected") {
+		t.Error("expected empty view for nil configmap")
+	}
+}
+
+func TestDetailView_ViewSecret(t *testing.T) {
+	secret := &models.SecretInfo{
+		Name:      "shop-tls",
+		Namespace: "default",
+		Type:      "kubernetes.io/tls",
+		DataCount: 2,
+		Age:       "30d",
+		Keys: []models.ConfigKey{
+			{Name: "tls.crt", Size: 1200},
+			{Name: "tls.key", Size: 240},
+		},
+		Certificates: []models.CertificateInfo{{
+			Subject:  "CN=shop.example.com",
+			Issuer:   "CN=Example CA",
+			DNSNames: []string{"shop.example.com"},
+			NotAfter: time.Now().Add(60 * 24 * time.Hour),
+		}},
+		Registries: []models.RegistryAuth{{Host: "ghcr.io", Username: "bot"}},
+	}
+
+	d := NewDetailView()
+	d.SetSize(100, 40)
+	view := d.ViewSecret(secret, 1)
+
+	for _, expected := range []string{
+		"Secret Details",
+		"kubernetes.io/tls",
+		"tls.crt",
+		"▸ tls.key",
+		"••••••",
+		"240 B",
+		"Reveal",
+		"CN=shop.example.com",
+		"in 59d",
+		"ghcr.io",
+	} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("expected view to contain %q, but it didn't", expected)
+		}
+	}
+
+	if !strings.Contains(d.ViewSecret(nil, 0), "No secret selected") {
+		t.Error("expected empty view for nil secret")
+	}
+}
+
+func TestDetailView_ViewNode(t *testing.T) {
+	node := &models.NodeInfo{
+		Name:           "worker-1",
+		Status:         "Ready,SchedulingDisabled",
+		Roles:          "worker",
+		KubeletVersion: "v1.31.2",
+		Age:            "10d",
+		Unschedulable:  true,
+		InternalIP:     "10.0.0.11",
+		Conditions:     []models.NodeCondition{{Type: "Ready", Status: "True", Reason: "KubeletReady", Age: "2d"}},
+		Taints:         []string{"dedicated=ingress:NoSchedule"},
+		Resources:      []models.NodeResource{{Name: "cpu", Capacity: "4", Allocatable: "3800m"}},
+	}
+
+	d := NewDetailView()
+	d.SetSize(100, 50)
+
+	view := d.ViewNode(node, nil)
+	for _, expected := range []string{"Node Details", "worker-1", "Cordoned", "Yes", "KubeletReady", "dedicated=ingress:NoSchedule", "3800m", "Loading..."} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("expected view to contain %q, but it didn't", expected)
+		}
+	}
+
+	allocation := &models.NodeAllocation{
+		PodCount:  7,
+		Resources: []models.AllocatedResource{{Name: "cpu", Requests: "950m", RequestsPercent: 25, Limits: "5", LimitsPercent: 131}},
+	}
+	view = d.ViewNode(node, allocation)
+	for _, expected := range []string{"Non-terminated pods: 7", "950m (25%)", "5 (131%)"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("expected view to contain %q, but it didn't", expected)
+		}
+	}
+
+	if !strings.Contains(d.ViewNode(nil, nil), "No node selected") {
+		t.Error("expected empty view for nil node")
+	}
+}
+
+func TestDetailView_ViewJob(t *testing.T) {
+	job := &models.JobInfo{
+		Name:        "nightly-report-29012345",
+		Namespace:   "batch",
+		Status:      "Failed",
+		Completions: "0/1",
+		Duration:    "12m",
+		Age:         "1h",
+		Failed:      6,
+		Owner:       "nightly-report",
+	}
+
+	d := NewDetailView()
+	d.SetSize(100, 40)
+
+	view := d.ViewJob(job)
+	for _, expected := range []string{"Job Details", "nightly-report-29012345", "Failed", "0/1", "12m", "nightly-report", "6"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("expected view to contain %q, but it didn't", expected)
+		}
+	}
+
+	if !strings.Contains(d.ViewJob(nil), "No job selected") {
+		t.Error("expected empty view for nil job")
+	}
+}
+
+func TestDetailView_ViewCronJob(t *testing.T) {
+	cronJob := &models.CronJobInfo{
+		Name:               "nightly-report",
+		Namespace:          "batch",
+		Schedule:           "0 2 * * *",
+		Suspend:            true,
+		LastSchedule:       "22h",
+		LastSuccessfulTime: "22h",
+		Age:                "30d",
+	}
+
+	d := NewDetailView()
+	d.SetSize(120, 40)
+
+	view := d.ViewCronJob(cronJob, nil)
+	for _, expected := range []string{"CronJob Details", "0 2 * * *", "Suspended", "Yes", "22h", "Loading..."} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("expected view to contain %q, but it didn't", expected)
+		}
+	}
+
+	if view = d.ViewCronJob(cronJob, []models.JobInfo{}); !strings.Contains(view, "<none>") {
+		t.Error("expected <none> for a cronjob without jobs")
+	}
+
+	jobs := []models.JobInfo{
+		{Name: "nightly-report-2", Status: "Running", Completions: "0/1", Duration: "3m", Age: "3m"},
+		{Name: "nightly-report-1", Status: "Complete", Completions: "1/1", Duration: "4m", Age: "1d"},
+	}
+	view = d.ViewCronJob(cronJob, jobs)
+	for _, expected := range []string{"COMPLETIONS", "nightly-report-2", "Running", "nightly-report-1", "Complete"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("expected view to contain %q, but it didn't", expected)
+		}
+	}
+	if strings.Contains(view, "Loading...") {
+		t.Error("expected loaded history to replace the loading placeholder")
+	}
+
+	if !strings.Contains(d.ViewCronJob(nil, nil), "No cronjob selected") {
+		t.Error("expected empty view for nil cronjob")
+	}
+}
+
+func TestDetailView_ViewDaemonSet(t *testing.T) {
+	daemonSet := &models.DaemonSetInfo{
+		Name:         "fluent-bit",
+		Namespace:    "logging",
+		Desired:      3,
+		Current:      2,
+		Ready:        2,
+		UpToDate:     3,
+		Available:    2,
+		NodeSelector: "<none>",
+		Strategy:     "RollingUpdate",
+		Age:          "5d",
+	}
+
+	d := NewDetailView()
+	d.SetSize(120, 40)
+
+	view := d.ViewDaemonSet(daemonSet, nil)
+	for _, expected := range []string{"DaemonSet Details", "fluent-bit", "Up-to-date", "RollingUpdate", "Loading..."} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("expected view to contain %q, but it didn't", expected)
+		}
+	}
+
+	nodes := []models.DaemonSetNodeStatus{
+		{Node: "worker-1", Pod: "fluent-bit-abcde", Status: "Running", Ready: "1/1", Eligible: true, Symbol: "●", OtherPods: []string{"fluent-bit-old"}},
+		{Node: "worker-2", Status: "Missing", Eligible: true, Symbol: "✖"},
+		{Node: "windows-1", Pod: "fluent-bit-fghij", Status: "Running", Ready: "1/1", Symbol: "●"},
+	}
+	view = d.ViewDaemonSet(daemonSet, nodes)
+	for _, expected := range []string{"Missing on 1 of 3 nodes", "fluent-bit-abcde", "↳ fluent-bit-old", "worker-2", "Missing", "(misscheduled)"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("expected view to contain %q, but it didn't", expected)
+		}
+	}
+
+	if !strings.Contains(d.ViewDaemonSet(nil, nil), "No daemonset selected") {
+		t.Error("expected empty view for nil daemonset")
+	}
+}
+
+func TestDetailView_ViewReplicaSet(t *testing.T) {
+	replicaSet := &models.ReplicaSetInfo{
+		Name:      "web-7d4b9c8f5",
+		Namespace: "shop",
+		Desired:   3,
+		Current:   3,
+		Ready:     2,
+		Owner:     "web",
+		Revision:  "4",
+		Images:    []string{"shop/web:1.4.2"},
+		Age:       "3h",
+	}
+
+	d := NewDetailView()
+	d.SetSize(100, 40)
+
+	view := d.ViewReplicaSet(replicaSet)
+	for _, expected := range []string{"ReplicaSet Details", "web-7d4b9c8f5", "Deployment", "Revision", "shop/web:1.4.2"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("expected view to contain %q, but it didn't", expected)
+		}
+	}
+
+	if !strings.Contains(d.ViewReplicaSet(nil), "No replicaset selected") {
+		t.Error("expected empty view for nil replicaset")
+	}
+}
+
+func TestDetailView_ViewIngress(t *testing.T) {
+	ingress := &models.IngressInfo{
+		Name:           "shop",
+		Namespace:      "shop",
+		Class:          "nginx",
+		Hosts:          "shop.example.com",
+		Addresses:      "203.0.113.10",
+		Ports:          "80, 443",
+		Age:            "2h",
+		DefaultBackend: &models.IngressBackend{Service: "default-http-backend", Port: "80"},
+		Paths: []models.IngressPath{
+			{Host: "shop.example.com", Path: "/api", PathType: "Prefix", Backend: models.IngressBackend{Service: "api", Port: "8080"}},
+			{Host: "shop.example.com", Path: "/", PathType: "Prefix", Backend: models.IngressBackend{Service: "web", Port: "http"}},
+		},
+		TLS: []models.IngressTLS{{SecretName: "shop-tls", Hosts: []string{"shop.example.com"}}},
+	}
+
+	d := NewDetailView()
+	d.SetSize(140, 40)
+
+	view := d.ViewIngress(ingress, nil)
+	for _, expected := range []string{"Ingress Details", "nginx", "Checking backends...", "api:8080", "web:http", "shop-tls terminates shop.example.com"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("expected view to contain %q, but it didn't", expected)
+		}
+	}
+
+	view = d.ViewIngress(ingress, map[string]string{"api:8080": `service "api" has no port 8080`})
+	for _, expected := range []string{"1 backend(s) point at a missing service or port", `✖  shop.example.com`, `api:8080 (service "api" has no port 8080)`} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("expected view to contain %q, but it didn't", expected)
+		}
+	}
+	if strings.Contains(view, "Checking backends...") {
+		t.Error("expected no loading message once backends are checked")
+	}
+
+	if !strings.Contains(d.ViewIngress(nil, nil), "No ingress selected") {
+		t.Error("expected empty view for nil ingress")
+	}
+}
+
+func TestDetailView_ViewPersistentVolumeClaim(t *testing.T) {
+	claim := &models.PersistentVolumeClaimInfo{
+		Name:         "data-postgres-0",
+		Namespace:    "default",
+		Status:       "Bound",
+		Volume:       "pvc-1234",
+		Capacity:     "10Gi",
+		Requested:    "10Gi",
+		AccessModes:  "RWO",
+		StorageClass: "gp3",
+		VolumeMode:   "Filesystem",
+		Age:          "2d",
+	}
+
+	d := NewDetailView()
+	d.SetSize(120, 40)
+
+	view := d.ViewPersistentVolumeClaim(claim, nil)
+	for _, expected := range []string{"PersistentVolumeClaim Details", "● Bound", "pvc-1234", "gp3", "Used By", "Loading..."} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("expected view to contain %q, but it didn't", expected)
+		}
+	}
+
+	view = d.ViewPersistentVolumeClaim(claim, []models.PodInfo{{Name: "postgres-0", Status: "Running", Node: "worker-1"}})
+	for _, expected := range []string{"POD", "postgres-0", "worker-1"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("expected view to contain %q, but it didn't", expected)
+		}
+	}
+
+	if view := d.ViewPersistentVolumeClaim(claim, []models.PodInfo{}); !strings.Contains(view, "<none>") {
+		t.Error("expected <none> when no pods mount the claim")
+	}
+
+	if !strings.Contains(d.ViewPersistentVolumeClaim(nil, nil), "No persistentvolumeclaim selected") {
+		t.Error("expected empty view for nil claim")
+	}
+}
+
+func TestDetailView_ViewPersistentVolume(t *testing.T) {
+	volume := &models.PersistentVolumeInfo{
+		Name:          "pvc-1234",
+		Capacity:      "10Gi",
+		AccessModes:   "RWO",
+		ReclaimPolicy: "Delete",
+		Status:        "Failed",
+		Reason:        "VolumeFailedDelete",
+		StorageClass:  "gp3",
+		Source:        "CSI (ebs.csi.aws.com)",
+		Age:           "2d",
+	}
+
+	d := NewDetailView()
+	d.SetSize(120, 40)
+
+	view := d.ViewPersistentVolume(volume)
+	for _, expected := range []string{"PersistentVolume Details", "✖ Failed", "VolumeFailedDelete", "<none>", "CSI (ebs.csi.aws.com)"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("expected view to contain %q, but it didn't", expected)
+		}
+	}
+
+	if !strings.Contains(d.ViewPersistentVolume(nil), "No persistentvolume selected") {
+		t.Error("expected empty view for nil volume")
+	}
+}
+
+func TestDetailView_ViewStorageClass(t *testing.T) {
+	storageClass := &models.StorageClassInfo{
+		Name:              "gp3",
+		Provisioner:       "ebs.csi.aws.com",
+		ReclaimPolicy:     "Delete",
+		VolumeBindingMode: "WaitForFirstConsumer",
+		IsDefault:         true,
+		Parameters:        []string{"type=gp3"},
+		Age:               "30d",
+	}
+
+	d := NewDetailView()
+	d.SetSize(120, 40)
+
+	view := d.ViewStorageClass(storageClass)
+	for _, expected := range []string{"StorageClass Details", "ebs.csi.aws.com", "WaitForFirstConsumer", "Parameters", "type=gp3"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("expected view to contain %q, but it didn't", expected)
+		}
+	}
+
+	if !strings.Contains(d.ViewStorageClass(nil), "No storageclass selected") {
+		t.Error("expected empty view for nil storage class")
+	}
+}
+
+func TestDetailView_ViewStatefulSetVolumeClaimTemplates(t *testing.T) {
+	statefulSet := &models.StatefulSetInfo{
+		Name:      "postgres",
+		Namespace: "default",
+		VolumeClaimTemplates: []models.VolumeClaimTemplate{
+			{Name: "data", Storage: "10Gi", AccessModes: "RWO", StorageClass: "gp3"},
+		},
+	}
+
+	d := NewDetailView()
+	d.SetSize(120, 40)
+
+	view := d.ViewStatefulSet(statefulSet, nil, nil, nil)
+	for _, expected := range []string{"Volume Claim Templates", "data (10Gi, RWO, gp3)"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("expected view to contain %q, but it didn't", expected)
+		}
+	}
+}
+
+func TestDetailView_ViewHorizontalPodAutoscaler(t *testing.T) {
+	hpa := &models.HorizontalPodAutoscalerInfo{
+		Name:            "web",
+		Namespace:       "default",
+		TargetKind:      "Deployment",
+		TargetName:      "web",
+		MinReplicas:     2,
+		MaxReplicas:     10,
+		CurrentReplicas: 10,
+		DesiredReplicas: 10,
+		Metrics:         []models.HPAMetric{{Name: "cpu", Current: "95%", Target: "80%"}},
+		Conditions: []models.HPACondition{
+			{Type: "AbleToScale", Status: "True", Reason: "ReadyForNewScale"},
+			{Type: "ScalingLimited", Status: "True", Reason: "TooManyReplicas", Message: "the desired replica count is more than the maximum replica count"},
+		},
+		LastScale: "5m",
+		Age:       "1d",
+	}
+
+	d := NewDetailView()
+	d.SetSize(140, 40)
+
+	view := d.ViewHorizontalPodAutoscaler(hpa)
+	for _, expected := range []string{"HorizontalPodAutoscaler Details", "Deployment/web", "5m ago", "CURRENT", "95%", "80%", "ScalingLimited", "TooManyReplicas", "more than the maximum"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("expected view to contain %q, but it didn't", expected)
+		}
+	}
+
+	if !strings.Contains(d.ViewHorizontalPodAutoscaler(nil), "No horizontalpodautoscaler selected") {
+		t.Error("expected empty view for nil autoscaler")
+	}
+}
+
+func TestDetailView_ViewDeploymentAutoscalers(t *testing.T) {
+	deployment := &models.DeploymentInfo{Name: "web", Namespace: "default", Replicas: 10, Ready: "10/10"}
+
+	d := NewDetailView()
+	d.SetSize(140, 40)
+
+	if view := d.ViewDeployment(deployment, nil, nil, nil); !strings.Contains(view, "Autoscaling") || !strings.Contains(view, "Loading...") {
+		t.Error("expected the autoscaling section to be loading")
+	}
+	if view := d.ViewDeployment(deployment, []models.HorizontalPodAutoscalerInfo{}, nil, nil); !strings.Contains(view, "replicas are set manually") {
+		t.Error("expected a note that no HPA scales the deployment")
+	}
+
+	view := d.ViewDeployment(deployment, []models.HorizontalPodAutoscalerInfo{{
+		Name:            "web",
+		MinReplicas:     2,
+		MaxReplicas:     10,
+		CurrentReplicas: 10,
+		DesiredReplicas: 10,
+		Targets:         "cpu: 95%/80%",
+		LastScale:       "5m",
+		Conditions:      []models.HPACondition{{Type: "ScalingLimited", Status: "True", Message: "the desired replica count is more than the maximum replica count"}},
+	}}, nil, nil)
+	for _, expected := range []string{"HPA web: 2-10 replicas, 10 current, 10 desired", "cpu: 95%/80%", "Last scaled 5m ago", "Limited: the desired replica count"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("expected view to contain %q, but it didn't", expected)
+		}
+	}
+}
+
+func TestDetailView_ViewStatefulSetBudgets(t *testing.T) {
+	statefulSet := &models.StatefulSetInfo{Name: "db", Namespace: "default", Replicas: 3, Ready: "3/3"}
+
+	d := NewDetailView()
+	d.SetSize(200, 40)
+
+	if view := d.ViewStatefulSet(statefulSet, nil, nil, nil); !strings.Contains(view, "Disruption Budgets") {
+		t.Error("expected a disruption budgets section")
+	}
+	if view := d.ViewStatefulSet(statefulSet, nil, []models.PodDisruptionBudgetInfo{}, nil); !strings.Contains(view, "pods can be evicted without limit") {
+		t.Error("expected a note that no PDB covers the statefulset")
+	}
+
+	view := d.ViewStatefulSet(statefulSet, nil, []models.PodDisruptionBudgetInfo{{
+		Name:               "db",
+		MinAvailable:       "3",
+		MaxUnavailable:     "N/A",
+		CurrentHealthy:     3,
+		DesiredHealthy:     3,
+		DisruptionsAllowed: 0,
+	}}, nil)
+	if !strings.Contains(view, "⊘ PDB db: min available 3, max unavailable N/A, 3/3 healthy, 0 disruptions allowed") {
+		t.Errorf("expected the blocking budget in the view, got %s", view)
+	}
+}
+
+func TestDetailView_ViewPodDisruptionBudget(t *testing.T) {
+	d := NewDetailView()
+	d.SetSize(140, 40)
+
+	if view := d.ViewPodDisruptionBudget(nil); !strings.Contains(view, "No poddisruptionbudget selected") {
+		t.Error("expected the empty view for a nil budget")
+	}
+
+	budget := &models.PodDisruptionBudgetInfo{
+		Name:               "web",
+		Namespace:          "shop",
+		MinAvailable:       "N/A",
+		MaxUnavailable:     "1",
+		CurrentHealthy:     3,
+		DesiredHealthy:     2,
+		ExpectedPods:       3,
+		DisruptionsAllowed: 1,
+		Selector:           "app=web",
+	}
+	view := d.ViewPodDisruptionBudget(budget)
+	for _, expected := range []string{"PodDisruptionBudget Details", "app=web", "3 (2 desired)", "1 allowed", "1 pod(s) can be evicted"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("expected view to contain %q, but it didn't", expected)
+		}
+	}
+
+	budget.DisruptionsAllowed = 0
+	if view := d.ViewPodDisruptionBudget(budget); !strings.Contains(view, "No disruptions allowed") {
+		t.Error("expected a warning for a budget allowing no disruptions")
+	}
+}
+
+func TestDetailView_ViewNamespaceQuotas(t *testing.T) {
+	d := NewDetailView()
+	d.SetSize(160, 40)
+
+	if view := d.ViewNamespaceQuotas("shop", nil, nil); !strings.Contains(view, "Loading...") {
+		t.Error("expected the quotas to be loading")
+	}
+	if view := d.ViewNamespaceQuotas("shop", []models.ResourceQuotaInfo{}, []models.LimitRangeInfo{}); !strings.Contains(view, "<none>") {
+		t.Error("expected a note that the namespace has no quotas")
+	}
+
+	view := d.ViewNamespaceQuotas("shop", []models.ResourceQuotaInfo{{
+		Name: "compute",
+		Resources: []models.QuotaUsage{
+			{Name: "pods", Used: "5", Hard: "10", Percent: 50},
+			{Name: "requests.cpu", Used: "4", Hard: "4", Percent: 100},
+		},
+	}}, []models.LimitRangeInfo{{
+		Name:   "limits",
+		Limits: []models.LimitRangeLimit{{Type: "Container", Resource: "cpu", Min: "-", Max: "2", DefaultRequest: "100m", Default: "500m", MaxLimitRequestRatio: "-"}},
+	}})
+	for _, expected := range []string{
+		"Quotas: shop",
+		"compute",
+		"██████████░░░░░░░░░░  50%  5 / 10",
+		"████████████████████ 100%  4 / 4",
+		"limits",
+		"DEFAULT REQUEST",
+		"100m",
+	} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("expected view to contain %q, but it didn't", expected)
+		}
+	}
+}
+
+func TestDetailView_ViewNetworkPolicy(t *testing.T) {
+	d := NewDetailView()
+	d.SetSize(160, 40)
+
+	view := d.ViewNetworkPolicy(&models.NetworkPolicyInfo{
+		Name:         "web",
+		Namespace:    "shop",
+		PodSelector:  "app=web",
+		PolicyTypes:  []string{"Ingress", "Egress"},
+		IngressRules: []models.NetworkPolicyRule{{Peers: []string{"pods app=frontend in shop"}, Ports: []string{"TCP/8080"}}},
+		EgressRules:  []models.NetworkPolicyRule{},
+	})
+	for _, expected := range []string{"NetworkPolicy Details", "app=web", "Ingress,Egress", "from pods app=frontend in shop on TCP/8080", "All egress denied"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("expected view to contain %q, but it didn't", expected)
+		}
+	}
+
+	view = d.ViewNetworkPolicy(&models.NetworkPolicyInfo{Name: "ingress-only", PolicyTypes: []string{"Ingress"}})
+	if !strings.Contains(view, "Not affected by this policy") {
+		t.Error("expected egress to be unaffected by an ingress-only policy")
+	}
+	if !strings.Contains(d.ViewNetworkPolicy(nil), "No networkpolicy selected") {
+		t.Error("expected empty view for nil networkpolicy")
+	}
+}
+
+func TestDetailView_ViewPodNetworkPolicies(t *testing.T) {
+	d := NewDetailView()
+	d.SetSize(160, 40)
+	pod := &models.PodInfo{Name: "web-1", Namespace: "shop", Pod: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"tier": "api", "app": "web"}}}}
+
+	if view := d.ViewPodNetworkPolicies(pod, nil); !strings.Contains(view, "Loading...") || !strings.Contains(view, "app=web,tier=api") {
+		t.Error("expected the analysis to be loading and the pod labels to be shown")
+	}
+
+	view := d.ViewPodNetworkPolicies(pod, &models.PodNetworkAnalysis{
+		Policies: []string{"default-deny", "allow-ops"},
+		Ingress: models.NetworkPolicyDirection{
+			Isolated: true,
+			Policies: []string{"default-deny", "allow-ops"},
+			Rules:    []models.NetworkPolicyRule{{Policy: "allow-ops", Peers: []string{"all pods in namespaces team=ops"}}},
+		},
+		Egress: models.NetworkPolicyDirection{Isolated: true, Policies: []string{"default-deny"}},
+	})
+	for _, expected := range []string{"default-deny, allow-ops", "Restricted", "from all pods in namespaces team=ops on any port  [allow-ops]", "Default deny: all egress blocked"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("expected view to contain %q, but it didn't", expected)
+		}
+	}
+
+	view = d.ViewPodNetworkPolicies(pod, &models.PodNetworkAnalysis{})
+	if !strings.Contains(view, "All ingress allowed") || !strings.Contains(view, "no policy selecting the pod restricts egress") {
+		t.Error("expected a pod without policies to allow all traffic")
+	}
+}
+
+func TestDetailView_ViewDeploymentRevisions(t *testing.T) {
+	d := NewDetailView()
+	d.SetSize(160, 60)
+	deployment := &models.DeploymentInfo{Name: "web", Namespace: "shop"}
+
+	if view := d.ViewDeployment(deployment, nil, nil, nil); !strings.Contains(view, "Revision History") {
+		t.Error("expected the Revision History section while loading")
+	}
+	if view := d.ViewDeployment(deployment, nil, nil, []models.RevisionInfo{}); !strings.Contains(view, "<none>") {
+		t.Error("expected no revisions")
+	}
+
+	revisions := []models.RevisionInfo{
+		{Revision: 7, Name: "web-7", Images: "web:1.7", ChangeCause: "set image web:1.7", Current: true, Age: "1h"},
+	}
+	for i := 6; i > 0; i-- {
+		revisions = append(revisions, models.RevisionInfo{Revision: int64(i), Name: fmt.Sprintf("web-%d", i), Images: fmt.Sprintf("web:1.%d", i), Age: "2d"})
+	}
+	view := d.ViewDeployment(deployment, nil, nil, revisions)
+	for _, expected := range []string{"●   7  web-7", "web:1.7  (set image web:1.7)", "web-3", "... 2 older revisions", "[H]"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("expected view to contain %q, got %s", expected, view)
+		}
+	}
+	if strings.Contains(view, "web-2 ") {
+		t.Error("expected only the newest revisions in the detail view")
+	}
+}
@@ -0,0 +1,175 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/williajm/k8s-tui/internal/models"
+	"github.com/williajm/k8s-tui/internal/ui/styles"
+)
+
+// editDiffContext is how many unchanged lines are kept around each change of an edit
+const editDiffContext = 3
+
+// editState is the progress of an edit under review
+type editState int
+
+const (
+	editStateUnchanged  editState = iota // The editor closed without changes
+	editStateValidating                  // Server-side dry run in progress
+	editStateInvalid                     // The edit failed to parse, validate or apply
+	editStateReady                       // The dry run passed; the edit can be applied
+	editStateApplying                    // Update in progress
+)
+
+// EditReview shows the diff of an object edited in $EDITOR against the live object,
+// with the outcome of its server-side dry run, before the edit is applied
+type EditReview struct {
+	kind      string
+	namespace string
+	name      string
+	state     editState
+	err       error
+	viewport  viewport.Model
+	width     int
+	height    int
+}
+
+// NewEditReview creates a new edit review
+func NewEditReview() *EditReview {
+	vp := viewport.New(80, 20)
+	vp.Style = lipgloss.NewStyle()
+
+	return &EditReview{
+		viewport: vp,
+		width:    80,
+		height:   20,
+	}
+}
+
+// SetSize sets the dimensions of the edit review
+func (e *EditReview) SetSize(width, height int) {
+	e.width = width
+	e.height = height
+	e.viewport.Width = width - 4
+	// Height: total - footer (2) - border (2) - title (2) - status (2)
+	e.viewport.Height = max(1, height-8)
+}
+
+// Show shows the diff of an edit. An edit with changes is validated next.
+func (e *EditReview) Show(kind, namespace, name string, diff []models.DiffLine) {
+	e.kind = kind
+	e.namespace = namespace
+	e.name = name
+	e.err = nil
+
+	if models.HasChanges(diff) {
+		e.state = editStateValidating
+		e.viewport.SetContent(renderDiff(models.CompactDiff(diff, editDiffContext)))
+	} else {
+		e.state = editStateUnchanged
+		e.viewport.SetContent("")
+	}
+	e.viewport.GotoTop()
+}
+
+// SetInvalid marks the edit as unusable, e.g. because it is not valid YAML
+func (e *EditReview) SetInvalid(err error) {
+	e.state = editStateInvalid
+	e.err = err
+}
+
+// SetValidated records the outcome of the server-side dry run
+func (e *EditReview) SetValidated(err error) {
+	if err != nil {
+		e.SetInvalid(err)
+		return
+	}
+	e.state = editStateReady
+}
+
+// SetApplying marks the update as in progress
+func (e *EditReview) SetApplying() {
+	e.state = editStateApplying
+}
+
+// CanApply reports whether the dry run passed and the edit can be applied
+func (e *EditReview) CanApply() bool {
+	return e.state == editStateReady
+}
+
+// IsBusy reports whether a dry run or update is in progress
+func (e *EditReview) IsBusy() bool {
+	return e.state == editStateValidating || e.state == editStateApplying
+}
+
+// ScrollUp scrolls the diff up a page
+func (e *EditReview) ScrollUp() {
+	e.viewport.PageUp()
+}
+
+// ScrollDown scrolls the diff down a page
+func (e *EditReview) ScrollDown() {
+	e.viewport.PageDown()
+}
+
+// View renders the edit review
+func (e *EditReview) View() string {
+	target := e.name
+	if e.namespace != "" {
+		target = e.namespace + "/" + e.name
+	}
+
+	var status string
+	switch e.state {
+	case editStateUnchanged:
+		status = "No changes were made"
+	case editStateValidating:
+		status = styles.StatusPendingStyle.Render("Validating with a server-side dry run...")
+	case editStateInvalid:
+		status = styles.StatusErrorStyle.Render("✖ " + e.err.Error())
+	case editStateReady:
+		status = styles.StatusRunningStyle.Render("✔ Server-side dry run passed")
+	case editStateApplying:
+		status = styles.StatusPendingStyle.Render("Applying...")
+	}
+
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		styles.DetailHeaderStyle.Render(fmt.Sprintf("Edit %s %s", e.kind, target)),
+		lipgloss.NewStyle().Width(e.width-4).Render(status),
+		"",
+		e.viewport.View(),
+	)
+
+	borderedContent := styles.BorderStyle.
+		Width(e.width).
+		Height(e.height - 2). // Reserve 2 lines for footer outside border
+		Render(content)
+
+	return lipgloss.JoinVertical(lipgloss.Left, borderedContent, e.renderFooter())
+}
+
+// renderFooter renders the keyboard shortcuts
+func (e *EditReview) renderFooter() string {
+	legend := "Diff against the live object: " +
+		styles.DiffDeleteStyle.Render("- live") + "  " + styles.DiffInsertStyle.Render("+ edited")
+
+	var shortcuts []string
+	if e.CanApply() {
+		shortcuts = append(shortcuts, styles.RenderKeyHelp("[y]", "Apply"))
+	}
+	shortcuts = append(shortcuts,
+		styles.RenderKeyHelp("[e]", "Edit again"),
+		styles.RenderKeyHelp("[PgUp/PgDn]", "Scroll diff"),
+		styles.RenderKeyHelp("[Esc]", "Discard"),
+	)
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		styles.FooterStyle.Width(e.width).Render(legend),
+		styles.FooterStyle.Width(e.width).Render(strings.Join(shortcuts, "  ")),
+	)
+}
@@ -0,0 +1,58 @@
+package components
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/williajm/k8s-tui/internal/models"
+)
+
+func TestEditReview_Validation(t *testing.T) {
+	e := NewEditReview()
+	e.SetSize(120, 30)
+
+	e.Show("Deployment", "shop", "web", models.DiffLines("replicas: 2\n", "replicas: 3\n"))
+	if !e.IsBusy() || e.CanApply() {
+		t.Error("Show() with changes should validate the edit first")
+	}
+	view := e.View()
+	for _, want := range []string{"Edit Deployment shop/web", "dry run", "- replicas: 2", "+ replicas: 3", "[e]"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("View() missing %q", want)
+		}
+	}
+	if strings.Contains(view, "[y]") {
+		t.Error("View() should not offer apply before the dry run passes")
+	}
+
+	e.SetValidated(nil)
+	if e.IsBusy() || !e.CanApply() || !strings.Contains(e.View(), "[y]") {
+		t.Error("SetValidated(nil) should allow applying the edit")
+	}
+
+	e.SetApplying()
+	if !e.IsBusy() || e.CanApply() {
+		t.Error("SetApplying() should mark the update in progress")
+	}
+
+	e.SetValidated(errors.New("spec.replicas: Invalid value"))
+	if e.CanApply() || !strings.Contains(e.View(), "spec.replicas: Invalid value") {
+		t.Error("SetValidated() should show the dry run error")
+	}
+}
+
+func TestEditReview_Unchanged(t *testing.T) {
+	e := NewEditReview()
+	e.SetSize(120, 30)
+
+	e.Show("ConfigMap", "shop", "settings", models.DiffLines("a: b\n", "a: b\n"))
+	if e.IsBusy() || e.CanApply() || !strings.Contains(e.View(), "No changes") {
+		t.Error("Show() without changes should have nothing to apply")
+	}
+
+	e.SetInvalid(errors.New("failed to parse edited YAML"))
+	if !strings.Contains(e.View(), "failed to parse edited YAML") {
+		t.Error("SetInvalid() should show the error")
+	}
+}
@@ -75,6 +75,7 @@ func (f *Footer) ViewDetailed() string {
 				styles.RenderKeyHelp("←/h/Backspace", "Go back/collapse"),
 				styles.RenderKeyHelp("Tab", "Switch panes"),
 				styles.RenderKeyHelp("Shift+Tab", "Previous pane"),
+				styles.RenderKeyHelp("[/]", "Switch view (Storage: PVCs, PVs, StorageClasses)"),
 			},
 		},
 		{
@@ -82,6 +83,7 @@ func (f *Footer) ViewDetailed() string {
 			shortcuts: []string{
 				styles.RenderKeyHelp("n", "Change namespace"),
 				styles.RenderKeyHelp("c", "Change context"),
+				styles.RenderKeyHelp("a", "RBAC access query"),
 				styles.RenderKeyHelp("/", "Search/filter"),
 				styles.RenderKeyHelp("r/F5", "Refresh"),
 			},
@@ -91,6 +93,17 @@ func (f *Footer) ViewDetailed() string {
 			shortcuts: []string{
 				styles.RenderKeyHelp("l", "View logs (pods)"),
 				styles.RenderKeyHelp("d", "Describe resource"),
+				styles.RenderKeyHelp("v", "Reveal secret key"),
+				styles.RenderKeyHelp("N", "Go to pod's node"),
+				styles.RenderKeyHelp("V", "Go to claim's volume"),
+				styles.RenderKeyHelp("T", "Go to HPA's scale target"),
+				styles.RenderKeyHelp("P", "Pod network policies"),
+				styles.RenderKeyHelp("Q", "Namespace quotas"),
+				styles.RenderKeyHelp("D", "Delete resource"),
+				styles.RenderKeyHelp("S", "Scale workload"),
+				styles.RenderKeyHelp("R", "Rollout restart/pause/resume"),
+				styles.RenderKeyHelp("H", "Rollout history and rollback"),
+				styles.RenderKeyHelp("e", "Edit YAML in $EDITOR (describe view)"),
 				styles.RenderKeyHelp("5", "Jump to Events tab"),
 			},
 		},
@@ -23,6 +23,7 @@ type Header struct {
 	namespace       string
 	connected       bool
 	connectionState ConnectionState
+	mode            string // Offline mode, e.g. "Replay"; empty for a live cluster
 	width           int
 }
 
@@ -67,6 +68,12 @@ func (h *Header) SetConnectionState(state ConnectionState) {
 	h.connected = (state == ConnectionStateConnected || state == ConnectionStateConnecting || state == ConnectionStateReconnecting)
 }
 
+// SetMode shows that the data does not come from a live cluster, e.g. "Snapshot"
+// or "Replay". An empty mode hides the indicator.
+func (h *Header) SetMode(mode string) {
+	h.mode = mode
+}
+
 // SetWidth sets the width of the header
 func (h *Header) SetWidth(width int) {
 	h.width = width
@@ -101,6 +108,9 @@ func (h *Header) View() string {
 	contextInfo := fmt.Sprintf("Context: %s", h.context)
 	nsInfo := fmt.Sprintf("NS: %s", h.namespace)
 	connInfo := fmt.Sprintf("%s %s", connIndicator, connStatus)
+	if h.mode != "" {
+		connInfo = fmt.Sprintf("%s (read-only) | %s", h.mode, connInfo)
+	}
 
 	// Calculate spacing
 	separator := " | "
@@ -420,3 +420,17 @@ func TestHeader_AllConnectionStatesRender(t *testing.T) {
 		})
 	}
 }
+
+func TestHeader_SetMode(t *testing.T) {
+	h := NewHeader("replay:events.jsonl", "default", true)
+	h.SetWidth(120)
+
+	if strings.Contains(h.View(), "read-only") {
+		t.Error("Expected no read-only indicator without a mode")
+	}
+
+	h.SetMode("Replay")
+	if !strings.Contains(h.View(), "Replay (read-only)") {
+		t.Errorf("Expected the replay indicator in the header, got %q", h.View())
+	}
+}
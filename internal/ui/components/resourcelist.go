@@ -18,36 +18,101 @@ const (
 	ResourceTypeDeployment
 	ResourceTypeStatefulSet
 	ResourceTypeEvent
+	ResourceTypeConfigMap
+	ResourceTypeSecret
+	ResourceTypeNode
+	ResourceTypeJob
+	ResourceTypeCronJob
+	ResourceTypeDaemonSet
+	ResourceTypeReplicaSet
+	ResourceTypeIngress
+	ResourceTypePersistentVolumeClaim
+	ResourceTypePersistentVolume
+	ResourceTypeStorageClass
+	ResourceTypeHorizontalPodAutoscaler
+	ResourceTypeServiceAccount
+	ResourceTypeRole
+	ResourceTypeClusterRole
+	ResourceTypeRoleBinding
+	ResourceTypeClusterRoleBinding
+	ResourceTypeNetworkPolicy
+	ResourceTypePodDisruptionBudget
+	ResourceTypeGeneric
 )
 
 // ResourceList represents a generic list of resources
 type ResourceList struct {
-	resourceType ResourceType
-	pods         []models.PodInfo
-	services     []models.ServiceInfo
-	deployments  []models.DeploymentInfo
-	statefulSets []models.StatefulSetInfo
-	events       []models.EventInfo
-	selectedIdx  int
-	viewportTop  int
-	width        int
-	height       int
-	searchFilter string
+	resourceType             ResourceType
+	pods                     []models.PodInfo
+	services                 []models.ServiceInfo
+	deployments              []models.DeploymentInfo
+	statefulSets             []models.StatefulSetInfo
+	events                   []models.EventInfo
+	configMaps               []models.ConfigMapInfo
+	secrets                  []models.SecretInfo
+	nodes                    []models.NodeInfo
+	jobs                     []models.JobInfo
+	cronJobs                 []models.CronJobInfo
+	daemonSets               []models.DaemonSetInfo
+	replicaSets              []models.ReplicaSetInfo
+	ingresses                []models.IngressInfo
+	persistentVolumeClaims   []models.PersistentVolumeClaimInfo
+	persistentVolumes        []models.PersistentVolumeInfo
+	storageClasses           []models.StorageClassInfo
+	horizontalPodAutoscalers []models.HorizontalPodAutoscalerInfo
+	serviceAccounts          []models.ServiceAccountInfo
+	roles                    []models.RoleInfo
+	clusterRoles             []models.RoleInfo
+	roleBindings             []models.RoleBindingInfo
+	clusterRoleBindings      []models.RoleBindingInfo
+	networkPolicies          []models.NetworkPolicyInfo
+	podDisruptionBudgets     []models.PodDisruptionBudgetInfo
+	generic                  []models.GenericResourceInfo
+	tableColumns             []string // Server-side table columns for generic resources, if any
+	selectedIdx              int
+	viewportTop              int
+	width                    int
+	height                   int
+	searchFilter             string
+	showCluster              bool
 }
 
+// clusterColumnWidth is the width of the CLUSTER column in multi-cluster mode
+const clusterColumnWidth = 16
+
 // NewResourceList creates a new resource list component
 func NewResourceList(resourceType ResourceType) *ResourceList {
 	return &ResourceList{
-		resourceType: resourceType,
-		pods:         []models.PodInfo{},
-		services:     []models.ServiceInfo{},
-		deployments:  []models.DeploymentInfo{},
-		statefulSets: []models.StatefulSetInfo{},
-		events:       []models.EventInfo{},
-		selectedIdx:  0,
-		viewportTop:  0,
-		width:        80,
-		height:       20,
+		resourceType:             resourceType,
+		pods:                     []models.PodInfo{},
+		services:                 []models.ServiceInfo{},
+		deployments:              []models.DeploymentInfo{},
+		statefulSets:             []models.StatefulSetInfo{},
+		events:                   []models.EventInfo{},
+		configMaps:               []models.ConfigMapInfo{},
+		secrets:                  []models.SecretInfo{},
+		nodes:                    []models.NodeInfo{},
+		jobs:                     []models.JobInfo{},
+		cronJobs:                 []models.CronJobInfo{},
+		daemonSets:               []models.DaemonSetInfo{},
+		replicaSets:              []models.ReplicaSetInfo{},
+		ingresses:                []models.IngressInfo{},
+		persistentVolumeClaims:   []models.PersistentVolumeClaimInfo{},
+		persistentVolumes:        []models.PersistentVolumeInfo{},
+		storageClasses:           []models.StorageClassInfo{},
+		horizontalPodAutoscalers: []models.HorizontalPodAutoscalerInfo{},
+		serviceAccounts:          []models.ServiceAccountInfo{},
+		roles:                    []models.RoleInfo{},
+		clusterRoles:             []models.RoleInfo{},
+		roleBindings:             []models.RoleBindingInfo{},
+		clusterRoleBindings:      []models.RoleBindingInfo{},
+		networkPolicies:          []models.NetworkPolicyInfo{},
+		podDisruptionBudgets:     []models.PodDisruptionBudgetInfo{},
+		generic:                  []models.GenericResourceInfo{},
+		selectedIdx:              0,
+		viewportTop:              0,
+		width:                    80,
+		height:                   20,
 	}
 }
 
@@ -98,12 +163,225 @@ func (l *ResourceList) SetEvents(events []models.EventInfo) {
 	}
 }
 
+// SetConfigMaps updates the list of configmaps
+func (l *ResourceList) SetConfigMaps(configMaps []models.ConfigMapInfo) {
+	l.configMaps = configMaps
+	if l.selectedIdx >= len(l.configMaps) {
+		l.selectedIdx = 0
+	}
+}
+
+// SetSecrets updates the list of secrets
+func (l *ResourceList) SetSecrets(secrets []models.SecretInfo) {
+	l.secrets = secrets
+	if l.selectedIdx >= len(l.secrets) {
+		l.selectedIdx = 0
+	}
+}
+
+// SetNodes updates the list of nodes
+func (l *ResourceList) SetNodes(nodes []models.NodeInfo) {
+	l.nodes = nodes
+	if l.selectedIdx >= len(l.nodes) {
+		l.selectedIdx = 0
+	}
+}
+
+// SetJobs updates the list of jobs
+func (l *ResourceList) SetJobs(jobs []models.JobInfo) {
+	l.jobs = jobs
+	if l.selectedIdx >= len(l.jobs) {
+		l.selectedIdx = 0
+	}
+}
+
+// SetCronJobs updates the list of cronjobs
+func (l *ResourceList) SetCronJobs(cronJobs []models.CronJobInfo) {
+	l.cronJobs = cronJobs
+	if l.selectedIdx >= len(l.cronJobs) {
+		l.selectedIdx = 0
+	}
+}
+
+// SetDaemonSets updates the list of daemonsets
+func (l *ResourceList) SetDaemonSets(daemonSets []models.DaemonSetInfo) {
+	l.daemonSets = daemonSets
+	if l.selectedIdx >= len(l.daemonSets) {
+		l.selectedIdx = 0
+	}
+}
+
+// SetReplicaSets updates the list of replicasets
+func (l *ResourceList) SetReplicaSets(replicaSets []models.ReplicaSetInfo) {
+	l.replicaSets = replicaSets
+	if l.selectedIdx >= len(l.replicaSets) {
+		l.selectedIdx = 0
+	}
+}
+
+// SetIngresses updates the list of ingresses
+func (l *ResourceList) SetIngresses(ingresses []models.IngressInfo) {
+	l.ingresses = ingresses
+	if l.selectedIdx >= len(l.ingresses) {
+		l.selectedIdx = 0
+	}
+}
+
+// SetPersistentVolumeClaims updates the list of persistentvolumeclaims
+func (l *ResourceList) SetPersistentVolumeClaims(persistentVolumeClaims []models.PersistentVolumeClaimInfo) {
+	l.persistentVolumeClaims = persistentVolumeClaims
+	if l.selectedIdx >= len(l.persistentVolumeClaims) {
+		l.selectedIdx = 0
+	}
+}
+
+// SetPersistentVolumes updates the list of persistentvolumes
+func (l *ResourceList) SetPersistentVolumes(persistentVolumes []models.PersistentVolumeInfo) {
+	l.persistentVolumes = persistentVolumes
+	if l.selectedIdx >= len(l.persistentVolumes) {
+		l.selectedIdx = 0
+	}
+}
+
+// SetStorageClasses updates the list of storageclasses
+func (l *ResourceList) SetStorageClasses(storageClasses []models.StorageClassInfo) {
+	l.storageClasses = storageClasses
+	if l.selectedIdx >= len(l.storageClasses) {
+		l.selectedIdx = 0
+	}
+}
+
+// SetHorizontalPodAutoscalers updates the list of horizontalpodautoscalers
+func (l *ResourceList) SetHorizontalPodAutoscalers(horizontalPodAutoscalers []models.HorizontalPodAutoscalerInfo) {
+	l.horizontalPodAutoscalers = horizontalPodAutoscalers
+	if l.selectedIdx >= len(l.horizontalPodAutoscalers) {
+		l.selectedIdx = 0
+	}
+}
+
+// SetServiceAccounts updates the list of serviceaccounts
+func (l *ResourceList) SetServiceAccounts(serviceAccounts []models.ServiceAccountInfo) {
+	l.serviceAccounts = serviceAccounts
+	if l.selectedIdx >= len(l.serviceAccounts) {
+		l.selectedIdx = 0
+	}
+}
+
+// SetRoles updates the list of roles
+func (l *ResourceList) SetRoles(roles []models.RoleInfo) {
+	l.roles = roles
+	if l.selectedIdx >= len(l.roles) {
+		l.selectedIdx = 0
+	}
+}
+
+// SetClusterRoles updates the list of clusterroles
+func (l *ResourceList) SetClusterRoles(clusterRoles []models.RoleInfo) {
+	l.clusterRoles = clusterRoles
+	if l.selectedIdx >= len(l.clusterRoles) {
+		l.selectedIdx = 0
+	}
+}
+
+// SetRoleBindings updates the list of rolebindings
+func (l *ResourceList) SetRoleBindings(roleBindings []models.RoleBindingInfo) {
+	l.roleBindings = roleBindings
+	if l.selectedIdx >= len(l.roleBindings) {
+		l.selectedIdx = 0
+	}
+}
+
+// SetClusterRoleBindings updates the list of clusterrolebindings
+func (l *ResourceList) SetClusterRoleBindings(clusterRoleBindings []models.RoleBindingInfo) {
+	l.clusterRoleBindings = clusterRoleBindings
+	if l.selectedIdx >= len(l.clusterRoleBindings) {
+		l.selectedIdx = 0
+	}
+}
+
+// SetNetworkPolicies updates the list of networkpolicies
+func (l *ResourceList) SetNetworkPolicies(networkPolicies []models.NetworkPolicyInfo) {
+	l.networkPolicies = networkPolicies
+	if l.selectedIdx >= len(l.networkPolicies) {
+		l.selectedIdx = 0
+	}
+}
+
+// SetPodDisruptionBudgets updates the list of poddisruptionbudgets
+func (l *ResourceList) SetPodDisruptionBudgets(podDisruptionBudgets []models.PodDisruptionBudgetInfo) {
+	l.podDisruptionBudgets = podDisruptionBudgets
+	if l.selectedIdx >= len(l.podDisruptionBudgets) {
+		l.selectedIdx = 0
+	}
+}
+
+// SetGenericResources updates the list of objects shown in the generic resource browser
+func (l *ResourceList) SetGenericResources(resources []models.GenericResourceInfo) {
+	l.generic = resources
+	if l.selectedIdx >= len(l.generic) {
+		l.selectedIdx = 0
+	}
+}
+
+// SetGenericColumns sets the server-side table columns used to render generic resources.
+// With no columns, generic resources are shown with a fixed NAME/NAMESPACE/STATUS/AGE layout.
+func (l *ResourceList) SetGenericColumns(columns []string) {
+	l.tableColumns = columns
+}
+
+// ClearGenericResources removes all generic objects, e.g. when another kind is selected
+func (l *ResourceList) ClearGenericResources() {
+	l.generic = []models.GenericResourceInfo{}
+	l.tableColumns = nil
+	if l.resourceType == ResourceTypeGeneric {
+		l.selectedIdx = 0
+		l.viewportTop = 0
+	}
+}
+
+// Clear removes all resources and resets the selection
+func (l *ResourceList) Clear() {
+	l.pods = []models.PodInfo{}
+	l.services = []models.ServiceInfo{}
+	l.deployments = []models.DeploymentInfo{}
+	l.statefulSets = []models.StatefulSetInfo{}
+	l.events = []models.EventInfo{}
+	l.configMaps = []models.ConfigMapInfo{}
+	l.secrets = []models.SecretInfo{}
+	l.nodes = []models.NodeInfo{}
+	l.jobs = []models.JobInfo{}
+	l.cronJobs = []models.CronJobInfo{}
+	l.daemonSets = []models.DaemonSetInfo{}
+	l.replicaSets = []models.ReplicaSetInfo{}
+	l.ingresses = []models.IngressInfo{}
+	l.persistentVolumeClaims = []models.PersistentVolumeClaimInfo{}
+	l.persistentVolumes = []models.PersistentVolumeInfo{}
+	l.storageClasses = []models.StorageClassInfo{}
+	l.horizontalPodAutoscalers = []models.HorizontalPodAutoscalerInfo{}
+	l.serviceAccounts = []models.ServiceAccountInfo{}
+	l.roles = []models.RoleInfo{}
+	l.clusterRoles = []models.RoleInfo{}
+	l.roleBindings = []models.RoleBindingInfo{}
+	l.clusterRoleBindings = []models.RoleBindingInfo{}
+	l.networkPolicies = []models.NetworkPolicyInfo{}
+	l.podDisruptionBudgets = []models.PodDisruptionBudgetInfo{}
+	l.generic = []models.GenericResourceInfo{}
+	l.tableColumns = nil
+	l.selectedIdx = 0
+	l.viewportTop = 0
+}
+
 // SetSize sets the dimensions
 func (l *ResourceList) SetSize(width, height int) {
 	l.width = width
 	l.height = height
 }
 
+// SetShowCluster enables or disables the CLUSTER column (multi-cluster mode)
+func (l *ResourceList) SetShowCluster(show bool) {
+	l.showCluster = show
+}
+
 // SetSearchFilter sets the search filter
 func (l *ResourceList) SetSearchFilter(filter string) {
 	l.searchFilter = filter
@@ -165,6 +443,19 @@ func (l *ResourceList) GetSelectedPod() *models.PodInfo {
 	return nil
 }
 
+// SelectPod selects the pod with the given source cluster, namespace and name.
+// It returns false if the pod is not in the list.
+func (l *ResourceList) SelectPod(cluster, namespace, name string) bool {
+	for i, pod := range l.pods {
+		if pod.Cluster == cluster && pod.Namespace == namespace && pod.Name == name {
+			l.selectedIdx = i
+			l.adjustViewport()
+			return true
+		}
+	}
+	return false
+}
+
 // GetSelectedService returns the currently selected service
 func (l *ResourceList) GetSelectedService() *models.ServiceInfo {
 	if l.resourceType == ResourceTypeService && l.selectedIdx >= 0 && l.selectedIdx < len(l.services) {
@@ -181,6 +472,19 @@ func (l *ResourceList) GetSelectedDeployment() *models.DeploymentInfo {
 	return nil
 }
 
+// SelectDeployment selects the deployment with the given source cluster, namespace and name.
+// It returns false if the deployment is not in the list.
+func (l *ResourceList) SelectDeployment(cluster, namespace, name string) bool {
+	for i, deployment := range l.deployments {
+		if deployment.Cluster == cluster && deployment.Namespace == namespace && deployment.Name == name {
+			l.selectedIdx = i
+			l.adjustViewport()
+			return true
+		}
+	}
+	return false
+}
+
 // GetSelectedStatefulSet returns the currently selected statefulset
 func (l *ResourceList) GetSelectedStatefulSet() *models.StatefulSetInfo {
 	if l.resourceType == ResourceTypeStatefulSet && l.selectedIdx >= 0 && l.selectedIdx < len(l.statefulSets) {
@@ -189,6 +493,19 @@ func (l *ResourceList) GetSelectedStatefulSet() *models.StatefulSetInfo {
 	return nil
 }
 
+// SelectStatefulSet selects the statefulset with the given source cluster, namespace and name.
+// It returns false if the statefulset is not in the list.
+func (l *ResourceList) SelectStatefulSet(cluster, namespace, name string) bool {
+	for i, statefulSet := range l.statefulSets {
+		if statefulSet.Cluster == cluster && statefulSet.Namespace == namespace && statefulSet.Name == name {
+			l.selectedIdx = i
+			l.adjustViewport()
+			return true
+		}
+	}
+	return false
+}
+
 // GetSelectedEvent returns the currently selected event
 func (l *ResourceList) GetSelectedEvent() *models.EventInfo {
 	if l.resourceType == ResourceTypeEvent && l.selectedIdx >= 0 && l.selectedIdx < len(l.events) {
@@ -197,6 +514,192 @@ func (l *ResourceList) GetSelectedEvent() *models.EventInfo {
 	return nil
 }
 
+// GetSelectedConfigMap returns the currently selected configmap
+func (l *ResourceList) GetSelectedConfigMap() *models.ConfigMapInfo {
+	if l.resourceType == ResourceTypeConfigMap && l.selectedIdx >= 0 && l.selectedIdx < len(l.configMaps) {
+		return &l.configMaps[l.selectedIdx]
+	}
+	return nil
+}
+
+// GetSelectedSecret returns the currently selected secret
+func (l *ResourceList) GetSelectedSecret() *models.SecretInfo {
+	if l.resourceType == ResourceTypeSecret && l.selectedIdx >= 0 && l.selectedIdx < len(l.secrets) {
+		return &l.secrets[l.selectedIdx]
+	}
+	return nil
+}
+
+// GetSelectedNode returns the currently selected node
+func (l *ResourceList) GetSelectedNode() *models.NodeInfo {
+	if l.resourceType == ResourceTypeNode && l.selectedIdx >= 0 && l.selectedIdx < len(l.nodes) {
+		return &l.nodes[l.selectedIdx]
+	}
+	return nil
+}
+
+// SelectNode selects the node with the given source cluster and name.
+// It returns false if the node is not in the list.
+func (l *ResourceList) SelectNode(cluster, name string) bool {
+	for i, node := range l.nodes {
+		if node.Cluster == cluster && node.Name == name {
+			l.selectedIdx = i
+			l.adjustViewport()
+			return true
+		}
+	}
+	return false
+}
+
+// GetSelectedJob returns the currently selected job
+func (l *ResourceList) GetSelectedJob() *models.JobInfo {
+	if l.resourceType == ResourceTypeJob && l.selectedIdx >= 0 && l.selectedIdx < len(l.jobs) {
+		return &l.jobs[l.selectedIdx]
+	}
+	return nil
+}
+
+// GetSelectedCronJob returns the currently selected cronjob
+func (l *ResourceList) GetSelectedCronJob() *models.CronJobInfo {
+	if l.resourceType == ResourceTypeCronJob && l.selectedIdx >= 0 && l.selectedIdx < len(l.cronJobs) {
+		return &l.cronJobs[l.selectedIdx]
+	}
+	return nil
+}
+
+// GetSelectedDaemonSet returns the currently selected daemonset
+func (l *ResourceList) GetSelectedDaemonSet() *models.DaemonSetInfo {
+	if l.resourceType == ResourceTypeDaemonSet && l.selectedIdx >= 0 && l.selectedIdx < len(l.daemonSets) {
+		return &l.daemonSets[l.selectedIdx]
+	}
+	return nil
+}
+
+// GetSelectedReplicaSet returns the currently selected replicaset
+func (l *ResourceList) GetSelectedReplicaSet() *models.ReplicaSetInfo {
+	if l.resourceType == ResourceTypeReplicaSet && l.selectedIdx >= 0 && l.selectedIdx < len(l.replicaSets) {
+		return &l.replicaSets[l.selectedIdx]
+	}
+	return nil
+}
+
+// GetSelectedIngress returns the currently selected ingress
+func (l *ResourceList) GetSelectedIngress() *models.IngressInfo {
+	if l.resourceType == ResourceTypeIngress && l.selectedIdx >= 0 && l.selectedIdx < len(l.ingresses) {
+		return &l.ingresses[l.selectedIdx]
+	}
+	return nil
+}
+
+// GetSelectedPersistentVolumeClaim returns the currently selected persistentvolumeclaim
+func (l *ResourceList) GetSelectedPersistentVolumeClaim() *models.PersistentVolumeClaimInfo {
+	if l.resourceType == ResourceTypePersistentVolumeClaim && l.selectedIdx >= 0 && l.selectedIdx < len(l.persistentVolumeClaims) {
+		return &l.persistentVolumeClaims[l.selectedIdx]
+	}
+	return nil
+}
+
+// GetSelectedPersistentVolume returns the currently selected persistentvolume
+func (l *ResourceList) GetSelectedPersistentVolume() *models.PersistentVolumeInfo {
+	if l.resourceType == ResourceTypePersistentVolume && l.selectedIdx >= 0 && l.selectedIdx < len(l.persistentVolumes) {
+		return &l.persistentVolumes[l.selectedIdx]
+	}
+	return nil
+}
+
+// SelectPersistentVolume selects the persistentvolume with the given source cluster and name.
+// It returns false if the volume is not in the list.
+func (l *ResourceList) SelectPersistentVolume(cluster, name string) bool {
+	for i, volume := range l.persistentVolumes {
+		if volume.Cluster == cluster && volume.Name == name {
+			l.selectedIdx = i
+			l.adjustViewport()
+			return true
+		}
+	}
+	return false
+}
+
+// GetSelectedStorageClass returns the currently selected storageclass
+func (l *ResourceList) GetSelectedStorageClass() *models.StorageClassInfo {
+	if l.resourceType == ResourceTypeStorageClass && l.selectedIdx >= 0 && l.selectedIdx < len(l.storageClasses) {
+		return &l.storageClasses[l.selectedIdx]
+	}
+	return nil
+}
+
+// GetSelectedHorizontalPodAutoscaler returns the currently selected horizontalpodautoscaler
+func (l *ResourceList) GetSelectedHorizontalPodAutoscaler() *models.HorizontalPodAutoscalerInfo {
+	if l.resourceType == ResourceTypeHorizontalPodAutoscaler && l.selectedIdx >= 0 && l.selectedIdx < len(l.horizontalPodAutoscalers) {
+		return &l.horizontalPodAutoscalers[l.selectedIdx]
+	}
+	return nil
+}
+
+// GetSelectedServiceAccount returns the currently selected serviceaccount
+func (l *ResourceList) GetSelectedServiceAccount() *models.ServiceAccountInfo {
+	if l.resourceType == ResourceTypeServiceAccount && l.selectedIdx >= 0 && l.selectedIdx < len(l.serviceAccounts) {
+		return &l.serviceAccounts[l.selectedIdx]
+	}
+	return nil
+}
+
+// GetSelectedRole returns the currently selected role
+func (l *ResourceList) GetSelectedRole() *models.RoleInfo {
+	if l.resourceType == ResourceTypeRole && l.selectedIdx >= 0 && l.selectedIdx < len(l.roles) {
+		return &l.roles[l.selectedIdx]
+	}
+	return nil
+}
+
+// GetSelectedClusterRole returns the currently selected clusterrole
+func (l *ResourceList) GetSelectedClusterRole() *models.RoleInfo {
+	if l.resourceType == ResourceTypeClusterRole && l.selectedIdx >= 0 && l.selectedIdx < len(l.clusterRoles) {
+		return &l.clusterRoles[l.selectedIdx]
+	}
+	return nil
+}
+
+// GetSelectedRoleBinding returns the currently selected rolebinding
+func (l *ResourceList) GetSelectedRoleBinding() *models.RoleBindingInfo {
+	if l.resourceType == ResourceTypeRoleBinding && l.selectedIdx >= 0 && l.selectedIdx < len(l.roleBindings) {
+		return &l.roleBindings[l.selectedIdx]
+	}
+	return nil
+}
+
+// GetSelectedClusterRoleBinding returns the currently selected clusterrolebinding
+func (l *ResourceList) GetSelectedClusterRoleBinding() *models.RoleBindingInfo {
+	if l.resourceType == ResourceTypeClusterRoleBinding && l.selectedIdx >= 0 && l.selectedIdx < len(l.clusterRoleBindings) {
+		return &l.clusterRoleBindings[l.selectedIdx]
+	}
+	return nil
+}
+
+// GetSelectedNetworkPolicy returns the currently selected networkpolicy
+func (l *ResourceList) GetSelectedNetworkPolicy() *models.NetworkPolicyInfo {
+	if l.resourceType == ResourceTypeNetworkPolicy && l.selectedIdx >= 0 && l.selectedIdx < len(l.networkPolicies) {
+		return &l.networkPolicies[l.selectedIdx]
+	}
+	return nil
+}
+
+// GetSelectedPodDisruptionBudget returns the currently selected poddisruptionbudget
+func (l *ResourceList) GetSelectedPodDisruptionBudget() *models.PodDisruptionBudgetInfo {
+	if l.resourceType == ResourceTypePodDisruptionBudget && l.selectedIdx >= 0 && l.selectedIdx < len(l.podDisruptionBudgets) {
+		return &l.podDisruptionBudgets[l.selectedIdx]
+	}
+	return nil
+}
+
+// GetSelectedGeneric returns the currently selected object in the generic resource browser
+func (l *ResourceList) GetSelectedGeneric() *models.GenericResourceInfo {
+	if l.resourceType == ResourceTypeGeneric && l.selectedIdx >= 0 && l.selectedIdx < len(l.generic) {
+		return &l.generic[l.selectedIdx]
+	}
+	return nil
+}
+
 // getItemCount returns the number of items in the current resource list
 func (l *ResourceList) getItemCount() int {
 	switch l.resourceType {
@@ -210,11 +713,248 @@ func (l *ResourceList) getItemCount() int {
 		return len(l.statefulSets)
 	case ResourceTypeEvent:
 		return len(l.events)
+	case ResourceTypeConfigMap:
+		return len(l.configMaps)
+	case ResourceTypeSecret:
+		return len(l.secrets)
+	case ResourceTypeNode:
+		return len(l.nodes)
+	case ResourceTypeJob:
+		return len(l.jobs)
+	case ResourceTypeCronJob:
+		return len(l.cronJobs)
+	case ResourceTypeDaemonSet:
+		return len(l.daemonSets)
+	case ResourceTypeReplicaSet:
+		return len(l.replicaSets)
+	case ResourceTypeIngress:
+		return len(l.ingresses)
+	case ResourceTypePersistentVolumeClaim:
+		return len(l.persistentVolumeClaims)
+	case ResourceTypePersistentVolume:
+		return len(l.persistentVolumes)
+	case ResourceTypeStorageClass:
+		return len(l.storageClasses)
+	case ResourceTypeHorizontalPodAutoscaler:
+		return len(l.horizontalPodAutoscalers)
+	case ResourceTypeServiceAccount:
+		return len(l.serviceAccounts)
+	case ResourceTypeRole:
+		return len(l.roles)
+	case ResourceTypeClusterRole:
+		return len(l.clusterRoles)
+	case ResourceTypeRoleBinding:
+		return len(l.roleBindings)
+	case ResourceTypeClusterRoleBinding:
+		return len(l.clusterRoleBindings)
+	case ResourceTypeNetworkPolicy:
+		return len(l.networkPolicies)
+	case ResourceTypePodDisruptionBudget:
+		return len(l.podDisruptionBudgets)
+	case ResourceTypeGeneric:
+		return len(l.generic)
 	default:
 		return 0
 	}
 }
 
+// getItemCluster returns the source cluster of the item at idx in the current resource list
+func (l *ResourceList) getItemCluster(idx int) string {
+	switch l.resourceType {
+	case ResourceTypePod:
+		if idx < len(l.pods) {
+			return l.pods[idx].Cluster
+		}
+	case ResourceTypeService:
+		if idx < len(l.services) {
+			return l.services[idx].Cluster
+		}
+	case ResourceTypeDeployment:
+		if idx < len(l.deployments) {
+			return l.deployments[idx].Cluster
+		}
+	case ResourceTypeStatefulSet:
+		if idx < len(l.statefulSets) {
+			return l.statefulSets[idx].Cluster
+		}
+	case ResourceTypeEvent:
+		if idx < len(l.events) {
+			return l.events[idx].Cluster
+		}
+	case ResourceTypeConfigMap:
+		if idx < len(l.configMaps) {
+			return l.configMaps[idx].Cluster
+		}
+	case ResourceTypeSecret:
+		if idx < len(l.secrets) {
+			return l.secrets[idx].Cluster
+		}
+	case ResourceTypeNode:
+		if idx < len(l.nodes) {
+			return l.nodes[idx].Cluster
+		}
+	case ResourceTypeJob:
+		if idx < len(l.jobs) {
+			return l.jobs[idx].Cluster
+		}
+	case ResourceTypeCronJob:
+		if idx < len(l.cronJobs) {
+			return l.cronJobs[idx].Cluster
+		}
+	case ResourceTypeDaemonSet:
+		if idx < len(l.daemonSets) {
+			return l.daemonSets[idx].Cluster
+		}
+	case ResourceTypeReplicaSet:
+		if idx < len(l.replicaSets) {
+			return l.replicaSets[idx].Cluster
+		}
+	case ResourceTypeIngress:
+		if idx < len(l.ingresses) {
+			return l.ingresses[idx].Cluster
+		}
+	case ResourceTypePersistentVolumeClaim:
+		if idx < len(l.persistentVolumeClaims) {
+			return l.persistentVolumeClaims[idx].Cluster
+		}
+	case ResourceTypePersistentVolume:
+		if idx < len(l.persistentVolumes) {
+			return l.persistentVolumes[idx].Cluster
+		}
+	case ResourceTypeStorageClass:
+		if idx < len(l.storageClasses) {
+			return l.storageClasses[idx].Cluster
+		}
+	case ResourceTypeHorizontalPodAutoscaler:
+		if idx < len(l.horizontalPodAutoscalers) {
+			return l.horizontalPodAutoscalers[idx].Cluster
+		}
+	case ResourceTypeServiceAccount:
+		if idx < len(l.serviceAccounts) {
+			return l.serviceAccounts[idx].Cluster
+		}
+	case ResourceTypeRole:
+		if idx < len(l.roles) {
+			return l.roles[idx].Cluster
+		}
+	case ResourceTypeClusterRole:
+		if idx < len(l.clusterRoles) {
+			return l.clusterRoles[idx].Cluster
+		}
+	case ResourceTypeRoleBinding:
+		if idx < len(l.roleBindings) {
+			return l.roleBindings[idx].Cluster
+		}
+	case ResourceTypeClusterRoleBinding:
+		if idx < len(l.clusterRoleBindings) {
+			return l.clusterRoleBindings[idx].Cluster
+		}
+	case ResourceTypeNetworkPolicy:
+		if idx < len(l.networkPolicies) {
+			return l.networkPolicies[idx].Cluster
+		}
+	case ResourceTypePodDisruptionBudget:
+		if idx < len(l.podDisruptionBudgets) {
+			return l.podDisruptionBudgets[idx].Cluster
+		}
+	case ResourceTypeGeneric:
+		if idx < len(l.generic) {
+			return l.generic[idx].Cluster
+		}
+	}
+	return ""
+}
+
+// GetSelectedRef returns the source cluster, namespace and name of the selected item
+// of any resource type. The namespace is empty for cluster-scoped resources.
+//
+//nolint:gocyclo // Acceptable complexity for resource type switching
+func (l *ResourceList) GetSelectedRef() (cluster, namespace, name string, ok bool) {
+	idx := l.selectedIdx
+	if idx < 0 || idx >= l.getItemCount() {
+		return "", "", "", false
+	}
+
+	switch l.resourceType {
+	case ResourceTypePod:
+		item := l.pods[idx]
+		return item.Cluster, item.Namespace, item.Name, true
+	case ResourceTypeService:
+		item := l.services[idx]
+		return item.Cluster, item.Namespace, item.Name, true
+	case ResourceTypeDeployment:
+		item := l.deployments[idx]
+		return item.Cluster, item.Namespace, item.Name, true
+	case ResourceTypeStatefulSet:
+		item := l.statefulSets[idx]
+		return item.Cluster, item.Namespace, item.Name, true
+	case ResourceTypeEvent:
+		item := l.events[idx]
+		return item.Cluster, item.Namespace, item.Name, true
+	case ResourceTypeConfigMap:
+		item := l.configMaps[idx]
+		return item.Cluster, item.Namespace, item.Name, true
+	case ResourceTypeSecret:
+		item := l.secrets[idx]
+		return item.Cluster, item.Namespace, item.Name, true
+	case ResourceTypeNode:
+		item := l.nodes[idx]
+		return item.Cluster, "", item.Name, true
+	case ResourceTypeJob:
+		item := l.jobs[idx]
+		return item.Cluster, item.Namespace, item.Name, true
+	case ResourceTypeCronJob:
+		item := l.cronJobs[idx]
+		return item.Cluster, item.Namespace, item.Name, true
+	case ResourceTypeDaemonSet:
+		item := l.daemonSets[idx]
+		return item.Cluster, item.Namespace, item.Name, true
+	case ResourceTypeReplicaSet:
+		item := l.replicaSets[idx]
+		return item.Cluster, item.Namespace, item.Name, true
+	case ResourceTypeIngress:
+		item := l.ingresses[idx]
+		return item.Cluster, item.Namespace, item.Name, true
+	case ResourceTypePersistentVolumeClaim:
+		item := l.persistentVolumeClaims[idx]
+		return item.Cluster, item.Namespace, item.Name, true
+	case ResourceTypePersistentVolume:
+		item := l.persistentVolumes[idx]
+		return item.Cluster, "", item.Name, true
+	case ResourceTypeStorageClass:
+		item := l.storageClasses[idx]
+		return item.Cluster, "", item.Name, true
+	case ResourceTypeHorizontalPodAutoscaler:
+		item := l.horizontalPodAutoscalers[idx]
+		return item.Cluster, item.Namespace, item.Name, true
+	case ResourceTypeServiceAccount:
+		item := l.serviceAccounts[idx]
+		return item.Cluster, item.Namespace, item.Name, true
+	case ResourceTypeRole:
+		item := l.roles[idx]
+		return item.Cluster, item.Namespace, item.Name, true
+	case ResourceTypeClusterRole:
+		item := l.clusterRoles[idx]
+		return item.Cluster, item.Namespace, item.Name, true
+	case ResourceTypeRoleBinding:
+		item := l.roleBindings[idx]
+		return item.Cluster, item.Namespace, item.Name, true
+	case ResourceTypeClusterRoleBinding:
+		item := l.clusterRoleBindings[idx]
+		return item.Cluster, item.Namespace, item.Name, true
+	case ResourceTypeNetworkPolicy:
+		item := l.networkPolicies[idx]
+		return item.Cluster, item.Namespace, item.Name, true
+	case ResourceTypePodDisruptionBudget:
+		item := l.podDisruptionBudgets[idx]
+		return item.Cluster, item.Namespace, item.Name, true
+	case ResourceTypeGeneric:
+		item := l.generic[idx]
+		return item.Cluster, item.Namespace, item.Name, true
+	}
+	return "", "", "", false
+}
+
 // adjustViewport ensures the selected item is visible
 func (l *ResourceList) adjustViewport() {
 	visibleHeight := l.height - 3 // Account for header and borders
@@ -364,18 +1104,372 @@ func (l *ResourceList) renderHeader() string {
 			objectWidth, "OBJECT",
 			messageWidth, "MESSAGE",
 		)
-	}
 
-	return styles.TableHeaderStyle.
-		Width(l.width - 4).
-		Render(header)
-}
+	case ResourceTypeConfigMap:
+		nameWidth := 40
+		dataWidth := 6
+		ageWidth := 8
 
-// renderRow renders a single row based on resource type
-func (l *ResourceList) renderRow(idx int, selected bool) string {
-	var row string
+		header = fmt.Sprintf(
+			"%-3s %-*s %-*s %-*s",
+			"",
+			nameWidth, "NAME",
+			dataWidth, "DATA",
+			ageWidth, "AGE",
+		)
 
-	switch l.resourceType {
+	case ResourceTypeSecret:
+		nameWidth := 40
+		typeWidth := 36
+		dataWidth := 6
+		ageWidth := 8
+
+		header = fmt.Sprintf(
+			"%-3s %-*s %-*s %-*s %-*s",
+			"",
+			nameWidth, "NAME",
+			typeWidth, "TYPE",
+			dataWidth, "DATA",
+			ageWidth, "AGE",
+		)
+
+	case ResourceTypeNode:
+		nameWidth := 36
+		statusWidth := 26
+		rolesWidth := 20
+		versionWidth := 14
+		ageWidth := 8
+
+		header = fmt.Sprintf(
+			"%-3s %-*s %-*s %-*s %-*s %-*s",
+			"",
+			nameWidth, "NAME",
+			statusWidth, "STATUS",
+			rolesWidth, "ROLES",
+			versionWidth, "VERSION",
+			ageWidth, "AGE",
+		)
+
+	case ResourceTypeJob:
+		nameWidth := 40
+		statusWidth := 10
+		completionsWidth := 12
+		durationWidth := 9
+		ageWidth := 8
+
+		header = fmt.Sprintf(
+			"%-3s %-*s %-*s %-*s %-*s %-*s",
+			"",
+			nameWidth, "NAME",
+			statusWidth, "STATUS",
+			completionsWidth, "COMPLETIONS",
+			durationWidth, "DURATION",
+			ageWidth, "AGE",
+		)
+
+	case ResourceTypeCronJob:
+		nameWidth := 32
+		scheduleWidth := 16
+		suspendWidth := 8
+		activeWidth := 7
+		lastScheduleWidth := 14
+		ageWidth := 8
+
+		header = fmt.Sprintf(
+			"%-3s %-*s %-*s %-*s %-*s %-*s %-*s",
+			"",
+			nameWidth, "NAME",
+			scheduleWidth, "SCHEDULE",
+			suspendWidth, "SUSPEND",
+			activeWidth, "ACTIVE",
+			lastScheduleWidth, "LAST SCHEDULE",
+			ageWidth, "AGE",
+		)
+
+	case ResourceTypeDaemonSet:
+		nameWidth := 28
+		countWidth := 8
+		upToDateWidth := 11
+		availableWidth := 10
+		nodeSelectorWidth := 24
+		ageWidth := 8
+
+		header = fmt.Sprintf(
+			"%-3s %-*s %-*s %-*s %-*s %-*s %-*s %-*s %-*s",
+			"",
+			nameWidth, "NAME",
+			countWidth, "DESIRED",
+			countWidth, "CURRENT",
+			countWidth, "READY",
+			upToDateWidth, "UP-TO-DATE",
+			availableWidth, "AVAILABLE",
+			nodeSelectorWidth, "NODE SELECTOR",
+			ageWidth, "AGE",
+		)
+
+	case ResourceTypeReplicaSet:
+		nameWidth := 40
+		countWidth := 8
+		ownerWidth := 28
+		ageWidth := 8
+
+		header = fmt.Sprintf(
+			"%-3s %-*s %-*s %-*s %-*s %-*s %-*s",
+			"",
+			nameWidth, "NAME",
+			countWidth, "DESIRED",
+			countWidth, "CURRENT",
+			countWidth, "READY",
+			ownerWidth, "DEPLOYMENT",
+			ageWidth, "AGE",
+		)
+
+	case ResourceTypeIngress:
+		nameWidth := 28
+		classWidth := 12
+		hostsWidth := 32
+		addressWidth := 18
+		portsWidth := 8
+		ageWidth := 8
+
+		header = fmt.Sprintf(
+			"%-3s %-*s %-*s %-*s %-*s %-*s %-*s",
+			"",
+			nameWidth, "NAME",
+			classWidth, "CLASS",
+			hostsWidth, "HOSTS",
+			addressWidth, "ADDRESS",
+			portsWidth, "PORTS",
+			ageWidth, "AGE",
+		)
+
+	case ResourceTypePersistentVolumeClaim:
+		nameWidth := 32
+		statusWidth := 10
+		volumeWidth := 24
+		capacityWidth := 10
+		accessModesWidth := 14
+		storageClassWidth := 16
+		ageWidth := 8
+
+		header = fmt.Sprintf(
+			"%-3s %-*s %-*s %-*s %-*s %-*s %-*s %-*s",
+			"",
+			nameWidth, "NAME",
+			statusWidth, "STATUS",
+			volumeWidth, "VOLUME",
+			capacityWidth, "CAPACITY",
+			accessModesWidth, "ACCESS MODES",
+			storageClassWidth, "STORAGECLASS",
+			ageWidth, "AGE",
+		)
+
+	case ResourceTypePersistentVolume:
+		nameWidth := 28
+		capacityWidth := 10
+		accessModesWidth := 14
+		reclaimWidth := 10
+		statusWidth := 10
+		claimWidth := 32
+		storageClassWidth := 16
+		ageWidth := 8
+
+		header = fmt.Sprintf(
+			"%-3s %-*s %-*s %-*s %-*s %-*s %-*s %-*s %-*s",
+			"",
+			nameWidth, "NAME",
+			capacityWidth, "CAPACITY",
+			accessModesWidth, "ACCESS MODES",
+			reclaimWidth, "RECLAIM",
+			statusWidth, "STATUS",
+			claimWidth, "CLAIM",
+			storageClassWidth, "STORAGECLASS",
+			ageWidth, "AGE",
+		)
+
+	case ResourceTypeStorageClass:
+		nameWidth := 28
+		provisionerWidth := 32
+		reclaimPolicyWidth := 14
+		bindingModeWidth := 22
+		expansionWidth := 10
+		ageWidth := 8
+
+		header = fmt.Sprintf(
+			"%-3s %-*s %-*s %-*s %-*s %-*s %-*s",
+			"",
+			nameWidth, "NAME",
+			provisionerWidth, "PROVISIONER",
+			reclaimPolicyWidth, "RECLAIMPOLICY",
+			bindingModeWidth, "VOLUMEBINDINGMODE",
+			expansionWidth, "EXPANSION",
+			ageWidth, "AGE",
+		)
+
+	case ResourceTypeHorizontalPodAutoscaler:
+		nameWidth := 28
+		referenceWidth := 30
+		targetsWidth := 36
+		minPodsWidth := 8
+		maxPodsWidth := 8
+		replicasWidth := 9
+		ageWidth := 8
+
+		header = fmt.Sprintf(
+			"%-3s %-*s %-*s %-*s %-*s %-*s %-*s %-*s",
+			"",
+			nameWidth, "NAME",
+			referenceWidth, "REFERENCE",
+			targetsWidth, "TARGETS",
+			minPodsWidth, "MINPODS",
+			maxPodsWidth, "MAXPODS",
+			replicasWidth, "REPLICAS",
+			ageWidth, "AGE",
+		)
+
+	case ResourceTypeServiceAccount:
+		nameWidth := 40
+		secretsWidth := 8
+		automountWidth := 10
+		ageWidth := 8
+
+		header = fmt.Sprintf(
+			"%-3s %-*s %-*s %-*s %-*s",
+			"",
+			nameWidth, "NAME",
+			secretsWidth, "SECRETS",
+			automountWidth, "AUTOMOUNT",
+			ageWidth, "AGE",
+		)
+
+	case ResourceTypeRole:
+		nameWidth := 48
+		rulesWidth := 6
+		ageWidth := 8
+
+		header = fmt.Sprintf(
+			"%-3s %-*s %-*s %-*s",
+			"",
+			nameWidth, "NAME",
+			rulesWidth, "RULES",
+			ageWidth, "AGE",
+		)
+
+	case ResourceTypeClusterRole:
+		nameWidth := 56
+		rulesWidth := 6
+		aggregatesWidth := 40
+		ageWidth := 8
+
+		header = fmt.Sprintf(
+			"%-3s %-*s %-*s %-*s %-*s",
+			"",
+			nameWidth, "NAME",
+			rulesWidth, "RULES",
+			aggregatesWidth, "AGGREGATES",
+			ageWidth, "AGE",
+		)
+
+	case ResourceTypeRoleBinding:
+		nameWidth := 36
+		roleWidth := 36
+		subjectsWidth := 60
+		ageWidth := 8
+
+		header = fmt.Sprintf(
+			"%-3s %-*s %-*s %-*s %-*s",
+			"",
+			nameWidth, "NAME",
+			roleWidth, "ROLE",
+			subjectsWidth, "SUBJECTS",
+			ageWidth, "AGE",
+		)
+
+	case ResourceTypeClusterRoleBinding:
+		nameWidth := 40
+		roleWidth := 40
+		subjectsWidth := 60
+		ageWidth := 8
+
+		header = fmt.Sprintf(
+			"%-3s %-*s %-*s %-*s %-*s",
+			"",
+			nameWidth, "NAME",
+			roleWidth, "ROLE",
+			subjectsWidth, "SUBJECTS",
+			ageWidth, "AGE",
+		)
+
+	case ResourceTypeNetworkPolicy:
+		nameWidth := 40
+		podSelectorWidth := 40
+		policyTypesWidth := 16
+		ageWidth := 8
+
+		header = fmt.Sprintf(
+			"%-3s %-*s %-*s %-*s %-*s",
+			"",
+			nameWidth, "NAME",
+			podSelectorWidth, "POD-SELECTOR",
+			policyTypesWidth, "POLICY TYPES",
+			ageWidth, "AGE",
+		)
+
+	case ResourceTypePodDisruptionBudget:
+		nameWidth := 40
+		minAvailableWidth := 14
+		maxUnavailableWidth := 16
+		allowedDisruptionsWidth := 20
+		healthyWidth := 10
+		ageWidth := 8
+
+		header = fmt.Sprintf(
+			"%-3s %-*s %-*s %-*s %-*s %-*s %-*s",
+			"",
+			nameWidth, "NAME",
+			minAvailableWidth, "MIN AVAILABLE",
+			maxUnavailableWidth, "MAX UNAVAILABLE",
+			allowedDisruptionsWidth, "ALLOWED DISRUPTIONS",
+			healthyWidth, "HEALTHY",
+			ageWidth, "AGE",
+		)
+
+	case ResourceTypeGeneric:
+		if len(l.tableColumns) > 0 {
+			header = l.renderTableHeader()
+			break
+		}
+
+		nameWidth := 30
+		namespaceWidth := 16
+		statusWidth := 20
+		ageWidth := 8
+
+		header = fmt.Sprintf(
+			"%-3s %-*s %-*s %-*s %-*s",
+			"",
+			nameWidth, "NAME",
+			namespaceWidth, "NAMESPACE",
+			statusWidth, "STATUS",
+			ageWidth, "AGE",
+		)
+	}
+
+	if l.showCluster && len(header) >= 4 {
+		// Insert the CLUSTER column after the symbol column
+		header = header[:4] + fmt.Sprintf("%-*s ", clusterColumnWidth, "CLUSTER") + header[4:]
+	}
+
+	return styles.TableHeaderStyle.
+		Width(l.width - 4).
+		Render(header)
+}
+
+// renderRow renders a single row based on resource type
+func (l *ResourceList) renderRow(idx int, selected bool) string {
+	var row string
+
+	switch l.resourceType {
 	case ResourceTypePod:
 		row = l.renderPodRow(idx)
 	case ResourceTypeService:
@@ -386,12 +1480,62 @@ func (l *ResourceList) renderRow(idx int, selected bool) string {
 		row = l.renderStatefulSetRow(idx)
 	case ResourceTypeEvent:
 		row = l.renderEventRow(idx)
+	case ResourceTypeConfigMap:
+		row = l.renderConfigMapRow(idx)
+	case ResourceTypeSecret:
+		row = l.renderSecretRow(idx)
+	case ResourceTypeNode:
+		row = l.renderNodeRow(idx)
+	case ResourceTypeJob:
+		row = l.renderJobRow(idx)
+	case ResourceTypeCronJob:
+		row = l.renderCronJobRow(idx)
+	case ResourceTypeDaemonSet:
+		row = l.renderDaemonSetRow(idx)
+	case ResourceTypeReplicaSet:
+		row = l.renderReplicaSetRow(idx)
+	case ResourceTypeIngress:
+		row = l.renderIngressRow(idx)
+	case ResourceTypePersistentVolumeClaim:
+		row = l.renderPersistentVolumeClaimRow(idx)
+	case ResourceTypePersistentVolume:
+		row = l.renderPersistentVolumeRow(idx)
+	case ResourceTypeStorageClass:
+		row = l.renderStorageClassRow(idx)
+	case ResourceTypeHorizontalPodAutoscaler:
+		row = l.renderHorizontalPodAutoscalerRow(idx)
+	case ResourceTypeServiceAccount:
+		row = l.renderServiceAccountRow(idx)
+	case ResourceTypeRole:
+		row = l.renderRoleRow(idx)
+	case ResourceTypeClusterRole:
+		row = l.renderClusterRoleRow(idx)
+	case ResourceTypeRoleBinding:
+		row = l.renderRoleBindingRow(idx)
+	case ResourceTypeClusterRoleBinding:
+		row = l.renderClusterRoleBindingRow(idx)
+	case ResourceTypeNetworkPolicy:
+		row = l.renderNetworkPolicyRow(idx)
+	case ResourceTypePodDisruptionBudget:
+		row = l.renderPodDisruptionBudgetRow(idx)
+	case ResourceTypeGeneric:
+		row = l.renderGenericRow(idx)
 	}
 
 	if row == "" {
 		return ""
 	}
 
+	if l.showCluster {
+		// Insert the CLUSTER column after the status symbol
+		symbol, rest, _ := strings.Cut(row, " ")
+		cluster := l.getItemCluster(idx)
+		if len(cluster) > clusterColumnWidth {
+			cluster = cluster[:clusterColumnWidth-3] + "..."
+		}
+		row = fmt.Sprintf("%s %-*s %s", symbol, clusterColumnWidth, cluster, rest)
+	}
+
 	// Apply selection style
 	if selected {
 		return styles.SelectedListItemStyle.
@@ -563,85 +1707,1054 @@ func (l *ResourceList) renderEventRow(idx int) string {
 	)
 }
 
-// AddOrUpdatePod adds a new pod or updates an existing one
-func (l *ResourceList) AddOrUpdatePod(pod models.PodInfo) {
-	// Find if pod already exists
-	for i, existing := range l.pods {
-		if existing.Namespace == pod.Namespace && existing.Name == pod.Name {
-			// Update existing pod
-			l.pods[i] = pod
-			return
-		}
+func (l *ResourceList) renderConfigMapRow(idx int) string {
+	if idx >= len(l.configMaps) {
+		return ""
 	}
-	// Add new pod
-	l.pods = append(l.pods, pod)
-}
+	cm := l.configMaps[idx]
+	symbol := cm.GetStatusSymbol()
 
-// RemovePod removes a pod by namespace and name
-func (l *ResourceList) RemovePod(namespace, name string) {
-	for i, pod := range l.pods {
-		if pod.Namespace == namespace && pod.Name == name {
-			// Remove pod from slice
-			l.pods = append(l.pods[:i], l.pods[i+1:]...)
-			// Adjust selection if needed
-			if l.selectedIdx >= len(l.pods) && len(l.pods) > 0 {
-				l.selectedIdx = len(l.pods) - 1
-			}
-			if len(l.pods) == 0 {
-				l.selectedIdx = 0
-			}
-			return
-		}
+	nameWidth := 40
+	dataWidth := 6
+	ageWidth := 8
+
+	name := cm.Name
+	if len(name) > nameWidth {
+		name = name[:nameWidth-3] + "..."
 	}
+
+	return fmt.Sprintf(
+		"%s %-*s %-*d %-*s",
+		symbol,
+		nameWidth, name,
+		dataWidth, cm.DataCount,
+		ageWidth, cm.Age,
+	)
 }
 
-// AddOrUpdateService adds a new service or updates an existing one
-func (l *ResourceList) AddOrUpdateService(service models.ServiceInfo) {
-	for i, existing := range l.services {
-		if existing.Namespace == service.Namespace && existing.Name == service.Name {
-			l.services[i] = service
-			return
-		}
+func (l *ResourceList) renderSecretRow(idx int) string {
+	if idx >= len(l.secrets) {
+		return ""
 	}
-	l.services = append(l.services, service)
-}
+	secret := l.secrets[idx]
+	symbol := secret.GetStatusSymbol()
 
-// RemoveService removes a service by namespace and name
-func (l *ResourceList) RemoveService(namespace, name string) {
-	for i, svc := range l.services {
-		if svc.Namespace == namespace && svc.Name == name {
-			l.services = append(l.services[:i], l.services[i+1:]...)
-			if l.selectedIdx >= len(l.services) && len(l.services) > 0 {
-				l.selectedIdx = len(l.services) - 1
-			}
-			if len(l.services) == 0 {
-				l.selectedIdx = 0
-			}
-			return
-		}
+	nameWidth := 40
+	typeWidth := 36
+	dataWidth := 6
+	ageWidth := 8
+
+	name := secret.Name
+	if len(name) > nameWidth {
+		name = name[:nameWidth-3] + "..."
 	}
-}
 
-// AddOrUpdateDeployment adds a new deployment or updates an existing one
-func (l *ResourceList) AddOrUpdateDeployment(deployment models.DeploymentInfo) {
-	for i, existing := range l.deployments {
-		if existing.Namespace == deployment.Namespace && existing.Name == deployment.Name {
-			l.deployments[i] = deployment
-			return
-		}
+	secretType := secret.Type
+	if len(secretType) > typeWidth {
+		secretType = secretType[:typeWidth-3] + "..."
 	}
-	l.deployments = append(l.deployments, deployment)
+
+	return fmt.Sprintf(
+		"%s %-*s %-*s %-*d %-*s",
+		symbol,
+		nameWidth, name,
+		typeWidth, secretType,
+		dataWidth, secret.DataCount,
+		ageWidth, secret.Age,
+	)
+}
+
+func (l *ResourceList) renderNodeRow(idx int) string {
+	if idx >= len(l.nodes) {
+		return ""
+	}
+	node := l.nodes[idx]
+	symbol := node.GetStatusSymbol()
+
+	nameWidth := 36
+	statusWidth := 26
+	rolesWidth := 20
+	versionWidth := 14
+	ageWidth := 8
+
+	name := node.Name
+	if len(name) > nameWidth {
+		name = name[:nameWidth-3] + "..."
+	}
+
+	roles := node.Roles
+	if len(roles) > rolesWidth {
+		roles = roles[:rolesWidth-3] + "..."
+	}
+
+	return fmt.Sprintf(
+		"%s %-*s %-*s %-*s %-*s %-*s",
+		symbol,
+		nameWidth, name,
+		statusWidth, node.Status,
+		rolesWidth, roles,
+		versionWidth, node.KubeletVersion,
+		ageWidth, node.Age,
+	)
+}
+
+func (l *ResourceList) renderJobRow(idx int) string {
+	if idx >= len(l.jobs) {
+		return ""
+	}
+	job := l.jobs[idx]
+	symbol := job.GetStatusSymbol()
+
+	nameWidth := 40
+	statusWidth := 10
+	completionsWidth := 12
+	durationWidth := 9
+	ageWidth := 8
+
+	name := job.Name
+	if len(name) > nameWidth {
+		name = name[:nameWidth-3] + "..."
+	}
+
+	return fmt.Sprintf(
+		"%s %-*s %-*s %-*s %-*s %-*s",
+		symbol,
+		nameWidth, name,
+		statusWidth, job.Status,
+		completionsWidth, job.Completions,
+		durationWidth, job.Duration,
+		ageWidth, job.Age,
+	)
+}
+
+func (l *ResourceList) renderCronJobRow(idx int) string {
+	if idx >= len(l.cronJobs) {
+		return ""
+	}
+	cronJob := l.cronJobs[idx]
+	symbol := cronJob.GetStatusSymbol()
+
+	nameWidth := 32
+	scheduleWidth := 16
+	suspendWidth := 8
+	activeWidth := 7
+	lastScheduleWidth := 14
+	ageWidth := 8
+
+	name := cronJob.Name
+	if len(name) > nameWidth {
+		name = name[:nameWidth-3] + "..."
+	}
+
+	schedule := cronJob.Schedule
+	if len(schedule) > scheduleWidth {
+		schedule = schedule[:scheduleWidth-3] + "..."
+	}
+
+	return fmt.Sprintf(
+		"%s %-*s %-*s %-*v %-*d %-*s %-*s",
+		symbol,
+		nameWidth, name,
+		scheduleWidth, schedule,
+		suspendWidth, cronJob.Suspend,
+		activeWidth, cronJob.Active,
+		lastScheduleWidth, cronJob.LastSchedule,
+		ageWidth, cronJob.Age,
+	)
+}
+
+func (l *ResourceList) renderDaemonSetRow(idx int) string {
+	if idx >= len(l.daemonSets) {
+		return ""
+	}
+	ds := l.daemonSets[idx]
+	symbol := ds.GetStatusSymbol()
+
+	nameWidth := 28
+	countWidth := 8
+	upToDateWidth := 11
+	availableWidth := 10
+	nodeSelectorWidth := 24
+	ageWidth := 8
+
+	name := ds.Name
+	if len(name) > nameWidth {
+		name = name[:nameWidth-3] + "..."
+	}
+
+	nodeSelector := ds.NodeSelector
+	if len(nodeSelector) > nodeSelectorWidth {
+		nodeSelector = nodeSelector[:nodeSelectorWidth-3] + "..."
+	}
+
+	return fmt.Sprintf(
+		"%s %-*s %-*d %-*d %-*d %-*d %-*d %-*s %-*s",
+		symbol,
+		nameWidth, name,
+		countWidth, ds.Desired,
+		countWidth, ds.Current,
+		countWidth, ds.Ready,
+		upToDateWidth, ds.UpToDate,
+		availableWidth, ds.Available,
+		nodeSelectorWidth, nodeSelector,
+		ageWidth, ds.Age,
+	)
+}
+
+func (l *ResourceList) renderReplicaSetRow(idx int) string {
+	if idx >= len(l.replicaSets) {
+		return ""
+	}
+	rs := l.replicaSets[idx]
+	symbol := rs.GetStatusSymbol()
+
+	nameWidth := 40
+	countWidth := 8
+	ownerWidth := 28
+	ageWidth := 8
+
+	name := rs.Name
+	if len(name) > nameWidth {
+		name = name[:nameWidth-3] + "..."
+	}
+
+	owner := rs.Owner
+	if owner == "" {
+		owner = "<none>"
+	}
+	if len(owner) > ownerWidth {
+		owner = owner[:ownerWidth-3] + "..."
+	}
+
+	return fmt.Sprintf(
+		"%s %-*s %-*d %-*d %-*d %-*s %-*s",
+		symbol,
+		nameWidth, name,
+		countWidth, rs.Desired,
+		countWidth, rs.Current,
+		countWidth, rs.Ready,
+		ownerWidth, owner,
+		ageWidth, rs.Age,
+	)
+}
+
+func (l *ResourceList) renderIngressRow(idx int) string {
+	if idx >= len(l.ingresses) {
+		return ""
+	}
+	ingress := l.ingresses[idx]
+	symbol := ingress.GetStatusSymbol()
+
+	nameWidth := 28
+	classWidth := 12
+	hostsWidth := 32
+	addressWidth := 18
+	portsWidth := 8
+	ageWidth := 8
+
+	name := ingress.Name
+	if len(name) > nameWidth {
+		name = name[:nameWidth-3] + "..."
+	}
+
+	class := ingress.Class
+	if len(class) > classWidth {
+		class = class[:classWidth-3] + "..."
+	}
+
+	hosts := ingress.Hosts
+	if len(hosts) > hostsWidth {
+		hosts = hosts[:hostsWidth-3] + "..."
+	}
+
+	address := ingress.Addresses
+	if len(address) > addressWidth {
+		address = address[:addressWidth-3] + "..."
+	}
+
+	return fmt.Sprintf(
+		"%s %-*s %-*s %-*s %-*s %-*s %-*s",
+		symbol,
+		nameWidth, name,
+		classWidth, class,
+		hostsWidth, hosts,
+		addressWidth, address,
+		portsWidth, ingress.Ports,
+		ageWidth, ingress.Age,
+	)
+}
+
+func (l *ResourceList) renderPersistentVolumeClaimRow(idx int) string {
+	if idx >= len(l.persistentVolumeClaims) {
+		return ""
+	}
+	claim := l.persistentVolumeClaims[idx]
+	symbol := claim.GetStatusSymbol()
+
+	nameWidth := 32
+	statusWidth := 10
+	volumeWidth := 24
+	capacityWidth := 10
+	accessModesWidth := 14
+	storageClassWidth := 16
+	ageWidth := 8
+
+	name := claim.Name
+	if len(name) > nameWidth {
+		name = name[:nameWidth-3] + "..."
+	}
+
+	volume := claim.Volume
+	if len(volume) > volumeWidth {
+		volume = volume[:volumeWidth-3] + "..."
+	}
+
+	storageClass := claim.StorageClass
+	if len(storageClass) > storageClassWidth {
+		storageClass = storageClass[:storageClassWidth-3] + "..."
+	}
+
+	return fmt.Sprintf(
+		"%s %-*s %-*s %-*s %-*s %-*s %-*s %-*s",
+		symbol,
+		nameWidth, name,
+		statusWidth, claim.Status,
+		volumeWidth, volume,
+		capacityWidth, claim.Capacity,
+		accessModesWidth, claim.AccessModes,
+		storageClassWidth, storageClass,
+		ageWidth, claim.Age,
+	)
+}
+
+func (l *ResourceList) renderPersistentVolumeRow(idx int) string {
+	if idx >= len(l.persistentVolumes) {
+		return ""
+	}
+	volume := l.persistentVolumes[idx]
+	symbol := volume.GetStatusSymbol()
+
+	nameWidth := 28
+	capacityWidth := 10
+	accessModesWidth := 14
+	reclaimWidth := 10
+	statusWidth := 10
+	claimWidth := 32
+	storageClassWidth := 16
+	ageWidth := 8
+
+	name := volume.Name
+	if len(name) > nameWidth {
+		name = name[:nameWidth-3] + "..."
+	}
+
+	claim := volume.Claim
+	if len(claim) > claimWidth {
+		claim = claim[:claimWidth-3] + "..."
+	}
+
+	storageClass := volume.StorageClass
+	if len(storageClass) > storageClassWidth {
+		storageClass = storageClass[:storageClassWidth-3] + "..."
+	}
+
+	return fmt.Sprintf(
+		"%s %-*s %-*s %-*s %-*s %-*s %-*s %-*s %-*s",
+		symbol,
+		nameWidth, name,
+		capacityWidth, volume.Capacity,
+		accessModesWidth, volume.AccessModes,
+		reclaimWidth, volume.ReclaimPolicy,
+		statusWidth, volume.Status,
+		claimWidth, claim,
+		storageClassWidth, storageClass,
+		ageWidth, volume.Age,
+	)
+}
+
+func (l *ResourceList) renderStorageClassRow(idx int) string {
+	if idx >= len(l.storageClasses) {
+		return ""
+	}
+	storageClass := l.storageClasses[idx]
+	symbol := storageClass.GetStatusSymbol()
+
+	nameWidth := 28
+	provisionerWidth := 32
+	reclaimPolicyWidth := 14
+	bindingModeWidth := 22
+	expansionWidth := 10
+	ageWidth := 8
+
+	name := storageClass.Name
+	if len(name) > nameWidth {
+		name = name[:nameWidth-3] + "..."
+	}
+
+	provisioner := storageClass.Provisioner
+	if len(provisioner) > provisionerWidth {
+		provisioner = provisioner[:provisionerWidth-3] + "..."
+	}
+
+	return fmt.Sprintf(
+		"%s %-*s %-*s %-*s %-*s %-*s %-*s",
+		symbol,
+		nameWidth, name,
+		provisionerWidth, provisioner,
+		reclaimPolicyWidth, storageClass.ReclaimPolicy,
+		bindingModeWidth, storageClass.VolumeBindingMode,
+		expansionWidth, fmt.Sprintf("%v", storageClass.AllowVolumeExpansion),
+		ageWidth, storageClass.Age,
+	)
+}
+
+func (l *ResourceList) renderHorizontalPodAutoscalerRow(idx int) string {
+	if idx >= len(l.horizontalPodAutoscalers) {
+		return ""
+	}
+	hpa := l.horizontalPodAutoscalers[idx]
+	symbol := hpa.GetStatusSymbol()
+
+	nameWidth := 28
+	referenceWidth := 30
+	targetsWidth := 36
+	minPodsWidth := 8
+	maxPodsWidth := 8
+	replicasWidth := 9
+	ageWidth := 8
+
+	name := hpa.Name
+	if len(name) > nameWidth {
+		name = name[:nameWidth-3] + "..."
+	}
+
+	reference := hpa.Reference()
+	if len(reference) > referenceWidth {
+		reference = reference[:referenceWidth-3] + "..."
+	}
+
+	targets := hpa.Targets
+	if len(targets) > targetsWidth {
+		targets = targets[:targetsWidth-3] + "..."
+	}
+
+	return fmt.Sprintf(
+		"%s %-*s %-*s %-*s %-*s %-*s %-*s %-*s",
+		symbol,
+		nameWidth, name,
+		referenceWidth, reference,
+		targetsWidth, targets,
+		minPodsWidth, fmt.Sprintf("%d", hpa.MinReplicas),
+		maxPodsWidth, fmt.Sprintf("%d", hpa.MaxReplicas),
+		replicasWidth, fmt.Sprintf("%d", hpa.CurrentReplicas),
+		ageWidth, hpa.Age,
+	)
+}
+
+func (l *ResourceList) renderServiceAccountRow(idx int) string {
+	if idx >= len(l.serviceAccounts) {
+		return ""
+	}
+	serviceAccount := l.serviceAccounts[idx]
+	symbol := serviceAccount.GetStatusSymbol()
+
+	nameWidth := 40
+	secretsWidth := 8
+	automountWidth := 10
+	ageWidth := 8
+
+	name := serviceAccount.Name
+	if len(name) > nameWidth {
+		name = name[:nameWidth-3] + "..."
+	}
+
+	return fmt.Sprintf(
+		"%s %-*s %-*s %-*s %-*s",
+		symbol,
+		nameWidth, name,
+		secretsWidth, fmt.Sprintf("%d", serviceAccount.Secrets),
+		automountWidth, serviceAccount.AutomountToken,
+		ageWidth, serviceAccount.Age,
+	)
+}
+
+func (l *ResourceList) renderRoleRow(idx int) string {
+	if idx >= len(l.roles) {
+		return ""
+	}
+	role := l.roles[idx]
+	symbol := role.GetStatusSymbol()
+
+	nameWidth := 48
+	rulesWidth := 6
+	ageWidth := 8
+
+	name := role.Name
+	if len(name) > nameWidth {
+		name = name[:nameWidth-3] + "..."
+	}
+
+	return fmt.Sprintf(
+		"%s %-*s %-*s %-*s",
+		symbol,
+		nameWidth, name,
+		rulesWidth, fmt.Sprintf("%d", len(role.Rules)),
+		ageWidth, role.Age,
+	)
+}
+
+func (l *ResourceList) renderClusterRoleRow(idx int) string {
+	if idx >= len(l.clusterRoles) {
+		return ""
+	}
+	clusterRole := l.clusterRoles[idx]
+	symbol := clusterRole.GetStatusSymbol()
+
+	nameWidth := 56
+	rulesWidth := 6
+	aggregatesWidth := 40
+	ageWidth := 8
+
+	name := clusterRole.Name
+	if len(name) > nameWidth {
+		name = name[:nameWidth-3] + "..."
+	}
+
+	aggregates := strings.Join(clusterRole.AggregateOf, ",")
+	if len(aggregates) > aggregatesWidth {
+		aggregates = aggregates[:aggregatesWidth-3] + "..."
+	}
+
+	return fmt.Sprintf(
+		"%s %-*s %-*s %-*s %-*s",
+		symbol,
+		nameWidth, name,
+		rulesWidth, fmt.Sprintf("%d", len(clusterRole.Rules)),
+		aggregatesWidth, aggregates,
+		ageWidth, clusterRole.Age,
+	)
+}
+
+func (l *ResourceList) renderRoleBindingRow(idx int) string {
+	if idx >= len(l.roleBindings) {
+		return ""
+	}
+	binding := l.roleBindings[idx]
+	symbol := binding.GetStatusSymbol()
+
+	nameWidth := 36
+	roleWidth := 36
+	subjectsWidth := 60
+	ageWidth := 8
+
+	name := binding.Name
+	if len(name) > nameWidth {
+		name = name[:nameWidth-3] + "..."
+	}
+
+	role := binding.RoleRef()
+	if len(role) > roleWidth {
+		role = role[:roleWidth-3] + "..."
+	}
+
+	subjects := binding.SubjectsSummary()
+	if len(subjects) > subjectsWidth {
+		subjects = subjects[:subjectsWidth-3] + "..."
+	}
+
+	return fmt.Sprintf(
+		"%s %-*s %-*s %-*s %-*s",
+		symbol,
+		nameWidth, name,
+		roleWidth, role,
+		subjectsWidth, subjects,
+		ageWidth, binding.Age,
+	)
+}
+
+func (l *ResourceList) renderClusterRoleBindingRow(idx int) string {
+	if idx >= len(l.clusterRoleBindings) {
+		return ""
+	}
+	clusterBinding := l.clusterRoleBindings[idx]
+	symbol := clusterBinding.GetStatusSymbol()
+
+	nameWidth := 40
+	roleWidth := 40
+	subjectsWidth := 60
+	ageWidth := 8
+
+	name := clusterBinding.Name
+	if len(name) > nameWidth {
+		name = name[:nameWidth-3] + "..."
+	}
+
+	role := clusterBinding.RoleRef()
+	if len(role) > roleWidth {
+		role = role[:roleWidth-3] + "..."
+	}
+
+	subjects := clusterBinding.SubjectsSummary()
+	if len(subjects) > subjectsWidth {
+		subjects = subjects[:subjectsWidth-3] + "..."
+	}
+
+	return fmt.Sprintf(
+		"%s %-*s %-*s %-*s %-*s",
+		symbol,
+		nameWidth, name,
+		roleWidth, role,
+		subjectsWidth, subjects,
+		ageWidth, clusterBinding.Age,
+	)
+}
+
+func (l *ResourceList) renderNetworkPolicyRow(idx int) string {
+	if idx >= len(l.networkPolicies) {
+		return ""
+	}
+	policy := l.networkPolicies[idx]
+	symbol := policy.GetStatusSymbol()
+
+	nameWidth := 40
+	podSelectorWidth := 40
+	policyTypesWidth := 16
+	ageWidth := 8
+
+	name := policy.Name
+	if len(name) > nameWidth {
+		name = name[:nameWidth-3] + "..."
+	}
+
+	podSelector := policy.PodSelector
+	if len(podSelector) > podSelectorWidth {
+		podSelector = podSelector[:podSelectorWidth-3] + "..."
+	}
+
+	return fmt.Sprintf(
+		"%s %-*s %-*s %-*s %-*s",
+		symbol,
+		nameWidth, name,
+		podSelectorWidth, podSelector,
+		policyTypesWidth, policy.PolicyTypesSummary(),
+		ageWidth, policy.Age,
+	)
+}
+
+func (l *ResourceList) renderPodDisruptionBudgetRow(idx int) string {
+	if idx >= len(l.podDisruptionBudgets) {
+		return ""
+	}
+	budget := l.podDisruptionBudgets[idx]
+	symbol := budget.GetStatusSymbol()
+
+	nameWidth := 40
+	minAvailableWidth := 14
+	maxUnavailableWidth := 16
+	allowedDisruptionsWidth := 20
+	healthyWidth := 10
+	ageWidth := 8
+
+	name := budget.Name
+	if len(name) > nameWidth {
+		name = name[:nameWidth-3] + "..."
+	}
+
+	return fmt.Sprintf(
+		"%s %-*s %-*s %-*s %-*s %-*s %-*s",
+		symbol,
+		nameWidth, name,
+		minAvailableWidth, budget.MinAvailable,
+		maxUnavailableWidth, budget.MaxUnavailable,
+		allowedDisruptionsWidth, fmt.Sprintf("%d", budget.DisruptionsAllowed),
+		healthyWidth, fmt.Sprintf("%d/%d", budget.CurrentHealthy, budget.DesiredHealthy),
+		ageWidth, budget.Age,
+	)
+}
+
+// maxTableColumnWidth caps server-side table columns so one long value cannot hide the rest
+const maxTableColumnWidth = 40
+
+// tableColumnWidths sizes each server-side table column to fit its header and cells
+func (l *ResourceList) tableColumnWidths() []int {
+	widths := make([]int, len(l.tableColumns))
+	for i, column := range l.tableColumns {
+		widths[i] = len(column)
+	}
+	for _, obj := range l.generic {
+		for i, cell := range obj.Cells {
+			if i < len(widths) && len(cell) > widths[i] {
+				widths[i] = len(cell)
+			}
+		}
+	}
+	for i := range widths {
+		if widths[i] > maxTableColumnWidth {
+			widths[i] = maxTableColumnWidth
+		}
+	}
+	return widths
+}
+
+// renderTableHeader renders the header for server-side table columns
+func (l *ResourceList) renderTableHeader() string {
+	widths := l.tableColumnWidths()
+	parts := make([]string, len(l.tableColumns))
+	for i, column := range l.tableColumns {
+		parts[i] = fmt.Sprintf("%-*s", widths[i], strings.ToUpper(column))
+	}
+	return fmt.Sprintf("%-3s %s", "", strings.Join(parts, " "))
+}
+
+// renderTableRow renders a generic resource using its server-side table cells
+func (l *ResourceList) renderTableRow(obj models.GenericResourceInfo) string {
+	cells := obj.Cells
+	if len(cells) == 0 {
+		// Not rendered by the server yet (e.g. just arrived from a watch)
+		cells = []string{obj.Name}
+	}
+
+	widths := l.tableColumnWidths()
+	parts := make([]string, len(widths))
+	for i, width := range widths {
+		cell := ""
+		if i < len(cells) {
+			cell = cells[i]
+		}
+		if len(cell) > width {
+			cell = cell[:width-3] + "..."
+		}
+		parts[i] = fmt.Sprintf("%-*s", width, cell)
+	}
+	return fmt.Sprintf("%s %s", obj.GetStatusSymbol(), strings.Join(parts, " "))
+}
+
+func (l *ResourceList) renderGenericRow(idx int) string {
+	if idx >= len(l.generic) {
+		return ""
+	}
+	obj := l.generic[idx]
+	if len(l.tableColumns) > 0 {
+		return l.renderTableRow(obj)
+	}
+	symbol := obj.GetStatusSymbol()
+
+	nameWidth := 30
+	namespaceWidth := 16
+	statusWidth := 20
+	ageWidth := 8
+
+	name := obj.Name
+	if len(name) > nameWidth {
+		name = name[:nameWidth-3] + "..."
+	}
+
+	namespace := obj.Namespace
+	if len(namespace) > namespaceWidth {
+		namespace = namespace[:namespaceWidth-3] + "..."
+	}
+
+	status := obj.Status
+	if len(status) > statusWidth {
+		status = status[:statusWidth-3] + "..."
+	}
+
+	return fmt.Sprintf(
+		"%s %-*s %-*s %-*s %-*s",
+		symbol,
+		nameWidth, name,
+		namespaceWidth, namespace,
+		statusWidth, status,
+		ageWidth, obj.Age,
+	)
+}
+
+// AddOrUpdatePod adds a new pod or updates an existing one
+func (l *ResourceList) AddOrUpdatePod(pod models.PodInfo) {
+	// Find if pod already exists
+	for i, existing := range l.pods {
+		if existing.Cluster == pod.Cluster && existing.Namespace == pod.Namespace && existing.Name == pod.Name {
+			// Update existing pod
+			l.pods[i] = pod
+			return
+		}
+	}
+	// Add new pod
+	l.pods = append(l.pods, pod)
+}
+
+// RemovePod removes a pod by namespace and name
+func (l *ResourceList) RemovePod(namespace, name string) {
+	l.RemovePodFromCluster("", namespace, name)
+}
+
+// RemovePodFromCluster removes a pod by source cluster, namespace and name
+func (l *ResourceList) RemovePodFromCluster(cluster, namespace, name string) {
+	for i, pod := range l.pods {
+		if pod.Cluster == cluster && pod.Namespace == namespace && pod.Name == name {
+			// Remove pod from slice
+			l.pods = append(l.pods[:i], l.pods[i+1:]...)
+			// Adjust selection if needed
+			if l.selectedIdx >= len(l.pods) && len(l.pods) > 0 {
+				l.selectedIdx = len(l.pods) - 1
+			}
+			if len(l.pods) == 0 {
+				l.selectedIdx = 0
+			}
+			return
+		}
+	}
+}
+
+// AddOrUpdateService adds a new service or updates an existing one
+func (l *ResourceList) AddOrUpdateService(service models.ServiceInfo) {
+	for i, existing := range l.services {
+		if existing.Cluster == service.Cluster && existing.Namespace == service.Namespace && existing.Name == service.Name {
+			l.services[i] = service
+			return
+		}
+	}
+	l.services = append(l.services, service)
+}
+
+// RemoveService removes a service by namespace and name
+func (l *ResourceList) RemoveService(namespace, name string) {
+	l.RemoveServiceFromCluster("", namespace, name)
+}
+
+// RemoveServiceFromCluster removes a service by source cluster, namespace and name
+func (l *ResourceList) RemoveServiceFromCluster(cluster, namespace, name string) {
+	for i, svc := range l.services {
+		if svc.Cluster == cluster && svc.Namespace == namespace && svc.Name == name {
+			l.services = append(l.services[:i], l.services[i+1:]...)
+			if l.selectedIdx >= len(l.services) && len(l.services) > 0 {
+				l.selectedIdx = len(l.services) - 1
+			}
+			if len(l.services) == 0 {
+				l.selectedIdx = 0
+			}
+			return
+		}
+	}
+}
+
+// AddOrUpdateDeployment adds a new deployment or updates an existing one
+func (l *ResourceList) AddOrUpdateDeployment(deployment models.DeploymentInfo) {
+	for i, existing := range l.deployments {
+		if existing.Cluster == deployment.Cluster && existing.Namespace == deployment.Namespace && existing.Name == deployment.Name {
+			l.deployments[i] = deployment
+			return
+		}
+	}
+	l.deployments = append(l.deployments, deployment)
+}
+
+// RemoveDeployment removes a deployment by namespace and name
+func (l *ResourceList) RemoveDeployment(namespace, name string) {
+	l.RemoveDeploymentFromCluster("", namespace, name)
+}
+
+// RemoveDeploymentFromCluster removes a deployment by source cluster, namespace and name
+func (l *ResourceList) RemoveDeploymentFromCluster(cluster, namespace, name string) {
+	for i, dep := range l.deployments {
+		if dep.Cluster == cluster && dep.Namespace == namespace && dep.Name == name {
+			l.deployments = append(l.deployments[:i], l.deployments[i+1:]...)
+			if l.selectedIdx >= len(l.deployments) && len(l.deployments) > 0 {
+				l.selectedIdx = len(l.deployments) - 1
+			}
+			if len(l.deployments) == 0 {
+				l.selectedIdx = 0
+			}
+			return
+		}
+	}
+}
+
+// AddOrUpdateStatefulSet adds a new statefulset or updates an existing one
+func (l *ResourceList) AddOrUpdateStatefulSet(statefulSet models.StatefulSetInfo) {
+	for i, existing := range l.statefulSets {
+		if existing.Cluster == statefulSet.Cluster && existing.Namespace == statefulSet.Namespace && existing.Name == statefulSet.Name {
+			l.statefulSets[i] = statefulSet
+			return
+		}
+	}
+	l.statefulSets = append(l.statefulSets, statefulSet)
+}
+
+// RemoveStatefulSet removes a statefulset by namespace and name
+func (l *ResourceList) RemoveStatefulSet(namespace, name string) {
+	l.RemoveStatefulSetFromCluster("", namespace, name)
+}
+
+// RemoveStatefulSetFromCluster removes a statefulset by source cluster, namespace and name
+func (l *ResourceList) RemoveStatefulSetFromCluster(cluster, namespace, name string) {
+	for i, sts := range l.statefulSets {
+		if sts.Cluster == cluster && sts.Namespace == namespace && sts.Name == name {
+			l.statefulSets = append(l.statefulSets[:i], l.statefulSets[i+1:]...)
+			if l.selectedIdx >= len(l.statefulSets) && len(l.statefulSets) > 0 {
+				l.selectedIdx = len(l.statefulSets) - 1
+			}
+			if len(l.statefulSets) == 0 {
+				l.selectedIdx = 0
+			}
+			return
+		}
+	}
+}
+
+// AddOrUpdateEvent adds a new event or updates an existing one
+func (l *ResourceList) AddOrUpdateEvent(event models.EventInfo) {
+	for i, existing := range l.events {
+		if existing.Cluster == event.Cluster && existing.Namespace == event.Namespace && existing.Name == event.Name {
+			l.events[i] = event
+			return
+		}
+	}
+	l.events = append(l.events, event)
+}
+
+// RemoveEvent removes an event by namespace and name
+func (l *ResourceList) RemoveEvent(namespace, name string) {
+	l.RemoveEventFromCluster("", namespace, name)
+}
+
+// RemoveEventFromCluster removes an event by source cluster, namespace and name
+func (l *ResourceList) RemoveEventFromCluster(cluster, namespace, name string) {
+	for i, evt := range l.events {
+		if evt.Cluster == cluster && evt.Namespace == namespace && evt.Name == name {
+			l.events = append(l.events[:i], l.events[i+1:]...)
+			if l.selectedIdx >= len(l.events) && len(l.events) > 0 {
+				l.selectedIdx = len(l.events) - 1
+			}
+			if len(l.events) == 0 {
+				l.selectedIdx = 0
+			}
+			return
+		}
+	}
+}
+
+// AddOrUpdateConfigMap adds a new configmap or updates an existing one
+func (l *ResourceList) AddOrUpdateConfigMap(configMap models.ConfigMapInfo) {
+	for i, existing := range l.configMaps {
+		if existing.Cluster == configMap.Cluster && existing.Namespace == configMap.Namespace && existing.Name == configMap.Name {
+			l.configMaps[i] = configMap
+			return
+		}
+	}
+	l.configMaps = append(l.configMaps, configMap)
+}
+
+// RemoveConfigMap removes a configmap by namespace and name
+func (l *ResourceList) RemoveConfigMap(namespace, name string) {
+	l.RemoveConfigMapFromCluster("", namespace, name)
+}
+
+// RemoveConfigMapFromCluster removes a configmap by source cluster, namespace and name
+func (l *ResourceList) RemoveConfigMapFromCluster(cluster, namespace, name string) {
+	for i, cm := range l.configMaps {
+		if cm.Cluster == cluster && cm.Namespace == namespace && cm.Name == name {
+			l.configMaps = append(l.configMaps[:i], l.configMaps[i+1:]...)
+			if l.selectedIdx >= len(l.configMaps) && len(l.configMaps) > 0 {
+				l.selectedIdx = len(l.configMaps) - 1
+			}
+			if len(l.configMaps) == 0 {
+				l.selectedIdx = 0
+			}
+			return
+		}
+	}
+}
+
+// AddOrUpdateSecret adds a new secret or updates an existing one
+func (l *ResourceList) AddOrUpdateSecret(secret models.SecretInfo) {
+	for i, existing := range l.secrets {
+		if existing.Cluster == secret.Cluster && existing.Namespace == secret.Namespace && existing.Name == secret.Name {
+			l.secrets[i] = secret
+			return
+		}
+	}
+	l.secrets = append(l.secrets, secret)
+}
+
+// RemoveSecret removes a secret by namespace and name
+func (l *ResourceList) RemoveSecret(namespace, name string) {
+	l.RemoveSecretFromCluster("", namespace, name)
+}
+
+// RemoveSecretFromCluster removes a secret by source cluster, namespace and name
+func (l *ResourceList) RemoveSecretFromCluster(cluster, namespace, name string) {
+	for i, secret := range l.secrets {
+		if secret.Cluster == cluster && secret.Namespace == namespace && secret.Name == name {
+			l.secrets = append(l.secrets[:i], l.secrets[i+1:]...)
+			if l.selectedIdx >= len(l.secrets) && len(l.secrets) > 0 {
+				l.selectedIdx = len(l.secrets) - 1
+			}
+			if len(l.secrets) == 0 {
+				l.selectedIdx = 0
+			}
+			return
+		}
+	}
+}
+
+// AddOrUpdateNode adds a new node or updates an existing one
+func (l *ResourceList) AddOrUpdateNode(node models.NodeInfo) {
+	for i, existing := range l.nodes {
+		if existing.Cluster == node.Cluster && existing.Name == node.Name {
+			l.nodes[i] = node
+			return
+		}
+	}
+	l.nodes = append(l.nodes, node)
+}
+
+// RemoveNode removes a node by name
+func (l *ResourceList) RemoveNode(name string) {
+	l.RemoveNodeFromCluster("", name)
+}
+
+// RemoveNodeFromCluster removes a node by source cluster and name
+func (l *ResourceList) RemoveNodeFromCluster(cluster, name string) {
+	for i, node := range l.nodes {
+		if node.Cluster == cluster && node.Name == name {
+			l.nodes = append(l.nodes[:i], l.nodes[i+1:]...)
+			if l.selectedIdx >= len(l.nodes) && len(l.nodes) > 0 {
+				l.selectedIdx = len(l.nodes) - 1
+			}
+			if len(l.nodes) == 0 {
+				l.selectedIdx = 0
+			}
+			return
+		}
+	}
+}
+
+// AddOrUpdateJob adds a new job or updates an existing one
+func (l *ResourceList) AddOrUpdateJob(job models.JobInfo) {
+	for i, existing := range l.jobs {
+		if existing.Cluster == job.Cluster && existing.Namespace == job.Namespace && existing.Name == job.Name {
+			l.jobs[i] = job
+			return
+		}
+	}
+	l.jobs = append(l.jobs, job)
+}
+
+// RemoveJob removes a job by namespace and name
+func (l *ResourceList) RemoveJob(namespace, name string) {
+	l.RemoveJobFromCluster("", namespace, name)
 }
 
-// RemoveDeployment removes a deployment by namespace and name
-func (l *ResourceList) RemoveDeployment(namespace, name string) {
-	for i, dep := range l.deployments {
-		if dep.Namespace == namespace && dep.Name == name {
-			l.deployments = append(l.deployments[:i], l.deployments[i+1:]...)
-			if l.selectedIdx >= len(l.deployments) && len(l.deployments) > 0 {
-				l.selectedIdx = len(l.deployments) - 1
+// RemoveJobFromCluster removes a job by source cluster, namespace and name
+func (l *ResourceList) RemoveJobFromCluster(cluster, namespace, name string) {
+	for i, job := range l.jobs {
+		if job.Cluster == cluster && job.Namespace == namespace && job.Name == name {
+			l.jobs = append(l.jobs[:i], l.jobs[i+1:]...)
+			if l.selectedIdx >= len(l.jobs) && len(l.jobs) > 0 {
+				l.selectedIdx = len(l.jobs) - 1
 			}
-			if len(l.deployments) == 0 {
+			if len(l.jobs) == 0 {
 				l.selectedIdx = 0
 			}
 			return
@@ -649,26 +2762,31 @@ func (l *ResourceList) RemoveDeployment(namespace, name string) {
 	}
 }
 
-// AddOrUpdateStatefulSet adds a new statefulset or updates an existing one
-func (l *ResourceList) AddOrUpdateStatefulSet(statefulSet models.StatefulSetInfo) {
-	for i, existing := range l.statefulSets {
-		if existing.Namespace == statefulSet.Namespace && existing.Name == statefulSet.Name {
-			l.statefulSets[i] = statefulSet
+// AddOrUpdateCronJob adds a new cronjob or updates an existing one
+func (l *ResourceList) AddOrUpdateCronJob(cronJob models.CronJobInfo) {
+	for i, existing := range l.cronJobs {
+		if existing.Cluster == cronJob.Cluster && existing.Namespace == cronJob.Namespace && existing.Name == cronJob.Name {
+			l.cronJobs[i] = cronJob
 			return
 		}
 	}
-	l.statefulSets = append(l.statefulSets, statefulSet)
+	l.cronJobs = append(l.cronJobs, cronJob)
 }
 
-// RemoveStatefulSet removes a statefulset by namespace and name
-func (l *ResourceList) RemoveStatefulSet(namespace, name string) {
-	for i, sts := range l.statefulSets {
-		if sts.Namespace == namespace && sts.Name == name {
-			l.statefulSets = append(l.statefulSets[:i], l.statefulSets[i+1:]...)
-			if l.selectedIdx >= len(l.statefulSets) && len(l.statefulSets) > 0 {
-				l.selectedIdx = len(l.statefulSets) - 1
+// RemoveCronJob removes a cronjob by namespace and name
+func (l *ResourceList) RemoveCronJob(namespace, name string) {
+	l.RemoveCronJobFromCluster("", namespace, name)
+}
+
+// RemoveCronJobFromCluster removes a cronjob by source cluster, namespace and name
+func (l *ResourceList) RemoveCronJobFromCluster(cluster, namespace, name string) {
+	for i, cronJob := range l.cronJobs {
+		if cronJob.Cluster == cluster && cronJob.Namespace == namespace && cronJob.Name == name {
+			l.cronJobs = append(l.cronJobs[:i], l.cronJobs[i+1:]...)
+			if l.selectedIdx >= len(l.cronJobs) && len(l.cronJobs) > 0 {
+				l.selectedIdx = len(l.cronJobs) - 1
 			}
-			if len(l.statefulSets) == 0 {
+			if len(l.cronJobs) == 0 {
 				l.selectedIdx = 0
 			}
 			return
@@ -676,26 +2794,504 @@ func (l *ResourceList) RemoveStatefulSet(namespace, name string) {
 	}
 }
 
-// AddOrUpdateEvent adds a new event or updates an existing one
-func (l *ResourceList) AddOrUpdateEvent(event models.EventInfo) {
-	for i, existing := range l.events {
-		if existing.Namespace == event.Namespace && existing.Name == event.Name {
-			l.events[i] = event
+// AddOrUpdateDaemonSet adds a new daemonset or updates an existing one
+func (l *ResourceList) AddOrUpdateDaemonSet(daemonSet models.DaemonSetInfo) {
+	for i, existing := range l.daemonSets {
+		if existing.Cluster == daemonSet.Cluster && existing.Namespace == daemonSet.Namespace && existing.Name == daemonSet.Name {
+			l.daemonSets[i] = daemonSet
 			return
 		}
 	}
-	l.events = append(l.events, event)
+	l.daemonSets = append(l.daemonSets, daemonSet)
 }
 
-// RemoveEvent removes an event by namespace and name
-func (l *ResourceList) RemoveEvent(namespace, name string) {
-	for i, evt := range l.events {
-		if evt.Namespace == namespace && evt.Name == name {
-			l.events = append(l.events[:i], l.events[i+1:]...)
-			if l.selectedIdx >= len(l.events) && len(l.events) > 0 {
-				l.selectedIdx = len(l.events) - 1
+// RemoveDaemonSet removes a daemonset by namespace and name
+func (l *ResourceList) RemoveDaemonSet(namespace, name string) {
+	l.RemoveDaemonSetFromCluster("", namespace, name)
+}
+
+// RemoveDaemonSetFromCluster removes a daemonset by source cluster, namespace and name
+func (l *ResourceList) RemoveDaemonSetFromCluster(cluster, namespace, name string) {
+	for i, daemonSet := range l.daemonSets {
+		if daemonSet.Cluster == cluster && daemonSet.Namespace == namespace && daemonSet.Name == name {
+			l.daemonSets = append(l.daemonSets[:i], l.daemonSets[i+1:]...)
+			if l.selectedIdx >= len(l.daemonSets) && len(l.daemonSets) > 0 {
+				l.selectedIdx = len(l.daemonSets) - 1
 			}
-			if len(l.events) == 0 {
+			if len(l.daemonSets) == 0 {
+				l.selectedIdx = 0
+			}
+			return
+		}
+	}
+}
+
+// AddOrUpdateReplicaSet adds a new replicaset or updates an existing one
+func (l *ResourceList) AddOrUpdateReplicaSet(replicaSet models.ReplicaSetInfo) {
+	for i, existing := range l.replicaSets {
+		if existing.Cluster == replicaSet.Cluster && existing.Namespace == replicaSet.Namespace && existing.Name == replicaSet.Name {
+			l.replicaSets[i] = replicaSet
+			return
+		}
+	}
+	l.replicaSets = append(l.replicaSets, replicaSet)
+}
+
+// RemoveReplicaSet removes a replicaset by namespace and name
+func (l *ResourceList) RemoveReplicaSet(namespace, name string) {
+	l.RemoveReplicaSetFromCluster("", namespace, name)
+}
+
+// RemoveReplicaSetFromCluster removes a replicaset by source cluster, namespace and name
+func (l *ResourceList) RemoveReplicaSetFromCluster(cluster, namespace, name string) {
+	for i, replicaSet := range l.replicaSets {
+		if replicaSet.Cluster == cluster && replicaSet.Namespace == namespace && replicaSet.Name == name {
+			l.replicaSets = append(l.replicaSets[:i], l.replicaSets[i+1:]...)
+			if l.selectedIdx >= len(l.replicaSets) && len(l.replicaSets) > 0 {
+				l.selectedIdx = len(l.replicaSets) - 1
+			}
+			if len(l.replicaSets) == 0 {
+				l.selectedIdx = 0
+			}
+			return
+		}
+	}
+}
+
+// AddOrUpdateIngress adds a new ingress or updates an existing one
+func (l *ResourceList) AddOrUpdateIngress(ingress models.IngressInfo) {
+	for i, existing := range l.ingresses {
+		if existing.Cluster == ingress.Cluster && existing.Namespace == ingress.Namespace && existing.Name == ingress.Name {
+			l.ingresses[i] = ingress
+			return
+		}
+	}
+	l.ingresses = append(l.ingresses, ingress)
+}
+
+// RemoveIngress removes a ingress by namespace and name
+func (l *ResourceList) RemoveIngress(namespace, name string) {
+	l.RemoveIngressFromCluster("", namespace, name)
+}
+
+// RemoveIngressFromCluster removes a ingress by source cluster, namespace and name
+func (l *ResourceList) RemoveIngressFromCluster(cluster, namespace, name string) {
+	for i, ingress := range l.ingresses {
+		if ingress.Cluster == cluster && ingress.Namespace == namespace && ingress.Name == name {
+			l.ingresses = append(l.ingresses[:i], l.ingresses[i+1:]...)
+			if l.selectedIdx >= len(l.ingresses) && len(l.ingresses) > 0 {
+				l.selectedIdx = len(l.ingresses) - 1
+			}
+			if len(l.ingresses) == 0 {
+				l.selectedIdx = 0
+			}
+			return
+		}
+	}
+}
+
+// AddOrUpdatePersistentVolumeClaim adds a new persistentvolumeclaim or updates an existing one
+func (l *ResourceList) AddOrUpdatePersistentVolumeClaim(claim models.PersistentVolumeClaimInfo) {
+	for i, existing := range l.persistentVolumeClaims {
+		if existing.Cluster == claim.Cluster && existing.Namespace == claim.Namespace && existing.Name == claim.Name {
+			l.persistentVolumeClaims[i] = claim
+			return
+		}
+	}
+	l.persistentVolumeClaims = append(l.persistentVolumeClaims, claim)
+}
+
+// RemovePersistentVolumeClaim removes a persistentvolumeclaim by namespace and name
+func (l *ResourceList) RemovePersistentVolumeClaim(namespace, name string) {
+	l.RemovePersistentVolumeClaimFromCluster("", namespace, name)
+}
+
+// RemovePersistentVolumeClaimFromCluster removes a persistentvolumeclaim by source cluster, namespace and name
+func (l *ResourceList) RemovePersistentVolumeClaimFromCluster(cluster, namespace, name string) {
+	for i, claim := range l.persistentVolumeClaims {
+		if claim.Cluster == cluster && claim.Namespace == namespace && claim.Name == name {
+			l.persistentVolumeClaims = append(l.persistentVolumeClaims[:i], l.persistentVolumeClaims[i+1:]...)
+			if l.selectedIdx >= len(l.persistentVolumeClaims) && len(l.persistentVolumeClaims) > 0 {
+				l.selectedIdx = len(l.persistentVolumeClaims) - 1
+			}
+			if len(l.persistentVolumeClaims) == 0 {
+				l.selectedIdx = 0
+			}
+			return
+		}
+	}
+}
+
+// AddOrUpdatePersistentVolume adds a new persistentvolume or updates an existing one
+func (l *ResourceList) AddOrUpdatePersistentVolume(volume models.PersistentVolumeInfo) {
+	for i, existing := range l.persistentVolumes {
+		if existing.Cluster == volume.Cluster && existing.Name == volume.Name {
+			l.persistentVolumes[i] = volume
+			return
+		}
+	}
+	l.persistentVolumes = append(l.persistentVolumes, volume)
+}
+
+// RemovePersistentVolume removes a persistentvolume by name
+func (l *ResourceList) RemovePersistentVolume(name string) {
+	l.RemovePersistentVolumeFromCluster("", name)
+}
+
+// RemovePersistentVolumeFromCluster removes a persistentvolume by source cluster and name
+func (l *ResourceList) RemovePersistentVolumeFromCluster(cluster, name string) {
+	for i, volume := range l.persistentVolumes {
+		if volume.Cluster == cluster && volume.Name == name {
+			l.persistentVolumes = append(l.persistentVolumes[:i], l.persistentVolumes[i+1:]...)
+			if l.selectedIdx >= len(l.persistentVolumes) && len(l.persistentVolumes) > 0 {
+				l.selectedIdx = len(l.persistentVolumes) - 1
+			}
+			if len(l.persistentVolumes) == 0 {
+				l.selectedIdx = 0
+			}
+			return
+		}
+	}
+}
+
+// AddOrUpdateStorageClass adds a new storageclass or updates an existing one
+func (l *ResourceList) AddOrUpdateStorageClass(storageClass models.StorageClassInfo) {
+	for i, existing := range l.storageClasses {
+		if existing.Cluster == storageClass.Cluster && existing.Name == storageClass.Name {
+			l.storageClasses[i] = storageClass
+			return
+		}
+	}
+	l.storageClasses = append(l.storageClasses, storageClass)
+}
+
+// RemoveStorageClass removes a storageclass by name
+func (l *ResourceList) RemoveStorageClass(name string) {
+	l.RemoveStorageClassFromCluster("", name)
+}
+
+// RemoveStorageClassFromCluster removes a storageclass by source cluster and name
+func (l *ResourceList) RemoveStorageClassFromCluster(cluster, name string) {
+	for i, storageClass := range l.storageClasses {
+		if storageClass.Cluster == cluster && storageClass.Name == name {
+			l.storageClasses = append(l.storageClasses[:i], l.storageClasses[i+1:]...)
+			if l.selectedIdx >= len(l.storageClasses) && len(l.storageClasses) > 0 {
+				l.selectedIdx = len(l.storageClasses) - 1
+			}
+			if len(l.storageClasses) == 0 {
+				l.selectedIdx = 0
+			}
+			return
+		}
+	}
+}
+
+// AddOrUpdateHorizontalPodAutoscaler adds a new horizontalpodautoscaler or updates an existing one
+func (l *ResourceList) AddOrUpdateHorizontalPodAutoscaler(hpa models.HorizontalPodAutoscalerInfo) {
+	for i, existing := range l.horizontalPodAutoscalers {
+		if existing.Cluster == hpa.Cluster && existing.Namespace == hpa.Namespace && existing.Name == hpa.Name {
+			l.horizontalPodAutoscalers[i] = hpa
+			return
+		}
+	}
+	l.horizontalPodAutoscalers = append(l.horizontalPodAutoscalers, hpa)
+}
+
+// RemoveHorizontalPodAutoscaler removes a horizontalpodautoscaler by namespace and name
+func (l *ResourceList) RemoveHorizontalPodAutoscaler(namespace, name string) {
+	l.RemoveHorizontalPodAutoscalerFromCluster("", namespace, name)
+}
+
+// RemoveHorizontalPodAutoscalerFromCluster removes a horizontalpodautoscaler by source cluster, namespace and name
+func (l *ResourceList) RemoveHorizontalPodAutoscalerFromCluster(cluster, namespace, name string) {
+	for i, hpa := range l.horizontalPodAutoscalers {
+		if hpa.Cluster == cluster && hpa.Namespace == namespace && hpa.Name == name {
+			l.horizontalPodAutoscalers = append(l.horizontalPodAutoscalers[:i], l.horizontalPodAutoscalers[i+1:]...)
+			if l.selectedIdx >= len(l.horizontalPodAutoscalers) && len(l.horizontalPodAutoscalers) > 0 {
+				l.selectedIdx = len(l.horizontalPodAutoscalers) - 1
+			}
+			if len(l.horizontalPodAutoscalers) == 0 {
+				l.selectedIdx = 0
+			}
+			return
+		}
+	}
+}
+
+// AddOrUpdateServiceAccount adds a new serviceaccount or updates an existing one
+func (l *ResourceList) AddOrUpdateServiceAccount(serviceAccount models.ServiceAccountInfo) {
+	for i, existing := range l.serviceAccounts {
+		if existing.Cluster == serviceAccount.Cluster && existing.Namespace == serviceAccount.Namespace && existing.Name == serviceAccount.Name {
+			l.serviceAccounts[i] = serviceAccount
+			return
+		}
+	}
+	l.serviceAccounts = append(l.serviceAccounts, serviceAccount)
+}
+
+// RemoveServiceAccount removes a serviceaccount by namespace and name
+func (l *ResourceList) RemoveServiceAccount(namespace, name string) {
+	l.RemoveServiceAccountFromCluster("", namespace, name)
+}
+
+// RemoveServiceAccountFromCluster removes a serviceaccount by source cluster, namespace and name
+func (l *ResourceList) RemoveServiceAccountFromCluster(cluster, namespace, name string) {
+	for i, serviceAccount := range l.serviceAccounts {
+		if serviceAccount.Cluster == cluster && serviceAccount.Namespace == namespace && serviceAccount.Name == name {
+			l.serviceAccounts = append(l.serviceAccounts[:i], l.serviceAccounts[i+1:]...)
+			if l.selectedIdx >= len(l.serviceAccounts) && len(l.serviceAccounts) > 0 {
+				l.selectedIdx = len(l.serviceAccounts) - 1
+			}
+			if len(l.serviceAccounts) == 0 {
+				l.selectedIdx = 0
+			}
+			return
+		}
+	}
+}
+
+// AddOrUpdateRole adds a new role or updates an existing one
+func (l *ResourceList) AddOrUpdateRole(role models.RoleInfo) {
+	for i, existing := range l.roles {
+		if existing.Cluster == role.Cluster && existing.Namespace == role.Namespace && existing.Name == role.Name {
+			l.roles[i] = role
+			return
+		}
+	}
+	l.roles = append(l.roles, role)
+}
+
+// RemoveRole removes a role by namespace and name
+func (l *ResourceList) RemoveRole(namespace, name string) {
+	l.RemoveRoleFromCluster("", namespace, name)
+}
+
+// RemoveRoleFromCluster removes a role by source cluster, namespace and name
+func (l *ResourceList) RemoveRoleFromCluster(cluster, namespace, name string) {
+	for i, role := range l.roles {
+		if role.Cluster == cluster && role.Namespace == namespace && role.Name == name {
+			l.roles = append(l.roles[:i], l.roles[i+1:]...)
+			if l.selectedIdx >= len(l.roles) && len(l.roles) > 0 {
+				l.selectedIdx = len(l.roles) - 1
+			}
+			if len(l.roles) == 0 {
+				l.selectedIdx = 0
+			}
+			return
+		}
+	}
+}
+
+// AddOrUpdateClusterRole adds a new clusterrole or updates an existing one
+func (l *ResourceList) AddOrUpdateClusterRole(clusterRole models.RoleInfo) {
+	for i, existing := range l.clusterRoles {
+		if existing.Cluster == clusterRole.Cluster && existing.Name == clusterRole.Name {
+			l.clusterRoles[i] = clusterRole
+			return
+		}
+	}
+	l.clusterRoles = append(l.clusterRoles, clusterRole)
+}
+
+// RemoveClusterRole removes a clusterrole by name
+func (l *ResourceList) RemoveClusterRole(name string) {
+	l.RemoveClusterRoleFromCluster("", name)
+}
+
+// RemoveClusterRoleFromCluster removes a clusterrole by source cluster and name
+func (l *ResourceList) RemoveClusterRoleFromCluster(cluster, name string) {
+	for i, clusterRole := range l.clusterRoles {
+		if clusterRole.Cluster == cluster && clusterRole.Name == name {
+			l.clusterRoles = append(l.clusterRoles[:i], l.clusterRoles[i+1:]...)
+			if l.selectedIdx >= len(l.clusterRoles) && len(l.clusterRoles) > 0 {
+				l.selectedIdx = len(l.clusterRoles) - 1
+			}
+			if len(l.clusterRoles) == 0 {
+				l.selectedIdx = 0
+			}
+			return
+		}
+	}
+}
+
+// AddOrUpdateRoleBinding adds a new rolebinding or updates an existing one
+func (l *ResourceList) AddOrUpdateRoleBinding(binding models.RoleBindingInfo) {
+	for i, existing := range l.roleBindings {
+		if existing.Cluster == binding.Cluster && existing.Namespace == binding.Namespace && existing.Name == binding.Name {
+			l.roleBindings[i] = binding
+			return
+		}
+	}
+	l.roleBindings = append(l.roleBindings, binding)
+}
+
+// RemoveRoleBinding removes a rolebinding by namespace and name
+func (l *ResourceList) RemoveRoleBinding(namespace, name string) {
+	l.RemoveRoleBindingFromCluster("", namespace, name)
+}
+
+// RemoveRoleBindingFromCluster removes a rolebinding by source cluster, namespace and name
+func (l *ResourceList) RemoveRoleBindingFromCluster(cluster, namespace, name string) {
+	for i, binding := range l.roleBindings {
+		if binding.Cluster == cluster && binding.Namespace == namespace && binding.Name == name {
+			l.roleBindings = append(l.roleBindings[:i], l.roleBindings[i+1:]...)
+			if l.selectedIdx >= len(l.roleBindings) && len(l.roleBindings) > 0 {
+				l.selectedIdx = len(l.roleBindings) - 1
+			}
+			if len(l.roleBindings) == 0 {
+				l.selectedIdx = 0
+			}
+			return
+		}
+	}
+}
+
+// AddOrUpdateClusterRoleBinding adds a new clusterrolebinding or updates an existing one
+func (l *ResourceList) AddOrUpdateClusterRoleBinding(clusterBinding models.RoleBindingInfo) {
+	for i, existing := range l.clusterRoleBindings {
+		if existing.Cluster == clusterBinding.Cluster && existing.Name == clusterBinding.Name {
+			l.clusterRoleBindings[i] = clusterBinding
+			return
+		}
+	}
+	l.clusterRoleBindings = append(l.clusterRoleBindings, clusterBinding)
+}
+
+// RemoveClusterRoleBinding removes a clusterrolebinding by name
+func (l *ResourceList) RemoveClusterRoleBinding(name string) {
+	l.RemoveClusterRoleBindingFromCluster("", name)
+}
+
+// RemoveClusterRoleBindingFromCluster removes a clusterrolebinding by source cluster and name
+func (l *ResourceList) RemoveClusterRoleBindingFromCluster(cluster, name string) {
+	for i, clusterBinding := range l.clusterRoleBindings {
+		if clusterBinding.Cluster == cluster && clusterBinding.Name == name {
+			l.clusterRoleBindings = append(l.clusterRoleBindings[:i], l.clusterRoleBindings[i+1:]...)
+			if l.selectedIdx >= len(l.clusterRoleBindings) && len(l.clusterRoleBindings) > 0 {
+				l.selectedIdx = len(l.clusterRoleBindings) - 1
+			}
+			if len(l.clusterRoleBindings) == 0 {
+				l.selectedIdx = 0
+			}
+			return
+		}
+	}
+}
+
+// AddOrUpdateNetworkPolicy adds a new networkpolicy or updates an existing one
+func (l *ResourceList) AddOrUpdateNetworkPolicy(policy models.NetworkPolicyInfo) {
+	for i, existing := range l.networkPolicies {
+		if existing.Cluster == policy.Cluster && existing.Namespace == policy.Namespace && existing.Name == policy.Name {
+			l.networkPolicies[i] = policy
+			return
+		}
+	}
+	l.networkPolicies = append(l.networkPolicies, policy)
+}
+
+// RemoveNetworkPolicy removes a networkpolicy by namespace and name
+func (l *ResourceList) RemoveNetworkPolicy(namespace, name string) {
+	l.RemoveNetworkPolicyFromCluster("", namespace, name)
+}
+
+// RemoveNetworkPolicyFromCluster removes a networkpolicy by source cluster, namespace and name
+func (l *ResourceList) RemoveNetworkPolicyFromCluster(cluster, namespace, name string) {
+	for i, policy := range l.networkPolicies {
+		if policy.Cluster == cluster && policy.Namespace == namespace && policy.Name == name {
+			l.networkPolicies = append(l.networkPolicies[:i], l.networkPolicies[i+1:]...)
+			if l.selectedIdx >= len(l.networkPolicies) && len(l.networkPolicies) > 0 {
+				l.selectedIdx = len(l.networkPolicies) - 1
+			}
+			if len(l.networkPolicies) == 0 {
+				l.selectedIdx = 0
+			}
+			return
+		}
+	}
+}
+
+// AddOrUpdatePodDisruptionBudget adds a new poddisruptionbudget or updates an existing one
+func (l *ResourceList) AddOrUpdatePodDisruptionBudget(budget models.PodDisruptionBudgetInfo) {
+	for i, existing := range l.podDisruptionBudgets {
+		if existing.Cluster == budget.Cluster && existing.Namespace == budget.Namespace && existing.Name == budget.Name {
+			l.podDisruptionBudgets[i] = budget
+			return
+		}
+	}
+	l.podDisruptionBudgets = append(l.podDisruptionBudgets, budget)
+}
+
+// RemovePodDisruptionBudget removes a poddisruptionbudget by namespace and name
+func (l *ResourceList) RemovePodDisruptionBudget(namespace, name string) {
+	l.RemovePodDisruptionBudgetFromCluster("", namespace, name)
+}
+
+// RemovePodDisruptionBudgetFromCluster removes a poddisruptionbudget by source cluster, namespace and name
+func (l *ResourceList) RemovePodDisruptionBudgetFromCluster(cluster, namespace, name string) {
+	for i, budget := range l.podDisruptionBudgets {
+		if budget.Cluster == cluster && budget.Namespace == namespace && budget.Name == name {
+			l.podDisruptionBudgets = append(l.podDisruptionBudgets[:i], l.podDisruptionBudgets[i+1:]...)
+			if l.selectedIdx >= len(l.podDisruptionBudgets) && len(l.podDisruptionBudgets) > 0 {
+				l.selectedIdx = len(l.podDisruptionBudgets) - 1
+			}
+			if len(l.podDisruptionBudgets) == 0 {
+				l.selectedIdx = 0
+			}
+			return
+		}
+	}
+}
+
+// AddOrUpdateGeneric adds a new object to the generic resource browser or updates an existing one
+func (l *ResourceList) AddOrUpdateGeneric(obj models.GenericResourceInfo) {
+	for i, existing := range l.generic {
+		if existing.Cluster == obj.Cluster && existing.Namespace == obj.Namespace && existing.Name == obj.Name {
+			if len(obj.Cells) == 0 {
+				// Keep the last server-rendered cells until fresh ones arrive
+				obj.Cells = existing.Cells
+			}
+			l.generic[i] = obj
+			return
+		}
+	}
+	l.generic = append(l.generic, obj)
+}
+
+// GetGeneric returns the object with the given source cluster, namespace and name, or nil
+func (l *ResourceList) GetGeneric(cluster, namespace, name string) *models.GenericResourceInfo {
+	for i := range l.generic {
+		if l.generic[i].Cluster == cluster && l.generic[i].Namespace == namespace && l.generic[i].Name == name {
+			return &l.generic[i]
+		}
+	}
+	return nil
+}
+
+// UpdateGenericCells replaces the table cells of an object already in the list.
+// Objects that were removed meanwhile are not re-added.
+func (l *ResourceList) UpdateGenericCells(cluster, namespace, name string, cells []string) {
+	for i, existing := range l.generic {
+		if existing.Cluster == cluster && existing.Namespace == namespace && existing.Name == name {
+			l.generic[i].Cells = cells
+			return
+		}
+	}
+}
+
+// RemoveGeneric removes an object from the generic resource browser by namespace and name
+func (l *ResourceList) RemoveGeneric(namespace, name string) {
+	l.RemoveGenericFromCluster("", namespace, name)
+}
+
+// RemoveGenericFromCluster removes an object from the generic resource browser by source cluster, namespace and name
+func (l *ResourceList) RemoveGenericFromCluster(cluster, namespace, name string) {
+	for i, obj := range l.generic {
+		if obj.Cluster == cluster && obj.Namespace == namespace && obj.Name == name {
+			l.generic = append(l.generic[:i], l.generic[i+1:]...)
+			if l.selectedIdx >= len(l.generic) && len(l.generic) > 0 {
+				l.selectedIdx = len(l.generic) - 1
+			}
+			if len(l.generic) == 0 {
 				l.selectedIdx = 0
 			}
 			return
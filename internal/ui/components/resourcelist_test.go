@@ -1,6 +1,7 @@
 package components
 
 import (
+	"strings"
 	"testing"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -106,6 +107,25 @@ func TestResourceList_SetPods(t *testing.T) {
 	}
 }
 
+func TestResourceList_Clear(t *testing.T) {
+	list := NewResourceList(ResourceTypePod)
+	list.SetPods([]models.PodInfo{{Name: "pod1"}, {Name: "pod2"}})
+	list.SetServices([]models.ServiceInfo{{Name: "svc1"}})
+	list.SetEvents([]models.EventInfo{{Name: "evt1"}})
+	list.selectedIdx = 1
+	list.viewportTop = 1
+
+	list.Clear()
+
+	if len(list.pods) != 0 || len(list.services) != 0 || len(list.events) != 0 {
+		t.Errorf("Clear() left resources: pods=%d services=%d events=%d",
+			len(list.pods), len(list.services), len(list.events))
+	}
+	if list.selectedIdx != 0 || list.viewportTop != 0 {
+		t.Errorf("Clear() selectedIdx=%d viewportTop=%d, want 0/0", list.selectedIdx, list.viewportTop)
+	}
+}
+
 func TestResourceList_SetServices(t *testing.T) {
 	list := NewResourceList(ResourceTypeService)
 
@@ -873,3 +893,598 @@ func TestResourceList_RemoveEvent(t *testing.T) {
 		t.Errorf("Expected event name 'evt2', got '%s'", list.events[0].Name)
 	}
 }
+
+func TestResourceList_View_ShowCluster(t *testing.T) {
+	list := NewResourceList(ResourceTypePod)
+	list.SetSize(160, 20)
+	list.SetPods([]models.PodInfo{
+		{Name: "pod1", Namespace: "default", Status: "Running", Cluster: "east"},
+	})
+
+	view := list.View()
+	if strings.Contains(view, "CLUSTER") {
+		t.Error("Cluster column should be hidden by default")
+	}
+
+	list.SetShowCluster(true)
+	view = list.View()
+	if !strings.Contains(view, "CLUSTER") {
+		t.Error("Expected CLUSTER header when cluster column is shown")
+	}
+	if !strings.Contains(view, "east") {
+		t.Error("Expected cluster name in row")
+	}
+}
+
+func TestResourceList_SameNameInDifferentClusters(t *testing.T) {
+	list := NewResourceList(ResourceTypePod)
+
+	list.AddOrUpdatePod(models.PodInfo{Name: "web", Namespace: "default", Cluster: "east"})
+	list.AddOrUpdatePod(models.PodInfo{Name: "web", Namespace: "default", Cluster: "west"})
+
+	if len(list.pods) != 2 {
+		t.Fatalf("Expected 2 pods from different clusters, got %d", len(list.pods))
+	}
+
+	list.RemovePodFromCluster("east", "default", "web")
+
+	if len(list.pods) != 1 {
+		t.Fatalf("Expected 1 pod after removal, got %d", len(list.pods))
+	}
+	if list.pods[0].Cluster != "west" {
+		t.Errorf("Expected remaining pod from west, got '%s'", list.pods[0].Cluster)
+	}
+}
+
+func TestResourceList_GenericResources(t *testing.T) {
+	list := NewResourceList(ResourceTypeGeneric)
+	list.SetSize(120, 20)
+
+	list.AddOrUpdateGeneric(models.GenericResourceInfo{Name: "web-tls", Namespace: "shop", Status: "Ready"})
+	list.AddOrUpdateGeneric(models.GenericResourceInfo{Name: "api-tls", Namespace: "shop", Status: "Issuing"})
+	list.AddOrUpdateGeneric(models.GenericResourceInfo{Name: "web-tls", Namespace: "shop", Status: "Expired"})
+
+	if len(list.generic) != 2 {
+		t.Fatalf("Expected 2 resources, got %d", len(list.generic))
+	}
+	if list.generic[0].Status != "Expired" {
+		t.Errorf("Expected updated status 'Expired', got '%s'", list.generic[0].Status)
+	}
+
+	view := list.View()
+	for _, expected := range []string{"NAMESPACE", "STATUS", "web-tls", "api-tls"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected view to contain %q", expected)
+		}
+	}
+
+	selected := list.GetSelectedGeneric()
+	if selected == nil || selected.Name != "web-tls" {
+		t.Errorf("Expected web-tls to be selected, got %v", selected)
+	}
+
+	list.RemoveGeneric("shop", "web-tls")
+	if len(list.generic) != 1 || list.generic[0].Name != "api-tls" {
+		t.Errorf("Expected only api-tls after removal, got %v", list.generic)
+	}
+
+	list.ClearGenericResources()
+	if list.GetSelectedGeneric() != nil {
+		t.Error("Expected no selection after ClearGenericResources")
+	}
+
+	list.SetResourceType(ResourceTypePod)
+	if list.GetSelectedGeneric() != nil {
+		t.Error("GetSelectedGeneric() should return nil for other resource types")
+	}
+}
+
+func TestResourceList_GenericTableColumns(t *testing.T) {
+	list := NewResourceList(ResourceTypeGeneric)
+	list.SetSize(160, 20)
+	list.SetGenericColumns([]string{"Name", "Ready", "Secret", "Age"})
+	list.SetGenericResources([]models.GenericResourceInfo{
+		{Name: "web-tls", Namespace: "shop", Cells: []string{"web-tls", "True", "web-tls-secret", "3d"}},
+	})
+
+	view := list.View()
+	for _, expected := range []string{"NAME", "READY", "SECRET", "web-tls-secret"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected view to contain %q", expected)
+		}
+	}
+	if strings.Contains(view, "NAMESPACE") {
+		t.Error("Expected server columns to replace the default layout")
+	}
+
+	// Watch updates without cells keep the last rendered cells
+	list.AddOrUpdateGeneric(models.GenericResourceInfo{Name: "web-tls", Namespace: "shop", Status: "Ready"})
+	if got := list.generic[0].Cells; len(got) != 4 || got[2] != "web-tls-secret" {
+		t.Errorf("Expected previous cells to be kept, got %v", got)
+	}
+
+	// New objects without cells show their name until the server renders them
+	list.AddOrUpdateGeneric(models.GenericResourceInfo{Name: "api-tls", Namespace: "shop"})
+	if !strings.Contains(list.View(), "api-tls") {
+		t.Error("Expected new object to be listed by name")
+	}
+
+	list.UpdateGenericCells("", "shop", "api-tls", []string{"api-tls", "False", "api-tls-secret", "1m"})
+	if !strings.Contains(list.View(), "api-tls-secret") {
+		t.Error("Expected refreshed cells to be rendered")
+	}
+	list.UpdateGenericCells("", "shop", "gone", []string{"gone"})
+	if len(list.generic) != 2 {
+		t.Errorf("UpdateGenericCells should not add objects, got %d", len(list.generic))
+	}
+
+	list.ClearGenericResources()
+	if list.tableColumns != nil {
+		t.Error("Expected ClearGenericResources to reset the table columns")
+	}
+}
+
+func TestResourceList_ConfigMaps(t *testing.T) {
+	list := NewResourceList(ResourceTypeConfigMap)
+	list.SetSize(120, 20)
+
+	list.SetConfigMaps([]models.ConfigMapInfo{
+		{Name: "app-config", Namespace: "default", DataCount: 3, Age: "2h"},
+	})
+	list.AddOrUpdateConfigMap(models.ConfigMapInfo{Name: "kube-root-ca.crt", Namespace: "default", DataCount: 1, Age: "30d"})
+	list.AddOrUpdateConfigMap(models.ConfigMapInfo{Name: "app-config", Namespace: "default", DataCount: 4, Age: "2h"})
+
+	if len(list.configMaps) != 2 {
+		t.Fatalf("Expected 2 configmaps, got %d", len(list.configMaps))
+	}
+	if list.configMaps[0].DataCount != 4 {
+		t.Errorf("Expected updated data count 4, got %d", list.configMaps[0].DataCount)
+	}
+
+	view := list.View()
+	for _, expected := range []string{"DATA", "app-config", "kube-root-ca.crt", "30d"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected view to contain %q", expected)
+		}
+	}
+
+	selected := list.GetSelectedConfigMap()
+	if selected == nil || selected.Name != "app-config" {
+		t.Errorf("Expected app-config to be selected, got %v", selected)
+	}
+
+	list.RemoveConfigMap("default", "app-config")
+	if len(list.configMaps) != 1 || list.configMaps[0].Name != "kube-root-ca.crt" {
+		t.Errorf("Expected only kube-root-ca.crt after removal, got %v", list.configMaps)
+	}
+
+	list.SetResourceType(ResourceTypePod)
+	if list.GetSelectedConfigMap() != nil {
+		t.Error("GetSelectedConfigMap() should return nil for other resource types")
+	}
+}
+
+func TestResourceList_Secrets(t *testing.T) {
+	list := NewResourceList(ResourceTypeSecret)
+	list.SetSize(120, 20)
+
+	list.SetSecrets([]models.SecretInfo{
+		{Name: "db-credentials", Namespace: "default", Type: "Opaque", DataCount: 2, Age: "5d"},
+	})
+	list.AddOrUpdateSecret(models.SecretInfo{Name: "shop-tls", Namespace: "default", Type: "kubernetes.io/tls", DataCount: 2, Age: "30d"})
+	list.AddOrUpdateSecret(models.SecretInfo{Name: "db-credentials", Namespace: "default", Type: "Opaque", DataCount: 3, Age: "5d"})
+
+	if len(list.secrets) != 2 {
+		t.Fatalf("Expected 2 secrets, got %d", len(list.secrets))
+	}
+	if list.secrets[0].DataCount != 3 {
+		t.Errorf("Expected updated data count 3, got %d", list.secrets[0].DataCount)
+	}
+
+	view := list.View()
+	for _, expected := range []string{"TYPE", "DATA", "db-credentials", "kubernetes.io/tls", "30d"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected view to contain %q", expected)
+		}
+	}
+
+	selected := list.GetSelectedSecret()
+	if selected == nil || selected.Name != "db-credentials" {
+		t.Errorf("Expected db-credentials to be selected, got %v", selected)
+	}
+
+	list.RemoveSecret("default", "db-credentials")
+	if len(list.secrets) != 1 || list.secrets[0].Name != "shop-tls" {
+		t.Errorf("Expected only shop-tls after removal, got %v", list.secrets)
+	}
+
+	list.SetResourceType(ResourceTypePod)
+	if list.GetSelectedSecret() != nil {
+		t.Error("GetSelectedSecret() should return nil for other resource types")
+	}
+}
+
+func TestResourceList_Nodes(t *testing.T) {
+	list := NewResourceList(ResourceTypeNode)
+	list.SetSize(140, 20)
+
+	list.SetNodes([]models.NodeInfo{
+		{Name: "control-plane", Status: "Ready", Roles: "control-plane", KubeletVersion: "v1.31.2", Age: "90d"},
+	})
+	list.AddOrUpdateNode(models.NodeInfo{Name: "worker-1", Status: "Ready", Roles: "<none>", KubeletVersion: "v1.31.2", Age: "10d"})
+	list.AddOrUpdateNode(models.NodeInfo{Name: "worker-1", Status: "Ready,SchedulingDisabled", Unschedulable: true, Roles: "<none>", KubeletVersion: "v1.31.2", Age: "10d"})
+
+	if len(list.nodes) != 2 {
+		t.Fatalf("Expected 2 nodes, got %d", len(list.nodes))
+	}
+
+	view := list.View()
+	for _, expected := range []string{"ROLES", "VERSION", "control-plane", "Ready,SchedulingDisabled", "v1.31.2"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected view to contain %q", expected)
+		}
+	}
+
+	if !list.SelectNode("", "worker-1") || list.GetSelectedNode().Name != "worker-1" {
+		t.Error("Expected SelectNode to select worker-1")
+	}
+	if list.SelectNode("", "missing") {
+		t.Error("SelectNode should return false for an unknown node")
+	}
+
+	list.RemoveNode("worker-1")
+	if len(list.nodes) != 1 || list.GetSelectedNode().Name != "control-plane" {
+		t.Errorf("Expected only control-plane after removal, got %v", list.nodes)
+	}
+
+	list.SetResourceType(ResourceTypePod)
+	if list.GetSelectedNode() != nil {
+		t.Error("GetSelectedNode() should return nil for other resource types")
+	}
+}
+
+func TestResourceList_Jobs(t *testing.T) {
+	list := NewResourceList(ResourceTypeJob)
+	list.SetSize(140, 20)
+
+	list.SetJobs([]models.JobInfo{
+		{Name: "migrate-db", Namespace: "default", Status: "Complete", Completions: "1/1", Duration: "42s", Age: "2d"},
+	})
+	list.AddOrUpdateJob(models.JobInfo{Name: "nightly-report-1", Namespace: "default", Status: "Running", Completions: "0/1", Duration: "3m", Age: "3m"})
+	list.AddOrUpdateJob(models.JobInfo{Name: "nightly-report-1", Namespace: "default", Status: "Failed", Completions: "0/1", Duration: "5m", Age: "5m"})
+
+	if len(list.jobs) != 2 {
+		t.Fatalf("Expected 2 jobs, got %d", len(list.jobs))
+	}
+
+	view := list.View()
+	for _, expected := range []string{"COMPLETIONS", "DURATION", "migrate-db", "42s", "Failed"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected view to contain %q", expected)
+		}
+	}
+
+	list.RemoveJob("default", "migrate-db")
+	if len(list.jobs) != 1 || list.GetSelectedJob().Name != "nightly-report-1" {
+		t.Errorf("Expected only nightly-report-1 after removal, got %v", list.jobs)
+	}
+
+	list.SetResourceType(ResourceTypeCronJob)
+	if list.GetSelectedJob() != nil {
+		t.Error("GetSelectedJob() should return nil for other resource types")
+	}
+}
+
+func TestResourceList_CronJobs(t *testing.T) {
+	list := NewResourceList(ResourceTypeCronJob)
+	list.SetSize(140, 20)
+
+	list.SetCronJobs([]models.CronJobInfo{
+		{Name: "nightly-report", Namespace: "default", Schedule: "0 2 * * *", LastSchedule: "22h", Age: "30d"},
+	})
+	list.AddOrUpdateCronJob(models.CronJobInfo{Name: "cleanup", Namespace: "default", Schedule: "*/15 * * * *", Suspend: true, LastSchedule: "<none>", Age: "1d"})
+	list.AddOrUpdateCronJob(models.CronJobInfo{Name: "nightly-report", Namespace: "default", Schedule: "0 2 * * *", Active: 1, LastSchedule: "1m", Age: "30d"})
+
+	if len(list.cronJobs) != 2 {
+		t.Fatalf("Expected 2 cronjobs, got %d", len(list.cronJobs))
+	}
+
+	view := list.View()
+	for _, expected := range []string{"SCHEDULE", "SUSPEND", "LAST SCHEDULE", "0 2 * * *", "*/15 * * * *", "true", "1m"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected view to contain %q", expected)
+		}
+	}
+
+	list.RemoveCronJob("default", "nightly-report")
+	if len(list.cronJobs) != 1 || list.GetSelectedCronJob().Name != "cleanup" {
+		t.Errorf("Expected only cleanup after removal, got %v", list.cronJobs)
+	}
+
+	list.SetResourceType(ResourceTypeJob)
+	if list.GetSelectedCronJob() != nil {
+		t.Error("GetSelectedCronJob() should return nil for other resource types")
+	}
+}
+
+func TestResourceList_DaemonSets(t *testing.T) {
+	list := NewResourceList(ResourceTypeDaemonSet)
+	list.SetSize(160, 20)
+
+	list.SetDaemonSets([]models.DaemonSetInfo{
+		{Name: "fluent-bit", Namespace: "logging", Desired: 3, Current: 3, Ready: 3, UpToDate: 3, Available: 3, NodeSelector: "<none>", Age: "5d"},
+	})
+	list.AddOrUpdateDaemonSet(models.DaemonSetInfo{Name: "calico-node", Namespace: "logging", Desired: 3, Current: 2, Ready: 2, UpToDate: 3, Available: 2, NodeSelector: "kubernetes.io/os=linux", Age: "90d"})
+
+	if len(list.daemonSets) != 2 {
+		t.Fatalf("Expected 2 daemonsets, got %d", len(list.daemonSets))
+	}
+
+	view := list.View()
+	for _, expected := range []string{"DESIRED", "UP-TO-DATE", "NODE SELECTOR", "calico-node", "kubernetes.io/os=linux"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected view to contain %q", expected)
+		}
+	}
+
+	list.RemoveDaemonSet("logging", "fluent-bit")
+	if len(list.daemonSets) != 1 || list.GetSelectedDaemonSet().Name != "calico-node" {
+		t.Errorf("Expected only calico-node after removal, got %v", list.daemonSets)
+	}
+
+	list.SetResourceType(ResourceTypeReplicaSet)
+	if list.GetSelectedDaemonSet() != nil {
+		t.Error("GetSelectedDaemonSet() should return nil for other resource types")
+	}
+}
+
+func TestResourceList_ReplicaSets(t *testing.T) {
+	list := NewResourceList(ResourceTypeReplicaSet)
+	list.SetSize(140, 20)
+
+	list.SetReplicaSets([]models.ReplicaSetInfo{
+		{Name: "web-7d4b9c8f5", Namespace: "shop", Desired: 3, Current: 3, Ready: 3, Owner: "web", Age: "3h"},
+	})
+	list.AddOrUpdateReplicaSet(models.ReplicaSetInfo{Name: "standalone", Namespace: "shop", Desired: 1, Current: 1, Age: "1d"})
+	list.AddOrUpdateReplicaSet(models.ReplicaSetInfo{Name: "web-7d4b9c8f5", Namespace: "shop", Desired: 0, Age: "3h", Owner: "web"})
+
+	if len(list.replicaSets) != 2 {
+		t.Fatalf("Expected 2 replicasets, got %d", len(list.replicaSets))
+	}
+
+	view := list.View()
+	for _, expected := range []string{"DEPLOYMENT", "web-7d4b9c8f5", "<none>", "○"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected view to contain %q", expected)
+		}
+	}
+
+	list.RemoveReplicaSet("shop", "web-7d4b9c8f5")
+	if len(list.replicaSets) != 1 || list.GetSelectedReplicaSet().Name != "standalone" {
+		t.Errorf("Expected only standalone after removal, got %v", list.replicaSets)
+	}
+}
+
+func TestResourceList_Ingresses(t *testing.T) {
+	list := NewResourceList(ResourceTypeIngress)
+	list.SetSize(140, 20)
+
+	list.SetIngresses([]models.IngressInfo{
+		{Name: "shop", Namespace: "shop", Class: "nginx", Hosts: "shop.example.com", Addresses: "203.0.113.10", Ports: "80, 443", Age: "2h"},
+	})
+	list.AddOrUpdateIngress(models.IngressInfo{Name: "admin", Namespace: "shop", Class: "<none>", Hosts: "*", Addresses: "<none>", Ports: "80", Age: "1m"})
+
+	if len(list.ingresses) != 2 {
+		t.Fatalf("Expected 2 ingresses, got %d", len(list.ingresses))
+	}
+
+	view := list.View()
+	for _, expected := range []string{"CLASS", "HOSTS", "ADDRESS", "PORTS", "shop.example.com", "80, 443", "◐"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected view to contain %q", expected)
+		}
+	}
+
+	list.RemoveIngress("shop", "shop")
+	if len(list.ingresses) != 1 || list.GetSelectedIngress().Name != "admin" {
+		t.Errorf("Expected only admin after removal, got %v", list.ingresses)
+	}
+}
+
+func TestResourceList_PersistentVolumeClaims(t *testing.T) {
+	list := NewResourceList(ResourceTypePersistentVolumeClaim)
+	list.SetSize(140, 20)
+
+	list.SetPersistentVolumeClaims([]models.PersistentVolumeClaimInfo{
+		{Name: "data-postgres-0", Namespace: "default", Status: "Bound", Volume: "pvc-1234", Capacity: "10Gi", AccessModes: "RWO", StorageClass: "gp3", Age: "2d"},
+	})
+	list.AddOrUpdatePersistentVolumeClaim(models.PersistentVolumeClaimInfo{Name: "uploads", Namespace: "default", Status: "Pending", AccessModes: "RWX", StorageClass: "efs", Age: "5m"})
+
+	if len(list.persistentVolumeClaims) != 2 {
+		t.Fatalf("Expected 2 claims, got %d", len(list.persistentVolumeClaims))
+	}
+
+	view := list.View()
+	for _, expected := range []string{"VOLUME", "CAPACITY", "ACCESS MODES", "STORAGECLASS", "pvc-1234", "Pending", "◐"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected view to contain %q", expected)
+		}
+	}
+
+	list.RemovePersistentVolumeClaim("default", "data-postgres-0")
+	if len(list.persistentVolumeClaims) != 1 || list.GetSelectedPersistentVolumeClaim().Name != "uploads" {
+		t.Errorf("Expected only uploads after removal, got %v", list.persistentVolumeClaims)
+	}
+}
+
+func TestResourceList_PersistentVolumes(t *testing.T) {
+	list := NewResourceList(ResourceTypePersistentVolume)
+	list.SetSize(160, 20)
+
+	list.SetPersistentVolumes([]models.PersistentVolumeInfo{
+		{Name: "pvc-1234", Capacity: "10Gi", AccessModes: "RWO", ReclaimPolicy: "Delete", Status: "Bound", Claim: "default/data-postgres-0", StorageClass: "gp3", Age: "2d"},
+		{Name: "pvc-5678", Capacity: "1Gi", AccessModes: "RWO", ReclaimPolicy: "Retain", Status: "Released", Claim: "default/old", StorageClass: "gp3", Age: "9d"},
+	})
+
+	view := list.View()
+	for _, expected := range []string{"RECLAIM", "CLAIM", "default/data-postgres-0", "Released", "◑"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected view to contain %q", expected)
+		}
+	}
+
+	if !list.SelectPersistentVolume("", "pvc-5678") || list.GetSelectedPersistentVolume().Name != "pvc-5678" {
+		t.Error("Expected SelectPersistentVolume to select pvc-5678")
+	}
+	if list.SelectPersistentVolume("", "missing") {
+		t.Error("SelectPersistentVolume should return false for an unknown volume")
+	}
+
+	list.RemovePersistentVolume("pvc-5678")
+	if len(list.persistentVolumes) != 1 || list.GetSelectedPersistentVolume().Name != "pvc-1234" {
+		t.Errorf("Expected only pvc-1234 after removal, got %v", list.persistentVolumes)
+	}
+}
+
+func TestResourceList_StorageClasses(t *testing.T) {
+	list := NewResourceList(ResourceTypeStorageClass)
+	list.SetSize(140, 20)
+
+	list.SetStorageClasses([]models.StorageClassInfo{
+		{Name: "gp3", Provisioner: "ebs.csi.aws.com", ReclaimPolicy: "Delete", VolumeBindingMode: "WaitForFirstConsumer", AllowVolumeExpansion: true, IsDefault: true, Age: "30d"},
+	})
+	list.AddOrUpdateStorageClass(models.StorageClassInfo{Name: "standard", Provisioner: "kubernetes.io/no-provisioner", ReclaimPolicy: "Retain", VolumeBindingMode: "Immediate", Age: "30d"})
+
+	view := list.View()
+	for _, expected := range []string{"PROVISIONER", "VOLUMEBINDINGMODE", "WaitForFirstConsumer", "ebs.csi.aws.com", "★"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected view to contain %q", expected)
+		}
+	}
+
+	list.RemoveStorageClass("gp3")
+	if len(list.storageClasses) != 1 || list.GetSelectedStorageClass().Name != "standard" {
+		t.Errorf("Expected only standard after removal, got %v", list.storageClasses)
+	}
+}
+
+func TestResourceList_HorizontalPodAutoscalers(t *testing.T) {
+	list := NewResourceList(ResourceTypeHorizontalPodAutoscaler)
+	list.SetSize(160, 20)
+
+	list.SetHorizontalPodAutoscalers([]models.HorizontalPodAutoscalerInfo{
+		{Name: "web", Namespace: "default", TargetKind: "Deployment", TargetName: "web", Targets: "cpu: 45%/80%", MinReplicas: 2, MaxReplicas: 10, CurrentReplicas: 3, Age: "1d"},
+	})
+	list.AddOrUpdateHorizontalPodAutoscaler(models.HorizontalPodAutoscalerInfo{Name: "worker", Namespace: "default", TargetKind: "StatefulSet", TargetName: "worker", Targets: "<none>", MinReplicas: 1, MaxReplicas: 3, Age: "1m"})
+
+	view := list.View()
+	for _, expected := range []string{"REFERENCE", "TARGETS", "MINPODS", "MAXPODS", "Deployment/web", "cpu: 45%/80%", "◐"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected view to contain %q", expected)
+		}
+	}
+
+	list.RemoveHorizontalPodAutoscaler("default", "web")
+	if len(list.horizontalPodAutoscalers) != 1 || list.GetSelectedHorizontalPodAutoscaler().Name != "worker" {
+		t.Errorf("Expected only worker after removal, got %v", list.horizontalPodAutoscalers)
+	}
+}
+
+func TestResourceList_NetworkPolicies(t *testing.T) {
+	list := NewResourceList(ResourceTypeNetworkPolicy)
+	list.SetSize(160, 20)
+
+	list.SetNetworkPolicies([]models.NetworkPolicyInfo{
+		{Name: "default-deny", Namespace: "default", PodSelector: "<all pods>", PolicyTypes: []string{"Ingress"}, Age: "1d"},
+	})
+	list.AddOrUpdateNetworkPolicy(models.NetworkPolicyInfo{
+		Name: "allow-web", Namespace: "default", PodSelector: "app=web", PolicyTypes: []string{"Ingress"},
+		IngressRules: []models.NetworkPolicyRule{{}}, Age: "1m",
+	})
+
+	view := list.View()
+	for _, expected := range []string{"POD-SELECTOR", "POLICY TYPES", "<all pods>", "app=web", "⊘"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected view to contain %q", expected)
+		}
+	}
+
+	list.RemoveNetworkPolicy("default", "default-deny")
+	if len(list.networkPolicies) != 1 || list.GetSelectedNetworkPolicy().Name != "allow-web" {
+		t.Errorf("Expected only allow-web after removal, got %v", list.networkPolicies)
+	}
+}
+
+func TestResourceList_PodDisruptionBudgets(t *testing.T) {
+	list := NewResourceList(ResourceTypePodDisruptionBudget)
+	list.SetSize(160, 20)
+
+	list.SetPodDisruptionBudgets([]models.PodDisruptionBudgetInfo{
+		{Name: "web", Namespace: "default", MinAvailable: "2", MaxUnavailable: "N/A", CurrentHealthy: 2, DesiredHealthy: 2, Age: "1d"},
+	})
+	list.AddOrUpdatePodDisruptionBudget(models.PodDisruptionBudgetInfo{
+		Name: "api", Namespace: "default", MinAvailable: "N/A", MaxUnavailable: "25%",
+		CurrentHealthy: 4, DesiredHealthy: 3, DisruptionsAllowed: 1, Age: "1m",
+	})
+
+	view := list.View()
+	for _, expected := range []string{"MIN AVAILABLE", "ALLOWED DISRUPTIONS", "25%", "2/2", "4/3", "⊘"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected view to contain %q", expected)
+		}
+	}
+
+	list.RemovePodDisruptionBudget("default", "web")
+	if len(list.podDisruptionBudgets) != 1 || list.GetSelectedPodDisruptionBudget().Name != "api" {
+		t.Errorf("Expected only api after removal, got %v", list.podDisruptionBudgets)
+	}
+}
+
+func TestResourceList_SelectPod(t *testing.T) {
+	list := NewResourceList(ResourceTypePod)
+	list.SetPods([]models.PodInfo{{Name: "web-1", Namespace: "default"}, {Name: "web-2", Namespace: "default"}})
+	if !list.SelectPod("", "default", "web-2") || list.GetSelectedPod().Name != "web-2" {
+		t.Error("Expected SelectPod to select web-2")
+	}
+	if list.SelectPod("", "shop", "web-1") {
+		t.Error("SelectPod should return false for a pod in another namespace")
+	}
+}
+
+func TestResourceList_GetSelectedRef(t *testing.T) {
+	list := NewResourceList(ResourceTypeNode)
+	if _, _, _, ok := list.GetSelectedRef(); ok {
+		t.Error("GetSelectedRef should return false for an empty list")
+	}
+
+	list.SetNodes([]models.NodeInfo{{Name: "worker-1", Cluster: "east"}})
+	if cluster, namespace, name, ok := list.GetSelectedRef(); !ok || cluster != "east" || namespace != "" || name != "worker-1" {
+		t.Errorf("Expected east//worker-1, got %s/%s/%s", cluster, namespace, name)
+	}
+
+	list.SetResourceType(ResourceTypeConfigMap)
+	list.SetConfigMaps([]models.ConfigMapInfo{{Name: "settings", Namespace: "shop"}})
+	if _, namespace, name, ok := list.GetSelectedRef(); !ok || namespace != "shop" || name != "settings" {
+		t.Errorf("Expected shop/settings, got %s/%s", namespace, name)
+	}
+}
+
+func TestResourceList_SelectWorkload(t *testing.T) {
+	list := NewResourceList(ResourceTypeDeployment)
+	list.SetDeployments([]models.DeploymentInfo{
+		{Name: "web", Namespace: "shop"},
+		{Name: "web", Namespace: "default"},
+	})
+	if !list.SelectDeployment("", "default", "web") || list.GetSelectedDeployment().Namespace != "default" {
+		t.Error("Expected SelectDeployment to select default/web")
+	}
+	if list.SelectDeployment("", "default", "missing") {
+		t.Error("SelectDeployment should return false for an unknown deployment")
+	}
+
+	list.SetResourceType(ResourceTypeStatefulSet)
+	list.SetStatefulSets([]models.StatefulSetInfo{{Name: "db", Namespace: "default"}, {Name: "cache", Namespace: "default"}})
+	if !list.SelectStatefulSet("", "default", "cache") || list.GetSelectedStatefulSet().Name != "cache" {
+		t.Error("Expected SelectStatefulSet to select cache")
+	}
+}
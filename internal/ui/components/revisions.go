@@ -0,0 +1,238 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/williajm/k8s-tui/internal/models"
+	"github.com/williajm/k8s-tui/internal/ui/styles"
+)
+
+const (
+	// maxListedRevisions is how many revisions the revision viewer lists at once
+	maxListedRevisions = 8
+	// revisionDiffContext is how many unchanged lines are kept around each change
+	revisionDiffContext = 3
+)
+
+// RevisionViewer lists the revisions of a deployment or statefulset and shows the pod
+// template diff between a base revision and the selected one
+type RevisionViewer struct {
+	kind      string
+	namespace string
+	name      string
+	revisions []models.RevisionInfo // nil while loading
+	selected  int
+	base      int
+	viewport  viewport.Model
+	width     int
+	height    int
+}
+
+// NewRevisionViewer creates a new revision viewer
+func NewRevisionViewer() *RevisionViewer {
+	vp := viewport.New(80, 10)
+	vp.Style = lipgloss.NewStyle()
+
+	return &RevisionViewer{
+		viewport: vp,
+		width:    80,
+		height:   20,
+	}
+}
+
+// SetSize sets the dimensions of the revision viewer
+func (r *RevisionViewer) SetSize(width, height int) {
+	r.width = width
+	r.height = height
+	r.refreshDiff()
+}
+
+// Open shows the revisions of a workload, which are loading
+func (r *RevisionViewer) Open(kind, namespace, name string) {
+	r.kind = kind
+	r.namespace = namespace
+	r.name = name
+	r.SetRevisions(nil)
+}
+
+// SetRevisions sets the revisions, newest first. The diff starts from the current
+// revision to the one before it, the usual rollback target.
+func (r *RevisionViewer) SetRevisions(revisions []models.RevisionInfo) {
+	r.revisions = revisions
+	r.base = 0
+	for i := range revisions {
+		if revisions[i].Current {
+			r.base = i
+			break
+		}
+	}
+	r.selected = r.base
+	if r.selected+1 < len(revisions) {
+		r.selected++
+	}
+	r.refreshDiff()
+}
+
+// MoveUp selects the next newer revision
+func (r *RevisionViewer) MoveUp() {
+	if r.selected > 0 {
+		r.selected--
+		r.refreshDiff()
+	}
+}
+
+// MoveDown selects the next older revision
+func (r *RevisionViewer) MoveDown() {
+	if r.selected+1 < len(r.revisions) {
+		r.selected++
+		r.refreshDiff()
+	}
+}
+
+// SetBase diffs the other revisions against the selected one
+func (r *RevisionViewer) SetBase() {
+	r.base = r.selected
+	r.refreshDiff()
+}
+
+// Selected returns the selected revision, or nil while loading
+func (r *RevisionViewer) Selected() *models.RevisionInfo {
+	if r.selected < 0 || r.selected >= len(r.revisions) {
+		return nil
+	}
+	return &r.revisions[r.selected]
+}
+
+// ScrollUp scrolls the diff up a page
+func (r *RevisionViewer) ScrollUp() {
+	r.viewport.PageUp()
+}
+
+// ScrollDown scrolls the diff down a page
+func (r *RevisionViewer) ScrollDown() {
+	r.viewport.PageDown()
+}
+
+// listHeight returns how many rows the revision list takes
+func (r *RevisionViewer) listHeight() int {
+	return max(1, min(len(r.revisions), maxListedRevisions))
+}
+
+// refreshDiff renders the diff from the base revision to the selected one
+func (r *RevisionViewer) refreshDiff() {
+	r.viewport.Width = r.width - 4
+	// Height: total - footer (2) - border (2) - title (2) - list - diff header (2)
+	r.viewport.Height = max(1, r.height-8-r.listHeight())
+
+	switch {
+	case r.revisions == nil:
+		r.viewport.SetContent("")
+	case len(r.revisions) < 2:
+		r.viewport.SetContent("Only one revision: nothing to compare")
+	case r.base == r.selected:
+		r.viewport.SetContent("Select a revision other than the base to compare")
+	default:
+		diff, err := models.TemplateDiff(r.revisions[r.base].Template, r.revisions[r.selected].Template)
+		switch {
+		case err != nil:
+			r.viewport.SetContent(styles.StatusErrorStyle.Render(err.Error()))
+		case !models.HasChanges(diff):
+			r.viewport.SetContent("The pod templates are identical")
+		default:
+			r.viewport.SetContent(renderDiff(models.CompactDiff(diff, revisionDiffContext)))
+		}
+	}
+	r.viewport.GotoTop()
+}
+
+// renderDiff colours the lines of a diff
+func renderDiff(lines []models.DiffLine) string {
+	rendered := make([]string, len(lines))
+	for i, line := range lines {
+		switch line.Op {
+		case models.DiffInsert:
+			rendered[i] = styles.DiffInsertStyle.Render(line.String())
+		case models.DiffDelete:
+			rendered[i] = styles.DiffDeleteStyle.Render(line.String())
+		case models.DiffSkip:
+			rendered[i] = styles.DiffSkipStyle.Render(line.String())
+		default:
+			rendered[i] = line.String()
+		}
+	}
+	return strings.Join(rendered, "\n")
+}
+
+// View renders the revision viewer
+func (r *RevisionViewer) View() string {
+	target := r.name
+	if r.namespace != "" {
+		target = r.namespace + "/" + r.name
+	}
+	lines := []string{styles.DetailHeaderStyle.Render(fmt.Sprintf("Revisions: %s %s", r.kind, target)), ""}
+
+	switch {
+	case r.revisions == nil:
+		lines = append(lines, "Loading...")
+	case len(r.revisions) == 0:
+		lines = append(lines, "No revisions found")
+	default:
+		lines = append(lines, r.renderList()...)
+		lines = append(lines, "", styles.DetailHeaderStyle.Render(fmt.Sprintf("Pod template diff: revision %d → revision %d",
+			r.revisions[r.base].Revision, r.revisions[r.selected].Revision)))
+		lines = append(lines, r.viewport.View())
+	}
+
+	borderedContent := styles.BorderStyle.
+		Width(r.width).
+		Height(r.height - 2). // Reserve 2 lines for footer outside border
+		Render(strings.Join(lines, "\n"))
+
+	return lipgloss.JoinVertical(lipgloss.Left, borderedContent, r.renderFooter())
+}
+
+// renderList renders the window of revisions around the selected one, marking the
+// current revision (●), the diff base (◆) and the selection (>)
+func (r *RevisionViewer) renderList() []string {
+	start := max(0, min(r.selected-maxListedRevisions/2, len(r.revisions)-maxListedRevisions))
+	end := min(len(r.revisions), start+maxListedRevisions)
+
+	lines := make([]string, 0, end-start)
+	for i := start; i < end; i++ {
+		cursor := " "
+		if i == r.selected {
+			cursor = ">"
+		}
+		base := " "
+		if i == r.base {
+			base = "◆"
+		}
+		line := cursor + base + revisionSummary(r.revisions[i])
+		if i == r.selected {
+			line = styles.TableSelectedRowStyle.Render(line)
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// renderFooter renders the keyboard shortcuts
+func (r *RevisionViewer) renderFooter() string {
+	legend := "● current  ◆ diff base  > selected"
+	shortcuts := []string{
+		styles.RenderKeyHelp("[↑↓]", "Select revision"),
+		styles.RenderKeyHelp("[b]", "Set diff base"),
+		styles.RenderKeyHelp("[PgUp/PgDn]", "Scroll diff"),
+		styles.RenderKeyHelp("[u]", "Roll back to selected"),
+		styles.RenderKeyHelp("[q/Esc]", "Back"),
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		styles.FooterStyle.Width(r.width).Render(legend),
+		styles.FooterStyle.Width(r.width).Render(strings.Join(shortcuts, "  ")),
+	)
+}
@@ -0,0 +1,86 @@
+package components
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/williajm/k8s-tui/internal/models"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func testRevision(revision int64, image string, current bool) models.RevisionInfo {
+	return models.RevisionInfo{
+		Revision: revision,
+		Name:     "web-" + image,
+		Images:   image,
+		Current:  current,
+		Age:      "1h",
+		Template: &corev1.PodTemplateSpec{
+			Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "web", Image: image}}},
+		},
+	}
+}
+
+func TestRevisionViewer_Diff(t *testing.T) {
+	r := NewRevisionViewer()
+	r.SetSize(120, 30)
+	r.Open("Deployment", "shop", "web")
+
+	if r.Selected() != nil || !strings.Contains(r.View(), "Loading...") {
+		t.Error("Open() should show the revisions loading")
+	}
+
+	r.SetRevisions([]models.RevisionInfo{
+		testRevision(3, "web:3", true),
+		testRevision(2, "web:2", false),
+		testRevision(1, "web:1", false),
+	})
+	if selected := r.Selected(); selected == nil || selected.Revision != 2 {
+		t.Fatalf("SetRevisions() should select the revision before the current one, got %v", selected)
+	}
+	view := r.View()
+	for _, want := range []string{"Revisions: Deployment shop/web", "revision 3 → revision 2", "- ", "image: web:3", "+ ", "image: web:2"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("View() missing %q", want)
+		}
+	}
+
+	r.MoveDown()
+	if r.Selected().Revision != 1 || !strings.Contains(r.View(), "image: web:1") {
+		t.Error("MoveDown() should diff against the older revision")
+	}
+	r.MoveDown()
+	if r.Selected().Revision != 1 {
+		t.Error("MoveDown() should stop at the oldest revision")
+	}
+
+	r.SetBase()
+	if !strings.Contains(r.View(), "compare") {
+		t.Error("SetBase() on the selected revision should leave nothing to compare")
+	}
+	r.MoveUp()
+	if !strings.Contains(r.View(), "revision 1 → revision 2") {
+		t.Error("MoveUp() should diff from the new base")
+	}
+}
+
+func TestRevisionViewer_NoRevisions(t *testing.T) {
+	r := NewRevisionViewer()
+	r.SetSize(120, 30)
+	r.Open("StatefulSet", "shop", "db")
+
+	r.SetRevisions([]models.RevisionInfo{})
+	if r.Selected() != nil || !strings.Contains(r.View(), "No revisions found") {
+		t.Error("SetRevisions() with none should say so")
+	}
+
+	r.SetRevisions([]models.RevisionInfo{testRevision(1, "db:1", true)})
+	if !strings.Contains(r.View(), "nothing to compare") {
+		t.Error("A single revision should have nothing to compare")
+	}
+
+	r.SetRevisions([]models.RevisionInfo{testRevision(2, "db:1", true), testRevision(1, "db:1", false)})
+	if !strings.Contains(r.View(), "identical") {
+		t.Error("Identical templates should say so")
+	}
+}
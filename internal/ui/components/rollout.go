@@ -0,0 +1,195 @@
+package components
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/williajm/k8s-tui/internal/models"
+	"github.com/williajm/k8s-tui/internal/ui/styles"
+)
+
+// RolloutAction is an action on the rollout of a workload
+type RolloutAction int
+
+const (
+	// RolloutRestart rolls out new pods with an unchanged pod template
+	RolloutRestart RolloutAction = iota
+	// RolloutPause stops a deployment from rolling out pod template changes
+	RolloutPause
+	// RolloutResume rolls out the changes made while a deployment was paused
+	RolloutResume
+	// RolloutUndo rolls the pod template back to an earlier revision
+	RolloutUndo
+)
+
+// String returns the name of the action
+func (a RolloutAction) String() string {
+	switch a {
+	case RolloutRestart:
+		return "Restart"
+	case RolloutPause:
+		return "Pause"
+	case RolloutResume:
+		return "Resume"
+	case RolloutUndo:
+		return "Roll back"
+	default:
+		return "Unknown"
+	}
+}
+
+// rolloutState is the progress of the rollout dialog
+type rolloutState int
+
+const (
+	rolloutStateChoosing  rolloutState = iota // Choosing an action
+	rolloutStateRunning                       // Action request in progress
+	rolloutStateFollowing                     // Action applied; following the rollout
+)
+
+// RolloutDialog restarts, pauses or resumes the rollout of a deployment, statefulset
+// or daemonset, then follows the rollout until it completes or fails
+type RolloutDialog struct {
+	kind      string
+	namespace string
+	name      string
+	status    models.RolloutStatus
+	action    RolloutAction
+	state     rolloutState
+	err       error
+	width     int
+	visible   bool
+}
+
+// NewRolloutDialog creates a new, hidden rollout dialog
+func NewRolloutDialog() *RolloutDialog {
+	return &RolloutDialog{
+		width: 60,
+	}
+}
+
+// SetWidth sets the width of the dialog
+func (d *RolloutDialog) SetWidth(width int) {
+	d.width = width
+}
+
+// Show shows the dialog for a workload with its current rollout status
+func (d *RolloutDialog) Show(kind, namespace, name string, status models.RolloutStatus) {
+	d.kind = kind
+	d.namespace = namespace
+	d.name = name
+	d.status = status
+	d.state = rolloutStateChoosing
+	d.err = nil
+	d.visible = true
+}
+
+// Hide hides the dialog
+func (d *RolloutDialog) Hide() {
+	d.visible = false
+}
+
+// IsVisible returns whether the dialog is visible
+func (d *RolloutDialog) IsVisible() bool {
+	return d.visible
+}
+
+// IsChoosing reports whether an action can be chosen
+func (d *RolloutDialog) IsChoosing() bool {
+	return d.state == rolloutStateChoosing
+}
+
+// CanPause reports whether the rollout can be paused; only deployments can be
+func (d *RolloutDialog) CanPause() bool {
+	return d.kind == "Deployment" && !d.status.Paused
+}
+
+// CanResume reports whether the rollout is paused and can be resumed
+func (d *RolloutDialog) CanResume() bool {
+	return d.kind == "Deployment" && d.status.Paused
+}
+
+// SetRunning marks the action as in progress
+func (d *RolloutDialog) SetRunning(action RolloutAction) {
+	d.action = action
+	d.state = rolloutStateRunning
+	d.err = nil
+}
+
+// SetActionResult records the outcome of the action. On failure another action
+// can be chosen.
+func (d *RolloutDialog) SetActionResult(err error) {
+	if err != nil {
+		d.state = rolloutStateChoosing
+		d.err = err
+		return
+	}
+	d.state = rolloutStateFollowing
+}
+
+// SetStatus records the live rollout status of the workload
+func (d *RolloutDialog) SetStatus(status models.RolloutStatus) {
+	d.status = status
+}
+
+// View renders the dialog
+func (d *RolloutDialog) View() string {
+	if !d.visible {
+		return ""
+	}
+
+	target := d.name
+	if d.namespace != "" {
+		target = d.namespace + "/" + d.name
+	}
+
+	lines := []string{
+		styles.DetailHeaderStyle.Render("Rollout " + d.kind),
+		"",
+		styles.RenderDetailRow("Object", target),
+		styles.RenderDetailRow("Updated", fmt.Sprintf("%d/%d", d.status.Updated, d.status.Desired)),
+		styles.RenderDetailRow("Ready", fmt.Sprintf("%d/%d", d.status.Ready, d.status.Desired)),
+		styles.RenderDetailRow("Available", fmt.Sprintf("%d/%d", d.status.Available, d.status.Desired)),
+	}
+	if d.status.Paused {
+		lines = append(lines, styles.RenderDetailRow("Paused", "yes"))
+	}
+	if d.status.RestartedAt != "" {
+		lines = append(lines, styles.RenderDetailRow("Restarted", d.status.RestartedAt))
+	}
+	lines = append(lines, "")
+
+	var status string
+	switch {
+	case d.err != nil:
+		status = styles.StatusErrorStyle.Render("✖ " + d.err.Error())
+	case d.state == rolloutStateRunning:
+		status = styles.StatusPendingStyle.Render(d.action.String() + "...")
+	case d.status.Failed:
+		status = styles.StatusErrorStyle.Render("✖ " + d.status.Message)
+	case d.status.Complete:
+		status = styles.StatusRunningStyle.Render("✔ " + d.status.Message)
+	case d.state == rolloutStateFollowing && d.action == RolloutPause && d.status.Paused:
+		status = styles.StatusRunningStyle.Render("✔ Rollout is paused: pod template changes are not rolled out until it is resumed")
+	default:
+		status = styles.StatusPendingStyle.Render(d.status.Message)
+	}
+	lines = append(lines, lipgloss.NewStyle().Width(d.width-4).Render(status), "")
+
+	if d.state == rolloutStateChoosing {
+		helpText := styles.RenderKeyHelp("[r]", "Restart")
+		if d.CanPause() {
+			helpText += "  " + styles.RenderKeyHelp("[p]", "Pause")
+		}
+		if d.CanResume() {
+			helpText += "  " + styles.RenderKeyHelp("[u]", "Resume")
+		}
+		lines = append(lines, helpText+"  "+styles.RenderKeyHelp("[Esc]", "Cancel"))
+	} else {
+		lines = append(lines, styles.RenderKeyHelp("[Esc]", "Close"))
+	}
+
+	return styles.BorderStyle.
+		Width(d.width).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
@@ -0,0 +1,82 @@
+package components
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/williajm/k8s-tui/internal/models"
+)
+
+func TestRolloutDialog_Actions(t *testing.T) {
+	d := NewRolloutDialog()
+	d.SetWidth(100)
+
+	if d.IsVisible() || d.View() != "" {
+		t.Error("NewRolloutDialog() should be hidden")
+	}
+
+	d.Show("Deployment", "shop", "web", models.RolloutStatus{Desired: 3, Updated: 3, Ready: 3, Available: 3, Complete: true, Message: "rolled out"})
+	if !d.IsChoosing() || !d.CanPause() || d.CanResume() {
+		t.Error("Show() should offer restart and pause for a running deployment")
+	}
+	view := d.View()
+	for _, want := range []string{"Rollout Deployment", "shop/web", "3/3", "[r]", "[p]"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("View() missing %q", want)
+		}
+	}
+
+	d.SetStatus(models.RolloutStatus{Desired: 3, Paused: true, Message: "Rollout is paused"})
+	if d.CanPause() || !d.CanResume() || !strings.Contains(d.View(), "[u]") {
+		t.Error("A paused deployment should offer resume instead of pause")
+	}
+
+	d.Show("StatefulSet", "shop", "db", models.RolloutStatus{Desired: 2})
+	if d.CanPause() || d.CanResume() || strings.Contains(d.View(), "[p]") {
+		t.Error("A statefulset should not offer pause or resume")
+	}
+}
+
+func TestRolloutDialog_Progress(t *testing.T) {
+	d := NewRolloutDialog()
+	d.SetWidth(100)
+	d.Show("Deployment", "shop", "web", models.RolloutStatus{Desired: 3, Updated: 3, Ready: 3, Available: 3, Complete: true})
+
+	d.SetRunning(RolloutRestart)
+	if d.IsChoosing() || !strings.Contains(d.View(), "Restart...") {
+		t.Error("SetRunning() should show the restart in progress")
+	}
+
+	d.SetActionResult(errors.New("forbidden"))
+	if !d.IsChoosing() || !strings.Contains(d.View(), "forbidden") {
+		t.Error("SetActionResult() should show the error and allow choosing again")
+	}
+
+	d.SetRunning(RolloutRestart)
+	d.SetActionResult(nil)
+	d.SetStatus(models.RolloutStatus{Desired: 3, Updated: 1, Ready: 3, Available: 3, Message: "1 of 3 new replicas have been updated", RestartedAt: "2026-10-16T09:00:00Z"})
+	view := d.View()
+	for _, want := range []string{"1/3", "1 of 3 new replicas", "2026-10-16T09:00:00Z", "[Esc]"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("View() missing %q", want)
+		}
+	}
+
+	d.SetStatus(models.RolloutStatus{Desired: 3, Updated: 1, Failed: true, Message: "exceeded its progress deadline"})
+	if !strings.Contains(d.View(), "✖ exceeded its progress deadline") {
+		t.Error("View() should show the failed rollout")
+	}
+
+	d.SetStatus(models.RolloutStatus{Desired: 3, Updated: 3, Ready: 3, Available: 3, Complete: true, Message: "successfully rolled out"})
+	if !strings.Contains(d.View(), "✔ successfully rolled out") {
+		t.Error("View() should show the completed rollout")
+	}
+
+	d.SetRunning(RolloutPause)
+	d.SetActionResult(nil)
+	d.SetStatus(models.RolloutStatus{Desired: 3, Paused: true, Message: "Rollout is paused"})
+	if !strings.Contains(d.View(), "✔ Rollout is paused") {
+		t.Error("View() should confirm the pause")
+	}
+}
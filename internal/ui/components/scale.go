@@ -0,0 +1,219 @@
+package components
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/williajm/k8s-tui/internal/models"
+	"github.com/williajm/k8s-tui/internal/ui/styles"
+)
+
+// maxScaleDigits limits the length of the replica count input
+const maxScaleDigits = 5
+
+// scaleState is the progress of the scale dialog
+type scaleState int
+
+const (
+	scaleStateEditing    scaleState = iota // Entering the replica count
+	scaleStateScaling                      // Scale request in progress
+	scaleStateConverging                   // Scaled; waiting for the pods to follow
+)
+
+// ScaleDialog sets the replica count of a deployment or statefulset and follows
+// the ready count as the workload converges on it
+type ScaleDialog struct {
+	kind        string
+	namespace   string
+	name        string
+	input       string
+	target      int32
+	ready       int32
+	current     int32
+	autoscalers []models.HorizontalPodAutoscalerInfo // nil while loading
+	state       scaleState
+	err         error
+	width       int
+	visible     bool
+}
+
+// NewScaleDialog creates a new, hidden scale dialog
+func NewScaleDialog() *ScaleDialog {
+	return &ScaleDialog{
+		width: 60,
+	}
+}
+
+// SetWidth sets the width of the dialog
+func (d *ScaleDialog) SetWidth(width int) {
+	d.width = width
+}
+
+// Show shows the dialog for a workload, with the input prefilled with its replica count
+func (d *ScaleDialog) Show(kind, namespace, name string, replicas int32) {
+	d.kind = kind
+	d.namespace = namespace
+	d.name = name
+	d.input = strconv.Itoa(int(replicas))
+	d.target = replicas
+	d.ready = 0
+	d.current = 0
+	d.autoscalers = nil
+	d.state = scaleStateEditing
+	d.err = nil
+	d.visible = true
+}
+
+// Hide hides the dialog
+func (d *ScaleDialog) Hide() {
+	d.visible = false
+}
+
+// IsVisible returns whether the dialog is visible
+func (d *ScaleDialog) IsVisible() bool {
+	return d.visible
+}
+
+// IsEditing reports whether the replica count is being entered
+func (d *ScaleDialog) IsEditing() bool {
+	return d.state == scaleStateEditing
+}
+
+// InsertText appends the digits of text to the replica count
+func (d *ScaleDialog) InsertText(text string) {
+	for _, r := range text {
+		if r >= '0' && r <= '9' && len(d.input) < maxScaleDigits {
+			d.input += string(r)
+		}
+	}
+}
+
+// DeleteChar removes the last digit of the replica count
+func (d *ScaleDialog) DeleteChar() {
+	if len(d.input) > 0 {
+		d.input = d.input[:len(d.input)-1]
+	}
+}
+
+// Increment adds one to the replica count
+func (d *ScaleDialog) Increment() {
+	if replicas, err := d.Replicas(); err == nil || d.input == "" {
+		d.input = strconv.Itoa(int(replicas) + 1)
+	}
+}
+
+// Decrement subtracts one from the replica count, stopping at zero
+func (d *ScaleDialog) Decrement() {
+	if replicas, err := d.Replicas(); err == nil && replicas > 0 {
+		d.input = strconv.Itoa(int(replicas) - 1)
+	}
+}
+
+// Replicas returns the entered replica count
+func (d *ScaleDialog) Replicas() (int32, error) {
+	replicas, err := strconv.ParseInt(d.input, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid replica count: %q", d.input)
+	}
+	return int32(replicas), nil
+}
+
+// SetAutoscalers sets the HPAs that scale the workload
+func (d *ScaleDialog) SetAutoscalers(autoscalers []models.HorizontalPodAutoscalerInfo) {
+	d.autoscalers = autoscalers
+}
+
+// SetScaling marks the scale to the target replica count as in progress
+func (d *ScaleDialog) SetScaling(target int32) {
+	d.target = target
+	d.state = scaleStateScaling
+	d.err = nil
+}
+
+// SetScaleResult records the outcome of the scale request. On failure the replica
+// count can be edited again.
+func (d *ScaleDialog) SetScaleResult(err error) {
+	if err != nil {
+		d.state = scaleStateEditing
+		d.err = err
+		return
+	}
+	d.state = scaleStateConverging
+}
+
+// SetProgress records the ready and current replica counts of the workload
+func (d *ScaleDialog) SetProgress(ready, current int32) {
+	d.ready = ready
+	d.current = current
+}
+
+// IsConverged reports whether the workload runs exactly the target number of ready replicas
+func (d *ScaleDialog) IsConverged() bool {
+	return d.state == scaleStateConverging && d.ready == d.target && d.current == d.target
+}
+
+// View renders the dialog
+func (d *ScaleDialog) View() string {
+	if !d.visible {
+		return ""
+	}
+
+	target := d.name
+	if d.namespace != "" {
+		target = d.namespace + "/" + d.name
+	}
+
+	lines := []string{
+		styles.DetailHeaderStyle.Render("Scale " + d.kind),
+		"",
+		styles.RenderDetailRow("Object", target),
+		styles.RenderDetailRow("Pods", fmt.Sprintf("%d ready, %d current", d.ready, d.current)),
+	}
+
+	switch d.state {
+	case scaleStateEditing:
+		lines = append(lines, styles.RenderDetailRow("Replicas", d.input+"█"))
+	default:
+		lines = append(lines, styles.RenderDetailRow("Replicas", strconv.Itoa(int(d.target))))
+	}
+
+	lines = append(lines, "")
+	if d.autoscalers == nil {
+		lines = append(lines, styles.StatusPendingStyle.Render("Checking for autoscalers..."))
+	}
+	for _, hpa := range d.autoscalers {
+		warning := fmt.Sprintf("⚠ HPA %s scales this %s between %d and %d replicas and will override a manual scale",
+			hpa.Name, d.kind, hpa.MinReplicas, hpa.MaxReplicas)
+		lines = append(lines, lipgloss.NewStyle().Width(d.width-4).Render(styles.StatusPendingStyle.Render(warning)))
+	}
+
+	var status string
+	switch {
+	case d.err != nil:
+		status = styles.StatusErrorStyle.Render("✖ " + d.err.Error())
+	case d.state == scaleStateScaling:
+		status = styles.StatusPendingStyle.Render("Scaling...")
+	case d.IsConverged():
+		status = styles.StatusRunningStyle.Render(fmt.Sprintf("✔ Scaled to %d ready replicas", d.target))
+	case d.state == scaleStateConverging:
+		status = styles.StatusPendingStyle.Render(fmt.Sprintf("Waiting for %d ready replicas...", d.target))
+	}
+	if status != "" {
+		lines = append(lines, lipgloss.NewStyle().Width(d.width-4).Render(status))
+	}
+
+	var helpText string
+	if d.state == scaleStateEditing {
+		helpText = styles.RenderKeyHelp("[0-9 ↑/↓]", "Replicas") + "  " +
+			styles.RenderKeyHelp("[Enter]", "Scale") + "  " +
+			styles.RenderKeyHelp("[Esc]", "Cancel")
+	} else {
+		helpText = styles.RenderKeyHelp("[Esc]", "Close")
+	}
+	lines = append(lines, "", helpText)
+
+	return styles.BorderStyle.
+		Width(d.width).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
@@ -0,0 +1,104 @@
+package components
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/williajm/k8s-tui/internal/models"
+)
+
+func TestScaleDialog_Input(t *testing.T) {
+	d := NewScaleDialog()
+	d.SetWidth(100)
+
+	if d.IsVisible() || d.View() != "" {
+		t.Error("NewScaleDialog() should be hidden")
+	}
+
+	d.Show("Deployment", "shop", "web", 3)
+	if replicas, err := d.Replicas(); err != nil || replicas != 3 {
+		t.Fatalf("Replicas() = %d, %v, want the prefilled 3", replicas, err)
+	}
+
+	d.InsertText("2x")
+	d.Increment()
+	if replicas, _ := d.Replicas(); replicas != 33 {
+		t.Errorf("Replicas() = %d, want 33 after typing 2 and incrementing", replicas)
+	}
+	d.DeleteChar()
+	d.DeleteChar()
+	if _, err := d.Replicas(); err == nil {
+		t.Error("Replicas() should fail for an empty input")
+	}
+	d.Decrement()
+	d.Increment()
+	if replicas, _ := d.Replicas(); replicas != 1 {
+		t.Errorf("Replicas() = %d, want 1", replicas)
+	}
+	d.Decrement()
+	d.Decrement()
+	if replicas, _ := d.Replicas(); replicas != 0 {
+		t.Errorf("Replicas() = %d, want decrement to stop at 0", replicas)
+	}
+
+	d.InsertText("1234567")
+	if len(d.input) != maxScaleDigits {
+		t.Errorf("input = %q, want at most %d digits", d.input, maxScaleDigits)
+	}
+}
+
+func TestScaleDialog_Autoscalers(t *testing.T) {
+	d := NewScaleDialog()
+	d.SetWidth(100)
+	d.Show("Deployment", "shop", "web", 3)
+
+	if view := d.View(); !strings.Contains(view, "Checking for autoscalers") {
+		t.Error("View() should show the autoscalers loading")
+	}
+
+	d.SetAutoscalers([]models.HorizontalPodAutoscalerInfo{})
+	if view := d.View(); strings.Contains(view, "HPA") || strings.Contains(view, "Checking") {
+		t.Error("View() should not warn without autoscalers")
+	}
+
+	d.SetAutoscalers([]models.HorizontalPodAutoscalerInfo{{Name: "web-hpa", MinReplicas: 2, MaxReplicas: 10}})
+	view := d.View()
+	for _, want := range []string{"HPA web-hpa", "between 2 and 10", "override a manual scale"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("View() missing %q", want)
+		}
+	}
+}
+
+func TestScaleDialog_Progress(t *testing.T) {
+	d := NewScaleDialog()
+	d.SetWidth(100)
+	d.Show("StatefulSet", "shop", "db", 1)
+	d.SetProgress(1, 1)
+
+	d.SetScaling(3)
+	if d.IsEditing() || !strings.Contains(d.View(), "Scaling...") {
+		t.Error("SetScaling() should show the scale in progress")
+	}
+
+	d.SetScaleResult(errors.New("forbidden"))
+	if !d.IsEditing() || !strings.Contains(d.View(), "forbidden") {
+		t.Error("SetScaleResult() should show the error and allow editing again")
+	}
+
+	d.SetScaling(3)
+	d.SetScaleResult(nil)
+	d.SetProgress(1, 2)
+	if d.IsConverged() || !strings.Contains(d.View(), "Waiting for 3 ready replicas") {
+		t.Error("View() should wait for the replicas")
+	}
+	if !strings.Contains(d.View(), "1 ready, 2 current") {
+		t.Error("View() should show the live pod counts")
+	}
+
+	d.SetProgress(3, 3)
+	if !d.IsConverged() || !strings.Contains(d.View(), "Scaled to 3 ready replicas") {
+		t.Error("View() should show the scale completed")
+	}
+}
@@ -80,6 +80,16 @@ func (s *Selector) GetSelected() string {
 	return ""
 }
 
+// SelectOption moves the selection to the given option if it exists
+func (s *Selector) SelectOption(option string) {
+	for i, o := range s.options {
+		if o == option {
+			s.selectedIdx = i
+			return
+		}
+	}
+}
+
 // View renders the selector
 func (s *Selector) View() string {
 	if !s.visible || len(s.options) == 0 {
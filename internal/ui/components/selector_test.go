@@ -172,6 +172,22 @@ func TestSelector_GetSelected(t *testing.T) {
 	}
 }
 
+func TestSelector_SelectOption(t *testing.T) {
+	selector := NewSelector("Test")
+	selector.SetOptions([]string{"dev", "staging", "production"})
+
+	selector.SelectOption("production")
+	if selector.GetSelected() != "production" {
+		t.Errorf("SelectOption(production) selected = %s, want production", selector.GetSelected())
+	}
+
+	// Unknown option leaves the selection unchanged
+	selector.SelectOption("missing")
+	if selector.GetSelected() != "production" {
+		t.Errorf("SelectOption(missing) selected = %s, want production", selector.GetSelected())
+	}
+}
+
 func TestSelector_View(t *testing.T) {
 	selector := NewSelector("Select Namespace")
 
@@ -1,14 +1,21 @@
 package components
 
 import (
+	"strings"
+
 	"github.com/charmbracelet/lipgloss"
 	"github.com/williajm/k8s-tui/internal/ui/styles"
 )
 
-// Tab represents a single tab
+// GenericTabTitle is the title of the generic resources tab before a resource is chosen
+const GenericTabTitle = "✦ Resources"
+
+// Tab represents a single tab. A tab with views groups related resource types under
+// one title; its ID is that of the view currently shown.
 type Tab struct {
 	Title string
 	ID    int
+	Views []Tab
 }
 
 // Tabs represents a tab navigation component
@@ -27,6 +34,28 @@ func NewTabs() *Tabs {
 			{Title: "⧉ Deployments", ID: 2},
 			{Title: "▦ StatefulSets", ID: 3},
 			{Title: "⚡ Events", ID: 4},
+			{Title: "⚙ ConfigMaps", ID: 5},
+			{Title: "◆ Secrets", ID: 6},
+			{Title: "▣ Nodes", ID: 7},
+			{Title: "◎ Jobs", ID: 8},
+			{Title: "◷ CronJobs", ID: 9},
+			{Title: "⊕ DaemonSets", ID: 10},
+			{Title: "▤ ReplicaSets", ID: 11},
+			{Title: "⇌ Ingresses", ID: 12},
+			{Title: "⛁ Storage", ID: 13, Views: []Tab{
+				{Title: "PVCs", ID: 13},
+				{Title: "PVs", ID: 14},
+				{Title: "StorageClasses", ID: 15},
+			}},
+			{Title: "⇅ HPAs", ID: 16},
+			{Title: "⚇ ServiceAccounts", ID: 17},
+			{Title: "⚿ Roles", ID: 18},
+			{Title: "⚿ ClusterRoles", ID: 19},
+			{Title: "⚿ RoleBindings", ID: 20},
+			{Title: "⚿ ClusterRoleBindings", ID: 21},
+			{Title: "⛨ NetworkPolicies", ID: 22},
+			{Title: "⛉ PDBs", ID: 23},
+			{Title: GenericTabTitle, ID: 24},
 		},
 		activeTab: 0,
 		width:     80,
@@ -38,49 +67,170 @@ func (t *Tabs) SetWidth(width int) {
 	t.width = width
 }
 
+// SetTitle changes the title of the tab with the given ID
+func (t *Tabs) SetTitle(tabID int, title string) {
+	for i := range t.tabs {
+		if t.tabs[i].ID == tabID {
+			t.tabs[i].Title = title
+			return
+		}
+	}
+}
+
 // GetActiveTab returns the currently active tab ID
 func (t *Tabs) GetActiveTab() int {
 	return t.activeTab
 }
 
-// SetActiveTab sets the active tab
+// SetActiveTab sets the active tab, or the tab holding the view with that ID
 func (t *Tabs) SetActiveTab(tabID int) {
-	if tabID >= 0 && tabID < len(t.tabs) {
+	if i := t.tabIndex(tabID); i >= 0 {
+		t.tabs[i].ID = tabID
 		t.activeTab = tabID
 	}
 }
 
 // NextTab switches to the next tab
 func (t *Tabs) NextTab() {
-	t.activeTab = (t.activeTab + 1) % len(t.tabs)
+	i := t.tabIndex(t.activeTab)
+	t.activeTab = t.tabs[(i+1)%len(t.tabs)].ID
 }
 
 // PrevTab switches to the previous tab
 func (t *Tabs) PrevTab() {
-	t.activeTab = (t.activeTab - 1 + len(t.tabs)) % len(t.tabs)
+	i := t.tabIndex(t.activeTab)
+	t.activeTab = t.tabs[(i-1+len(t.tabs))%len(t.tabs)].ID
 }
 
-// View renders the tabs
-func (t *Tabs) View() string {
-	var renderedTabs []string
+// NextView switches to the next view of the active tab. It returns false if the
+// tab has no views.
+func (t *Tabs) NextView() bool {
+	return t.cycleView(1)
+}
+
+// PrevView switches to the previous view of the active tab. It returns false if
+// the tab has no views.
+func (t *Tabs) PrevView() bool {
+	return t.cycleView(-1)
+}
+
+// cycleView moves the active tab step views forward, wrapping around
+func (t *Tabs) cycleView(step int) bool {
+	tab := &t.tabs[t.tabIndex(t.activeTab)]
+	if len(tab.Views) == 0 {
+		return false
+	}
+
+	current := 0
+	for i, view := range tab.Views {
+		if view.ID == tab.ID {
+			current = i
+		}
+	}
+	tab.ID = tab.Views[(current+step+len(tab.Views))%len(tab.Views)].ID
+	t.activeTab = tab.ID
+	return true
+}
+
+// tabIndex returns the index of the tab with the given ID or view ID, or -1
+func (t *Tabs) tabIndex(tabID int) int {
+	for i, tab := range t.tabs {
+		if tab.ID == tabID {
+			return i
+		}
+		for _, view := range tab.Views {
+			if view.ID == tabID {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// title returns the text shown for a tab. Tabs with views name the view shown;
+// the active one lists all of them with the current view in brackets.
+func (t *Tabs) title(tab Tab) string {
+	if len(tab.Views) == 0 {
+		return tab.Title
+	}
 
-	for _, tab := range t.tabs {
-		var style lipgloss.Style
+	if tab.ID != t.activeTab {
+		for _, view := range tab.Views {
+			if view.ID == tab.ID {
+				return tab.Title + ": " + view.Title
+			}
+		}
+		return tab.Title
+	}
 
+	views := make([]string, len(tab.Views))
+	for i, view := range tab.Views {
+		views[i] = view.Title
+		if view.ID == tab.ID {
+			views[i] = "[" + view.Title + "]"
+		}
+	}
+	return tab.Title + " " + strings.Join(views, " ")
+}
+
+// View renders the tabs. When they do not fit the width, only a window of tabs
+// around the active one is shown, with markers for the hidden tabs on either side.
+func (t *Tabs) View() string {
+	renderedTabs := make([]string, len(t.tabs))
+	for i, tab := range t.tabs {
+		style := styles.InactiveTabStyle
 		if tab.ID == t.activeTab {
 			style = styles.ActiveTabStyle
-		} else {
-			style = styles.InactiveTabStyle
 		}
+		renderedTabs[i] = style.Render(t.title(tab))
+	}
 
-		renderedTabs = append(renderedTabs, style.Render(tab.Title))
+	start, end := t.visibleRange(renderedTabs)
+	visible := renderedTabs[start:end]
+	if start > 0 {
+		visible = append([]string{styles.InactiveTabStyle.Render("‹")}, visible...)
+	}
+	if end < len(renderedTabs) {
+		visible = append(visible, styles.InactiveTabStyle.Render("›"))
 	}
 
 	// Join tabs horizontally
-	tabsRow := lipgloss.JoinHorizontal(lipgloss.Top, renderedTabs...)
+	tabsRow := lipgloss.JoinHorizontal(lipgloss.Top, visible...)
 
 	// Add border
 	return styles.TabBorderStyle.
 		Width(t.width).
 		Render(tabsRow)
 }
+
+// visibleRange returns the range of rendered tabs that fits the width, dropping
+// tabs from whichever end is farther from the active tab
+func (t *Tabs) visibleRange(renderedTabs []string) (start, end int) {
+	available := t.width - styles.TabBorderStyle.GetHorizontalFrameSize()
+	markerWidth := lipgloss.Width(styles.InactiveTabStyle.Render("‹"))
+
+	width := func(start, end int) int {
+		total := 0
+		for _, tab := range renderedTabs[start:end] {
+			total += lipgloss.Width(tab)
+		}
+		if start > 0 {
+			total += markerWidth
+		}
+		if end < len(renderedTabs) {
+			total += markerWidth
+		}
+		return total
+	}
+
+	active := t.tabIndex(t.activeTab)
+	start, end = 0, len(renderedTabs)
+	for end-start > 1 && width(start, end) > available {
+		if active-start > end-1-active {
+			start++
+		} else {
+			end--
+		}
+	}
+	return start, end
+}
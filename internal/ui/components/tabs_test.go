@@ -1,7 +1,10 @@
 package components
 
 import (
+	"strings"
 	"testing"
+
+	"github.com/charmbracelet/lipgloss"
 )
 
 func TestNewTabs(t *testing.T) {
@@ -19,17 +22,30 @@ func TestNewTabs(t *testing.T) {
 		t.Errorf("NewTabs().width = %d, want 80", tabs.width)
 	}
 
-	if len(tabs.tabs) != 5 {
-		t.Errorf("NewTabs() has %d tabs, want 5", len(tabs.tabs))
+	expectedTitles := []string{"⬡ Pods", "◈ Services", "⧉ Deployments", "▦ StatefulSets", "⚡ Events", "⚙ ConfigMaps", "◆ Secrets", "▣ Nodes", "◎ Jobs", "◷ CronJobs", "⊕ DaemonSets", "▤ ReplicaSets", "⇌ Ingresses", "⛁ Storage", "⇅ HPAs", "⚇ ServiceAccounts", "⚿ Roles", "⚿ ClusterRoles", "⚿ RoleBindings", "⚿ ClusterRoleBindings", "⛨ NetworkPolicies", "⛉ PDBs", "✦ Resources"}
+	if len(tabs.tabs) != len(expectedTitles) {
+		t.Fatalf("NewTabs() has %d tabs, want %d", len(tabs.tabs), len(expectedTitles))
 	}
 
-	expectedTitles := []string{"⬡ Pods", "◈ Services", "⧉ Deployments", "▦ StatefulSets", "⚡ Events"}
 	for i, expectedTitle := range expectedTitles {
 		if tabs.tabs[i].Title != expectedTitle {
 			t.Errorf("NewTabs().tabs[%d].Title = %s, want %s", i, tabs.tabs[i].Title, expectedTitle)
 		}
-		if tabs.tabs[i].ID != i {
-			t.Errorf("NewTabs().tabs[%d].ID = %d, want %d", i, tabs.tabs[i].ID, i)
+	}
+
+	// Tab and view IDs are the resource types, in order
+	var ids []int
+	for _, tab := range tabs.tabs {
+		if len(tab.Views) == 0 {
+			ids = append(ids, tab.ID)
+		}
+		for _, view := range tab.Views {
+			ids = append(ids, view.ID)
+		}
+	}
+	for i, id := range ids {
+		if id != i {
+			t.Errorf("Resource type %d has ID %d", i, id)
 		}
 	}
 }
@@ -91,7 +107,7 @@ func TestTabs_SetActiveTab(t *testing.T) {
 		},
 		{
 			name:     "invalid tab beyond range",
-			tabID:    10,
+			tabID:    99,
 			expected: 0, // Should not change from initial value
 		},
 	}
@@ -110,69 +126,84 @@ func TestTabs_SetActiveTab(t *testing.T) {
 
 func TestTabs_NextTab(t *testing.T) {
 	tabs := NewTabs()
+	tabCount := len(tabs.tabs)
 
-	// Start at 0, next should be 1
-	tabs.NextTab()
-	if tabs.activeTab != 1 {
-		t.Errorf("After NextTab() from 0, activeTab = %d, want 1", tabs.activeTab)
+	// Each NextTab advances by one tab
+	for want := 1; want < tabCount; want++ {
+		tabs.NextTab()
+		if tabs.activeTab != tabs.tabs[want].ID {
+			t.Errorf("After NextTab() from tab %d, activeTab = %d, want %d", want-1, tabs.activeTab, tabs.tabs[want].ID)
+		}
 	}
 
-	// Next should be 2
+	// Next should wrap around to 0
 	tabs.NextTab()
-	if tabs.activeTab != 2 {
-		t.Errorf("After NextTab() from 1, activeTab = %d, want 2", tabs.activeTab)
+	if tabs.activeTab != 0 {
+		t.Errorf("After NextTab() from %d, activeTab = %d, want 0 (wrap around)", tabCount-1, tabs.activeTab)
 	}
+}
 
-	// Next should be 3
-	tabs.NextTab()
-	if tabs.activeTab != 3 {
-		t.Errorf("After NextTab() from 2, activeTab = %d, want 3", tabs.activeTab)
-	}
+func TestTabs_PrevTab(t *testing.T) {
+	tabs := NewTabs()
+	tabCount := len(tabs.tabs)
 
-	// Next should be 4
-	tabs.NextTab()
-	if tabs.activeTab != 4 {
-		t.Errorf("After NextTab() from 3, activeTab = %d, want 4", tabs.activeTab)
+	// Start at 0, prev should wrap to the last tab
+	tabs.PrevTab()
+	if tabs.activeTab != tabs.tabs[tabCount-1].ID {
+		t.Errorf("After PrevTab() from 0, activeTab = %d, want %d (wrap around)", tabs.activeTab, tabs.tabs[tabCount-1].ID)
 	}
 
-	// Next should wrap around to 0
-	tabs.NextTab()
-	if tabs.activeTab != 0 {
-		t.Errorf("After NextTab() from 4, activeTab = %d, want 0 (wrap around)", tabs.activeTab)
+	// Each PrevTab moves back by one tab
+	for want := tabCount - 2; want >= 0; want-- {
+		tabs.PrevTab()
+		if tabs.activeTab != tabs.tabs[want].ID {
+			t.Errorf("After PrevTab() from tab %d, activeTab = %d, want %d", want+1, tabs.activeTab, tabs.tabs[want].ID)
+		}
 	}
 }
 
-func TestTabs_PrevTab(t *testing.T) {
+func TestTabs_Views(t *testing.T) {
 	tabs := NewTabs()
 
-	// Start at 0, prev should wrap to 4
-	tabs.PrevTab()
-	if tabs.activeTab != 4 {
-		t.Errorf("After PrevTab() from 0, activeTab = %d, want 4 (wrap around)", tabs.activeTab)
+	// Tabs without views ignore the view keys
+	if tabs.NextView() || tabs.PrevView() || tabs.activeTab != 0 {
+		t.Errorf("Expected no view change on the Pods tab, activeTab = %d", tabs.activeTab)
 	}
 
-	// Prev should be 3
-	tabs.PrevTab()
-	if tabs.activeTab != 3 {
-		t.Errorf("After PrevTab() from 4, activeTab = %d, want 3", tabs.activeTab)
+	// Selecting a view activates the Storage tab on that view
+	tabs.SetActiveTab(14)
+	if tabs.activeTab != 14 || tabs.tabs[13].ID != 14 {
+		t.Fatalf("Expected the PVs view, activeTab = %d", tabs.activeTab)
+	}
+	if view := tabs.View(); !strings.Contains(view, "Storage") || !strings.Contains(view, "[PVs]") {
+		t.Errorf("Expected the view selector with PVs selected, got:\n%s", view)
 	}
 
-	// Prev should be 2
-	tabs.PrevTab()
-	if tabs.activeTab != 2 {
-		t.Errorf("After PrevTab() from 3, activeTab = %d, want 2", tabs.activeTab)
+	if !tabs.NextView() || tabs.activeTab != 15 {
+		t.Errorf("Expected the StorageClasses view, activeTab = %d", tabs.activeTab)
+	}
+	if !tabs.NextView() || tabs.activeTab != 13 {
+		t.Errorf("Expected the views to wrap around to PVCs, activeTab = %d", tabs.activeTab)
+	}
+	if !tabs.PrevView() || tabs.activeTab != 15 {
+		t.Errorf("Expected PrevView to wrap around to StorageClasses, activeTab = %d", tabs.activeTab)
 	}
 
-	// Prev should be 1
+	// The Storage tab is a single stop and remembers its view
+	tabs.NextTab()
+	if tabs.activeTab != 16 {
+		t.Errorf("Expected the HPAs tab after Storage, activeTab = %d", tabs.activeTab)
+	}
+	if view := tabs.View(); !strings.Contains(view, "Storage: StorageClasses") {
+		t.Errorf("Expected the inactive Storage tab to name its view, got:\n%s", view)
+	}
 	tabs.PrevTab()
-	if tabs.activeTab != 1 {
-		t.Errorf("After PrevTab() from 2, activeTab = %d, want 1", tabs.activeTab)
+	if tabs.activeTab != 15 {
+		t.Errorf("Expected to return to the StorageClasses view, activeTab = %d", tabs.activeTab)
 	}
-
-	// Prev should be 0
 	tabs.PrevTab()
-	if tabs.activeTab != 0 {
-		t.Errorf("After PrevTab() from 1, activeTab = %d, want 0", tabs.activeTab)
+	if tabs.activeTab != 12 {
+		t.Errorf("Expected the Ingresses tab before Storage, activeTab = %d", tabs.activeTab)
 	}
 }
 
@@ -186,7 +217,7 @@ func TestTabs_View(t *testing.T) {
 	}
 
 	// Test with different active tabs
-	for i := 0; i < 5; i++ {
+	for i := 0; i <= int(ResourceTypeGeneric); i++ {
 		tabs.SetActiveTab(i)
 		view = tabs.View()
 		if view == "" {
@@ -204,11 +235,12 @@ func TestTabs_View(t *testing.T) {
 
 func TestTabs_NavigationCycle(t *testing.T) {
 	tabs := NewTabs()
+	tabCount := len(tabs.tabs)
 
 	// Test full forward cycle
-	for i := 0; i < 5; i++ {
-		if tabs.GetActiveTab() != i {
-			t.Errorf("Forward cycle iteration %d: activeTab = %d, want %d", i, tabs.GetActiveTab(), i)
+	for i := 0; i < tabCount; i++ {
+		if tabs.GetActiveTab() != tabs.tabs[i].ID {
+			t.Errorf("Forward cycle iteration %d: activeTab = %d, want %d", i, tabs.GetActiveTab(), tabs.tabs[i].ID)
 		}
 		tabs.NextTab()
 	}
@@ -219,8 +251,8 @@ func TestTabs_NavigationCycle(t *testing.T) {
 	}
 
 	// Test full backward cycle
-	for i := 0; i < 5; i++ {
-		expectedTab := (5 - i) % 5
+	for i := 0; i < tabCount; i++ {
+		expectedTab := tabs.tabs[(tabCount-i)%tabCount].ID
 		if tabs.GetActiveTab() != expectedTab {
 			t.Errorf("Backward cycle iteration %d: activeTab = %d, want %d", i, tabs.GetActiveTab(), expectedTab)
 		}
@@ -232,3 +264,47 @@ func TestTabs_NavigationCycle(t *testing.T) {
 		t.Errorf("After full backward cycle, activeTab = %d, want 0", tabs.GetActiveTab())
 	}
 }
+
+func TestTabs_SetTitle(t *testing.T) {
+	tabs := NewTabs()
+
+	last := len(tabs.tabs) - 1
+	tabs.SetTitle(int(ResourceTypeGeneric), "✦ certificates.cert-manager.io")
+	if tabs.tabs[last].Title != "✦ certificates.cert-manager.io" {
+		t.Errorf("SetTitle() resulted in title = %s", tabs.tabs[last].Title)
+	}
+
+	// Unknown IDs are ignored
+	tabs.SetTitle(42, "nope")
+	for _, tab := range tabs.tabs {
+		if tab.Title == "nope" {
+			t.Error("SetTitle() with unknown ID should not change any tab")
+		}
+	}
+}
+
+func TestTabs_ViewScrollsToActiveTab(t *testing.T) {
+	tabs := NewTabs()
+	tabs.SetWidth(60)
+
+	view := tabs.View()
+	if !strings.Contains(view, "Pods") || strings.Contains(view, "Resources") || !strings.Contains(view, "›") {
+		t.Errorf("Expected the first tabs and a marker for hidden tabs, got:\n%s", view)
+	}
+
+	tabs.SetActiveTab(int(ResourceTypeGeneric))
+	view = tabs.View()
+	if !strings.Contains(view, "Resources") || strings.Contains(view, "Pods") || !strings.Contains(view, "‹") {
+		t.Errorf("Expected the last tabs and a marker for hidden tabs, got:\n%s", view)
+	}
+	for _, line := range strings.Split(view, "\n") {
+		if lipgloss.Width(line) > 60 {
+			t.Errorf("Tab bar line is %d wide, want at most 60", lipgloss.Width(line))
+		}
+	}
+
+	tabs.SetWidth(500)
+	if view = tabs.View(); strings.Contains(view, "‹") || strings.Contains(view, "›") {
+		t.Error("Expected no markers when every tab fits")
+	}
+}
@@ -0,0 +1,107 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/williajm/k8s-tui/internal/ui/styles"
+)
+
+// ValueViewer displays the full value of a single key, such as a configmap entry,
+// in a scrollable viewport
+type ValueViewer struct {
+	title    string
+	value    string
+	viewport viewport.Model
+	width    int
+	height   int
+}
+
+// NewValueViewer creates a new value viewer component
+func NewValueViewer() *ValueViewer {
+	vp := viewport.New(80, 20)
+	vp.Style = lipgloss.NewStyle()
+
+	return &ValueViewer{
+		viewport: vp,
+		width:    80,
+		height:   20,
+	}
+}
+
+// SetSize sets the dimensions of the value viewer
+func (v *ValueViewer) SetSize(width, height int) {
+	v.width = width
+	v.height = height
+	v.viewport.Width = width - 4
+	// Height calculation: total - footer (2 lines outside box) - border (2) - header with border (2) = 6
+	v.viewport.Height = height - 6
+}
+
+// SetValue sets the title and value to display and scrolls back to the top
+func (v *ValueViewer) SetValue(title, value string) {
+	v.title = title
+	v.value = value
+	v.viewport.SetContent(value)
+	v.viewport.GotoTop()
+}
+
+// Clear discards the displayed value, e.g. to re-mask a revealed secret
+func (v *ValueViewer) Clear() {
+	v.SetValue("", "")
+}
+
+// Update handles viewport updates
+func (v *ValueViewer) Update(msg tea.Msg) (*ValueViewer, tea.Cmd) {
+	var cmd tea.Cmd
+	v.viewport, cmd = v.viewport.Update(msg)
+	return v, cmd
+}
+
+// View renders the value viewer
+func (v *ValueViewer) View() string {
+	header := styles.TableHeaderStyle.
+		Width(v.width - 4).
+		Render(v.title)
+
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		header,
+		v.viewport.View(),
+	)
+
+	borderedContent := styles.BorderStyle.
+		Width(v.width).
+		Height(v.height - 2). // Reserve 2 lines for footer outside border
+		Render(content)
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		borderedContent,
+		v.renderFooter(),
+	)
+}
+
+// renderFooter renders the line count, scroll position and keyboard shortcuts
+func (v *ValueViewer) renderFooter() string {
+	lines := strings.Count(v.value, "\n")
+	if v.value != "" && !strings.HasSuffix(v.value, "\n") {
+		lines++
+	}
+	positionLine := fmt.Sprintf("%d lines (%.0f%%)", lines, v.viewport.ScrollPercent()*100)
+
+	shortcuts := []string{
+		styles.RenderKeyHelp("[↑↓]", "Scroll"),
+		styles.RenderKeyHelp("[q/Esc]", "Back"),
+		styles.RenderKeyHelp("[Ctrl+C]", "Quit"),
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		styles.FooterStyle.Width(v.width).Render(positionLine),
+		styles.FooterStyle.Width(v.width).Render(strings.Join(shortcuts, "  ")),
+	)
+}
@@ -0,0 +1,73 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestValueViewer_SetSize(t *testing.T) {
+	v := NewValueViewer()
+	v.SetSize(120, 40)
+
+	if v.viewport.Width != 116 {
+		t.Errorf("viewport.Width = %d, want 116", v.viewport.Width)
+	}
+	if v.viewport.Height != 34 {
+		t.Errorf("viewport.Height = %d, want 34", v.viewport.Height)
+	}
+}
+
+func TestValueViewer_View(t *testing.T) {
+	v := NewValueViewer()
+	v.SetSize(80, 20)
+	v.SetValue("app-config / settings.yaml", "debug: true\nport: 8080\n")
+
+	view := v.View()
+	for _, want := range []string{"app-config / settings.yaml", "port: 8080", "2 lines", "Back"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("View() missing %q", want)
+		}
+	}
+}
+
+func TestValueViewer_Scroll(t *testing.T) {
+	var lines []string
+	for i := 0; i < 100; i++ {
+		lines = append(lines, fmt.Sprintf("line-%03d", i))
+	}
+
+	v := NewValueViewer()
+	v.SetSize(80, 20)
+	v.SetValue("long", strings.Join(lines, "\n"))
+
+	if strings.Contains(v.View(), "line-099") {
+		t.Fatal("Expected the end of a long value to be scrolled out of view")
+	}
+
+	for i := 0; i < 10; i++ {
+		v, _ = v.Update(tea.KeyMsg{Type: tea.KeyPgDown})
+	}
+	if !strings.Contains(v.View(), "line-099") {
+		t.Error("Expected paging down to reach the last line")
+	}
+
+	// Setting a new value scrolls back to the top
+	v.SetValue("long", strings.Join(lines, "\n"))
+	if !strings.Contains(v.View(), "line-000") {
+		t.Error("Expected SetValue to reset the scroll position")
+	}
+}
+
+func TestValueViewer_Clear(t *testing.T) {
+	v := NewValueViewer()
+	v.SetSize(80, 20)
+	v.SetValue("db-credentials: password", "hunter2")
+	v.Clear()
+
+	if strings.Contains(v.View(), "hunter2") {
+		t.Error("Expected Clear to remove the displayed value")
+	}
+}
@@ -24,10 +24,14 @@ type KeyMap struct {
 	// View switching keys
 	Tab      key.Binding
 	ShiftTab key.Binding
+	NextView key.Binding
+	PrevView key.Binding
 
 	// Resource actions
 	Namespace  key.Binding
 	Context    key.Binding
+	Resources  key.Binding
+	Access     key.Binding
 	Search     key.Binding
 	Logs       key.Binding
 	Events     key.Binding
@@ -37,6 +41,17 @@ type KeyMap struct {
 	Follow     key.Binding
 	Previous   key.Binding
 	Timestamps key.Binding
+	Reveal     key.Binding
+	Node       key.Binding
+	Volume     key.Binding
+	Target     key.Binding
+	Policies   key.Binding
+	Quotas     key.Binding
+	Delete     key.Binding
+	Scale      key.Binding
+	Rollout    key.Binding
+	History    key.Binding
+	Edit       key.Binding
 }
 
 // DefaultKeyMap returns the default key bindings
@@ -103,6 +118,14 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("shift+tab"),
 			key.WithHelp("shift+tab", "prev pane"),
 		),
+		NextView: key.NewBinding(
+			key.WithKeys("]"),
+			key.WithHelp("]", "next view"),
+		),
+		PrevView: key.NewBinding(
+			key.WithKeys("["),
+			key.WithHelp("[", "prev view"),
+		),
 
 		// Resource actions
 		Namespace: key.NewBinding(
@@ -113,6 +136,14 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("c"),
 			key.WithHelp("c", "context"),
 		),
+		Resources: key.NewBinding(
+			key.WithKeys(":"),
+			key.WithHelp(":", "resource kind"),
+		),
+		Access: key.NewBinding(
+			key.WithKeys("a"),
+			key.WithHelp("a", "rbac access query"),
+		),
 		Search: key.NewBinding(
 			key.WithKeys("/"),
 			key.WithHelp("/", "search"),
@@ -149,6 +180,50 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("t"),
 			key.WithHelp("t", "timestamps"),
 		),
+		Reveal: key.NewBinding(
+			key.WithKeys("v"),
+			key.WithHelp("v", "reveal secret"),
+		),
+		Node: key.NewBinding(
+			key.WithKeys("N"),
+			key.WithHelp("N", "go to node"),
+		),
+		Volume: key.NewBinding(
+			key.WithKeys("V"),
+			key.WithHelp("V", "go to volume"),
+		),
+		Target: key.NewBinding(
+			key.WithKeys("T"),
+			key.WithHelp("T", "go to scale target"),
+		),
+		Policies: key.NewBinding(
+			key.WithKeys("P"),
+			key.WithHelp("P", "pod network policies"),
+		),
+		Quotas: key.NewBinding(
+			key.WithKeys("Q"),
+			key.WithHelp("Q", "namespace quotas"),
+		),
+		Delete: key.NewBinding(
+			key.WithKeys("D"),
+			key.WithHelp("D", "delete resource"),
+		),
+		Scale: key.NewBinding(
+			key.WithKeys("S"),
+			key.WithHelp("S", "scale workload"),
+		),
+		Rollout: key.NewBinding(
+			key.WithKeys("R"),
+			key.WithHelp("R", "rollout restart/pause/resume"),
+		),
+		History: key.NewBinding(
+			key.WithKeys("H"),
+			key.WithHelp("H", "rollout history"),
+		),
+		Edit: key.NewBinding(
+			key.WithKeys("e"),
+			key.WithHelp("e", "edit YAML in $EDITOR"),
+		),
 	}
 }
 
@@ -171,13 +246,13 @@ func (k KeyMap) FullHelp() [][]key.Binding {
 		// Navigation
 		{k.Up, k.Down, k.PageUp, k.PageDown, k.Home, k.End},
 		// Selection
-		{k.Enter, k.Back, k.Tab, k.ShiftTab},
+		{k.Enter, k.Back, k.Tab, k.ShiftTab, k.NextView, k.PrevView},
 		// Actions
-		{k.Namespace, k.Context, k.Search, k.Refresh},
+		{k.Namespace, k.Context, k.Resources, k.Access, k.Search, k.Refresh},
 		// Resource actions
-		{k.Logs, k.Events, k.Describe},
+		{k.Logs, k.Events, k.Describe, k.Reveal, k.Node, k.Volume, k.Target, k.Policies, k.Quotas, k.Delete, k.Scale, k.Rollout, k.History},
 		// View actions
-		{k.YAML, k.JSON, k.Follow, k.Previous, k.Timestamps},
+		{k.YAML, k.JSON, k.Edit, k.Follow, k.Previous, k.Timestamps},
 		// Global
 		{k.Help, k.Quit},
 	}
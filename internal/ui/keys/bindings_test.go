@@ -27,13 +27,28 @@ func TestDefaultKeyMap(t *testing.T) {
 		{"Back", km.Back},
 		{"Tab", km.Tab},
 		{"ShiftTab", km.ShiftTab},
+		{"NextView", km.NextView},
+		{"PrevView", km.PrevView},
 		{"Namespace", km.Namespace},
 		{"Context", km.Context},
+		{"Resources", km.Resources},
+		{"Access", km.Access},
 		{"Search", km.Search},
 		{"Logs", km.Logs},
 		{"Events", km.Events},
 		{"YAML", km.YAML},
 		{"Describe", km.Describe},
+		{"Reveal", km.Reveal},
+		{"Node", km.Node},
+		{"Volume", km.Volume},
+		{"Target", km.Target},
+		{"Policies", km.Policies},
+		{"Quotas", km.Quotas},
+		{"Delete", km.Delete},
+		{"Scale", km.Scale},
+		{"Rollout", km.Rollout},
+		{"History", km.History},
+		{"Edit", km.Edit},
 	}
 
 	for _, tt := range tests {
@@ -147,6 +162,16 @@ func TestKeyMap_Selection(t *testing.T) {
 			binding:      km.ShiftTab,
 			expectedKeys: []string{"shift+tab"},
 		},
+		{
+			name:         "NextView",
+			binding:      km.NextView,
+			expectedKeys: []string{"]"},
+		},
+		{
+			name:         "PrevView",
+			binding:      km.PrevView,
+			expectedKeys: []string{"["},
+		},
 	}
 
 	for _, tt := range tests {
@@ -184,6 +209,16 @@ func TestKeyMap_ResourceActions(t *testing.T) {
 			binding:      km.Context,
 			expectedKeys: []string{"c"},
 		},
+		{
+			name:         "Resources",
+			binding:      km.Resources,
+			expectedKeys: []string{":"},
+		},
+		{
+			name:         "Access",
+			binding:      km.Access,
+			expectedKeys: []string{"a"},
+		},
 		{
 			name:         "Search",
 			binding:      km.Search,
@@ -209,6 +244,61 @@ func TestKeyMap_ResourceActions(t *testing.T) {
 			binding:      km.Describe,
 			expectedKeys: []string{"d"},
 		},
+		{
+			name:         "Reveal",
+			binding:      km.Reveal,
+			expectedKeys: []string{"v"},
+		},
+		{
+			name:         "Node",
+			binding:      km.Node,
+			expectedKeys: []string{"N"},
+		},
+		{
+			name:         "Volume",
+			binding:      km.Volume,
+			expectedKeys: []string{"V"},
+		},
+		{
+			name:         "Target",
+			binding:      km.Target,
+			expectedKeys: []string{"T"},
+		},
+		{
+			name:         "Policies",
+			binding:      km.Policies,
+			expectedKeys: []string{"P"},
+		},
+		{
+			name:         "Quotas",
+			binding:      km.Quotas,
+			expectedKeys: []string{"Q"},
+		},
+		{
+			name:         "Delete",
+			binding:      km.Delete,
+			expectedKeys: []string{"D"},
+		},
+		{
+			name:         "Scale",
+			binding:      km.Scale,
+			expectedKeys: []string{"S"},
+		},
+		{
+			name:         "Rollout",
+			binding:      km.Rollout,
+			expectedKeys: []string{"R"},
+		},
+		{
+			name:         "History",
+			binding:      km.History,
+			expectedKeys: []string{"H"},
+		},
+		{
+			name:         "Edit",
+			binding:      km.Edit,
+			expectedKeys: []string{"e"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -282,7 +372,7 @@ func TestKeyMap_FullHelp(t *testing.T) {
 	// Test selection category (second category)
 	if len(fullHelp) > 1 {
 		selectionBindings := fullHelp[1]
-		expectedSelCount := 4
+		expectedSelCount := 6
 		if len(selectionBindings) != expectedSelCount {
 			t.Errorf("expected %d selection bindings, got %d", expectedSelCount, len(selectionBindings))
 		}
@@ -291,7 +381,7 @@ func TestKeyMap_FullHelp(t *testing.T) {
 	// Test actions category (third category)
 	if len(fullHelp) > 2 {
 		actionsBindings := fullHelp[2]
-		expectedActCount := 4
+		expectedActCount := 6
 		if len(actionsBindings) != expectedActCount {
 			t.Errorf("expected %d action bindings, got %d", expectedActCount, len(actionsBindings))
 		}
@@ -300,7 +390,7 @@ func TestKeyMap_FullHelp(t *testing.T) {
 	// Test resource actions category (fourth category)
 	if len(fullHelp) > 3 {
 		resourceBindings := fullHelp[3]
-		expectedResCount := 3
+		expectedResCount := 13
 		if len(resourceBindings) != expectedResCount {
 			t.Errorf("expected %d resource action bindings, got %d", expectedResCount, len(resourceBindings))
 		}
@@ -309,7 +399,7 @@ func TestKeyMap_FullHelp(t *testing.T) {
 	// Test view actions category (fifth category)
 	if len(fullHelp) > 4 {
 		viewBindings := fullHelp[4]
-		expectedViewCount := 5
+		expectedViewCount := 6
 		if len(viewBindings) != expectedViewCount {
 			t.Errorf("expected %d view action bindings, got %d", expectedViewCount, len(viewBindings))
 		}
@@ -97,6 +97,16 @@ var (
 	DetailValueStyle = lipgloss.NewStyle().
 				Foreground(ColorText)
 
+	// Diff styles
+	DiffInsertStyle = lipgloss.NewStyle().
+			Foreground(ColorSuccess)
+
+	DiffDeleteStyle = lipgloss.NewStyle().
+			Foreground(ColorError)
+
+	DiffSkipStyle = lipgloss.NewStyle().
+			Foreground(ColorInfo)
+
 	// Table styles
 	TableHeaderStyle = lipgloss.NewStyle().
 				Foreground(ColorPrimary).
@@ -161,6 +161,9 @@ func TestStyleConstants(t *testing.T) {
 		DetailHeaderStyle,
 		DetailLabelStyle,
 		DetailValueStyle,
+		DiffInsertStyle,
+		DiffDeleteStyle,
+		DiffSkipStyle,
 		TableHeaderStyle,
 		TableRowStyle,
 		TableSelectedRowStyle,